@@ -113,6 +113,58 @@ func (x *EntityRes) GetEntityId() string {
 	return ""
 }
 
+type CertRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Revoked       bool                   `protobuf:"varint,2,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertRes) Reset() {
+	*x = CertRes{}
+	mi := &file_certs_v1_certs_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertRes) ProtoMessage() {}
+
+func (x *CertRes) ProtoReflect() protoreflect.Message {
+	mi := &file_certs_v1_certs_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertRes.ProtoReflect.Descriptor instead.
+func (*CertRes) Descriptor() ([]byte, []int) {
+	return file_certs_v1_certs_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CertRes) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *CertRes) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
 type RevokeReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EntityId      string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
@@ -122,7 +174,7 @@ type RevokeReq struct {
 
 func (x *RevokeReq) Reset() {
 	*x = RevokeReq{}
-	mi := &file_certs_v1_certs_proto_msgTypes[2]
+	mi := &file_certs_v1_certs_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -134,7 +186,7 @@ func (x *RevokeReq) String() string {
 func (*RevokeReq) ProtoMessage() {}
 
 func (x *RevokeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_certs_v1_certs_proto_msgTypes[2]
+	mi := &file_certs_v1_certs_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -147,7 +199,7 @@ func (x *RevokeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeReq.ProtoReflect.Descriptor instead.
 func (*RevokeReq) Descriptor() ([]byte, []int) {
-	return file_certs_v1_certs_proto_rawDescGZIP(), []int{2}
+	return file_certs_v1_certs_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RevokeReq) GetEntityId() string {
@@ -165,12 +217,16 @@ const file_certs_v1_certs_proto_rawDesc = "" +
 	"\tEntityReq\x12#\n" +
 	"\rserial_number\x18\x01 \x01(\tR\fserialNumber\"(\n" +
 	"\tEntityRes\x12\x1b\n" +
-	"\tentity_id\x18\x01 \x01(\tR\bentityId\"(\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\"@\n" +
+	"\aCertRes\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12\x18\n" +
+	"\arevoked\x18\x02 \x01(\bR\arevoked\"(\n" +
 	"\tRevokeReq\x12\x1b\n" +
-	"\tentity_id\x18\x01 \x01(\tR\bentityId2\x96\x01\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId2\xda\x01\n" +
 	"\fCertsService\x12C\n" +
 	"\vGetEntityID\x12\x18.absmach.certs.EntityReq\x1a\x18.absmach.certs.EntityRes\"\x00\x12A\n" +
-	"\vRevokeCerts\x12\x18.absmach.certs.RevokeReq\x1a\x16.google.protobuf.Empty\"\x00B1Z/github.com/absmach/magistrala/api/grpc/certs/v1b\x06proto3"
+	"\vRevokeCerts\x12\x18.absmach.certs.RevokeReq\x1a\x16.google.protobuf.Empty\"\x00\x12B\n" +
+	"\fRetrieveCert\x12\x18.absmach.certs.EntityReq\x1a\x16.absmach.certs.CertRes\"\x00B1Z/github.com/absmach/magistrala/api/grpc/certs/v1b\x06proto3"
 
 var (
 	file_certs_v1_certs_proto_rawDescOnce sync.Once
@@ -184,20 +240,23 @@ func file_certs_v1_certs_proto_rawDescGZIP() []byte {
 	return file_certs_v1_certs_proto_rawDescData
 }
 
-var file_certs_v1_certs_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_certs_v1_certs_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_certs_v1_certs_proto_goTypes = []any{
 	(*EntityReq)(nil),     // 0: absmach.certs.EntityReq
 	(*EntityRes)(nil),     // 1: absmach.certs.EntityRes
-	(*RevokeReq)(nil),     // 2: absmach.certs.RevokeReq
-	(*emptypb.Empty)(nil), // 3: google.protobuf.Empty
+	(*CertRes)(nil),       // 2: absmach.certs.CertRes
+	(*RevokeReq)(nil),     // 3: absmach.certs.RevokeReq
+	(*emptypb.Empty)(nil), // 4: google.protobuf.Empty
 }
 var file_certs_v1_certs_proto_depIdxs = []int32{
 	0, // 0: absmach.certs.CertsService.GetEntityID:input_type -> absmach.certs.EntityReq
-	2, // 1: absmach.certs.CertsService.RevokeCerts:input_type -> absmach.certs.RevokeReq
-	1, // 2: absmach.certs.CertsService.GetEntityID:output_type -> absmach.certs.EntityRes
-	3, // 3: absmach.certs.CertsService.RevokeCerts:output_type -> google.protobuf.Empty
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	3, // 1: absmach.certs.CertsService.RevokeCerts:input_type -> absmach.certs.RevokeReq
+	0, // 2: absmach.certs.CertsService.RetrieveCert:input_type -> absmach.certs.EntityReq
+	1, // 3: absmach.certs.CertsService.GetEntityID:output_type -> absmach.certs.EntityRes
+	4, // 4: absmach.certs.CertsService.RevokeCerts:output_type -> google.protobuf.Empty
+	2, // 5: absmach.certs.CertsService.RetrieveCert:output_type -> absmach.certs.CertRes
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -214,7 +273,7 @@ func file_certs_v1_certs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_certs_v1_certs_proto_rawDesc), len(file_certs_v1_certs_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
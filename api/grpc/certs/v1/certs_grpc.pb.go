@@ -23,8 +23,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CertsService_GetEntityID_FullMethodName = "/absmach.certs.CertsService/GetEntityID"
-	CertsService_RevokeCerts_FullMethodName = "/absmach.certs.CertsService/RevokeCerts"
+	CertsService_GetEntityID_FullMethodName  = "/absmach.certs.CertsService/GetEntityID"
+	CertsService_RevokeCerts_FullMethodName  = "/absmach.certs.CertsService/RevokeCerts"
+	CertsService_RetrieveCert_FullMethodName = "/absmach.certs.CertsService/RetrieveCert"
 )
 
 // CertsServiceClient is the client API for CertsService service.
@@ -33,6 +34,7 @@ const (
 type CertsServiceClient interface {
 	GetEntityID(ctx context.Context, in *EntityReq, opts ...grpc.CallOption) (*EntityRes, error)
 	RevokeCerts(ctx context.Context, in *RevokeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RetrieveCert(ctx context.Context, in *EntityReq, opts ...grpc.CallOption) (*CertRes, error)
 }
 
 type certsServiceClient struct {
@@ -63,12 +65,23 @@ func (c *certsServiceClient) RevokeCerts(ctx context.Context, in *RevokeReq, opt
 	return out, nil
 }
 
+func (c *certsServiceClient) RetrieveCert(ctx context.Context, in *EntityReq, opts ...grpc.CallOption) (*CertRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CertRes)
+	err := c.cc.Invoke(ctx, CertsService_RetrieveCert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CertsServiceServer is the server API for CertsService service.
 // All implementations must embed UnimplementedCertsServiceServer
 // for forward compatibility.
 type CertsServiceServer interface {
 	GetEntityID(context.Context, *EntityReq) (*EntityRes, error)
 	RevokeCerts(context.Context, *RevokeReq) (*emptypb.Empty, error)
+	RetrieveCert(context.Context, *EntityReq) (*CertRes, error)
 	mustEmbedUnimplementedCertsServiceServer()
 }
 
@@ -85,6 +98,9 @@ func (UnimplementedCertsServiceServer) GetEntityID(context.Context, *EntityReq)
 func (UnimplementedCertsServiceServer) RevokeCerts(context.Context, *RevokeReq) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method RevokeCerts not implemented")
 }
+func (UnimplementedCertsServiceServer) RetrieveCert(context.Context, *EntityReq) (*CertRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetrieveCert not implemented")
+}
 func (UnimplementedCertsServiceServer) mustEmbedUnimplementedCertsServiceServer() {}
 func (UnimplementedCertsServiceServer) testEmbeddedByValue()                      {}
 
@@ -142,6 +158,24 @@ func _CertsService_RevokeCerts_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CertsService_RetrieveCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertsServiceServer).RetrieveCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CertsService_RetrieveCert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertsServiceServer).RetrieveCert(ctx, req.(*EntityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CertsService_ServiceDesc is the grpc.ServiceDesc for CertsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +191,10 @@ var CertsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeCerts",
 			Handler:    _CertsService_RevokeCerts_Handler,
 		},
+		{
+			MethodName: "RetrieveCert",
+			Handler:    _CertsService_RetrieveCert_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "certs/v1/certs.proto",
@@ -185,6 +185,150 @@ func (*UnsetParentGroupFromChannelsRes) Descriptor() ([]byte, []int) {
 	return file_channels_v1_channels_proto_rawDescGZIP(), []int{3}
 }
 
+type ConnectReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      string                 `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DomainUserId  string                 `protobuf:"bytes,3,opt,name=domain_user_id,json=domainUserId,proto3" json:"domain_user_id,omitempty"`
+	PatId         string                 `protobuf:"bytes,4,opt,name=pat_id,json=patId,proto3" json:"pat_id,omitempty"`
+	SuperAdmin    bool                   `protobuf:"varint,5,opt,name=super_admin,json=superAdmin,proto3" json:"super_admin,omitempty"`
+	ChannelIds    []string               `protobuf:"bytes,6,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	ClientIds     []string               `protobuf:"bytes,7,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	Types         []uint32               `protobuf:"varint,8,rep,packed,name=types,proto3" json:"types,omitempty"`
+	Topics        []string               `protobuf:"bytes,9,rep,name=topics,proto3" json:"topics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectReq) Reset() {
+	*x = ConnectReq{}
+	mi := &file_channels_v1_channels_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectReq) ProtoMessage() {}
+
+func (x *ConnectReq) ProtoReflect() protoreflect.Message {
+	mi := &file_channels_v1_channels_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectReq.ProtoReflect.Descriptor instead.
+func (*ConnectReq) Descriptor() ([]byte, []int) {
+	return file_channels_v1_channels_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConnectReq) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+func (x *ConnectReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConnectReq) GetDomainUserId() string {
+	if x != nil {
+		return x.DomainUserId
+	}
+	return ""
+}
+
+func (x *ConnectReq) GetPatId() string {
+	if x != nil {
+		return x.PatId
+	}
+	return ""
+}
+
+func (x *ConnectReq) GetSuperAdmin() bool {
+	if x != nil {
+		return x.SuperAdmin
+	}
+	return false
+}
+
+func (x *ConnectReq) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+func (x *ConnectReq) GetClientIds() []string {
+	if x != nil {
+		return x.ClientIds
+	}
+	return nil
+}
+
+func (x *ConnectReq) GetTypes() []uint32 {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ConnectReq) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type ConnectRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectRes) Reset() {
+	*x = ConnectRes{}
+	mi := &file_channels_v1_channels_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectRes) ProtoMessage() {}
+
+func (x *ConnectRes) ProtoReflect() protoreflect.Message {
+	mi := &file_channels_v1_channels_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectRes.ProtoReflect.Descriptor instead.
+func (*ConnectRes) Descriptor() ([]byte, []int) {
+	return file_channels_v1_channels_proto_rawDescGZIP(), []int{5}
+}
+
 type AuthzReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DomainId      string                 `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -192,13 +336,14 @@ type AuthzReq struct {
 	ClientType    string                 `protobuf:"bytes,3,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
 	ChannelId     string                 `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	Type          uint32                 `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`
+	Subtopic      string                 `protobuf:"bytes,6,opt,name=subtopic,proto3" json:"subtopic,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AuthzReq) Reset() {
 	*x = AuthzReq{}
-	mi := &file_channels_v1_channels_proto_msgTypes[4]
+	mi := &file_channels_v1_channels_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -210,7 +355,7 @@ func (x *AuthzReq) String() string {
 func (*AuthzReq) ProtoMessage() {}
 
 func (x *AuthzReq) ProtoReflect() protoreflect.Message {
-	mi := &file_channels_v1_channels_proto_msgTypes[4]
+	mi := &file_channels_v1_channels_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -223,7 +368,7 @@ func (x *AuthzReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthzReq.ProtoReflect.Descriptor instead.
 func (*AuthzReq) Descriptor() ([]byte, []int) {
-	return file_channels_v1_channels_proto_rawDescGZIP(), []int{4}
+	return file_channels_v1_channels_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AuthzReq) GetDomainId() string {
@@ -261,6 +406,13 @@ func (x *AuthzReq) GetType() uint32 {
 	return 0
 }
 
+func (x *AuthzReq) GetSubtopic() string {
+	if x != nil {
+		return x.Subtopic
+	}
+	return ""
+}
+
 type AuthzRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Authorized    bool                   `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
@@ -270,7 +422,7 @@ type AuthzRes struct {
 
 func (x *AuthzRes) Reset() {
 	*x = AuthzRes{}
-	mi := &file_channels_v1_channels_proto_msgTypes[5]
+	mi := &file_channels_v1_channels_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -282,7 +434,7 @@ func (x *AuthzRes) String() string {
 func (*AuthzRes) ProtoMessage() {}
 
 func (x *AuthzRes) ProtoReflect() protoreflect.Message {
-	mi := &file_channels_v1_channels_proto_msgTypes[5]
+	mi := &file_channels_v1_channels_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,7 +447,7 @@ func (x *AuthzRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthzRes.ProtoReflect.Descriptor instead.
 func (*AuthzRes) Descriptor() ([]byte, []int) {
-	return file_channels_v1_channels_proto_rawDescGZIP(), []int{5}
+	return file_channels_v1_channels_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AuthzRes) GetAuthorized() bool {
@@ -315,7 +467,23 @@ const file_channels_v1_channels_proto_rawDesc = "" +
 	"\x1aRemoveClientConnectionsRes\"I\n" +
 	"\x1fUnsetParentGroupFromChannelsReq\x12&\n" +
 	"\x0fparent_group_id\x18\x01 \x01(\tR\rparentGroupId\"!\n" +
-	"\x1fUnsetParentGroupFromChannelsRes\"\x98\x01\n" +
+	"\x1fUnsetParentGroupFromChannelsRes\"\x8e\x02\n" +
+	"\n" +
+	"ConnectReq\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\tR\bdomainId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x0edomain_user_id\x18\x03 \x01(\tR\fdomainUserId\x12\x15\n" +
+	"\x06pat_id\x18\x04 \x01(\tR\x05patId\x12\x1f\n" +
+	"\vsuper_admin\x18\x05 \x01(\bR\n" +
+	"superAdmin\x12\x1f\n" +
+	"\vchannel_ids\x18\x06 \x03(\tR\n" +
+	"channelIds\x12\x1d\n" +
+	"\n" +
+	"client_ids\x18\a \x03(\tR\tclientIds\x12\x14\n" +
+	"\x05types\x18\b \x03(\rR\x05types\x12\x16\n" +
+	"\x06topics\x18\t \x03(\tR\x06topics\"\f\n" +
+	"\n" +
+	"ConnectRes\"\xb4\x01\n" +
 	"\bAuthzReq\x12\x1b\n" +
 	"\tdomain_id\x18\x01 \x01(\tR\bdomainId\x12\x1b\n" +
 	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1f\n" +
@@ -323,17 +491,19 @@ const file_channels_v1_channels_proto_rawDesc = "" +
 	"clientType\x12\x1d\n" +
 	"\n" +
 	"channel_id\x18\x04 \x01(\tR\tchannelId\x12\x12\n" +
-	"\x04type\x18\x05 \x01(\rR\x04type\"*\n" +
+	"\x04type\x18\x05 \x01(\rR\x04type\x12\x1a\n" +
+	"\bsubtopic\x18\x06 \x01(\tR\bsubtopic\"*\n" +
 	"\bAuthzRes\x12\x1e\n" +
 	"\n" +
 	"authorized\x18\x01 \x01(\bR\n" +
-	"authorized2\xe1\x03\n" +
+	"authorized2\xa0\x04\n" +
 	"\x0fChannelsService\x12;\n" +
 	"\tAuthorize\x12\x15.channels.v1.AuthzReq\x1a\x15.channels.v1.AuthzRes\"\x00\x12m\n" +
 	"\x17RemoveClientConnections\x12'.channels.v1.RemoveClientConnectionsReq\x1a'.channels.v1.RemoveClientConnectionsRes\"\x00\x12|\n" +
 	"\x1cUnsetParentGroupFromChannels\x12,.channels.v1.UnsetParentGroupFromChannelsReq\x1a,.channels.v1.UnsetParentGroupFromChannelsRes\"\x00\x12N\n" +
 	"\x0eRetrieveEntity\x12\x1c.common.v1.RetrieveEntityReq\x1a\x1c.common.v1.RetrieveEntityRes\"\x00\x12T\n" +
-	"\x11RetrieveIDByRoute\x12\x1f.common.v1.RetrieveIDByRouteReq\x1a\x1c.common.v1.RetrieveEntityRes\"\x00B4Z2github.com/absmach/magistrala/api/grpc/channels/v1b\x06proto3"
+	"\x11RetrieveIDByRoute\x12\x1f.common.v1.RetrieveIDByRouteReq\x1a\x1c.common.v1.RetrieveEntityRes\"\x00\x12=\n" +
+	"\aConnect\x12\x17.channels.v1.ConnectReq\x1a\x17.channels.v1.ConnectRes\"\x00B4Z2github.com/absmach/magistrala/api/grpc/channels/v1b\x06proto3"
 
 var (
 	file_channels_v1_channels_proto_rawDescOnce sync.Once
@@ -347,34 +517,38 @@ func file_channels_v1_channels_proto_rawDescGZIP() []byte {
 	return file_channels_v1_channels_proto_rawDescData
 }
 
-var file_channels_v1_channels_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_channels_v1_channels_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_channels_v1_channels_proto_goTypes = []any{
 	(*RemoveClientConnectionsReq)(nil),      // 0: channels.v1.RemoveClientConnectionsReq
 	(*RemoveClientConnectionsRes)(nil),      // 1: channels.v1.RemoveClientConnectionsRes
 	(*UnsetParentGroupFromChannelsReq)(nil), // 2: channels.v1.UnsetParentGroupFromChannelsReq
 	(*UnsetParentGroupFromChannelsRes)(nil), // 3: channels.v1.UnsetParentGroupFromChannelsRes
-	(*AuthzReq)(nil),                        // 4: channels.v1.AuthzReq
-	(*AuthzRes)(nil),                        // 5: channels.v1.AuthzRes
-	(*v1.RetrieveEntityReq)(nil),            // 6: common.v1.RetrieveEntityReq
-	(*v1.RetrieveIDByRouteReq)(nil),         // 7: common.v1.RetrieveIDByRouteReq
-	(*v1.RetrieveEntityRes)(nil),            // 8: common.v1.RetrieveEntityRes
+	(*ConnectReq)(nil),                      // 4: channels.v1.ConnectReq
+	(*ConnectRes)(nil),                      // 5: channels.v1.ConnectRes
+	(*AuthzReq)(nil),                        // 6: channels.v1.AuthzReq
+	(*AuthzRes)(nil),                        // 7: channels.v1.AuthzRes
+	(*v1.RetrieveEntityReq)(nil),            // 8: common.v1.RetrieveEntityReq
+	(*v1.RetrieveIDByRouteReq)(nil),         // 9: common.v1.RetrieveIDByRouteReq
+	(*v1.RetrieveEntityRes)(nil),            // 10: common.v1.RetrieveEntityRes
 }
 var file_channels_v1_channels_proto_depIdxs = []int32{
-	4, // 0: channels.v1.ChannelsService.Authorize:input_type -> channels.v1.AuthzReq
-	0, // 1: channels.v1.ChannelsService.RemoveClientConnections:input_type -> channels.v1.RemoveClientConnectionsReq
-	2, // 2: channels.v1.ChannelsService.UnsetParentGroupFromChannels:input_type -> channels.v1.UnsetParentGroupFromChannelsReq
-	6, // 3: channels.v1.ChannelsService.RetrieveEntity:input_type -> common.v1.RetrieveEntityReq
-	7, // 4: channels.v1.ChannelsService.RetrieveIDByRoute:input_type -> common.v1.RetrieveIDByRouteReq
-	5, // 5: channels.v1.ChannelsService.Authorize:output_type -> channels.v1.AuthzRes
-	1, // 6: channels.v1.ChannelsService.RemoveClientConnections:output_type -> channels.v1.RemoveClientConnectionsRes
-	3, // 7: channels.v1.ChannelsService.UnsetParentGroupFromChannels:output_type -> channels.v1.UnsetParentGroupFromChannelsRes
-	8, // 8: channels.v1.ChannelsService.RetrieveEntity:output_type -> common.v1.RetrieveEntityRes
-	8, // 9: channels.v1.ChannelsService.RetrieveIDByRoute:output_type -> common.v1.RetrieveEntityRes
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	6,  // 0: channels.v1.ChannelsService.Authorize:input_type -> channels.v1.AuthzReq
+	0,  // 1: channels.v1.ChannelsService.RemoveClientConnections:input_type -> channels.v1.RemoveClientConnectionsReq
+	2,  // 2: channels.v1.ChannelsService.UnsetParentGroupFromChannels:input_type -> channels.v1.UnsetParentGroupFromChannelsReq
+	8,  // 3: channels.v1.ChannelsService.RetrieveEntity:input_type -> common.v1.RetrieveEntityReq
+	9,  // 4: channels.v1.ChannelsService.RetrieveIDByRoute:input_type -> common.v1.RetrieveIDByRouteReq
+	4,  // 5: channels.v1.ChannelsService.Connect:input_type -> channels.v1.ConnectReq
+	7,  // 6: channels.v1.ChannelsService.Authorize:output_type -> channels.v1.AuthzRes
+	1,  // 7: channels.v1.ChannelsService.RemoveClientConnections:output_type -> channels.v1.RemoveClientConnectionsRes
+	3,  // 8: channels.v1.ChannelsService.UnsetParentGroupFromChannels:output_type -> channels.v1.UnsetParentGroupFromChannelsRes
+	10, // 9: channels.v1.ChannelsService.RetrieveEntity:output_type -> common.v1.RetrieveEntityRes
+	10, // 10: channels.v1.ChannelsService.RetrieveIDByRoute:output_type -> common.v1.RetrieveEntityRes
+	5,  // 11: channels.v1.ChannelsService.Connect:output_type -> channels.v1.ConnectRes
+	6,  // [6:12] is the sub-list for method output_type
+	0,  // [0:6] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_channels_v1_channels_proto_init() }
@@ -388,7 +562,7 @@ func file_channels_v1_channels_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_channels_v1_channels_proto_rawDesc), len(file_channels_v1_channels_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -230,6 +230,8 @@ type EntityBasic struct {
 	DomainId      string                 `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	ParentGroupId string                 `protobuf:"bytes,3,opt,name=parent_group_id,json=parentGroupId,proto3" json:"parent_group_id,omitempty"`
 	Status        uint32                 `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	// JSON encoded entity metadata, set only by services which expose it.
+	Metadata      []byte `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -292,6 +294,13 @@ func (x *EntityBasic) GetStatus() uint32 {
 	return 0
 }
 
+func (x *EntityBasic) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type AddConnectionsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Connections   []*Connection          `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
@@ -474,6 +483,7 @@ type Connection struct {
 	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	DomainId      string                 `protobuf:"bytes,3,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	Type          uint32                 `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	Topics        []string               `protobuf:"bytes,5,rep,name=topics,proto3" json:"topics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -536,6 +546,13 @@ func (x *Connection) GetType() uint32 {
 	return 0
 }
 
+func (x *Connection) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
 type RetrieveIDByRouteReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Route         string                 `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
@@ -603,12 +620,13 @@ const file_common_v1_common_proto_rawDesc = "" +
 	"\x11RetrieveEntityReq\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
 	"\x11RetrieveEntityRes\x12.\n" +
-	"\x06entity\x18\x01 \x01(\v2\x16.common.v1.EntityBasicR\x06entity\"z\n" +
+	"\x06entity\x18\x01 \x01(\v2\x16.common.v1.EntityBasicR\x06entity\"\x96\x01\n" +
 	"\vEntityBasic\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tdomain_id\x18\x02 \x01(\tR\bdomainId\x12&\n" +
 	"\x0fparent_group_id\x18\x03 \x01(\tR\rparentGroupId\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\rR\x06status\"L\n" +
+	"\x06status\x18\x04 \x01(\rR\x06status\x12\x1a\n" +
+	"\bmetadata\x18\x05 \x01(\fR\bmetadata\"L\n" +
 	"\x11AddConnectionsReq\x127\n" +
 	"\vconnections\x18\x01 \x03(\v2\x15.common.v1.ConnectionR\vconnections\"#\n" +
 	"\x11AddConnectionsRes\x12\x0e\n" +
@@ -616,14 +634,15 @@ const file_common_v1_common_proto_rawDesc = "" +
 	"\x14RemoveConnectionsReq\x127\n" +
 	"\vconnections\x18\x01 \x03(\v2\x15.common.v1.ConnectionR\vconnections\"&\n" +
 	"\x14RemoveConnectionsRes\x12\x0e\n" +
-	"\x02ok\x18\x01 \x01(\bR\x02ok\"y\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\"\x91\x01\n" +
 	"\n" +
 	"Connection\x12\x1b\n" +
 	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x1d\n" +
 	"\n" +
 	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1b\n" +
 	"\tdomain_id\x18\x03 \x01(\tR\bdomainId\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\rR\x04type\"I\n" +
+	"\x04type\x18\x04 \x01(\rR\x04type\x12\x16\n" +
+	"\x06topics\x18\x05 \x03(\tR\x06topics\"I\n" +
 	"\x14RetrieveIDByRouteReq\x12\x14\n" +
 	"\x05route\x18\x01 \x01(\tR\x05route\x12\x1b\n" +
 	"\tdomain_id\x18\x02 \x01(\tR\bdomainIdB2Z0github.com/absmach/magistrala/api/grpc/common/v1b\x06proto3"
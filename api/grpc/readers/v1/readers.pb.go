@@ -34,17 +34,29 @@ const (
 	Aggregation_AGGREGATION_SUM         Aggregation = 3
 	Aggregation_AGGREGATION_COUNT       Aggregation = 4
 	Aggregation_AGGREGATION_AVG         Aggregation = 5
+	Aggregation_AGGREGATION_PERCENTILE  Aggregation = 6
+	Aggregation_AGGREGATION_FIRST       Aggregation = 7
+	Aggregation_AGGREGATION_LAST        Aggregation = 8
+	Aggregation_AGGREGATION_STDDEV      Aggregation = 9
+	Aggregation_AGGREGATION_RATE        Aggregation = 10
+	Aggregation_AGGREGATION_INTEGRAL    Aggregation = 11
 )
 
 // Enum value maps for Aggregation.
 var (
 	Aggregation_name = map[int32]string{
-		0: "AGGREGATION_UNSPECIFIED",
-		1: "AGGREGATION_MAX",
-		2: "AGGREGATION_MIN",
-		3: "AGGREGATION_SUM",
-		4: "AGGREGATION_COUNT",
-		5: "AGGREGATION_AVG",
+		0:  "AGGREGATION_UNSPECIFIED",
+		1:  "AGGREGATION_MAX",
+		2:  "AGGREGATION_MIN",
+		3:  "AGGREGATION_SUM",
+		4:  "AGGREGATION_COUNT",
+		5:  "AGGREGATION_AVG",
+		6:  "AGGREGATION_PERCENTILE",
+		7:  "AGGREGATION_FIRST",
+		8:  "AGGREGATION_LAST",
+		9:  "AGGREGATION_STDDEV",
+		10: "AGGREGATION_RATE",
+		11: "AGGREGATION_INTEGRAL",
 	}
 	Aggregation_value = map[string]int32{
 		"AGGREGATION_UNSPECIFIED": 0,
@@ -53,6 +65,12 @@ var (
 		"AGGREGATION_SUM":         3,
 		"AGGREGATION_COUNT":       4,
 		"AGGREGATION_AVG":         5,
+		"AGGREGATION_PERCENTILE":  6,
+		"AGGREGATION_FIRST":       7,
+		"AGGREGATION_LAST":        8,
+		"AGGREGATION_STDDEV":      9,
+		"AGGREGATION_RATE":        10,
+		"AGGREGATION_INTEGRAL":    11,
 	}
 )
 
@@ -84,28 +102,31 @@ func (Aggregation) EnumDescriptor() ([]byte, []int) {
 }
 
 type PageMetadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         uint64                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        uint64                 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Value         float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
-	Publisher     string                 `protobuf:"bytes,6,opt,name=publisher,proto3" json:"publisher,omitempty"`
-	BoolValue     bool                   `protobuf:"varint,7,opt,name=bool_value,json=boolValue,proto3" json:"bool_value,omitempty"`
-	StringValue   string                 `protobuf:"bytes,8,opt,name=string_value,json=stringValue,proto3" json:"string_value,omitempty"`
-	DataValue     string                 `protobuf:"bytes,9,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
-	From          float64                `protobuf:"fixed64,10,opt,name=from,proto3" json:"from,omitempty"`
-	To            float64                `protobuf:"fixed64,11,opt,name=to,proto3" json:"to,omitempty"`
-	Subtopic      string                 `protobuf:"bytes,12,opt,name=subtopic,proto3" json:"subtopic,omitempty"`
-	Interval      string                 `protobuf:"bytes,13,opt,name=interval,proto3" json:"interval,omitempty"`
-	Read          bool                   `protobuf:"varint,14,opt,name=read,proto3" json:"read,omitempty"`
-	Aggregation   Aggregation            `protobuf:"varint,15,opt,name=aggregation,proto3,enum=readers.v1.Aggregation" json:"aggregation,omitempty"`
-	Comparator    string                 `protobuf:"bytes,16,opt,name=comparator,proto3" json:"comparator,omitempty"`
-	Format        string                 `protobuf:"bytes,17,opt,name=format,proto3" json:"format,omitempty"`
-	Order         string                 `protobuf:"bytes,18,opt,name=order,proto3" json:"order,omitempty"`
-	Dir           string                 `protobuf:"bytes,19,opt,name=dir,proto3" json:"dir,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Limit          uint64                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         uint64                 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Protocol       string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Value          float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	Publisher      string                 `protobuf:"bytes,6,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	BoolValue      bool                   `protobuf:"varint,7,opt,name=bool_value,json=boolValue,proto3" json:"bool_value,omitempty"`
+	StringValue    string                 `protobuf:"bytes,8,opt,name=string_value,json=stringValue,proto3" json:"string_value,omitempty"`
+	DataValue      string                 `protobuf:"bytes,9,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
+	From           float64                `protobuf:"fixed64,10,opt,name=from,proto3" json:"from,omitempty"`
+	To             float64                `protobuf:"fixed64,11,opt,name=to,proto3" json:"to,omitempty"`
+	Subtopic       string                 `protobuf:"bytes,12,opt,name=subtopic,proto3" json:"subtopic,omitempty"`
+	Interval       string                 `protobuf:"bytes,13,opt,name=interval,proto3" json:"interval,omitempty"`
+	Read           bool                   `protobuf:"varint,14,opt,name=read,proto3" json:"read,omitempty"`
+	Aggregation    Aggregation            `protobuf:"varint,15,opt,name=aggregation,proto3,enum=readers.v1.Aggregation" json:"aggregation,omitempty"`
+	Comparator     string                 `protobuf:"bytes,16,opt,name=comparator,proto3" json:"comparator,omitempty"`
+	Format         string                 `protobuf:"bytes,17,opt,name=format,proto3" json:"format,omitempty"`
+	Order          string                 `protobuf:"bytes,18,opt,name=order,proto3" json:"order,omitempty"`
+	Dir            string                 `protobuf:"bytes,19,opt,name=dir,proto3" json:"dir,omitempty"`
+	Percentile     float64                `protobuf:"fixed64,20,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	Fill           string                 `protobuf:"bytes,21,opt,name=fill,proto3" json:"fill,omitempty"`
+	PayloadFilters []*PayloadFilter       `protobuf:"bytes,22,rep,name=payload_filters,json=payloadFilters,proto3" json:"payload_filters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PageMetadata) Reset() {
@@ -271,6 +292,89 @@ func (x *PageMetadata) GetDir() string {
 	return ""
 }
 
+func (x *PageMetadata) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *PageMetadata) GetFill() string {
+	if x != nil {
+		return x.Fill
+	}
+	return ""
+}
+
+func (x *PageMetadata) GetPayloadFilters() []*PayloadFilter {
+	if x != nil {
+		return x.PayloadFilters
+	}
+	return nil
+}
+
+// PayloadFilter is a condition on a nested field of JSON message payload.
+type PayloadFilter struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Path       string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Comparator string                 `protobuf:"bytes,2,opt,name=comparator,proto3" json:"comparator,omitempty"`
+	// JSON encoded value.
+	Value         []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayloadFilter) Reset() {
+	*x = PayloadFilter{}
+	mi := &file_readers_v1_readers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayloadFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayloadFilter) ProtoMessage() {}
+
+func (x *PayloadFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_readers_v1_readers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayloadFilter.ProtoReflect.Descriptor instead.
+func (*PayloadFilter) Descriptor() ([]byte, []int) {
+	return file_readers_v1_readers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PayloadFilter) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PayloadFilter) GetComparator() string {
+	if x != nil {
+		return x.Comparator
+	}
+	return ""
+}
+
+func (x *PayloadFilter) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 type ReadMessagesRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
@@ -282,7 +386,7 @@ type ReadMessagesRes struct {
 
 func (x *ReadMessagesRes) Reset() {
 	*x = ReadMessagesRes{}
-	mi := &file_readers_v1_readers_proto_msgTypes[1]
+	mi := &file_readers_v1_readers_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -294,7 +398,7 @@ func (x *ReadMessagesRes) String() string {
 func (*ReadMessagesRes) ProtoMessage() {}
 
 func (x *ReadMessagesRes) ProtoReflect() protoreflect.Message {
-	mi := &file_readers_v1_readers_proto_msgTypes[1]
+	mi := &file_readers_v1_readers_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +411,7 @@ func (x *ReadMessagesRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadMessagesRes.ProtoReflect.Descriptor instead.
 func (*ReadMessagesRes) Descriptor() ([]byte, []int) {
-	return file_readers_v1_readers_proto_rawDescGZIP(), []int{1}
+	return file_readers_v1_readers_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ReadMessagesRes) GetTotal() uint64 {
@@ -344,7 +448,7 @@ type Message struct {
 
 func (x *Message) Reset() {
 	*x = Message{}
-	mi := &file_readers_v1_readers_proto_msgTypes[2]
+	mi := &file_readers_v1_readers_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -356,7 +460,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_readers_v1_readers_proto_msgTypes[2]
+	mi := &file_readers_v1_readers_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +473,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_readers_v1_readers_proto_rawDescGZIP(), []int{2}
+	return file_readers_v1_readers_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Message) GetPayload() isMessage_Payload {
@@ -425,7 +529,7 @@ type BaseMessage struct {
 
 func (x *BaseMessage) Reset() {
 	*x = BaseMessage{}
-	mi := &file_readers_v1_readers_proto_msgTypes[3]
+	mi := &file_readers_v1_readers_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +541,7 @@ func (x *BaseMessage) String() string {
 func (*BaseMessage) ProtoMessage() {}
 
 func (x *BaseMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_readers_v1_readers_proto_msgTypes[3]
+	mi := &file_readers_v1_readers_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +554,7 @@ func (x *BaseMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseMessage.ProtoReflect.Descriptor instead.
 func (*BaseMessage) Descriptor() ([]byte, []int) {
-	return file_readers_v1_readers_proto_rawDescGZIP(), []int{3}
+	return file_readers_v1_readers_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BaseMessage) GetChannel() string {
@@ -499,7 +603,7 @@ type SenMLMessage struct {
 
 func (x *SenMLMessage) Reset() {
 	*x = SenMLMessage{}
-	mi := &file_readers_v1_readers_proto_msgTypes[4]
+	mi := &file_readers_v1_readers_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -511,7 +615,7 @@ func (x *SenMLMessage) String() string {
 func (*SenMLMessage) ProtoMessage() {}
 
 func (x *SenMLMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_readers_v1_readers_proto_msgTypes[4]
+	mi := &file_readers_v1_readers_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,7 +628,7 @@ func (x *SenMLMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SenMLMessage.ProtoReflect.Descriptor instead.
 func (*SenMLMessage) Descriptor() ([]byte, []int) {
-	return file_readers_v1_readers_proto_rawDescGZIP(), []int{4}
+	return file_readers_v1_readers_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SenMLMessage) GetBase() *BaseMessage {
@@ -608,7 +712,7 @@ type JsonMessage struct {
 
 func (x *JsonMessage) Reset() {
 	*x = JsonMessage{}
-	mi := &file_readers_v1_readers_proto_msgTypes[5]
+	mi := &file_readers_v1_readers_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -620,7 +724,7 @@ func (x *JsonMessage) String() string {
 func (*JsonMessage) ProtoMessage() {}
 
 func (x *JsonMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_readers_v1_readers_proto_msgTypes[5]
+	mi := &file_readers_v1_readers_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +737,7 @@ func (x *JsonMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JsonMessage.ProtoReflect.Descriptor instead.
 func (*JsonMessage) Descriptor() ([]byte, []int) {
-	return file_readers_v1_readers_proto_rawDescGZIP(), []int{5}
+	return file_readers_v1_readers_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *JsonMessage) GetBase() *BaseMessage {
@@ -668,7 +772,7 @@ type ReadMessagesReq struct {
 
 func (x *ReadMessagesReq) Reset() {
 	*x = ReadMessagesReq{}
-	mi := &file_readers_v1_readers_proto_msgTypes[6]
+	mi := &file_readers_v1_readers_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -680,7 +784,7 @@ func (x *ReadMessagesReq) String() string {
 func (*ReadMessagesReq) ProtoMessage() {}
 
 func (x *ReadMessagesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_readers_v1_readers_proto_msgTypes[6]
+	mi := &file_readers_v1_readers_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +797,7 @@ func (x *ReadMessagesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadMessagesReq.ProtoReflect.Descriptor instead.
 func (*ReadMessagesReq) Descriptor() ([]byte, []int) {
-	return file_readers_v1_readers_proto_rawDescGZIP(), []int{6}
+	return file_readers_v1_readers_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ReadMessagesReq) GetChannelId() string {
@@ -722,7 +826,7 @@ var File_readers_v1_readers_proto protoreflect.FileDescriptor
 const file_readers_v1_readers_proto_rawDesc = "" +
 	"\n" +
 	"\x18readers/v1/readers.proto\x12\n" +
-	"readers.v1\"\x8c\x04\n" +
+	"readers.v1\"\x84\x05\n" +
 	"\fPageMetadata\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x04R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x04R\x06offset\x12\x1a\n" +
@@ -747,7 +851,18 @@ const file_readers_v1_readers_proto_rawDesc = "" +
 	"comparator\x12\x16\n" +
 	"\x06format\x18\x11 \x01(\tR\x06format\x12\x14\n" +
 	"\x05order\x18\x12 \x01(\tR\x05order\x12\x10\n" +
-	"\x03dir\x18\x13 \x01(\tR\x03dir\"\x97\x01\n" +
+	"\x03dir\x18\x13 \x01(\tR\x03dir\x12\x1e\n" +
+	"\n" +
+	"percentile\x18\x14 \x01(\x01R\n" +
+	"percentile\x12\x12\n" +
+	"\x04fill\x18\x15 \x01(\tR\x04fill\x12B\n" +
+	"\x0fpayload_filters\x18\x16 \x03(\v2\x19.readers.v1.PayloadFilterR\x0epayloadFilters\"Y\n" +
+	"\rPayloadFilter\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1e\n" +
+	"\n" +
+	"comparator\x18\x02 \x01(\tR\n" +
+	"comparator\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\fR\x05value\"\x97\x01\n" +
 	"\x0fReadMessagesRes\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x04R\x05total\x12=\n" +
 	"\rpage_metadata\x18\x02 \x01(\v2\x18.readers.v1.PageMetadataR\fpageMetadata\x12/\n" +
@@ -789,14 +904,21 @@ const file_readers_v1_readers_proto_rawDesc = "" +
 	"\n" +
 	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x1b\n" +
 	"\tdomain_id\x18\x02 \x01(\tR\bdomainId\x12=\n" +
-	"\rpage_metadata\x18\x03 \x01(\v2\x18.readers.v1.PageMetadataR\fpageMetadata*\x95\x01\n" +
+	"\rpage_metadata\x18\x03 \x01(\v2\x18.readers.v1.PageMetadataR\fpageMetadata*\xa6\x02\n" +
 	"\vAggregation\x12\x1b\n" +
 	"\x17AGGREGATION_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fAGGREGATION_MAX\x10\x01\x12\x13\n" +
 	"\x0fAGGREGATION_MIN\x10\x02\x12\x13\n" +
 	"\x0fAGGREGATION_SUM\x10\x03\x12\x15\n" +
 	"\x11AGGREGATION_COUNT\x10\x04\x12\x13\n" +
-	"\x0fAGGREGATION_AVG\x10\x052\\\n" +
+	"\x0fAGGREGATION_AVG\x10\x05\x12\x1a\n" +
+	"\x16AGGREGATION_PERCENTILE\x10\x06\x12\x15\n" +
+	"\x11AGGREGATION_FIRST\x10\a\x12\x14\n" +
+	"\x10AGGREGATION_LAST\x10\b\x12\x16\n" +
+	"\x12AGGREGATION_STDDEV\x10\t\x12\x14\n" +
+	"\x10AGGREGATION_RATE\x10\n" +
+	"\x12\x18\n" +
+	"\x14AGGREGATION_INTEGRAL\x10\v2\\\n" +
 	"\x0eReadersService\x12J\n" +
 	"\fReadMessages\x12\x1b.readers.v1.ReadMessagesReq\x1a\x1b.readers.v1.ReadMessagesRes\"\x00B3Z1github.com/absmach/magistrala/api/grpc/readers/v1b\x06proto3"
 
@@ -813,33 +935,35 @@ func file_readers_v1_readers_proto_rawDescGZIP() []byte {
 }
 
 var file_readers_v1_readers_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_readers_v1_readers_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_readers_v1_readers_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_readers_v1_readers_proto_goTypes = []any{
 	(Aggregation)(0),        // 0: readers.v1.Aggregation
 	(*PageMetadata)(nil),    // 1: readers.v1.PageMetadata
-	(*ReadMessagesRes)(nil), // 2: readers.v1.ReadMessagesRes
-	(*Message)(nil),         // 3: readers.v1.Message
-	(*BaseMessage)(nil),     // 4: readers.v1.BaseMessage
-	(*SenMLMessage)(nil),    // 5: readers.v1.SenMLMessage
-	(*JsonMessage)(nil),     // 6: readers.v1.JsonMessage
-	(*ReadMessagesReq)(nil), // 7: readers.v1.ReadMessagesReq
+	(*PayloadFilter)(nil),   // 2: readers.v1.PayloadFilter
+	(*ReadMessagesRes)(nil), // 3: readers.v1.ReadMessagesRes
+	(*Message)(nil),         // 4: readers.v1.Message
+	(*BaseMessage)(nil),     // 5: readers.v1.BaseMessage
+	(*SenMLMessage)(nil),    // 6: readers.v1.SenMLMessage
+	(*JsonMessage)(nil),     // 7: readers.v1.JsonMessage
+	(*ReadMessagesReq)(nil), // 8: readers.v1.ReadMessagesReq
 }
 var file_readers_v1_readers_proto_depIdxs = []int32{
-	0, // 0: readers.v1.PageMetadata.aggregation:type_name -> readers.v1.Aggregation
-	1, // 1: readers.v1.ReadMessagesRes.page_metadata:type_name -> readers.v1.PageMetadata
-	3, // 2: readers.v1.ReadMessagesRes.messages:type_name -> readers.v1.Message
-	5, // 3: readers.v1.Message.senml:type_name -> readers.v1.SenMLMessage
-	6, // 4: readers.v1.Message.json:type_name -> readers.v1.JsonMessage
-	4, // 5: readers.v1.SenMLMessage.base:type_name -> readers.v1.BaseMessage
-	4, // 6: readers.v1.JsonMessage.base:type_name -> readers.v1.BaseMessage
-	1, // 7: readers.v1.ReadMessagesReq.page_metadata:type_name -> readers.v1.PageMetadata
-	7, // 8: readers.v1.ReadersService.ReadMessages:input_type -> readers.v1.ReadMessagesReq
-	2, // 9: readers.v1.ReadersService.ReadMessages:output_type -> readers.v1.ReadMessagesRes
-	9, // [9:10] is the sub-list for method output_type
-	8, // [8:9] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: readers.v1.PageMetadata.aggregation:type_name -> readers.v1.Aggregation
+	2,  // 1: readers.v1.PageMetadata.payload_filters:type_name -> readers.v1.PayloadFilter
+	1,  // 2: readers.v1.ReadMessagesRes.page_metadata:type_name -> readers.v1.PageMetadata
+	4,  // 3: readers.v1.ReadMessagesRes.messages:type_name -> readers.v1.Message
+	6,  // 4: readers.v1.Message.senml:type_name -> readers.v1.SenMLMessage
+	7,  // 5: readers.v1.Message.json:type_name -> readers.v1.JsonMessage
+	5,  // 6: readers.v1.SenMLMessage.base:type_name -> readers.v1.BaseMessage
+	5,  // 7: readers.v1.JsonMessage.base:type_name -> readers.v1.BaseMessage
+	1,  // 8: readers.v1.ReadMessagesReq.page_metadata:type_name -> readers.v1.PageMetadata
+	8,  // 9: readers.v1.ReadersService.ReadMessages:input_type -> readers.v1.ReadMessagesReq
+	3,  // 10: readers.v1.ReadersService.ReadMessages:output_type -> readers.v1.ReadMessagesRes
+	10, // [10:11] is the sub-list for method output_type
+	9,  // [9:10] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_readers_v1_readers_proto_init() }
@@ -847,18 +971,18 @@ func file_readers_v1_readers_proto_init() {
 	if File_readers_v1_readers_proto != nil {
 		return
 	}
-	file_readers_v1_readers_proto_msgTypes[2].OneofWrappers = []any{
+	file_readers_v1_readers_proto_msgTypes[3].OneofWrappers = []any{
 		(*Message_Senml)(nil),
 		(*Message_Json)(nil),
 	}
-	file_readers_v1_readers_proto_msgTypes[4].OneofWrappers = []any{}
+	file_readers_v1_readers_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_readers_v1_readers_proto_rawDesc), len(file_readers_v1_readers_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
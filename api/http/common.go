@@ -39,6 +39,7 @@ const (
 	TagKey      = "tag"
 	TagsKey     = "tags"
 	StatusKey   = "status"
+	OnlineKey   = "online"
 
 	ClientKey   = "client"
 	ChannelKey  = "channel"
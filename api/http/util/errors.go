@@ -33,6 +33,9 @@ var (
 	// ErrMissingConnectionType indicates missing connection tpye.
 	ErrMissingConnectionType = errors.NewRequestError("missing connection type")
 
+	// ErrInvalidConnectionTopic indicates an invalid connection topic pattern.
+	ErrInvalidConnectionTopic = errors.NewRequestError("invalid connection topic")
+
 	// ErrMissingParentGroupID indicates missing parent group ID.
 	ErrMissingParentGroupID = errors.NewRequestError("missing parent group id")
 
@@ -171,6 +174,9 @@ var (
 	// ErrMissingSecret indicates missing secret.
 	ErrMissingSecret = errors.NewRequestError("missing secret")
 
+	// ErrInvalidSecretOverlap indicates invalid overlap period of the rotated secret.
+	ErrInvalidSecretOverlap = errors.NewRequestError("invalid secret overlap period")
+
 	// ErrPasswordFormat indicates weak password.
 	ErrPasswordFormat = errors.NewRequestError("password does not meet the requirements")
 
@@ -204,12 +210,30 @@ var (
 	// ErrInvalidInterval indicates invalid interval value.
 	ErrInvalidInterval = errors.NewRequestError("invalid interval value")
 
+	// ErrTooManyBuckets indicates aggregation interval too small for the time range.
+	ErrTooManyBuckets = errors.NewRequestError("too many aggregation buckets, use a larger interval or a shorter time range")
+
+	// ErrInvalidPercentile indicates invalid percentile value.
+	ErrInvalidPercentile = errors.NewRequestError("invalid percentile value")
+
+	// ErrInvalidFill indicates invalid gap-filling value.
+	ErrInvalidFill = errors.NewRequestError("invalid fill value")
+
+	// ErrInvalidPayloadFilter indicates invalid JSON payload filter.
+	ErrInvalidPayloadFilter = errors.NewRequestError("invalid payload filter")
+
 	// ErrMissingFrom indicates missing from value.
 	ErrMissingFrom = errors.NewRequestError("missing from time value")
 
 	// ErrMissingTo indicates missing to value.
 	ErrMissingTo = errors.NewRequestError("missing to time value")
 
+	// ErrMissingReplayStart indicates missing replay start time and sequence.
+	ErrMissingReplayStart = errors.NewRequestError("missing replay start time or sequence")
+
+	// ErrInvalidReplayRange indicates invalid replay start or end.
+	ErrInvalidReplayRange = errors.NewRequestError("invalid replay range")
+
 	// ErrEmptyMessage indicates empty message.
 	ErrEmptyMessage = errors.NewRequestError("empty message")
 
@@ -277,4 +301,13 @@ var (
 
 	// ErrMalformedRequest indicates malformed request body.
 	ErrMalformedRequestBody = errors.NewRequestError("request body is not a valid JSON, expecting a valid JSON")
+
+	// ErrMissingCommandName indicates missing command name.
+	ErrMissingCommandName = errors.NewRequestError("missing command name")
+
+	// ErrInvalidCommandTimeout indicates an invalid command timeout.
+	ErrInvalidCommandTimeout = errors.NewRequestError("invalid command timeout")
+
+	// ErrMissingTwinState indicates missing twin state.
+	ErrMissingTwinState = errors.NewRequestError("missing twin state")
 )
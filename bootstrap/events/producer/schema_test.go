@@ -0,0 +1,135 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package producer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/absmach/magistrala/bootstrap"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bootstrapPrefix = "bootstrap."
+
+func TestEventSchemas(t *testing.T) {
+	config := bootstrap.Config{
+		ID:         testsutil.GenerateUUID(t),
+		DomainID:   testsutil.GenerateUUID(t),
+		Name:       "config",
+		ClientCert: "cert",
+		ClientKey:  "key",
+		CACert:     "ca",
+		ExternalID: "external_id",
+		Content:    "content",
+		Status:     bootstrap.Active,
+	}
+	profile := bootstrap.Profile{
+		ID:       testsutil.GenerateUUID(t),
+		DomainID: config.DomainID,
+		Name:     "profile",
+	}
+
+	cases := []struct {
+		desc  string
+		event events.Event
+	}{
+		{
+			desc:  "create config",
+			event: configEvent{config, configCreate},
+		},
+		{
+			desc:  "view config",
+			event: configEvent{config, configView},
+		},
+		{
+			desc:  "update config",
+			event: configEvent{config, configUpdate},
+		},
+		{
+			desc:  "update cert",
+			event: updateCertEvent{configID: config.ID, clientCert: "cert", clientKey: "key", caCert: "ca"},
+		},
+		{
+			desc:  "list configs",
+			event: listConfigsEvent{offset: 0, limit: 10, fullMatch: map[string]string{"name": "config"}, partialMatch: map[string]string{"name": "con"}},
+		},
+		{
+			desc:  "remove config",
+			event: removeConfigEvent{config: config.ID},
+		},
+		{
+			desc:  "bootstrap client",
+			event: bootstrapEvent{Config: config, externalID: config.ExternalID, success: true},
+		},
+		{
+			desc:  "bootstrap unknown client",
+			event: bootstrapEvent{externalID: config.ExternalID},
+		},
+		{
+			desc:  "enable config",
+			event: enableConfigEvent{configID: config.ID},
+		},
+		{
+			desc:  "disable config",
+			event: disableConfigEvent{configID: config.ID},
+		},
+		{
+			desc:  "create profile",
+			event: profileEvent{profile, profileCreate},
+		},
+		{
+			desc:  "view profile",
+			event: profileEvent{profile, profileView},
+		},
+		{
+			desc:  "update profile",
+			event: profileEvent{profile, profileUpdate},
+		},
+		{
+			desc:  "list profiles",
+			event: profileEvent{operation: profileList},
+		},
+		{
+			desc:  "delete profile",
+			event: deleteProfileEvent{profileID: profile.ID},
+		},
+		{
+			desc:  "assign profile",
+			event: assignProfileEvent{configID: config.ID, profileID: profile.ID},
+		},
+		{
+			desc:  "bind resources",
+			event: bindResourcesEvent{configID: config.ID, slots: []string{"slot"}},
+		},
+		{
+			desc:  "list bindings",
+			event: listBindingsEvent{configID: config.ID},
+		},
+		{
+			desc:  "refresh bindings",
+			event: refreshBindingsEvent{configID: config.ID},
+		},
+	}
+
+	covered := make(map[string]bool)
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, s, err := schema.ValidateEvent(tc.event)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			covered[s.Operation] = true
+		})
+	}
+
+	for _, s := range schema.Schemas() {
+		if strings.HasPrefix(s.Operation, bootstrapPrefix) {
+			assert.True(t, covered[s.Operation], fmt.Sprintf("expected emitted event for schema %s", s.Operation))
+		}
+	}
+}
@@ -31,7 +31,7 @@ var config = bootstrap.Config{
 }
 
 func TestSave(t *testing.T) {
-	repo := postgres.NewConfigRepository(db, testLog)
+	repo := postgres.NewConfigRepository(database, testLog)
 
 	diff := "different"
 
@@ -72,7 +72,7 @@ func TestSave(t *testing.T) {
 }
 
 func TestRetrieveByID(t *testing.T) {
-	repo := postgres.NewConfigRepository(db, testLog)
+	repo := postgres.NewConfigRepository(database, testLog)
 
 	c := config
 	// Use UUID to prevent conflicts.
@@ -125,7 +125,7 @@ func TestRetrieveByID(t *testing.T) {
 }
 
 func TestRetrieveAll(t *testing.T) {
-	repo := postgres.NewConfigRepository(db, testLog)
+	repo := postgres.NewConfigRepository(database, testLog)
 
 	for i := 0; i < numConfigs; i++ {
 		c := config
@@ -206,7 +206,7 @@ func TestRetrieveAll(t *testing.T) {
 }
 
 func TestRetrieveByExternalID(t *testing.T) {
-	repo := postgres.NewConfigRepository(db, testLog)
+	repo := postgres.NewConfigRepository(database, testLog)
 
 	c := config
 	// Use UUID to prevent conflicts.
@@ -241,7 +241,7 @@ func TestRetrieveByExternalID(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
-	repo := postgres.NewConfigRepository(db, testLog)
+	repo := postgres.NewConfigRepository(database, testLog)
 
 	c := config
 	// Use UUID to prevent conflicts.
@@ -300,7 +300,7 @@ func TestUpdate(t *testing.T) {
 }
 
 func TestUpdateCert(t *testing.T) {
-	repo := postgres.NewConfigRepository(db, testLog)
+	repo := postgres.NewConfigRepository(database, testLog)
 
 	c := config
 	// Use UUID to prevent conflicts.
@@ -363,7 +363,7 @@ func TestUpdateCert(t *testing.T) {
 }
 
 func TestRemove(t *testing.T) {
-	repo := postgres.NewConfigRepository(db, testLog)
+	repo := postgres.NewConfigRepository(database, testLog)
 
 	c := config
 	// Use UUID to prevent conflicts.
@@ -387,7 +387,7 @@ func TestRemove(t *testing.T) {
 }
 
 func TestChangeStatus(t *testing.T) {
-	repo := postgres.NewConfigRepository(db, testLog)
+	repo := postgres.NewConfigRepository(database, testLog)
 
 	c := config
 	// Use UUID to prevent conflicts.
@@ -440,8 +440,8 @@ func TestChangeStatus(t *testing.T) {
 }
 
 func TestAssignProfile(t *testing.T) {
-	configRepo := postgres.NewConfigRepository(db, testLog)
-	profileRepo := postgres.NewProfileRepository(db, testLog)
+	configRepo := postgres.NewConfigRepository(database, testLog)
+	profileRepo := postgres.NewProfileRepository(database, testLog)
 
 	c := config
 	uid, err := uuid.NewV4()
@@ -15,11 +15,14 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/ory/dockertest/v3"
 	"github.com/ory/dockertest/v3/docker"
+	"go.opentelemetry.io/otel"
 )
 
 var (
 	testLog, _ = mglog.New(os.Stdout, "info")
 	db         *sqlx.DB
+	database   pgclient.Database
+	tracer     = otel.Tracer("repo_tests")
 )
 
 func TestMain(m *testing.M) {
@@ -75,6 +78,7 @@ func TestMain(m *testing.M) {
 	if db, err = pgclient.Setup(dbConfig, *migration); err != nil {
 		testLog.Error(fmt.Sprintf("Could not setup test DB connection: %s", err))
 	}
+	database = pgclient.NewDatabase(db, dbConfig, tracer)
 
 	code := m.Run()
 
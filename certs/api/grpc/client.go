@@ -15,12 +15,13 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-const svcName = "certs.ClientService"
+const svcName = "absmach.certs.CertsService"
 
 type grpcClient struct {
-	timeout     time.Duration
-	getEntityID endpoint.Endpoint
-	revokeCerts endpoint.Endpoint
+	timeout      time.Duration
+	getEntityID  endpoint.Endpoint
+	revokeCerts  endpoint.Endpoint
+	retrieveCert endpoint.Endpoint
 }
 
 func NewClient(conn *grpc.ClientConn, timeout time.Duration) grpcCertsV1.CertsServiceClient {
@@ -43,6 +44,15 @@ func NewClient(conn *grpc.ClientConn, timeout time.Duration) grpcCertsV1.CertsSe
 			emptypb.Empty{},
 		).Endpoint(),
 
+		retrieveCert: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"RetrieveCert",
+			encodeGetEntityIDRequest,
+			decodeRetrieveCertResponse,
+			grpcCertsV1.CertRes{},
+		).Endpoint(),
+
 		timeout: timeout,
 	}
 }
@@ -67,6 +77,16 @@ func (c *grpcClient) RevokeCerts(ctx context.Context, req *grpcCertsV1.RevokeReq
 	return res.(*emptypb.Empty), nil
 }
 
+func (c *grpcClient) RetrieveCert(ctx context.Context, req *grpcCertsV1.EntityReq, _ ...grpc.CallOption) (*grpcCertsV1.CertRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	res, err := c.retrieveCert(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*grpcCertsV1.CertRes), nil
+}
+
 func encodeGetEntityIDRequest(_ context.Context, request any) (any, error) {
 	req := request.(*grpcCertsV1.EntityReq)
 	return &grpcCertsV1.EntityReq{
@@ -81,6 +101,14 @@ func decodeGetEntityIDResponse(_ context.Context, response any) (any, error) {
 	}, nil
 }
 
+func decodeRetrieveCertResponse(_ context.Context, response any) (any, error) {
+	res := response.(*grpcCertsV1.CertRes)
+	return &grpcCertsV1.CertRes{
+		EntityId: res.GetEntityId(),
+		Revoked:  res.GetRevoked(),
+	}, nil
+}
+
 func encodeRevokeCertsRequest(_ context.Context, request any) (any, error) {
 	req := request.(*grpcCertsV1.RevokeReq)
 	return &grpcCertsV1.RevokeReq{
@@ -27,6 +27,19 @@ func getEntityEndpoint(svc certs.Service) endpoint.Endpoint {
 	}
 }
 
+func retrieveCertEndpoint(svc certs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(*grpcCertsV1.EntityReq)
+
+		cert, err := svc.RetrieveCert(ctx, req.SerialNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		return &grpcCertsV1.CertRes{EntityId: cert.EntityID, Revoked: cert.Revoked}, nil
+	}
+}
+
 func revokeCertsEndpoint(svc certs.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request any) (any, error) {
 		req := request.(*grpcCertsV1.RevokeReq)
@@ -19,8 +19,9 @@ import (
 var _ grpcCertsV1.CertsServiceServer = (*grpcServer)(nil)
 
 type grpcServer struct {
-	getEntity   kitgrpc.Handler
-	revokeCerts kitgrpc.Handler
+	getEntity    kitgrpc.Handler
+	revokeCerts  kitgrpc.Handler
+	retrieveCert kitgrpc.Handler
 	grpcCertsV1.UnimplementedCertsServiceServer
 }
 
@@ -36,6 +37,11 @@ func NewServer(svc certs.Service) grpcCertsV1.CertsServiceServer {
 			decodeRevokeCertsReq,
 			encodeRevokeCertsRes,
 		),
+		retrieveCert: kitgrpc.NewServer(
+			(retrieveCertEndpoint(svc)),
+			decodeGetEntityReq,
+			encodeRetrieveCertRes,
+		),
 	}
 }
 
@@ -47,6 +53,10 @@ func encodeGetEntityRes(_ context.Context, res any) (any, error) {
 	return res.(*grpcCertsV1.EntityRes), nil
 }
 
+func encodeRetrieveCertRes(_ context.Context, res any) (any, error) {
+	return res.(*grpcCertsV1.CertRes), nil
+}
+
 func decodeRevokeCertsReq(_ context.Context, req any) (any, error) {
 	return req.(*grpcCertsV1.RevokeReq), nil
 }
@@ -72,6 +82,15 @@ func (g *grpcServer) RevokeCerts(ctx context.Context, req *grpcCertsV1.RevokeReq
 	return res.(*emptypb.Empty), nil
 }
 
+// RetrieveCert returns the entity ID and the revocation status of the certificate.
+func (g *grpcServer) RetrieveCert(ctx context.Context, req *grpcCertsV1.EntityReq) (*grpcCertsV1.CertRes, error) {
+	_, res, err := g.retrieveCert.ServeGRPC(ctx, req)
+	if err != nil {
+		return &grpcCertsV1.CertRes{}, encodeError(err)
+	}
+	return res.(*grpcCertsV1.CertRes), nil
+}
+
 func encodeError(err error) error {
 	switch {
 	case errors.Contains(err, nil):
@@ -151,6 +151,9 @@ type Service interface {
 	// GetEntityID retrieves the entity ID for a certificate.
 	GetEntityID(ctx context.Context, serialNumber string) (string, error)
 
+	// RetrieveCert retrieves the entity ID and the revocation status of a certificate.
+	RetrieveCert(ctx context.Context, serialNumber string) (Certificate, error)
+
 	// GenerateCRL creates cert revocation list.
 	GenerateCRL(ctx context.Context) ([]byte, error)
 
@@ -257,6 +257,71 @@ func TestGetEntityID(t *testing.T) {
 	}
 }
 
+func TestRetrieveCert(t *testing.T) {
+	agent := new(mocks.Agent)
+	repo := new(mocks.Repository)
+	svc, err := certs.NewService(context.Background(), agent, repo)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc     string
+		serial   string
+		entityID string
+		viewRes  certs.Certificate
+		repoErr  error
+		viewErr  error
+		revoked  bool
+		err      error
+	}{
+		{
+			desc:     "retrieve cert successfully",
+			serial:   serialNumber,
+			entityID: "entity-123",
+			viewRes:  certs.Certificate{SerialNumber: serialNumber, Key: []byte("key")},
+		},
+		{
+			desc:     "retrieve revoked cert successfully",
+			serial:   serialNumber,
+			entityID: "entity-123",
+			viewRes:  certs.Certificate{SerialNumber: serialNumber, Revoked: true},
+			revoked:  true,
+		},
+		{
+			desc:    "retrieve cert with repository error",
+			serial:  serialNumber,
+			repoErr: certs.ErrNotFound,
+			err:     certs.ErrNotFound,
+		},
+		{
+			desc:     "retrieve cert with view error",
+			serial:   serialNumber,
+			entityID: "entity-123",
+			viewErr:  errors.New("view failed"),
+			err:      certs.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("GetEntityIDBySerial", mock.Anything, tc.serial).Return(tc.entityID, tc.repoErr)
+			agentCall := agent.On("View", tc.serial).Return(tc.viewRes, tc.viewErr)
+
+			cert, err := svc.RetrieveCert(context.Background(), tc.serial)
+			if tc.err != nil {
+				require.True(t, errors.Contains(err, tc.err), "expected error %v, got %v", tc.err, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.entityID, cert.EntityID)
+				require.Equal(t, tc.revoked, cert.Revoked)
+				require.Empty(t, cert.Key)
+			}
+
+			repoCall.Unset()
+			agentCall.Unset()
+		})
+	}
+}
+
 func TestListCerts(t *testing.T) {
 	agent := new(mocks.Agent)
 	repo := new(mocks.Repository)
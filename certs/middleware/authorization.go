@@ -71,6 +71,10 @@ func (am *authorizationMiddleware) GetEntityID(ctx context.Context, serialNumber
 	return am.svc.GetEntityID(ctx, serialNumber)
 }
 
+func (am *authorizationMiddleware) RetrieveCert(ctx context.Context, serialNumber string) (crt.Certificate, error) {
+	return am.svc.RetrieveCert(ctx, serialNumber)
+}
+
 func (am *authorizationMiddleware) OCSP(ctx context.Context, serialNumber string, ocspRequestDER []byte) ([]byte, error) {
 	return am.svc.OCSP(ctx, serialNumber, ocspRequestDER)
 }
@@ -125,6 +125,18 @@ func (lm *loggingMiddleware) GetEntityID(ctx context.Context, serialNumber strin
 	return lm.svc.GetEntityID(ctx, serialNumber)
 }
 
+func (lm *loggingMiddleware) RetrieveCert(ctx context.Context, serialNumber string) (cert certs.Certificate, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method retrieve_cert for serial number %s took %s to complete", serialNumber, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(message)
+	}(time.Now())
+	return lm.svc.RetrieveCert(ctx, serialNumber)
+}
+
 func (lm *loggingMiddleware) GenerateCRL(ctx context.Context) (crl []byte, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method generate_crl took %s to complete", time.Since(begin))
@@ -94,6 +94,14 @@ func (mm *metricsMiddleware) GetEntityID(ctx context.Context, serialNumber strin
 	return mm.svc.GetEntityID(ctx, serialNumber)
 }
 
+func (mm *metricsMiddleware) RetrieveCert(ctx context.Context, serialNumber string) (certs.Certificate, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "retrieve_cert").Add(1)
+		mm.latency.With("method", "retrieve_cert").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return mm.svc.RetrieveCert(ctx, serialNumber)
+}
+
 func (mm *metricsMiddleware) GenerateCRL(ctx context.Context) ([]byte, error) {
 	defer func(begin time.Time) {
 		mm.counter.With("method", "generate_crl").Add(1)
@@ -71,6 +71,12 @@ func (tm *tracingMiddleware) GetEntityID(ctx context.Context, serialNumber strin
 	return tm.svc.GetEntityID(ctx, serialNumber)
 }
 
+func (tm *tracingMiddleware) RetrieveCert(ctx context.Context, serialNumber string) (certs.Certificate, error) {
+	ctx, span := tm.tracer.Start(ctx, "retrieve_cert")
+	defer span.End()
+	return tm.svc.RetrieveCert(ctx, serialNumber)
+}
+
 func (tm *tracingMiddleware) GenerateCRL(ctx context.Context) ([]byte, error) {
 	ctx, span := tm.tracer.Start(ctx, "generate_crl")
 	defer span.End()
@@ -127,6 +127,89 @@ func (_c *CertsServiceClient_GetEntityID_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// RetrieveCert provides a mock function for the type CertsServiceClient
+func (_mock *CertsServiceClient) RetrieveCert(ctx context.Context, in *v1.EntityReq, opts ...grpc.CallOption) (*v1.CertRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveCert")
+	}
+
+	var r0 *v1.CertRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.EntityReq, ...grpc.CallOption) (*v1.CertRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.EntityReq, ...grpc.CallOption) *v1.CertRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.CertRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.EntityReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// CertsServiceClient_RetrieveCert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveCert'
+type CertsServiceClient_RetrieveCert_Call struct {
+	*mock.Call
+}
+
+// RetrieveCert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.EntityReq
+//   - opts ...grpc.CallOption
+func (_e *CertsServiceClient_Expecter) RetrieveCert(ctx interface{}, in interface{}, opts ...interface{}) *CertsServiceClient_RetrieveCert_Call {
+	return &CertsServiceClient_RetrieveCert_Call{Call: _e.mock.On("RetrieveCert",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *CertsServiceClient_RetrieveCert_Call) Run(run func(ctx context.Context, in *v1.EntityReq, opts ...grpc.CallOption)) *CertsServiceClient_RetrieveCert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.EntityReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.EntityReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *CertsServiceClient_RetrieveCert_Call) Return(certRes *v1.CertRes, err error) *CertsServiceClient_RetrieveCert_Call {
+	_c.Call.Return(certRes, err)
+	return _c
+}
+
+func (_c *CertsServiceClient_RetrieveCert_Call) RunAndReturn(run func(ctx context.Context, in *v1.EntityReq, opts ...grpc.CallOption) (*v1.CertRes, error)) *CertsServiceClient_RetrieveCert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevokeCerts provides a mock function for the type CertsServiceClient
 func (_mock *CertsServiceClient) RevokeCerts(ctx context.Context, in *v1.RevokeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	var tmpRet mock.Arguments
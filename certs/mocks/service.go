@@ -701,6 +701,72 @@ func (_c *Service_RetrieveCAChain_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// RetrieveCert provides a mock function for the type Service
+func (_mock *Service) RetrieveCert(ctx context.Context, serialNumber string) (certs.Certificate, error) {
+	ret := _mock.Called(ctx, serialNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveCert")
+	}
+
+	var r0 certs.Certificate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (certs.Certificate, error)); ok {
+		return returnFunc(ctx, serialNumber)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) certs.Certificate); ok {
+		r0 = returnFunc(ctx, serialNumber)
+	} else {
+		r0 = ret.Get(0).(certs.Certificate)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, serialNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RetrieveCert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveCert'
+type Service_RetrieveCert_Call struct {
+	*mock.Call
+}
+
+// RetrieveCert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serialNumber string
+func (_e *Service_Expecter) RetrieveCert(ctx interface{}, serialNumber interface{}) *Service_RetrieveCert_Call {
+	return &Service_RetrieveCert_Call{Call: _e.mock.On("RetrieveCert", ctx, serialNumber)}
+}
+
+func (_c *Service_RetrieveCert_Call) Run(run func(ctx context.Context, serialNumber string)) *Service_RetrieveCert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RetrieveCert_Call) Return(certificate certs.Certificate, err error) *Service_RetrieveCert_Call {
+	_c.Call.Return(certificate, err)
+	return _c
+}
+
+func (_c *Service_RetrieveCert_Call) RunAndReturn(run func(ctx context.Context, serialNumber string) (certs.Certificate, error)) *Service_RetrieveCert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevokeAll provides a mock function for the type Service
 func (_mock *Service) RevokeAll(ctx context.Context, session authn.Session, entityID string) error {
 	ret := _mock.Called(ctx, session, entityID)
@@ -245,6 +245,26 @@ func (s *service) GetEntityID(ctx context.Context, serialNumber string) (string,
 	return entityID, nil
 }
 
+func (s *service) RetrieveCert(ctx context.Context, serialNumber string) (Certificate, error) {
+	entityID, err := s.repo.GetEntityIDBySerial(ctx, serialNumber)
+	if err != nil {
+		return Certificate{}, errors.Wrap(ErrViewEntity, err)
+	}
+
+	cert, err := s.pki.View(serialNumber)
+	if err != nil {
+		return Certificate{}, errors.Wrap(ErrViewEntity, err)
+	}
+
+	return Certificate{
+		SerialNumber: cert.SerialNumber,
+		Revoked:      cert.Revoked,
+		ExpiryTime:   cert.ExpiryTime,
+		EntityID:     entityID,
+		Type:         cert.Type,
+	}, nil
+}
+
 func (s *service) GenerateCRL(ctx context.Context) ([]byte, error) {
 	crl, err := s.pki.GetCRL()
 	if err != nil {
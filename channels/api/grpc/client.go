@@ -31,6 +31,7 @@ type grpcClient struct {
 	unsetParentGroupFromChannels endpoint.Endpoint
 	retrieveEntity               endpoint.Endpoint
 	retrieveIDByRoute            endpoint.Endpoint
+	connect                      endpoint.Endpoint
 }
 
 // NewClient returns new gRPC client instance.
@@ -76,6 +77,14 @@ func NewClient(conn *grpc.ClientConn, timeout time.Duration) grpcChannelsV1.Chan
 			decodeRetrieveIDByRouteResponse,
 			grpcCommonV1.RetrieveEntityRes{},
 		).Endpoint(),
+		connect: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"Connect",
+			encodeConnectRequest,
+			decodeConnectResponse,
+			grpcChannelsV1.ConnectRes{},
+		).Endpoint(),
 		timeout: timeout,
 	}
 }
@@ -90,6 +99,7 @@ func (client grpcClient) Authorize(ctx context.Context, req *grpcChannelsV1.Auth
 		clientType: req.GetClientType(),
 		channelID:  req.GetChannelId(),
 		connType:   connections.ConnType(req.GetType()),
+		subtopic:   req.GetSubtopic(),
 	})
 	if err != nil {
 		return &grpcChannelsV1.AuthzRes{}, decodeError(err)
@@ -109,6 +119,7 @@ func encodeAuthorizeRequest(_ context.Context, grpcReq any) (any, error) {
 		ClientType: req.clientType,
 		ChannelId:  req.channelID,
 		Type:       uint32(req.connType),
+		Subtopic:   req.subtopic,
 	}, nil
 }
 
@@ -196,6 +207,25 @@ func decodeRetrieveIDByRouteResponse(_ context.Context, grpcRes any) (any, error
 	return grpcRes.(*grpcCommonV1.RetrieveEntityRes), nil
 }
 
+func (client grpcClient) Connect(ctx context.Context, req *grpcChannelsV1.ConnectReq, _ ...grpc.CallOption) (r *grpcChannelsV1.ConnectRes, err error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	if _, err := client.connect(ctx, req); err != nil {
+		return &grpcChannelsV1.ConnectRes{}, decodeError(err)
+	}
+
+	return &grpcChannelsV1.ConnectRes{}, nil
+}
+
+func encodeConnectRequest(_ context.Context, grpcReq any) (any, error) {
+	return grpcReq.(*grpcChannelsV1.ConnectReq), nil
+}
+
+func decodeConnectResponse(_ context.Context, grpcRes any) (any, error) {
+	return grpcRes.(*grpcChannelsV1.ConnectRes), nil
+}
+
 func decodeError(err error) error {
 	if st, ok := status.FromError(err); ok {
 		switch st.Code() {
@@ -24,6 +24,7 @@ func authorizeEndpoint(svc channels.Service) endpoint.Endpoint {
 			ClientType: req.clientType,
 			ChannelID:  req.channelID,
 			Type:       req.connType,
+			Subtopic:   req.subtopic,
 		}); err != nil {
 			return authorizeRes{}, err
 		}
@@ -64,7 +65,7 @@ func retrieveEntityEndpoint(svc channels.Service) endpoint.Endpoint {
 			return retrieveEntityRes{}, err
 		}
 
-		return retrieveEntityRes{id: channel.ID, domain: channel.Domain, parentGroup: channel.ParentGroup, status: uint8(channel.Status)}, nil
+		return retrieveEntityRes{id: channel.ID, domain: channel.Domain, parentGroup: channel.ParentGroup, status: uint8(channel.Status), metadata: channel.Metadata}, nil
 	}
 }
 
@@ -83,3 +84,18 @@ func retrieveIDByRouteEndpoint(svc channels.Service) endpoint.Endpoint {
 		return retrieveIDByRouteRes{id: id}, nil
 	}
 }
+
+func connectEndpoint(svc channels.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(connectReq)
+		if err := req.validate(); err != nil {
+			return connectRes{}, err
+		}
+
+		if err := svc.Connect(ctx, req.session, req.channelIDs, req.clientIDs, req.connTypes, req.topics); err != nil {
+			return connectRes{}, err
+		}
+
+		return connectRes{}, nil
+	}
+}
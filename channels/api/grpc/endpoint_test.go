@@ -18,6 +18,7 @@ import (
 	grpcapi "github.com/absmach/magistrala/channels/api/grpc"
 	"github.com/absmach/magistrala/channels/private/mocks"
 	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/connections"
 	"github.com/absmach/magistrala/pkg/errors"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
@@ -246,6 +247,25 @@ func TestRetrieveEntity(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			desc: "retrieve entity with metadata successfully",
+			id:   validID,
+			svcRes: ch.Channel{
+				ID:       validChannel.ID,
+				Domain:   validChannel.Domain,
+				Status:   validChannel.Status,
+				Metadata: ch.Metadata{"transformer": map[string]any{"format": "json"}},
+			},
+			resp: &grpcCommonV1.RetrieveEntityRes{
+				Entity: &grpcCommonV1.EntityBasic{
+					Id:       validChannel.ID,
+					DomainId: validChannel.Domain,
+					Status:   uint32(validChannel.Status),
+					Metadata: []byte(`{"transformer":{"format":"json"}}`),
+				},
+			},
+			err: nil,
+		},
 		{
 			desc: "retrieve entity with error",
 			id:   validID,
@@ -343,3 +363,91 @@ func TestRetrieveIDByRoute(t *testing.T) {
 		})
 	}
 }
+
+func TestConnect(t *testing.T) {
+	svc := new(mocks.Service)
+	server := startGRPCServer(svc, port)
+	defer server.GracefulStop()
+	authAddr := fmt.Sprintf("localhost:%d", port)
+	conn, _ := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	client := grpcapi.NewClient(conn, time.Second)
+
+	session := authn.Session{
+		DomainID:     testsutil.GenerateUUID(t),
+		UserID:       testsutil.GenerateUUID(t),
+		DomainUserID: testsutil.GenerateUUID(t),
+	}
+	clientID := testsutil.GenerateUUID(t)
+	validReq := &grpcChannelsV1.ConnectReq{
+		DomainId:     session.DomainID,
+		UserId:       session.UserID,
+		DomainUserId: session.DomainUserID,
+		ChannelIds:   []string{validID},
+		ClientIds:    []string{clientID},
+		Types:        []uint32{uint32(connections.Publish)},
+	}
+
+	cases := []struct {
+		desc   string
+		req    *grpcChannelsV1.ConnectReq
+		svcErr error
+		err    error
+	}{
+		{
+			desc: "connect successfully",
+			req:  validReq,
+		},
+		{
+			desc: "connect with missing domain ID",
+			req: &grpcChannelsV1.ConnectReq{
+				UserId:     session.UserID,
+				ChannelIds: []string{validID},
+				ClientIds:  []string{clientID},
+				Types:      []uint32{uint32(connections.Publish)},
+			},
+			err: apiutil.ErrMissingDomainID,
+		},
+		{
+			desc: "connect with missing channels",
+			req: &grpcChannelsV1.ConnectReq{
+				DomainId:  session.DomainID,
+				UserId:    session.UserID,
+				ClientIds: []string{clientID},
+				Types:     []uint32{uint32(connections.Publish)},
+			},
+			err: apiutil.ErrMissingID,
+		},
+		{
+			desc: "connect with missing connection types",
+			req: &grpcChannelsV1.ConnectReq{
+				DomainId:   session.DomainID,
+				UserId:     session.UserID,
+				ChannelIds: []string{validID},
+				ClientIds:  []string{clientID},
+			},
+			err: apiutil.ErrMissingConnectionType,
+		},
+		{
+			desc:   "connect with authorization error",
+			req:    validReq,
+			svcErr: svcerr.ErrAuthorization,
+			err:    svcerr.ErrAuthorization,
+		},
+		{
+			desc:   "connect already connected clients",
+			req:    validReq,
+			svcErr: svcerr.ErrConflict,
+			err:    svcerr.ErrConflict,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("Connect", mock.Anything, session, []string{validID}, []string{clientID}, []connections.ConnType{connections.Publish}, []string(nil)).Return(tc.svcErr)
+			res, err := client.Connect(context.Background(), tc.req)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			assert.Equal(t, &grpcChannelsV1.ConnectRes{}, res)
+			svcCall.Unset()
+		})
+	}
+}
@@ -5,6 +5,7 @@ package grpc
 
 import (
 	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/connections"
 	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/absmach/magistrala/pkg/policies"
@@ -18,6 +19,7 @@ type authorizeReq struct {
 	clientID   string
 	clientType string
 	connType   connections.ConnType
+	subtopic   string
 }
 
 func (req authorizeReq) validate() error {
@@ -54,3 +56,28 @@ func (req retrieveIDByRouteReq) validate() error {
 
 	return nil
 }
+
+type connectReq struct {
+	session    authn.Session
+	channelIDs []string
+	clientIDs  []string
+	connTypes  []connections.ConnType
+	topics     []string
+}
+
+func (req connectReq) validate() error {
+	if req.session.DomainID == "" {
+		return apiutil.ErrMissingDomainID
+	}
+	if req.session.UserID == "" {
+		return apiutil.ErrMissingUserID
+	}
+	if len(req.channelIDs) == 0 || len(req.clientIDs) == 0 {
+		return apiutil.ErrMissingID
+	}
+	if len(req.connTypes) == 0 {
+		return apiutil.ErrMissingConnectionType
+	}
+
+	return nil
+}
@@ -16,6 +16,7 @@ type channelBasic struct {
 	domain      string
 	parentGroup string
 	status      uint8
+	metadata    map[string]any
 }
 
 type retrieveEntityRes channelBasic
@@ -23,3 +24,5 @@ type retrieveEntityRes channelBasic
 type retrieveIDByRouteRes struct {
 	id string
 }
+
+type connectRes struct{}
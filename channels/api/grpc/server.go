@@ -5,12 +5,14 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
 
 	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
 	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	smqauth "github.com/absmach/magistrala/auth"
 	channels "github.com/absmach/magistrala/channels/private"
+	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/connections"
 	"github.com/absmach/magistrala/pkg/errors"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
@@ -28,6 +30,7 @@ type grpcServer struct {
 	unsetParentGroupFromChannels kitgrpc.Handler
 	retrieveEntity               kitgrpc.Handler
 	retrieveIDByRoute            kitgrpc.Handler
+	connect                      kitgrpc.Handler
 }
 
 // NewServer returns new AuthServiceServer instance.
@@ -58,6 +61,11 @@ func NewServer(svc channels.Service) grpcChannelsV1.ChannelsServiceServer {
 			decodeRetrieveIDByRouteRequest,
 			encodeRetrieveIDByRouteResponse,
 		),
+		connect: kitgrpc.NewServer(
+			connectEndpoint(svc),
+			decodeConnectRequest,
+			encodeConnectResponse,
+		),
 	}
 }
 
@@ -82,6 +90,7 @@ func decodeAuthorizeRequest(_ context.Context, grpcReq any) (any, error) {
 		clientType: req.GetClientType(),
 		channelID:  req.GetChannelId(),
 		connType:   connType,
+		subtopic:   req.GetSubtopic(),
 	}, nil
 }
 
@@ -150,12 +159,21 @@ func decodeRetrieveEntityRequest(_ context.Context, grpcReq any) (any, error) {
 func encodeRetrieveEntityResponse(_ context.Context, grpcRes any) (any, error) {
 	res := grpcRes.(retrieveEntityRes)
 
+	var metadata []byte
+	if len(res.metadata) > 0 {
+		var err error
+		if metadata, err = json.Marshal(res.metadata); err != nil {
+			return nil, err
+		}
+	}
+
 	return &grpcCommonV1.RetrieveEntityRes{
 		Entity: &grpcCommonV1.EntityBasic{
 			Id:            res.id,
 			DomainId:      res.domain,
 			ParentGroupId: res.parentGroup,
 			Status:        uint32(res.status),
+			Metadata:      metadata,
 		},
 	}, nil
 }
@@ -186,6 +204,46 @@ func (s *grpcServer) RetrieveIDByRoute(ctx context.Context, req *grpcCommonV1.Re
 	return res.(*grpcCommonV1.RetrieveEntityRes), nil
 }
 
+func (s *grpcServer) Connect(ctx context.Context, req *grpcChannelsV1.ConnectReq) (*grpcChannelsV1.ConnectRes, error) {
+	_, res, err := s.connect.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*grpcChannelsV1.ConnectRes), nil
+}
+
+func decodeConnectRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcChannelsV1.ConnectReq)
+
+	connTypes := []connections.ConnType{}
+	for _, t := range req.GetTypes() {
+		connType := connections.ConnType(t)
+		if err := connections.CheckConnType(connType); err != nil {
+			return nil, err
+		}
+		connTypes = append(connTypes, connType)
+	}
+
+	return connectReq{
+		session: authn.Session{
+			DomainID:     req.GetDomainId(),
+			UserID:       req.GetUserId(),
+			DomainUserID: req.GetDomainUserId(),
+			PatID:        req.GetPatId(),
+			SuperAdmin:   req.GetSuperAdmin(),
+		},
+		channelIDs: req.GetChannelIds(),
+		clientIDs:  req.GetClientIds(),
+		connTypes:  connTypes,
+		topics:     req.GetTopics(),
+	}, nil
+}
+
+func encodeConnectResponse(_ context.Context, grpcRes any) (any, error) {
+	_ = grpcRes.(connectRes)
+	return &grpcChannelsV1.ConnectRes{}, nil
+}
+
 func encodeError(err error) error {
 	switch {
 	case errors.Contains(err, nil):
@@ -193,6 +251,9 @@ func encodeError(err error) error {
 	case errors.Contains(err, errors.ErrMalformedEntity),
 		err == apiutil.ErrInvalidAuthKey,
 		err == apiutil.ErrMissingID,
+		err == apiutil.ErrMissingDomainID,
+		err == apiutil.ErrMissingUserID,
+		err == apiutil.ErrMissingConnectionType,
 		err == apiutil.ErrMissingMemberType,
 		err == apiutil.ErrMissingPolicySub,
 		err == apiutil.ErrMissingPolicyObj,
@@ -205,6 +266,8 @@ func encodeError(err error) error {
 		return status.Error(codes.Unauthenticated, err.Error())
 	case errors.Contains(err, svcerr.ErrAuthorization):
 		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Contains(err, svcerr.ErrConflict):
+		return status.Error(codes.AlreadyExists, err.Error())
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
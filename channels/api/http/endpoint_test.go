@@ -1749,6 +1749,7 @@ func TestConnectChannelClientEndpoint(t *testing.T) {
 		data        string
 		session     smqauthn.Session
 		contentType string
+		topics      []string
 		svcErr      error
 		status      int
 		authnErr    error
@@ -1765,6 +1766,28 @@ func TestConnectChannelClientEndpoint(t *testing.T) {
 			status:      http.StatusCreated,
 			err:         nil,
 		},
+		{
+			desc:        "connect channel client with topics successfully",
+			token:       validToken,
+			domainID:    validID,
+			id:          validID,
+			data:        fmt.Sprintf(`{"client_ids": ["%s"], "types": ["Publish"], "topics": ["sensors/+/temp"]}`, validID),
+			contentType: contentType,
+			topics:      []string{"sensors/+/temp"},
+			svcErr:      nil,
+			status:      http.StatusCreated,
+			err:         nil,
+		},
+		{
+			desc:        "connect channel client with invalid topics",
+			token:       validToken,
+			domainID:    validID,
+			id:          validID,
+			data:        fmt.Sprintf(`{"client_ids": ["%s"], "types": ["Publish"], "topics": ["sensors/#/temp"]}`, validID),
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidConnectionTopic,
+		},
 		{
 			desc:        "connect channel client with invalid token",
 			token:       invalidToken,
@@ -1829,7 +1852,7 @@ func TestConnectChannelClientEndpoint(t *testing.T) {
 				tc.session = smqauthn.Session{DomainUserID: validID + "_" + validID, UserID: validID, DomainID: validID}
 			}
 			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
-			svcCall := svc.On("Connect", mock.Anything, tc.session, []string{tc.id}, []string{validID}, []connections.ConnType{1}).Return(tc.svcErr)
+			svcCall := svc.On("Connect", mock.Anything, tc.session, []string{tc.id}, []string{validID}, []connections.ConnType{1}, tc.topics).Return(tc.svcErr)
 			res, err := req.make()
 			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
 			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
@@ -1952,6 +1975,7 @@ func TestConnectEndpoint(t *testing.T) {
 		domainID   string
 		clientIDs  []string
 		types      []connections.ConnType
+		topics     []string
 		session    smqauthn.Session
 		svcErr     error
 		status     int
@@ -1969,6 +1993,29 @@ func TestConnectEndpoint(t *testing.T) {
 			status:     http.StatusCreated,
 			err:        nil,
 		},
+		{
+			desc:       "connect with topics successfully",
+			token:      validToken,
+			domainID:   validID,
+			channelIDs: []string{validID},
+			clientIDs:  []string{validID},
+			types:      []connections.ConnType{2},
+			topics:     []string{"commands/{client_id}/#"},
+			svcErr:     nil,
+			status:     http.StatusCreated,
+			err:        nil,
+		},
+		{
+			desc:       "connect with invalid topics",
+			token:      validToken,
+			domainID:   validID,
+			channelIDs: []string{validID},
+			clientIDs:  []string{validID},
+			types:      []connections.ConnType{2},
+			topics:     []string{"commands//status"},
+			status:     http.StatusBadRequest,
+			err:        apiutil.ErrInvalidConnectionTopic,
+		},
 		{
 			desc:       "connect with invalid token",
 			token:      invalidToken,
@@ -2055,13 +2102,14 @@ func TestConnectEndpoint(t *testing.T) {
 					"channel_ids": tc.channelIDs,
 					"client_ids":  tc.clientIDs,
 					"types":       tc.types,
+					"topics":      tc.topics,
 				})),
 			}
 			if tc.token == validToken {
 				tc.session = smqauthn.Session{DomainUserID: validID + "_" + validID, UserID: validID, DomainID: validID}
 			}
 			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
-			svcCall := svc.On("Connect", mock.Anything, tc.session, tc.channelIDs, tc.clientIDs, tc.types).Return(tc.svcErr)
+			svcCall := svc.On("Connect", mock.Anything, tc.session, tc.channelIDs, tc.clientIDs, tc.types, tc.topics).Return(tc.svcErr)
 			res, err := req.make()
 			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
 			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
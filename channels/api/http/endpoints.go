@@ -275,7 +275,7 @@ func connectChannelClientEndpoint(svc channels.Service) endpoint.Endpoint {
 			return nil, svcerr.ErrAuthentication
 		}
 
-		if err := svc.Connect(ctx, session, []string{req.channelID}, req.ClientIDs, req.Types); err != nil {
+		if err := svc.Connect(ctx, session, []string{req.channelID}, req.ClientIDs, req.Types, req.Topics); err != nil {
 			return nil, err
 		}
 
@@ -315,7 +315,7 @@ func connectEndpoint(svc channels.Service) endpoint.Endpoint {
 			return nil, svcerr.ErrAuthentication
 		}
 
-		if err := svc.Connect(ctx, session, req.ChannelIds, req.ClientIds, req.Types); err != nil {
+		if err := svc.Connect(ctx, session, req.ChannelIds, req.ClientIds, req.Types, req.Topics); err != nil {
 			return nil, err
 		}
 
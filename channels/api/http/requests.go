@@ -10,6 +10,9 @@ import (
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	"github.com/absmach/magistrala/channels"
 	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/retention"
+	chtransformers "github.com/absmach/magistrala/pkg/transformers/channels"
 )
 
 type createChannelReq struct {
@@ -33,6 +36,12 @@ func (req createChannelReq) validate() error {
 			return apiutil.ErrInvalidRouteFormat
 		}
 	}
+	if err := retention.Validate(req.Channel.Metadata); err != nil {
+		return err
+	}
+	if err := chtransformers.ValidateSchema(req.Channel.Metadata); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -62,6 +71,12 @@ func (req createChannelsReq) validate() error {
 				return apiutil.ErrInvalidRouteFormat
 			}
 		}
+		if err := retention.Validate(channel.Metadata); err != nil {
+			return err
+		}
+		if err := chtransformers.ValidateSchema(channel.Metadata); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -126,6 +141,12 @@ func (req updateChannelReq) validate() error {
 	if len(req.Name) > api.MaxNameSize {
 		return apiutil.ErrNameSize
 	}
+	if err := retention.Validate(req.Metadata); err != nil {
+		return err
+	}
+	if err := chtransformers.ValidateSchema(req.Metadata); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -187,6 +208,7 @@ type connectChannelClientsRequest struct {
 	channelID string
 	ClientIDs []string               `json:"client_ids,omitempty"`
 	Types     []connections.ConnType `json:"types,omitempty"`
+	Topics    []string               `json:"topics,omitempty"`
 }
 
 func (req *connectChannelClientsRequest) validate() error {
@@ -208,6 +230,10 @@ func (req *connectChannelClientsRequest) validate() error {
 		return apiutil.ErrMissingConnectionType
 	}
 
+	if err := connections.ValidateTopics(req.Topics); err != nil {
+		return errors.Wrap(apiutil.ErrInvalidConnectionTopic, err)
+	}
+
 	return nil
 }
 
@@ -247,6 +273,7 @@ type connectRequest struct {
 	ChannelIds []string               `json:"channel_ids,omitempty"`
 	ClientIds  []string               `json:"client_ids,omitempty"`
 	Types      []connections.ConnType `json:"types,omitempty"`
+	Topics     []string               `json:"topics,omitempty"`
 }
 
 func (req *connectRequest) validate() error {
@@ -273,6 +300,10 @@ func (req *connectRequest) validate() error {
 		return apiutil.ErrMissingConnectionType
 	}
 
+	if err := connections.ValidateTopics(req.Topics); err != nil {
+		return errors.Wrap(apiutil.ErrInvalidConnectionTopic, err)
+	}
+
 	return nil
 }
 
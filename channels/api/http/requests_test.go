@@ -13,6 +13,9 @@ import (
 	"github.com/absmach/magistrala/channels"
 	"github.com/absmach/magistrala/internal/testsutil"
 	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/retention"
+	chtransformers "github.com/absmach/magistrala/pkg/transformers/channels"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -71,11 +74,51 @@ func TestCreateChannelReqValidation(t *testing.T) {
 			},
 			err: apiutil.ErrMissingChannelID,
 		},
+		{
+			desc: "valid retention policy",
+			req: createChannelReq{
+				Channel: channels.Channel{
+					Name:     valid,
+					Metadata: channels.Metadata{retention.MetadataKey: map[string]any{"period": "30d"}},
+				},
+			},
+			err: nil,
+		},
+		{
+			desc: "invalid retention policy",
+			req: createChannelReq{
+				Channel: channels.Channel{
+					Name:     valid,
+					Metadata: channels.Metadata{retention.MetadataKey: map[string]any{"period": "invalid"}},
+				},
+			},
+			err: retention.ErrInvalidPolicy,
+		},
+		{
+			desc: "valid payload schema",
+			req: createChannelReq{
+				Channel: channels.Channel{
+					Name:     valid,
+					Metadata: channels.Metadata{chtransformers.SchemaKey: map[string]any{"type": "object", "required": []string{"temp"}}},
+				},
+			},
+			err: nil,
+		},
+		{
+			desc: "invalid payload schema",
+			req: createChannelReq{
+				Channel: channels.Channel{
+					Name:     valid,
+					Metadata: channels.Metadata{chtransformers.SchemaKey: map[string]any{"type": "unknown"}},
+				},
+			},
+			err: chtransformers.ErrInvalidSchema,
+		},
 	}
 
 	for _, tc := range cases {
 		err := tc.req.validate()
-		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 	}
 }
 
@@ -127,11 +170,35 @@ func TestCreateChannelsReqValidation(t *testing.T) {
 			},
 			err: apiutil.ErrEmptyList,
 		},
+		{
+			desc: "invalid retention policy",
+			req: createChannelsReq{
+				Channels: []channels.Channel{
+					{
+						Name:     valid,
+						Metadata: channels.Metadata{retention.MetadataKey: map[string]any{"period": "-1d"}},
+					},
+				},
+			},
+			err: retention.ErrInvalidPolicy,
+		},
+		{
+			desc: "invalid payload schema",
+			req: createChannelsReq{
+				Channels: []channels.Channel{
+					{
+						Name:     valid,
+						Metadata: channels.Metadata{chtransformers.SchemaKey: map[string]any{"required": "temp"}},
+					},
+				},
+			},
+			err: chtransformers.ErrInvalidSchema,
+		},
 	}
 
 	for _, tc := range cases {
 		err := tc.req.validate()
-		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 	}
 }
 
@@ -232,10 +299,26 @@ func TestUpdateChannelReqValidate(t *testing.T) {
 			},
 			err: apiutil.ErrNameSize,
 		},
+		{
+			desc: "invalid retention policy",
+			req: updateChannelReq{
+				id:       valid,
+				Metadata: map[string]any{retention.MetadataKey: "30d"},
+			},
+			err: retention.ErrInvalidPolicy,
+		},
+		{
+			desc: "invalid payload schema",
+			req: updateChannelReq{
+				id:       valid,
+				Metadata: map[string]any{chtransformers.SchemaKey: "temp"},
+			},
+			err: chtransformers.ErrInvalidSchema,
+		},
 	}
 	for _, tc := range cases {
 		err := tc.req.validate()
-		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 	}
 }
 
@@ -115,6 +115,9 @@ type Connection struct {
 	ChannelID string
 	DomainID  string
 	Type      connections.ConnType
+	// Topics are the subtopic patterns the connection is restricted to.
+	// The connection allows all the subtopics if there are none.
+	Topics []string
 }
 
 type AuthzReq struct {
@@ -123,6 +126,7 @@ type AuthzReq struct {
 	ClientID   string
 	ClientType string
 	Type       connections.ConnType
+	Subtopic   string
 }
 
 type Service interface {
@@ -154,8 +158,9 @@ type Service interface {
 	// belongs to the user.
 	RemoveChannel(ctx context.Context, session authn.Session, id string) error
 
-	// Connect adds clients to the channels list of connected clients.
-	Connect(ctx context.Context, session authn.Session, chIDs, clIDs []string, connType []connections.ConnType) error
+	// Connect adds clients to the channels list of connected clients. The
+	// connections are restricted to the topics subtopic patterns, if any.
+	Connect(ctx context.Context, session authn.Session, chIDs, clIDs []string, connType []connections.ConnType, topics []string) error
 
 	// Disconnect removes clients from the channels list of connected clients.
 	Disconnect(ctx context.Context, session authn.Session, chIDs, clIDs []string, connType []connections.ConnType) error
@@ -210,7 +215,8 @@ type Repository interface {
 
 	CheckConnection(ctx context.Context, conn Connection) error
 
-	ClientAuthorize(ctx context.Context, conn Connection) error
+	// ClientAuthorize retrieves the connection of the client to the channel.
+	ClientAuthorize(ctx context.Context, conn Connection) (Connection, error)
 
 	ChannelConnectionsCount(ctx context.Context, id string) (uint64, error)
 
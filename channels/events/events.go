@@ -302,15 +302,16 @@ func (dce removeChannelEvent) Encode() (map[string]any, error) {
 }
 
 type connectEvent struct {
-	chIDs []string
-	thIDs []string
-	types []connections.ConnType
+	chIDs  []string
+	thIDs  []string
+	types  []connections.ConnType
+	topics []string
 	authn.Session
 	requestID string
 }
 
 func (ce connectEvent) Encode() (map[string]any, error) {
-	return map[string]any{
+	val := map[string]any{
 		"operation":   channelConnect,
 		"client_ids":  ce.thIDs,
 		"channel_ids": ce.chIDs,
@@ -320,7 +321,12 @@ func (ce connectEvent) Encode() (map[string]any, error) {
 		"token_type":  ce.Type.String(),
 		"super_admin": ce.SuperAdmin,
 		"request_id":  ce.requestID,
-	}, nil
+	}
+	if len(ce.topics) > 0 {
+		val["topics"] = ce.topics
+	}
+
+	return val, nil
 }
 
 type disconnectEvent struct {
@@ -0,0 +1,149 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/channels"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/pkg/roles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSchemas(t *testing.T) {
+	now := time.Now().UTC()
+	session := authn.Session{
+		Type:     authn.AccessToken,
+		UserID:   testsutil.GenerateUUID(t),
+		DomainID: testsutil.GenerateUUID(t),
+	}
+	channel := channels.Channel{
+		ID:        testsutil.GenerateUUID(t),
+		Name:      "channel",
+		Tags:      []string{"tag"},
+		Domain:    session.DomainID,
+		Route:     "route",
+		Metadata:  channels.Metadata{"key": "value"},
+		CreatedBy: session.UserID,
+		CreatedAt: now,
+		UpdatedAt: now,
+		UpdatedBy: session.UserID,
+		Status:    channels.EnabledStatus,
+	}
+	page := channels.Page{
+		Total:    1,
+		Limit:    10,
+		Name:     "channel",
+		Order:    "name",
+		Dir:      "asc",
+		Metadata: channels.Metadata{"key": "value"},
+		Tags:     channels.TagsQuery{Elements: []string{"tag"}},
+		IDs:      []string{channel.ID},
+	}
+	clientIDs := []string{testsutil.GenerateUUID(t)}
+	types := []connections.ConnType{connections.Publish, connections.Subscribe}
+	requestID := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc  string
+		event events.Event
+	}{
+		{
+			desc: "create channel",
+			event: createChannelEvent{
+				Channel:          channel,
+				rolesProvisioned: []roles.RoleProvision{{Role: roles.Role{ID: testsutil.GenerateUUID(t), Name: "admin"}, OptionalActions: []string{"read"}}},
+				Session:          session,
+				requestID:        requestID,
+			},
+		},
+		{
+			desc:  "create channel with minimal fields",
+			event: createChannelEvent{Channel: channels.Channel{ID: channel.ID, CreatedAt: now}, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update channel",
+			event: updateChannelEvent{Channel: channel, operation: channelUpdate, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update channel tags",
+			event: updateChannelEvent{Channel: channel, operation: channelUpdateTags, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "enable channel",
+			event: changeChannelStatusEvent{id: channel.ID, operation: channelEnable, status: channels.EnabledStatus.String(), updatedAt: now, updatedBy: session.UserID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "disable channel",
+			event: changeChannelStatusEvent{id: channel.ID, operation: channelDisable, status: channels.DisabledStatus.String(), updatedAt: now, updatedBy: session.UserID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "view channel",
+			event: viewChannelEvent{Channel: channel, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "list channels",
+			event: listChannelEvent{Page: page, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "list user channels",
+			event: listUserChannelsEvent{userID: session.UserID, Page: page, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "remove channel",
+			event: removeChannelEvent{id: channel.ID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "connect",
+			event: connectEvent{chIDs: []string{channel.ID}, thIDs: clientIDs, types: types, topics: []string{"sensors/+"}, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "connect without topics",
+			event: connectEvent{chIDs: []string{channel.ID}, thIDs: clientIDs, types: types, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "disconnect",
+			event: disconnectEvent{chIDs: []string{channel.ID}, thIDs: clientIDs, types: types, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "set parent group",
+			event: setParentGroupEvent{id: channel.ID, parentGroupID: testsutil.GenerateUUID(t), Session: session, requestID: requestID},
+		},
+		{
+			desc:  "remove parent group",
+			event: removeParentGroupEvent{id: channel.ID, Session: session, requestID: requestID},
+		},
+	}
+
+	covered := make(map[string]bool)
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, s, err := schema.ValidateEvent(tc.event)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			covered[s.Operation] = true
+		})
+	}
+
+	// Rejected payloads are reported by the channel transformers and role
+	// events are published by the roles manager.
+	skipped := []string{channelPrefix + "payload_reject"}
+	for _, s := range schema.Schemas() {
+		op := s.Operation
+		if !strings.HasPrefix(op, channelPrefix) || slices.Contains(skipped, op) ||
+			strings.HasPrefix(op, channelPrefix+"role") || strings.HasPrefix(op, channelPrefix+"members") {
+			continue
+		}
+		assert.True(t, covered[op], fmt.Sprintf("expected emitted event for schema %s", op))
+	}
+}
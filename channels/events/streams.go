@@ -10,7 +10,6 @@ import (
 	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/connections"
 	"github.com/absmach/magistrala/pkg/events"
-	"github.com/absmach/magistrala/pkg/events/store"
 	"github.com/absmach/magistrala/pkg/roles"
 	rmEvents "github.com/absmach/magistrala/pkg/roles/rolemanager/events"
 	"github.com/go-chi/chi/v5/middleware"
@@ -41,79 +40,82 @@ type eventStore struct {
 	rmEvents.RoleManagerEventStore
 }
 
-// NewEventStoreMiddleware returns wrapper around clients service that sends
-// events to event store.
-func NewEventStoreMiddleware(ctx context.Context, svc channels.Service, url string) (channels.Service, error) {
-	publisher, err := store.NewPublisher(ctx, url, "channels-es-pub")
-	if err != nil {
-		return nil, err
-	}
-
+// NewEventStoreMiddleware returns wrapper around channels service that sends
+// events to the publisher. If the publisher is transactional, such as the
+// PostgreSQL outbox, the events of each change are stored in the same
+// transaction as the change itself.
+func NewEventStoreMiddleware(svc channels.Service, publisher events.Publisher) channels.Service {
 	rolesSvcEventStoreMiddleware := rmEvents.NewRoleManagerEventStore("channels", channelPrefix, svc, publisher)
 	return &eventStore{
 		svc:                   svc,
 		Publisher:             publisher,
 		RoleManagerEventStore: rolesSvcEventStoreMiddleware,
-	}, nil
-}
-
-func (es *eventStore) CreateChannels(ctx context.Context, session authn.Session, chs ...channels.Channel) ([]channels.Channel, []roles.RoleProvision, error) {
-	chs, rps, err := es.svc.CreateChannels(ctx, session, chs...)
-	if err != nil {
-		return chs, rps, err
 	}
+}
 
-	for _, ch := range chs {
-		event := createChannelEvent{
-			Channel:          ch,
-			rolesProvisioned: rps,
-			Session:          session,
-			requestID:        middleware.GetReqID(ctx),
+func (es *eventStore) CreateChannels(ctx context.Context, session authn.Session, channels ...channels.Channel) (chs []channels.Channel, rps []roles.RoleProvision, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		chs, rps, err = es.svc.CreateChannels(ctx, session, channels...)
+		if err != nil {
+			return err
 		}
-		if err := es.Publish(ctx, createStream, event); err != nil {
-			return chs, rps, err
+
+		for _, ch := range chs {
+			event := createChannelEvent{
+				Channel:          ch,
+				rolesProvisioned: rps,
+				Session:          session,
+				requestID:        middleware.GetReqID(ctx),
+			}
+			if err := es.Publish(ctx, createStream, event); err != nil {
+				return err
+			}
 		}
-	}
 
-	return chs, rps, nil
+		return nil
+	})
+
+	return chs, rps, err
 }
 
-func (es *eventStore) UpdateChannel(ctx context.Context, session authn.Session, ch channels.Channel) (channels.Channel, error) {
-	ch, err := es.svc.UpdateChannel(ctx, session, ch)
-	if err != nil {
-		return ch, err
-	}
+func (es *eventStore) UpdateChannel(ctx context.Context, session authn.Session, channel channels.Channel) (ch channels.Channel, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		ch, err = es.svc.UpdateChannel(ctx, session, channel)
+		if err != nil {
+			return err
+		}
 
-	event := updateChannelEvent{
-		Channel:   ch,
-		Session:   session,
-		operation: channelUpdate,
-		requestID: middleware.GetReqID(ctx),
-	}
-	if err := es.Publish(ctx, updateStream, event); err != nil {
-		return ch, err
-	}
+		event := updateChannelEvent{
+			Channel:   ch,
+			Session:   session,
+			operation: channelUpdate,
+			requestID: middleware.GetReqID(ctx),
+		}
+
+		return es.Publish(ctx, updateStream, event)
+	})
 
-	return ch, nil
+	return ch, err
 }
 
-func (es *eventStore) UpdateChannelTags(ctx context.Context, session authn.Session, ch channels.Channel) (channels.Channel, error) {
-	ch, err := es.svc.UpdateChannelTags(ctx, session, ch)
-	if err != nil {
-		return ch, err
-	}
+func (es *eventStore) UpdateChannelTags(ctx context.Context, session authn.Session, channel channels.Channel) (ch channels.Channel, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		ch, err = es.svc.UpdateChannelTags(ctx, session, channel)
+		if err != nil {
+			return err
+		}
 
-	event := updateChannelEvent{
-		Channel:   ch,
-		Session:   session,
-		operation: channelUpdateTags,
-		requestID: middleware.GetReqID(ctx),
-	}
-	if err := es.Publish(ctx, updateTagsStream, event); err != nil {
-		return ch, err
-	}
+		event := updateChannelEvent{
+			Channel:   ch,
+			Session:   session,
+			operation: channelUpdateTags,
+			requestID: middleware.GetReqID(ctx),
+		}
 
-	return ch, nil
+		return es.Publish(ctx, updateTagsStream, event)
+	})
+
+	return ch, err
 }
 
 func (es *eventStore) ViewChannel(ctx context.Context, session authn.Session, id string, withRoles bool) (channels.Channel, error) {
@@ -169,25 +171,31 @@ func (es *eventStore) ListUserChannels(ctx context.Context, session authn.Sessio
 	return cp, nil
 }
 
-func (es *eventStore) EnableChannel(ctx context.Context, session authn.Session, id string) (channels.Channel, error) {
-	ch, err := es.svc.EnableChannel(ctx, session, id)
-	if err != nil {
-		return ch, err
-	}
+func (es *eventStore) EnableChannel(ctx context.Context, session authn.Session, id string) (ch channels.Channel, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if ch, err = es.svc.EnableChannel(ctx, session, id); err != nil {
+			return err
+		}
+
+		return es.changeStatus(ctx, session, channelEnable, enableStream, ch)
+	})
 
-	return es.changeStatus(ctx, session, channelEnable, enableStream, ch)
+	return ch, err
 }
 
-func (es *eventStore) DisableChannel(ctx context.Context, session authn.Session, id string) (channels.Channel, error) {
-	ch, err := es.svc.DisableChannel(ctx, session, id)
-	if err != nil {
-		return ch, err
-	}
+func (es *eventStore) DisableChannel(ctx context.Context, session authn.Session, id string) (ch channels.Channel, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if ch, err = es.svc.DisableChannel(ctx, session, id); err != nil {
+			return err
+		}
+
+		return es.changeStatus(ctx, session, channelDisable, disableStream, ch)
+	})
 
-	return es.changeStatus(ctx, session, channelDisable, disableStream, ch)
+	return ch, err
 }
 
-func (es *eventStore) changeStatus(ctx context.Context, session authn.Session, operation, stream string, ch channels.Channel) (channels.Channel, error) {
+func (es *eventStore) changeStatus(ctx context.Context, session authn.Session, operation, stream string, ch channels.Channel) error {
 	event := changeChannelStatusEvent{
 		id:        ch.ID,
 		operation: operation,
@@ -197,104 +205,92 @@ func (es *eventStore) changeStatus(ctx context.Context, session authn.Session, o
 		Session:   session,
 		requestID: middleware.GetReqID(ctx),
 	}
-	if err := es.Publish(ctx, stream, event); err != nil {
-		return ch, err
-	}
 
-	return ch, nil
+	return es.Publish(ctx, stream, event)
 }
 
 func (es *eventStore) RemoveChannel(ctx context.Context, session authn.Session, id string) error {
-	if err := es.svc.RemoveChannel(ctx, session, id); err != nil {
-		return err
-	}
-
-	event := removeChannelEvent{
-		id:        id,
-		Session:   session,
-		requestID: middleware.GetReqID(ctx),
-	}
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.RemoveChannel(ctx, session, id); err != nil {
+			return err
+		}
 
-	if err := es.Publish(ctx, removeStream, event); err != nil {
-		return err
-	}
+		event := removeChannelEvent{
+			id:        id,
+			Session:   session,
+			requestID: middleware.GetReqID(ctx),
+		}
 
-	return nil
+		return es.Publish(ctx, removeStream, event)
+	})
 }
 
-func (es *eventStore) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
-	if err := es.svc.Connect(ctx, session, chIDs, thIDs, connTypes); err != nil {
-		return err
-	}
-
-	event := connectEvent{
-		chIDs:     chIDs,
-		thIDs:     thIDs,
-		types:     connTypes,
-		Session:   session,
-		requestID: middleware.GetReqID(ctx),
-	}
+func (es *eventStore) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType, topics []string) error {
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.Connect(ctx, session, chIDs, thIDs, connTypes, topics); err != nil {
+			return err
+		}
 
-	if err := es.Publish(ctx, connectStream, event); err != nil {
-		return err
-	}
+		event := connectEvent{
+			chIDs:     chIDs,
+			thIDs:     thIDs,
+			types:     connTypes,
+			topics:    topics,
+			Session:   session,
+			requestID: middleware.GetReqID(ctx),
+		}
 
-	return nil
+		return es.Publish(ctx, connectStream, event)
+	})
 }
 
 func (es *eventStore) Disconnect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
-	if err := es.svc.Disconnect(ctx, session, chIDs, thIDs, connTypes); err != nil {
-		return err
-	}
-
-	event := disconnectEvent{
-		chIDs:     chIDs,
-		thIDs:     thIDs,
-		types:     connTypes,
-		Session:   session,
-		requestID: middleware.GetReqID(ctx),
-	}
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.Disconnect(ctx, session, chIDs, thIDs, connTypes); err != nil {
+			return err
+		}
 
-	if err := es.Publish(ctx, disconnectStream, event); err != nil {
-		return err
-	}
+		event := disconnectEvent{
+			chIDs:     chIDs,
+			thIDs:     thIDs,
+			types:     connTypes,
+			Session:   session,
+			requestID: middleware.GetReqID(ctx),
+		}
 
-	return nil
+		return es.Publish(ctx, disconnectStream, event)
+	})
 }
 
 func (es *eventStore) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) (err error) {
-	if err := es.svc.SetParentGroup(ctx, session, parentGroupID, id); err != nil {
-		return err
-	}
-
-	event := setParentGroupEvent{
-		parentGroupID: parentGroupID,
-		id:            id,
-		Session:       session,
-		requestID:     middleware.GetReqID(ctx),
-	}
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.SetParentGroup(ctx, session, parentGroupID, id); err != nil {
+			return err
+		}
 
-	if err := es.Publish(ctx, setParentStream, event); err != nil {
-		return err
-	}
+		event := setParentGroupEvent{
+			parentGroupID: parentGroupID,
+			id:            id,
+			Session:       session,
+			requestID:     middleware.GetReqID(ctx),
+		}
 
-	return nil
+		return es.Publish(ctx, setParentStream, event)
+	})
 }
 
 func (es *eventStore) RemoveParentGroup(ctx context.Context, session authn.Session, id string) (err error) {
-	if err := es.svc.RemoveParentGroup(ctx, session, id); err != nil {
-		return err
-	}
-
-	event := removeParentGroupEvent{
-		id:        id,
-		Session:   session,
-		requestID: middleware.GetReqID(ctx),
-	}
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.RemoveParentGroup(ctx, session, id); err != nil {
+			return err
+		}
 
-	if err := es.Publish(ctx, removeParentStream, event); err != nil {
-		return err
-	}
+		event := removeParentGroupEvent{
+			id:        id,
+			Session:   session,
+			requestID: middleware.GetReqID(ctx),
+		}
 
-	return nil
+		return es.Publish(ctx, removeParentStream, event)
+	})
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/absmach/magistrala/pkg/connections"
 	"github.com/absmach/magistrala/pkg/errors"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/events/store"
 	"github.com/absmach/magistrala/pkg/roles"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/redis/go-redis/v9"
@@ -45,8 +46,9 @@ var (
 
 func newEventStoreMiddleware(t *testing.T) (*mocks.Service, channels.Service) {
 	svc := new(mocks.Service)
-	nsvc, err := events.NewEventStoreMiddleware(context.Background(), svc, storeURL)
-	require.Nil(t, err, fmt.Sprintf("create events store middleware failed with unexpected error: %s", err))
+	publisher, err := store.NewPublisher(context.Background(), storeURL, "channels-es-pub")
+	require.Nil(t, err, fmt.Sprintf("create events store publisher failed with unexpected error: %s", err))
+	nsvc := events.NewEventStoreMiddleware(svc, publisher)
 
 	return svc, nsvc
 }
@@ -497,6 +499,7 @@ func TestConnect(t *testing.T) {
 		chIDs     []string
 		clIDs     []string
 		connTypes []connections.ConnType
+		topics    []string
 		svcErr    error
 		err       error
 	}{
@@ -509,6 +512,16 @@ func TestConnect(t *testing.T) {
 			svcErr:    nil,
 			err:       nil,
 		},
+		{
+			desc:      "publish with topics successfully",
+			session:   validSession,
+			chIDs:     []string{validChannel.ID},
+			clIDs:     []string{testsutil.GenerateUUID(t)},
+			connTypes: []connections.ConnType{connections.Publish},
+			topics:    []string{"sensors/+/temp"},
+			svcErr:    nil,
+			err:       nil,
+		},
 		{
 			desc:      "failed to publish with service error",
 			session:   validSession,
@@ -522,8 +535,8 @@ func TestConnect(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
-			svcCall := svc.On("Connect", validCtx, tc.session, tc.chIDs, tc.clIDs, tc.connTypes).Return(tc.svcErr)
-			err := nsvc.Connect(validCtx, tc.session, tc.chIDs, tc.clIDs, tc.connTypes)
+			svcCall := svc.On("Connect", validCtx, tc.session, tc.chIDs, tc.clIDs, tc.connTypes, tc.topics).Return(tc.svcErr)
+			err := nsvc.Connect(validCtx, tc.session, tc.chIDs, tc.clIDs, tc.connTypes, tc.topics)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			svcCall.Unset()
 		})
@@ -211,7 +211,7 @@ func (am *authorizationMiddleware) RemoveChannel(ctx context.Context, session au
 	return am.svc.RemoveChannel(ctx, session, id)
 }
 
-func (am *authorizationMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
+func (am *authorizationMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType, topics []string) error {
 	for _, chID := range chIDs {
 		if err := am.authorize(ctx, session, policies.ChannelType, operations.OpConnectClient, smqauthz.PolicyReq{
 			Domain:      session.DomainID,
@@ -236,7 +236,7 @@ func (am *authorizationMiddleware) Connect(ctx context.Context, session authn.Se
 		}
 	}
 
-	return am.svc.Connect(ctx, session, chIDs, thIDs, connTypes)
+	return am.svc.Connect(ctx, session, chIDs, thIDs, connTypes, topics)
 }
 
 func (am *authorizationMiddleware) Disconnect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
@@ -160,18 +160,21 @@ func (cm *calloutMiddleware) RemoveChannel(ctx context.Context, session authn.Se
 	return cm.svc.RemoveChannel(ctx, session, id)
 }
 
-func (cm *calloutMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
+func (cm *calloutMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType, topics []string) error {
 	params := map[string]any{
 		"channel_ids":      chIDs,
 		"client_ids":       thIDs,
 		"connection_types": connTypes,
 	}
+	if len(topics) > 0 {
+		params["topics"] = topics
+	}
 
 	if err := cm.callOut(ctx, session, policies.ChannelType, operations.OpConnectClient, params); err != nil {
 		return err
 	}
 
-	return cm.svc.Connect(ctx, session, chIDs, thIDs, connTypes)
+	return cm.svc.Connect(ctx, session, chIDs, thIDs, connTypes, topics)
 }
 
 func (cm *calloutMiddleware) Disconnect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
@@ -218,7 +218,7 @@ func (lm *loggingMiddleware) RemoveChannel(ctx context.Context, session authn.Se
 	return lm.svc.RemoveChannel(ctx, session, id)
 }
 
-func (lm *loggingMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, clIDs []string, connTypes []connections.ConnType) (err error) {
+func (lm *loggingMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, clIDs []string, connTypes []connections.ConnType, topics []string) (err error) {
 	defer func(begin time.Time) {
 		args := []any{
 			slog.String("duration", time.Since(begin).String()),
@@ -227,6 +227,9 @@ func (lm *loggingMiddleware) Connect(ctx context.Context, session authn.Session,
 			slog.Any("channel_ids", chIDs),
 			slog.Any("client_ids", clIDs),
 		}
+		if len(topics) > 0 {
+			args = append(args, slog.Any("topics", topics))
+		}
 		if err != nil {
 			args = append(args, slog.String("error", err.Error()))
 			lm.logger.Warn("Connect channels and clients failed", args...)
@@ -234,7 +237,7 @@ func (lm *loggingMiddleware) Connect(ctx context.Context, session authn.Session,
 		}
 		lm.logger.Info("Connect channels and clients completed successfully", args...)
 	}(time.Now())
-	return lm.svc.Connect(ctx, session, chIDs, clIDs, connTypes)
+	return lm.svc.Connect(ctx, session, chIDs, clIDs, connTypes, topics)
 }
 
 func (lm *loggingMiddleware) Disconnect(ctx context.Context, session authn.Session, chIDs, clIDs []string, connTypes []connections.ConnType) (err error) {
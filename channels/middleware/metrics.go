@@ -106,12 +106,12 @@ func (ms *metricsMiddleware) RemoveChannel(ctx context.Context, session authn.Se
 	return ms.svc.RemoveChannel(ctx, session, id)
 }
 
-func (ms *metricsMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
+func (ms *metricsMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType, topics []string) error {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "connect").Add(1)
 		ms.latency.With("method", "connect").Observe(time.Since(begin).Seconds())
 	}(time.Now())
-	return ms.svc.Connect(ctx, session, chIDs, thIDs, connTypes)
+	return ms.svc.Connect(ctx, session, chIDs, thIDs, connTypes, topics)
 }
 
 func (ms *metricsMiddleware) Disconnect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
@@ -99,13 +99,14 @@ func (tm *tracingMiddleware) RemoveChannel(ctx context.Context, session authn.Se
 	return tm.svc.RemoveChannel(ctx, session, id)
 }
 
-func (tm *tracingMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
+func (tm *tracingMiddleware) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType, topics []string) error {
 	ctx, span := tracing.StartSpan(ctx, tm.tracer, "connect", trace.WithAttributes(
 		attribute.StringSlice("channel_ids", chIDs),
 		attribute.StringSlice("client_ids", thIDs),
+		attribute.StringSlice("topics", topics),
 	))
 	defer span.End()
-	return tm.svc.Connect(ctx, session, chIDs, thIDs, connTypes)
+	return tm.svc.Connect(ctx, session, chIDs, thIDs, connTypes, topics)
 }
 
 func (tm *tracingMiddleware) Disconnect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) error {
@@ -127,6 +127,89 @@ func (_c *ChannelsServiceClient_Authorize_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// Connect provides a mock function for the type ChannelsServiceClient
+func (_mock *ChannelsServiceClient) Connect(ctx context.Context, in *v1.ConnectReq, opts ...grpc.CallOption) (*v1.ConnectRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Connect")
+	}
+
+	var r0 *v1.ConnectRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.ConnectReq, ...grpc.CallOption) (*v1.ConnectRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.ConnectReq, ...grpc.CallOption) *v1.ConnectRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ConnectRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.ConnectReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ChannelsServiceClient_Connect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Connect'
+type ChannelsServiceClient_Connect_Call struct {
+	*mock.Call
+}
+
+// Connect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ConnectReq
+//   - opts ...grpc.CallOption
+func (_e *ChannelsServiceClient_Expecter) Connect(ctx interface{}, in interface{}, opts ...interface{}) *ChannelsServiceClient_Connect_Call {
+	return &ChannelsServiceClient_Connect_Call{Call: _e.mock.On("Connect",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ChannelsServiceClient_Connect_Call) Run(run func(ctx context.Context, in *v1.ConnectReq, opts ...grpc.CallOption)) *ChannelsServiceClient_Connect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.ConnectReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.ConnectReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ChannelsServiceClient_Connect_Call) Return(connectRes *v1.ConnectRes, err error) *ChannelsServiceClient_Connect_Call {
+	_c.Call.Return(connectRes, err)
+	return _c
+}
+
+func (_c *ChannelsServiceClient_Connect_Call) RunAndReturn(run func(ctx context.Context, in *v1.ConnectReq, opts ...grpc.CallOption) (*v1.ConnectRes, error)) *ChannelsServiceClient_Connect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveClientConnections provides a mock function for the type ChannelsServiceClient
 func (_mock *ChannelsServiceClient) RemoveClientConnections(ctx context.Context, in *v1.RemoveClientConnectionsReq, opts ...grpc.CallOption) (*v1.RemoveClientConnectionsRes, error) {
 	var tmpRet mock.Arguments
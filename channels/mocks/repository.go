@@ -358,20 +358,29 @@ func (_c *Repository_CheckConnection_Call) RunAndReturn(run func(ctx context.Con
 }
 
 // ClientAuthorize provides a mock function for the type Repository
-func (_mock *Repository) ClientAuthorize(ctx context.Context, conn channels.Connection) error {
+func (_mock *Repository) ClientAuthorize(ctx context.Context, conn channels.Connection) (channels.Connection, error) {
 	ret := _mock.Called(ctx, conn)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ClientAuthorize")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, channels.Connection) error); ok {
+	var r0 channels.Connection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, channels.Connection) (channels.Connection, error)); ok {
+		return returnFunc(ctx, conn)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, channels.Connection) channels.Connection); ok {
 		r0 = returnFunc(ctx, conn)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(channels.Connection)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, channels.Connection) error); ok {
+		r1 = returnFunc(ctx, conn)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // Repository_ClientAuthorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClientAuthorize'
@@ -404,12 +413,12 @@ func (_c *Repository_ClientAuthorize_Call) Run(run func(ctx context.Context, con
 	return _c
 }
 
-func (_c *Repository_ClientAuthorize_Call) Return(err error) *Repository_ClientAuthorize_Call {
-	_c.Call.Return(err)
+func (_c *Repository_ClientAuthorize_Call) Return(connection channels.Connection, err error) *Repository_ClientAuthorize_Call {
+	_c.Call.Return(connection, err)
 	return _c
 }
 
-func (_c *Repository_ClientAuthorize_Call) RunAndReturn(run func(ctx context.Context, conn channels.Connection) error) *Repository_ClientAuthorize_Call {
+func (_c *Repository_ClientAuthorize_Call) RunAndReturn(run func(ctx context.Context, conn channels.Connection) (channels.Connection, error)) *Repository_ClientAuthorize_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -136,16 +136,16 @@ func (_c *Service_AddRole_Call) RunAndReturn(run func(ctx context.Context, sessi
 }
 
 // Connect provides a mock function for the type Service
-func (_mock *Service) Connect(ctx context.Context, session authn.Session, chIDs []string, clIDs []string, connType []connections.ConnType) error {
-	ret := _mock.Called(ctx, session, chIDs, clIDs, connType)
+func (_mock *Service) Connect(ctx context.Context, session authn.Session, chIDs []string, clIDs []string, connType []connections.ConnType, topics []string) error {
+	ret := _mock.Called(ctx, session, chIDs, clIDs, connType, topics)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Connect")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, []string, []string, []connections.ConnType) error); ok {
-		r0 = returnFunc(ctx, session, chIDs, clIDs, connType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, []string, []string, []connections.ConnType, []string) error); ok {
+		r0 = returnFunc(ctx, session, chIDs, clIDs, connType, topics)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -163,11 +163,12 @@ type Service_Connect_Call struct {
 //   - chIDs []string
 //   - clIDs []string
 //   - connType []connections.ConnType
-func (_e *Service_Expecter) Connect(ctx interface{}, session interface{}, chIDs interface{}, clIDs interface{}, connType interface{}) *Service_Connect_Call {
-	return &Service_Connect_Call{Call: _e.mock.On("Connect", ctx, session, chIDs, clIDs, connType)}
+//   - topics []string
+func (_e *Service_Expecter) Connect(ctx interface{}, session interface{}, chIDs interface{}, clIDs interface{}, connType interface{}, topics interface{}) *Service_Connect_Call {
+	return &Service_Connect_Call{Call: _e.mock.On("Connect", ctx, session, chIDs, clIDs, connType, topics)}
 }
 
-func (_c *Service_Connect_Call) Run(run func(ctx context.Context, session authn.Session, chIDs []string, clIDs []string, connType []connections.ConnType)) *Service_Connect_Call {
+func (_c *Service_Connect_Call) Run(run func(ctx context.Context, session authn.Session, chIDs []string, clIDs []string, connType []connections.ConnType, topics []string)) *Service_Connect_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -189,12 +190,17 @@ func (_c *Service_Connect_Call) Run(run func(ctx context.Context, session authn.
 		if args[4] != nil {
 			arg4 = args[4].([]connections.ConnType)
 		}
+		var arg5 []string
+		if args[5] != nil {
+			arg5 = args[5].([]string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
 			arg4,
+			arg5,
 		)
 	})
 	return _c
@@ -205,7 +211,7 @@ func (_c *Service_Connect_Call) Return(err error) *Service_Connect_Call {
 	return _c
 }
 
-func (_c *Service_Connect_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, chIDs []string, clIDs []string, connType []connections.ConnType) error) *Service_Connect_Call {
+func (_c *Service_Connect_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, chIDs []string, clIDs []string, connType []connections.ConnType, topics []string) error) *Service_Connect_Call {
 	_c.Call.Return(run)
 	return _c
 }
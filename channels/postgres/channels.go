@@ -952,8 +952,8 @@ func (cr *channelRepository) RemoveParentGroup(ctx context.Context, ch channels.
 
 func (cr *channelRepository) AddConnections(ctx context.Context, conns []channels.Connection) error {
 	dbConns := toDBConnections(conns)
-	q := `INSERT INTO connections (channel_id, domain_id, client_id, type)
-			VALUES (:channel_id, :domain_id, :client_id, :type );`
+	q := `INSERT INTO connections (channel_id, domain_id, client_id, type, topics)
+			VALUES (:channel_id, :domain_id, :client_id, :type, :topics);`
 
 	if _, err := cr.db.NamedExecContext(ctx, q, dbConns); err != nil {
 		return cr.eh.HandleError(repoerr.ErrCreateEntity, err)
@@ -1007,19 +1007,24 @@ func (cr *channelRepository) CheckConnection(ctx context.Context, conn channels.
 	return nil
 }
 
-func (cr *channelRepository) ClientAuthorize(ctx context.Context, conn channels.Connection) error {
-	query := `SELECT 1 FROM connections WHERE channel_id = :channel_id AND client_id = :client_id AND domain_id = :domain_id AND type = :type LIMIT 1`
+func (cr *channelRepository) ClientAuthorize(ctx context.Context, conn channels.Connection) (channels.Connection, error) {
+	query := `SELECT channel_id, domain_id, client_id, type, topics FROM connections WHERE channel_id = :channel_id AND client_id = :client_id AND domain_id = :domain_id AND type = :type LIMIT 1`
 	dbConn := toDBConnection(conn)
 	rows, err := cr.db.NamedQueryContext(ctx, query, dbConn)
 	if err != nil {
-		return cr.eh.HandleError(repoerr.ErrViewEntity, err)
+		return channels.Connection{}, cr.eh.HandleError(repoerr.ErrViewEntity, err)
 	}
 	defer rows.Close()
 
 	if !rows.Next() {
-		return repoerr.ErrNotFound
+		return channels.Connection{}, repoerr.ErrNotFound
 	}
-	return nil
+	dbConn = dbConnection{}
+	if err := rows.StructScan(&dbConn); err != nil {
+		return channels.Connection{}, cr.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return toConnection(dbConn), nil
 }
 
 func (cr *channelRepository) ChannelConnectionsCount(ctx context.Context, id string) (uint64, error) {
@@ -1456,6 +1461,7 @@ type dbConnection struct {
 	DomainID  string               `db:"domain_id"`
 	ClientID  string               `db:"client_id"`
 	Type      connections.ConnType `db:"type"`
+	Topics    pgtype.TextArray     `db:"topics"`
 }
 
 func toDBConnections(conns []channels.Connection) []dbConnection {
@@ -1467,10 +1473,30 @@ func toDBConnections(conns []channels.Connection) []dbConnection {
 }
 
 func toDBConnection(conn channels.Connection) dbConnection {
+	var topics pgtype.TextArray
+	// Set does not fail for string slices, and stores nil slices as NULL.
+	_ = topics.Set(conn.Topics)
+
 	return dbConnection{
 		ClientID:  conn.ClientID,
 		ChannelID: conn.ChannelID,
 		DomainID:  conn.DomainID,
 		Type:      conn.Type,
+		Topics:    topics,
+	}
+}
+
+func toConnection(dbConn dbConnection) channels.Connection {
+	var topics []string
+	for _, e := range dbConn.Topics.Elements {
+		topics = append(topics, e.String)
+	}
+
+	return channels.Connection{
+		ClientID:  dbConn.ClientID,
+		ChannelID: dbConn.ChannelID,
+		DomainID:  dbConn.DomainID,
+		Type:      dbConn.Type,
+		Topics:    topics,
 	}
 }
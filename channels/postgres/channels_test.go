@@ -1685,19 +1685,35 @@ func TestClientAuthorize(t *testing.T) {
 	_, err := repo.Save(context.Background(), validChannel)
 	require.Nil(t, err, fmt.Sprintf("save channel unexpected error: %s", err))
 
-	err = repo.AddConnections(context.Background(), []channels.Connection{validConnection})
+	topicsConnection := validConnection
+	topicsConnection.Type = connections.Subscribe
+	topicsConnection.Topics = []string{"commands/{client_id}/#", "status"}
+	err = repo.AddConnections(context.Background(), []channels.Connection{validConnection, topicsConnection})
 	require.Nil(t, err, fmt.Sprintf("add connection unexpected error: %s", err))
 
 	cases := []struct {
 		desc       string
 		connection channels.Connection
+		response   channels.Connection
 		err        error
 	}{
 		{
 			desc:       "authorize successfully",
 			connection: validConnection,
+			response:   validConnection,
 			err:        nil,
 		},
+		{
+			desc: "authorize connection with topics successfully",
+			connection: channels.Connection{
+				ClientID:  topicsConnection.ClientID,
+				ChannelID: topicsConnection.ChannelID,
+				DomainID:  topicsConnection.DomainID,
+				Type:      connections.Subscribe,
+			},
+			response: topicsConnection,
+			err:      nil,
+		},
 		{
 			desc: "authorize with  non-existent channel",
 			connection: channels.Connection{
@@ -1722,8 +1738,9 @@ func TestClientAuthorize(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
-			err := repo.ClientAuthorize(context.Background(), tc.connection)
+			conn, err := repo.ClientAuthorize(context.Background(), tc.connection)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.response, conn, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.response, conn))
 		})
 	}
 }
@@ -106,6 +106,15 @@ func Migration() (*migrate.MemoryMigrationSource, error) {
 					`DROP INDEX IF EXISTS idx_channels_parent_group_id;`,
 				},
 			},
+			{
+				Id: "channels_07",
+				Up: []string{
+					`ALTER TABLE connections ADD COLUMN IF NOT EXISTS topics TEXT[]`,
+				},
+				Down: []string{
+					`ALTER TABLE connections DROP COLUMN IF EXISTS topics`,
+				},
+			},
 		},
 	}
 	channelsMigration.Migrations = append(channelsMigration.Migrations, rolesMigration.Migrations...)
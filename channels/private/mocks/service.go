@@ -12,6 +12,8 @@ import (
 	"context"
 
 	"github.com/absmach/magistrala/channels"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/connections"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -99,6 +101,87 @@ func (_c *Service_Authorize_Call) RunAndReturn(run func(ctx context.Context, req
 	return _c
 }
 
+// Connect provides a mock function for the type Service
+func (_mock *Service) Connect(ctx context.Context, session authn.Session, chIDs []string, clIDs []string, connTypes []connections.ConnType, topics []string) error {
+	ret := _mock.Called(ctx, session, chIDs, clIDs, connTypes, topics)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Connect")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, []string, []string, []connections.ConnType, []string) error); ok {
+		r0 = returnFunc(ctx, session, chIDs, clIDs, connTypes, topics)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_Connect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Connect'
+type Service_Connect_Call struct {
+	*mock.Call
+}
+
+// Connect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - chIDs []string
+//   - clIDs []string
+//   - connTypes []connections.ConnType
+//   - topics []string
+func (_e *Service_Expecter) Connect(ctx interface{}, session interface{}, chIDs interface{}, clIDs interface{}, connTypes interface{}, topics interface{}) *Service_Connect_Call {
+	return &Service_Connect_Call{Call: _e.mock.On("Connect", ctx, session, chIDs, clIDs, connTypes, topics)}
+}
+
+func (_c *Service_Connect_Call) Run(run func(ctx context.Context, session authn.Session, chIDs []string, clIDs []string, connTypes []connections.ConnType, topics []string)) *Service_Connect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		var arg4 []connections.ConnType
+		if args[4] != nil {
+			arg4 = args[4].([]connections.ConnType)
+		}
+		var arg5 []string
+		if args[5] != nil {
+			arg5 = args[5].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_Connect_Call) Return(err error) *Service_Connect_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_Connect_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, chIDs []string, clIDs []string, connTypes []connections.ConnType, topics []string) error) *Service_Connect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveClientConnections provides a mock function for the type Service
 func (_mock *Service) RemoveClientConnections(ctx context.Context, clientID string) error {
 	ret := _mock.Called(ctx, clientID)
@@ -8,13 +8,18 @@ import (
 
 	"github.com/absmach/magistrala/channels"
 	dom "github.com/absmach/magistrala/domains"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/connections"
 	pkgDomains "github.com/absmach/magistrala/pkg/domains"
 	"github.com/absmach/magistrala/pkg/errors"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
 	"github.com/absmach/magistrala/pkg/policies"
 )
 
-var errDisabledDomain = errors.New("domain is disabled or frozen")
+var (
+	errDisabledDomain = errors.New("domain is disabled or frozen")
+	errTopic          = errors.New("subtopic is not allowed by the connection")
+)
 
 type Service interface {
 	Authorize(ctx context.Context, req channels.AuthzReq) error
@@ -22,6 +27,9 @@ type Service interface {
 	RemoveClientConnections(ctx context.Context, clientID string) error
 	RetrieveByID(ctx context.Context, id string) (channels.Channel, error)
 	RetrieveIDByRoute(ctx context.Context, route, domainID string) (string, error)
+	// Connect connects the clients to the channels on behalf of the session,
+	// so the permissions of the session apply as in the public API.
+	Connect(ctx context.Context, session authn.Session, chIDs, clIDs []string, connTypes []connections.ConnType, topics []string) error
 }
 
 type service struct {
@@ -30,12 +38,13 @@ type service struct {
 	evaluator policies.Evaluator
 	policy    policies.Service
 	domains   pkgDomains.Authorization
+	channels  channels.Service
 }
 
 var _ Service = (*service)(nil)
 
-func New(repo channels.Repository, cache channels.Cache, evaluator policies.Evaluator, policy policies.Service, domains pkgDomains.Authorization) Service {
-	return service{repo, cache, evaluator, policy, domains}
+func New(repo channels.Repository, cache channels.Cache, evaluator policies.Evaluator, policy policies.Service, domains pkgDomains.Authorization, chs channels.Service) Service {
+	return service{repo, cache, evaluator, policy, domains, chs}
 }
 
 func (svc service) Authorize(ctx context.Context, req channels.AuthzReq) error {
@@ -65,14 +74,18 @@ func (svc service) Authorize(ctx context.Context, req channels.AuthzReq) error {
 		return nil
 	case policies.ClientType:
 		// Optimization: Add cache
-		if err := svc.repo.ClientAuthorize(ctx, channels.Connection{
+		conn, err := svc.repo.ClientAuthorize(ctx, channels.Connection{
 			DomainID:  req.DomainID,
 			ChannelID: req.ChannelID,
 			ClientID:  req.ClientID,
 			Type:      req.Type,
-		}); err != nil {
+		})
+		if err != nil {
 			return errors.Wrap(svcerr.ErrAuthorization, err)
 		}
+		if !connections.AllowsTopic(conn.Topics, req.ClientID, req.Subtopic) {
+			return errors.Wrap(svcerr.ErrAuthorization, errTopic)
+		}
 		return nil
 	default:
 		return svcerr.ErrAuthentication
@@ -138,3 +151,7 @@ func (svc service) RetrieveIDByRoute(ctx context.Context, route, domainID string
 
 	return chn.ID, nil
 }
+
+func (svc service) Connect(ctx context.Context, session authn.Session, chIDs, clIDs []string, connTypes []connections.ConnType, topics []string) error {
+	return svc.channels.Connect(ctx, session, chIDs, clIDs, connTypes, topics)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/absmach/magistrala/pkg/errors"
 	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/events"
 	"github.com/absmach/magistrala/pkg/policies"
 	"github.com/absmach/magistrala/pkg/roles"
 )
@@ -235,7 +236,7 @@ func (svc service) RemoveChannel(ctx context.Context, session authn.Session, id
 		return errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 	if ch.Route != "" {
-		if err := svc.cache.Remove(ctx, ch.Route, ch.Domain); err != nil {
+		if err := svc.removeRouteCache(ctx, ch.Route, ch.Domain); err != nil {
 			return errors.Wrap(svcerr.ErrRemoveEntity, err)
 		}
 	}
@@ -282,7 +283,7 @@ func (svc service) RemoveChannel(ctx context.Context, session authn.Session, id
 	return nil
 }
 
-func (svc service) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType) (retErr error) {
+func (svc service) Connect(ctx context.Context, session authn.Session, chIDs, thIDs []string, connTypes []connections.ConnType, topics []string) (retErr error) {
 	for _, chID := range chIDs {
 		c, err := svc.repo.RetrieveByID(ctx, chID)
 		if err != nil {
@@ -319,12 +320,14 @@ func (svc service) Connect(ctx context.Context, session authn.Session, chIDs, th
 					ChannelID: chID,
 					DomainID:  session.DomainID,
 					Type:      connType,
+					Topics:    topics,
 				})
 				cliConns = append(cliConns, &grpcCommonV1.Connection{
 					ClientId:  thID,
 					ChannelId: chID,
 					DomainId:  session.DomainID,
 					Type:      uint32(connType),
+					Topics:    topics,
 				})
 			}
 		}
@@ -512,3 +515,16 @@ func (svc service) changeChannelStatus(ctx context.Context, userID string, chann
 	}
 	return channel, nil
 }
+
+// removeRouteCache removes the channel route from the cache, and again once
+// the change made with ctx is committed, so that a concurrent lookup can not
+// keep the route cached as it was before the change.
+func (svc service) removeRouteCache(ctx context.Context, route, domainID string) error {
+	if err := svc.cache.Remove(ctx, route, domainID); err != nil {
+		return err
+	}
+
+	return events.AfterCommit(ctx, func(ctx context.Context) error {
+		return svc.cache.Remove(ctx, route, domainID)
+	})
+}
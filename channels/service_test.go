@@ -806,6 +806,7 @@ func TestConnect(t *testing.T) {
 		channelIDs               []string
 		thingIDs                 []string
 		connTypes                []connections.ConnType
+		topics                   []string
 		repoConn                 channels.Connection
 		clientsConn              []*grpcCommonV1.Connection
 		retrieveByIDRes          channels.Channel
@@ -847,6 +848,39 @@ func TestConnect(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			desc:            "connect with topics successfully",
+			channelIDs:      []string{validChannel.ID},
+			thingIDs:        []string{validID},
+			connTypes:       []connections.ConnType{connections.Subscribe},
+			topics:          []string{"commands/{client_id}/#"},
+			retrieveByIDRes: validDomainChannel,
+			retrieveEntityRes: &grpcCommonV1.RetrieveEntityRes{
+				Entity: &grpcCommonV1.EntityBasic{
+					Id:       validID,
+					DomainId: validID,
+					Status:   uint32(channels.EnabledStatus),
+				},
+			},
+			checkConnErr: repoerr.ErrNotFound,
+			repoConn: channels.Connection{
+				ClientID:  validID,
+				ChannelID: validChannel.ID,
+				DomainID:  validID,
+				Type:      connections.Subscribe,
+				Topics:    []string{"commands/{client_id}/#"},
+			},
+			clientsConn: []*grpcCommonV1.Connection{
+				{
+					ClientId:  validID,
+					ChannelId: validChannel.ID,
+					DomainId:  validID,
+					Type:      uint32(connections.Subscribe),
+					Topics:    []string{"commands/{client_id}/#"},
+				},
+			},
+			err: nil,
+		},
 		{
 			desc:            "connect with failed to retrieve channel",
 			channelIDs:      []string{validChannel.ID},
@@ -1021,7 +1055,7 @@ func TestConnect(t *testing.T) {
 			repoCall1 := repo.On("CheckConnection", context.Background(), tc.repoConn).Return(tc.checkConnErr)
 			clientsCall1 := clientsSvc.On("AddConnections", context.Background(), &grpcCommonV1.AddConnectionsReq{Connections: tc.clientsConn}).Return(&grpcCommonV1.AddConnectionsRes{}, tc.addClientConnectionsErr)
 			repoCall2 := repo.On("AddConnections", context.Background(), []channels.Connection{tc.repoConn}).Return(tc.addChannelConnectionsErr)
-			err := svc.Connect(context.Background(), validSession, tc.channelIDs, tc.thingIDs, tc.connTypes)
+			err := svc.Connect(context.Background(), validSession, tc.channelIDs, tc.thingIDs, tc.connTypes, tc.topics)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v to contain %v", tc.err, err))
 			repoCall.Unset()
 			clientsCall.Unset()
@@ -6,6 +6,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/absmach/magistrala/clients"
 	smqsdk "github.com/absmach/magistrala/pkg/sdk"
@@ -20,6 +21,10 @@ const (
 	members    = "members"
 	secret     = "secret"
 
+	importClients = "import"
+	importStatus  = "import-status"
+	exportClients = "export"
+
 	// Usage strings for client operations.
 	usageClientCreate       = "cli clients create <JSON_client> <domain_id> <user_auth_token>"
 	usageClientGet          = "cli clients <client_id|all> get <domain_id> <user_auth_token>"
@@ -32,6 +37,9 @@ const (
 	usageClientConnect      = "cli clients <client_id> connect <channel_id> <conn_types_json_list> <domain_id> <user_auth_token>"
 	usageClientDisconnect   = "cli clients <client_id> disconnect <channel_id> <conn_types_json_list> <domain_id> <user_auth_token>"
 	usageClientUsers        = "cli clients <client_id> users <domain_id> <user_auth_token>"
+	usageClientImport       = "cli clients import <csv|ndjson> <file_path> <domain_id> <user_auth_token> [--dry-run]"
+	usageClientImportStatus = "cli clients import-status <job_id> <domain_id> <user_auth_token>"
+	usageClientExport       = "cli clients export <csv|ndjson> <domain_id> <user_auth_token>"
 
 	// Usage strings for client roles operations.
 	usageClientRolesCreate = "cli clients <client_id> roles create <JSON_role> <domain_id> <user_auth_token>"
@@ -51,12 +59,16 @@ const (
 	usageClientRoleMembersDelete = "cli clients <client_id> roles members delete <role_id> <JSON_members|all> <domain_id> <user_auth_token>"
 )
 
+// dryRun only validates the imported clients.
+var dryRun bool
+
 func NewClientsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "clients <client_id|all|create> [operation] [args...]",
 		Short: "Clients management",
 		Long: `Format: 
   clients create [args...]
+  clients import|import-status|export [args...]
   clients <client_id|all> <operation> [args...]
 
 Operations (require client_id/all): get, update, delete, enable, disable, connect, disconnect, users, roles
@@ -70,7 +82,10 @@ Examples:
   clients <client_id> enable <domain_id> <user_auth_token>
   clients <client_id> disable <domain_id> <user_auth_token>
   clients <client_id> connect <channel_id> <conn_types_json_list> <domain_id> <user_auth_token>
-  clients <client_id> users <domain_id> <user_auth_token>`,
+  clients <client_id> users <domain_id> <user_auth_token>
+  clients import <csv|ndjson> <file_path> <domain_id> <user_auth_token> [--dry-run]
+  clients import-status <job_id> <domain_id> <user_auth_token>
+  clients export <csv|ndjson> <domain_id> <user_auth_token>`,
 
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 {
@@ -78,9 +93,19 @@ Examples:
 				return
 			}
 
-			if args[0] == create {
+			switch args[0] {
+			case create:
 				handleClientCreate(cmd, args[1:])
 				return
+			case importClients:
+				handleClientImport(cmd, args[1:])
+				return
+			case importStatus:
+				handleClientImportStatus(cmd, args[1:])
+				return
+			case exportClients:
+				handleClientExport(cmd, args[1:])
+				return
 			}
 
 			if len(args) < 2 {
@@ -116,6 +141,7 @@ Examples:
 			}
 		},
 	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only validate the imported clients")
 
 	return cmd
 }
@@ -142,6 +168,57 @@ func handleClientCreate(cmd *cobra.Command, args []string) {
 	logJSONCmd(*cmd, client)
 }
 
+func handleClientImport(cmd *cobra.Command, args []string) {
+	if len(args) != 4 {
+		logUsageCmd(*cmd, usageClientImport)
+		return
+	}
+
+	data, err := os.ReadFile(args[1])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	job, err := sdk.ImportClients(cmd.Context(), data, args[0], dryRun, args[2], args[3])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	logJSONCmd(*cmd, job)
+}
+
+func handleClientImportStatus(cmd *cobra.Command, args []string) {
+	if len(args) != 3 {
+		logUsageCmd(*cmd, usageClientImportStatus)
+		return
+	}
+
+	job, err := sdk.ViewImportJob(cmd.Context(), args[0], args[1], args[2])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	logJSONCmd(*cmd, job)
+}
+
+func handleClientExport(cmd *cobra.Command, args []string) {
+	if len(args) != 3 {
+		logUsageCmd(*cmd, usageClientExport)
+		return
+	}
+
+	data, err := sdk.ExportClients(cmd.Context(), args[0], args[1], args[2])
+	if err != nil {
+		logErrorCmd(*cmd, err)
+		return
+	}
+
+	fmt.Fprint(cmd.OutOrStdout(), string(data))
+}
+
 func handleClientGet(cmd *cobra.Command, clientParams string, args []string) {
 	if len(args) != 2 {
 		logUsageCmd(*cmd, usageClientGet)
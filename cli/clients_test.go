@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -1938,3 +1940,281 @@ func TestClientUsersCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestImportClientsCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	clientsCmd := cli.NewClientsCmd()
+	rootCmd := setFlags(clientsCmd)
+
+	data := []byte("name,tags\nsensor,a;b\n")
+	filePath := filepath.Join(t.TempDir(), "clients.csv")
+	err := os.WriteFile(filePath, data, 0o600)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error writing import file: %s", err))
+
+	job := smqsdk.ImportJob{
+		ID:        testsutil.GenerateUUID(t),
+		DomainID:  domainID,
+		Format:    smqsdk.CSVFormat,
+		Status:    "pending",
+		Total:     1,
+		CreatedBy: client.ID,
+	}
+	var jb smqsdk.ImportJob
+
+	cases := []struct {
+		desc          string
+		args          []string
+		dryRun        bool
+		sdkErr        errors.SDKError
+		errLogMessage string
+		job           smqsdk.ImportJob
+		logType       outputLog
+	}{
+		{
+			desc: "import clients successfully",
+			args: []string{
+				importCmd,
+				smqsdk.CSVFormat,
+				filePath,
+				domainID,
+				token,
+			},
+			job:     job,
+			logType: entityLog,
+		},
+		{
+			desc: "import clients with invalid args",
+			args: []string{
+				importCmd,
+				smqsdk.CSVFormat,
+				filePath,
+				domainID,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "import clients from missing file",
+			args: []string{
+				importCmd,
+				smqsdk.CSVFormat,
+				filepath.Join(t.TempDir(), "missing.csv"),
+				domainID,
+				token,
+			},
+			logType: errLog,
+		},
+		{
+			desc: "import clients with invalid token",
+			args: []string{
+				importCmd,
+				smqsdk.CSVFormat,
+				filePath,
+				domainID,
+				invalidToken,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized)),
+			logType:       errLog,
+		},
+		{
+			desc: "import clients in dry-run mode",
+			args: []string{
+				importCmd,
+				smqsdk.CSVFormat,
+				filePath,
+				domainID,
+				token,
+				"--dry-run",
+			},
+			dryRun:  true,
+			job:     smqsdk.ImportJob{ID: job.ID, Format: smqsdk.CSVFormat, DryRun: true, Status: "completed", Total: 1, Processed: 1},
+			logType: entityLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var sdkCall *mock.Call
+			if len(tc.args) >= 5 {
+				sdkCall = sdkMock.On("ImportClients", mock.Anything, data, tc.args[1], tc.dryRun, tc.args[3], tc.args[4]).Return(tc.job, tc.sdkErr)
+			}
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &jb)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.job, jb, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.job, jb))
+			case errLog:
+				if tc.errLogMessage != "" {
+					assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+				}
+				assert.True(t, strings.Contains(out, "error"), fmt.Sprintf("%s expected error, got: %s", tc.desc, out))
+			case usageLog:
+				assert.True(t, strings.Contains(out, "cli clients import"), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+
+			if sdkCall != nil {
+				sdkCall.Unset()
+			}
+		})
+	}
+}
+
+func TestImportStatusCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	clientsCmd := cli.NewClientsCmd()
+	rootCmd := setFlags(clientsCmd)
+
+	job := smqsdk.ImportJob{
+		ID:        testsutil.GenerateUUID(t),
+		DomainID:  domainID,
+		Format:    smqsdk.NDJSONFormat,
+		Status:    "running",
+		Total:     10,
+		Processed: 4,
+		Imported:  3,
+		Failed:    1,
+		Errors:    []smqsdk.ImportError{{Line: 2, Error: "duplicate client id"}},
+	}
+	var jb smqsdk.ImportJob
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		errLogMessage string
+		job           smqsdk.ImportJob
+		logType       outputLog
+	}{
+		{
+			desc: "view import job successfully",
+			args: []string{
+				importStatusCmd,
+				job.ID,
+				domainID,
+				token,
+			},
+			job:     job,
+			logType: entityLog,
+		},
+		{
+			desc: "view import job with invalid args",
+			args: []string{
+				importStatusCmd,
+				job.ID,
+				domainID,
+				token,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "view import job of another user",
+			args: []string{
+				importStatusCmd,
+				job.ID,
+				domainID,
+				token,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+			logType:       errLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("ViewImportJob", mock.Anything, tc.args[1], tc.args[2], tc.args[3]).Return(tc.job, tc.sdkErr)
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &jb)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.job, jb, fmt.Sprintf("%s unexpected response: expected: %v, got: %v", tc.desc, tc.job, jb))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.True(t, strings.Contains(out, "cli clients import-status"), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestExportClientsCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	clientsCmd := cli.NewClientsCmd()
+	rootCmd := setFlags(clientsCmd)
+
+	exported := []byte(`{"id":"` + client.ID + `","name":"testclient"}` + "\n")
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		errLogMessage string
+		data          []byte
+		logType       outputLog
+	}{
+		{
+			desc: "export clients successfully",
+			args: []string{
+				exportCmd,
+				smqsdk.NDJSONFormat,
+				domainID,
+				token,
+			},
+			data:    exported,
+			logType: entityLog,
+		},
+		{
+			desc: "export clients with invalid args",
+			args: []string{
+				exportCmd,
+				smqsdk.NDJSONFormat,
+				domainID,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "export clients with invalid token",
+			args: []string{
+				exportCmd,
+				smqsdk.CSVFormat,
+				domainID,
+				invalidToken,
+			},
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized)),
+			logType:       errLog,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var sdkCall *mock.Call
+			if len(tc.args) >= 4 {
+				sdkCall = sdkMock.On("ExportClients", mock.Anything, tc.args[1], tc.args[2], tc.args[3]).Return(tc.data, tc.sdkErr)
+			}
+			out := executeCommand(t, rootCmd, tc.args...)
+
+			switch tc.logType {
+			case entityLog:
+				assert.Equal(t, string(tc.data), out, fmt.Sprintf("%s unexpected response: expected: %s, got: %s", tc.desc, tc.data, out))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.True(t, strings.Contains(out, "cli clients export"), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+
+			if sdkCall != nil {
+				sdkCall.Unset()
+			}
+		})
+	}
+}
@@ -26,9 +26,12 @@ const (
 
 // Clients commands
 const (
-	connCmd    = "connect"
-	disconnCmd = "disconnect"
-	usersCmd   = "users"
+	connCmd         = "connect"
+	disconnCmd      = "disconnect"
+	usersCmd        = "users"
+	importCmd       = "import"
+	importStatusCmd = "import-status"
+	exportCmd       = "export"
 )
 
 // Messages commands
@@ -59,3 +62,6 @@ const (
 	whitelistCmd = "whitelist"
 	bootStrapCmd = "bootstrap"
 )
+
+// Rules commands
+const replayCmd = "replay"
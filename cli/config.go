@@ -26,6 +26,8 @@ const (
 	defGroupsURL       string = defURL + ":9004"
 	defHTTPURL         string = defURL + ":8008"
 	defJournalURL      string = defURL + ":9021"
+	defRulesEngineURL  string = defURL + ":9008"
+	defCommandsURL     string = defURL + ":9022"
 	defTLSVerification bool   = false
 	defOffset          string = "0"
 	defLimit           string = "10"
@@ -42,6 +44,8 @@ type remotes struct {
 	HTTPAdapterURL  string `toml:"http_adapter_url"`
 	CertsURL        string `toml:"certs_url"`
 	JournalURL      string `toml:"journal_url"`
+	RulesEngineURL  string `toml:"rules_engine_url"`
+	CommandsURL     string `toml:"commands_url"`
 	HostURL         string `toml:"host_url"`
 	TLSVerification bool   `toml:"tls_verification"`
 }
@@ -111,6 +115,8 @@ func ParseConfig(sdkConf smqsdk.Config) (smqsdk.Config, error) {
 				GroupsURL:       defGroupsURL,
 				HTTPAdapterURL:  defHTTPURL,
 				JournalURL:      defJournalURL,
+				RulesEngineURL:  defRulesEngineURL,
+				CommandsURL:     defCommandsURL,
 				HostURL:         defURL,
 				TLSVerification: defTLSVerification,
 			},
@@ -198,6 +204,14 @@ func ParseConfig(sdkConf smqsdk.Config) (smqsdk.Config, error) {
 		sdkConf.JournalURL = config.Remotes.JournalURL
 	}
 
+	if sdkConf.RulesEngineURL == "" && config.Remotes.RulesEngineURL != "" {
+		sdkConf.RulesEngineURL = config.Remotes.RulesEngineURL
+	}
+
+	if sdkConf.CommandsURL == "" && config.Remotes.CommandsURL != "" {
+		sdkConf.CommandsURL = config.Remotes.CommandsURL
+	}
+
 	if sdkConf.HostURL == "" && config.Remotes.HostURL != "" {
 		sdkConf.HostURL = config.Remotes.HostURL
 	}
@@ -253,6 +267,8 @@ func setConfigValue(key, value string) error {
 		"users_url":        &config.Remotes.UsersURL,
 		"http_adapter_url": &config.Remotes.HTTPAdapterURL,
 		"certs_url":        &config.Remotes.CertsURL,
+		"rules_engine_url": &config.Remotes.RulesEngineURL,
+		"commands_url":     &config.Remotes.CommandsURL,
 		"tls_verification": &config.Remotes.TLSVerification,
 		"offset":           &config.Filter.Offset,
 		"limit":            &config.Filter.Limit,
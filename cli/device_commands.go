@@ -0,0 +1,96 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+
+	smqsdk "github.com/absmach/magistrala/pkg/sdk"
+	"github.com/spf13/cobra"
+)
+
+var cmdCommands = []cobra.Command{
+	{
+		Use:   "send <channel_id> <client_id> <JSON_command> <domain_id> <user_auth_token>",
+		Short: "Send command",
+		Long: "Sends the command to the client over the channel\n" +
+			"Timeout is the number of seconds to wait for the client response.\n" +
+			"Usage:\n" +
+			"\tmagistrala-cli commands send <channel_id> <client_id> '{\"name\":\"reboot\",\"payload\":{\"delay\":5},\"timeout\":60}' <domain_id> $USER_AUTH_TOKEN\n",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 5 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+
+			var command smqsdk.Command
+			if err := json.Unmarshal([]byte(args[2]), &command); err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+			command.ChannelID = args[0]
+			command.ClientID = args[1]
+
+			command, err := sdk.SendCommand(cmd.Context(), command, args[3], args[4])
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			logJSONCmd(*cmd, command)
+		},
+	},
+	{
+		Use:   "get [all | <command_id>] <domain_id> <user_auth_token>",
+		Short: "Get commands",
+		Long: "Get all commands or get command by id\n" +
+			"Usage:\n" +
+			"\tmagistrala-cli commands get all <domain_id> $USER_AUTH_TOKEN - lists all commands\n" +
+			"\tmagistrala-cli commands get all <domain_id> $USER_AUTH_TOKEN --offset 10 --limit 10 - lists all commands with provided offset and limit\n" +
+			"\tmagistrala-cli commands get <command_id> <domain_id> $USER_AUTH_TOKEN - shows command with provided <command_id>\n",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 3 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+
+			if args[0] == all {
+				pageMetadata := smqsdk.PageMetadata{
+					Offset: Offset,
+					Limit:  Limit,
+				}
+				page, err := sdk.ListCommands(cmd.Context(), pageMetadata, args[1], args[2])
+				if err != nil {
+					logErrorCmd(*cmd, err)
+					return
+				}
+				logJSONCmd(*cmd, page)
+				return
+			}
+
+			command, err := sdk.ViewCommand(cmd.Context(), args[0], args[1], args[2])
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			logJSONCmd(*cmd, command)
+		},
+	},
+}
+
+// NewCommandsCmd returns device commands command.
+func NewCommandsCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "commands [send | get]",
+		Short: "Device commands",
+		Long:  `Device commands management: send commands to clients and track their status`,
+	}
+
+	for i := range cmdCommands {
+		cmd.AddCommand(&cmdCommands[i])
+	}
+
+	return &cmd
+}
@@ -0,0 +1,184 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/absmach/magistrala/cli"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	mgsdk "github.com/absmach/magistrala/pkg/sdk"
+	sdkmocks "github.com/absmach/magistrala/pkg/sdk/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSendCommandCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	commandsCmd := cli.NewCommandsCmd()
+	rootCmd := setFlags(commandsCmd)
+
+	channelID := testsutil.GenerateUUID(t)
+	clientID := testsutil.GenerateUUID(t)
+	domainID := testsutil.GenerateUUID(t)
+	cmdJSON := `{"name":"reboot","payload":{"delay":5},"timeout":60}`
+	command := mgsdk.Command{
+		ChannelID: channelID,
+		ClientID:  clientID,
+		Name:      "reboot",
+		Payload:   json.RawMessage(`{"delay":5}`),
+		Timeout:   60,
+	}
+
+	cases := []struct {
+		desc          string
+		args          []string
+		command       mgsdk.Command
+		sdkErr        errors.SDKError
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc:    "send command successfully",
+			args:    []string{channelID, clientID, cmdJSON, domainID, token},
+			command: command,
+			logType: entityLog,
+		},
+		{
+			desc:          "send command with invalid JSON",
+			args:          []string{channelID, clientID, "{\"name\":", domainID, token},
+			logType:       errLog,
+			errLogMessage: "\nerror: unexpected end of JSON input\n\n",
+		},
+		{
+			desc:    "send command with invalid args",
+			args:    []string{channelID, clientID, cmdJSON, domainID},
+			logType: usageLog,
+		},
+		{
+			desc:          "send command with invalid token",
+			args:          []string{channelID, clientID, cmdJSON, domainID, invalidToken},
+			command:       command,
+			logType:       errLog,
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			res := tc.command
+			res.ID = testsutil.GenerateUUID(t)
+			res.Status = "published"
+			sdkCall := sdkMock.On("SendCommand", mock.Anything, mock.Anything, domainID, tc.args[len(tc.args)-1]).Return(res, tc.sdkErr)
+			out := executeCommand(t, rootCmd, append([]string{sendCmd}, tc.args...)...)
+
+			switch tc.logType {
+			case entityLog:
+				var cmd mgsdk.Command
+				err := json.Unmarshal([]byte(out), &cmd)
+				assert.Nil(t, err)
+				assert.Equal(t, res.ID, cmd.ID, fmt.Sprintf("%s unexpected response, expected: %v, got: %v", tc.desc, res, cmd))
+				assert.Equal(t, res.Status, cmd.Status, fmt.Sprintf("%s unexpected response, expected: %v, got: %v", tc.desc, res, cmd))
+				sdkMock.AssertCalled(t, "SendCommand", mock.Anything, tc.command, domainID, token)
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestGetCommandsCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	commandsCmd := cli.NewCommandsCmd()
+	rootCmd := setFlags(commandsCmd)
+
+	domainID := testsutil.GenerateUUID(t)
+	command := mgsdk.Command{
+		ID:       testsutil.GenerateUUID(t),
+		DomainID: domainID,
+		Name:     "reboot",
+		Status:   "acknowledged",
+	}
+	page := mgsdk.CommandsPage{
+		Total:    1,
+		Limit:    10,
+		Commands: []mgsdk.Command{command},
+	}
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		logType       outputLog
+		page          mgsdk.CommandsPage
+		command       mgsdk.Command
+		errLogMessage string
+	}{
+		{
+			desc:    "get all commands successfully",
+			args:    []string{all, domainID, token},
+			logType: entityLog,
+			page:    page,
+		},
+		{
+			desc:    "get command by id successfully",
+			args:    []string{command.ID, domainID, token},
+			logType: entityLog,
+			command: command,
+		},
+		{
+			desc:    "get commands with invalid args",
+			args:    []string{all, domainID},
+			logType: usageLog,
+		},
+		{
+			desc:          "get command with invalid token",
+			args:          []string{command.ID, domainID, invalidToken},
+			logType:       errLog,
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			listCall := sdkMock.On("ListCommands", mock.Anything, mock.Anything, domainID, tc.args[len(tc.args)-1]).Return(tc.page, tc.sdkErr)
+			viewCall := sdkMock.On("ViewCommand", mock.Anything, tc.args[0], domainID, tc.args[len(tc.args)-1]).Return(tc.command, tc.sdkErr)
+			out := executeCommand(t, rootCmd, append([]string{getCmd}, tc.args...)...)
+
+			switch tc.logType {
+			case entityLog:
+				if tc.args[0] == all {
+					var p mgsdk.CommandsPage
+					err := json.Unmarshal([]byte(out), &p)
+					assert.Nil(t, err)
+					assert.Equal(t, tc.page, p, fmt.Sprintf("%s unexpected response, expected: %v, got: %v", tc.desc, tc.page, p))
+					break
+				}
+				var cmd mgsdk.Command
+				err := json.Unmarshal([]byte(out), &cmd)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.command, cmd, fmt.Sprintf("%s unexpected response, expected: %v, got: %v", tc.desc, tc.command, cmd))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			listCall.Unset()
+			viewCall.Unset()
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	smqsdk "github.com/absmach/magistrala/pkg/sdk"
+	"github.com/spf13/cobra"
+)
+
+var cmdRules = []cobra.Command{
+	{
+		Use:   "replay <rule_id> <start_time | start_sequence> [<end_time>] <domain_id> <user_auth_token>",
+		Short: "Replay rule",
+		Long: "Re-runs the rule over the messages stored in the message broker\n" +
+			"Times are in RFC3339 format. Replay ends at end time or, if not provided, at the current time.\n" +
+			"Usage:\n" +
+			"\tmagistrala-cli rules replay <rule_id> 2026-10-18T00:00:00Z <domain_id> $USER_AUTH_TOKEN - replays messages received since the given time\n" +
+			"\tmagistrala-cli rules replay <rule_id> 2026-10-18T00:00:00Z 2026-10-19T00:00:00Z <domain_id> $USER_AUTH_TOKEN - replays messages received on the given day\n" +
+			"\tmagistrala-cli rules replay <rule_id> 1024 <domain_id> $USER_AUTH_TOKEN - replays messages starting from the stream sequence\n",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) < 4 || len(args) > 5 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+
+			var rp smqsdk.RuleReplay
+			if seq, err := strconv.ParseUint(args[1], 10, 64); err == nil {
+				rp.StartSequence = seq
+			} else {
+				start, err := time.Parse(time.RFC3339, args[1])
+				if err != nil {
+					logErrorCmd(*cmd, err)
+					return
+				}
+				rp.StartTime = start
+			}
+
+			domainID, token := args[2], args[3]
+			if len(args) == 5 {
+				end, err := time.Parse(time.RFC3339, args[2])
+				if err != nil {
+					logErrorCmd(*cmd, err)
+					return
+				}
+				rp.EndTime = end
+				domainID, token = args[3], args[4]
+			}
+
+			replay, err := sdk.ReplayRule(cmd.Context(), args[0], rp, domainID, token)
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			logJSONCmd(*cmd, replay)
+		},
+	},
+}
+
+// NewRulesCmd returns rules command.
+func NewRulesCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "rules [replay]",
+		Short: "Rules management",
+		Long:  `Rules engine rules management: replay`,
+	}
+
+	for i := range cmdRules {
+		cmd.AddCommand(&cmdRules[i])
+	}
+
+	return &cmd
+}
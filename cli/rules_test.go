@@ -0,0 +1,104 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/cli"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	mgsdk "github.com/absmach/magistrala/pkg/sdk"
+	sdkmocks "github.com/absmach/magistrala/pkg/sdk/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReplayRuleCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	rulesCmd := cli.NewRulesCmd()
+	rootCmd := setFlags(rulesCmd)
+
+	ruleID := testsutil.GenerateUUID(t)
+	domainID := testsutil.GenerateUUID(t)
+	start := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	cases := []struct {
+		desc          string
+		args          []string
+		replay        mgsdk.RuleReplay
+		sdkErr        errors.SDKError
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc:    "replay rule by start time",
+			args:    []string{ruleID, start.Format(time.RFC3339), domainID, token},
+			replay:  mgsdk.RuleReplay{StartTime: start},
+			logType: entityLog,
+		},
+		{
+			desc:    "replay rule by start time and end time",
+			args:    []string{ruleID, start.Format(time.RFC3339), end.Format(time.RFC3339), domainID, token},
+			replay:  mgsdk.RuleReplay{StartTime: start, EndTime: end},
+			logType: entityLog,
+		},
+		{
+			desc:    "replay rule by start sequence",
+			args:    []string{ruleID, "1024", domainID, token},
+			replay:  mgsdk.RuleReplay{StartSequence: 1024},
+			logType: entityLog,
+		},
+		{
+			desc:          "replay rule with invalid start",
+			args:          []string{ruleID, "yesterday", domainID, token},
+			logType:       errLog,
+			errLogMessage: "\nerror: parsing time \"yesterday\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"yesterday\" as \"2006\"\n\n",
+		},
+		{
+			desc:    "replay rule with invalid args",
+			args:    []string{ruleID, domainID, token},
+			logType: usageLog,
+		},
+		{
+			desc:          "replay rule with invalid token",
+			args:          []string{ruleID, "1024", domainID, invalidToken},
+			replay:        mgsdk.RuleReplay{StartSequence: 1024},
+			logType:       errLog,
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			res := tc.replay
+			res.ID = testsutil.GenerateUUID(t)
+			res.RuleID = ruleID
+			sdkCall := sdkMock.On("ReplayRule", mock.Anything, ruleID, tc.replay, domainID, tc.args[len(tc.args)-1]).Return(res, tc.sdkErr)
+			out := executeCommand(t, rootCmd, append([]string{replayCmd}, tc.args...)...)
+
+			switch tc.logType {
+			case entityLog:
+				var replay mgsdk.RuleReplay
+				err := json.Unmarshal([]byte(out), &replay)
+				assert.Nil(t, err)
+				assert.Equal(t, res, replay, fmt.Sprintf("%s unexpected response, expected: %v, got: %v", tc.desc, res, replay))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
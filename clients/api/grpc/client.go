@@ -142,9 +142,7 @@ func (client grpcClient) RetrieveEntity(ctx context.Context, req *grpcCommonV1.R
 		return &grpcCommonV1.RetrieveEntityRes{}, decodeError(err)
 	}
 
-	ebr := res.(retrieveEntityRes)
-
-	return &grpcCommonV1.RetrieveEntityRes{Entity: &grpcCommonV1.EntityBasic{Id: ebr.id, DomainId: ebr.domain, Status: uint32(ebr.status)}}, nil
+	return res.(*grpcCommonV1.RetrieveEntityRes), nil
 }
 
 func encodeRetrieveEntityRequest(_ context.Context, grpcReq any) (any, error) {
@@ -157,11 +155,13 @@ func encodeRetrieveEntityRequest(_ context.Context, grpcReq any) (any, error) {
 func decodeRetrieveEntityResponse(_ context.Context, grpcRes any) (any, error) {
 	res := grpcRes.(*grpcCommonV1.RetrieveEntityRes)
 
-	return retrieveEntityRes{
-		id:          res.Entity.GetId(),
-		domain:      res.Entity.GetDomainId(),
-		parentGroup: res.Entity.GetParentGroupId(),
-		status:      uint8(res.Entity.GetStatus()),
+	return &grpcCommonV1.RetrieveEntityRes{
+		Entity: &grpcCommonV1.EntityBasic{
+			Id:       res.Entity.GetId(),
+			DomainId: res.Entity.GetDomainId(),
+			Status:   res.Entity.GetStatus(),
+			Metadata: res.Entity.GetMetadata(),
+		},
 	}, nil
 }
 
@@ -221,6 +221,7 @@ func (client grpcClient) AddConnections(ctx context.Context, req *grpcCommonV1.A
 			ChannelID: c.GetChannelId(),
 			DomainID:  c.GetDomainId(),
 			Type:      connections.ConnType(c.GetType()),
+			Topics:    c.GetTopics(),
 		})
 	}
 
@@ -245,6 +246,7 @@ func encodeAddConnectionsRequest(_ context.Context, grpcReq any) (any, error) {
 			ChannelId: r.ChannelID,
 			DomainId:  r.DomainID,
 			Type:      uint32(r.Type),
+			Topics:    r.Topics,
 		})
 	}
 	return &grpcCommonV1.AddConnectionsReq{
@@ -33,7 +33,7 @@ func retrieveEntityEndpoint(svc pClients.Service) endpoint.Endpoint {
 			return retrieveEntityRes{}, err
 		}
 
-		return retrieveEntityRes{id: client.ID, domain: client.Domain, parentGroup: client.ParentGroup, status: uint8(client.Status)}, nil
+		return retrieveEntityRes{id: client.ID, domain: client.Domain, parentGroup: client.ParentGroup, status: uint8(client.Status), metadata: client.Metadata}, nil
 	}
 }
 
@@ -69,6 +69,7 @@ func addConnectionsEndpoint(svc pClients.Service) endpoint.Endpoint {
 				ChannelID: c.channelID,
 				DomainID:  c.domainID,
 				Type:      c.connType,
+				Topics:    c.topics,
 			})
 		}
 
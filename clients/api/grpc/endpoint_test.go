@@ -134,6 +134,25 @@ func TestRetrieveEntity(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			desc: "retrieve entity with metadata successfully",
+			id:   validID,
+			svcRes: clients.Client{
+				ID:       validID,
+				Domain:   validID,
+				Status:   clients.EnabledStatus,
+				Metadata: clients.Metadata{"secret_fallback": false},
+			},
+			resp: &grpcCommonV1.RetrieveEntityRes{
+				Entity: &grpcCommonV1.EntityBasic{
+					Id:       validID,
+					DomainId: validID,
+					Status:   uint32(clients.EnabledStatus),
+					Metadata: []byte(`{"secret_fallback":false}`),
+				},
+			},
+			err: nil,
+		},
 		{
 			desc:   "retrieve entity with empty ID",
 			id:     "",
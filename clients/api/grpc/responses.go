@@ -10,6 +10,7 @@ type entity struct {
 	domain      string
 	parentGroup string
 	status      uint8
+	metadata    map[string]any
 }
 
 type authenticateRes struct {
@@ -35,6 +36,7 @@ type connection struct {
 	channelID string
 	domainID  string
 	connType  connections.ConnType
+	topics    []string
 }
 type connectionsRes struct {
 	ok bool
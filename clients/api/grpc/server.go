@@ -5,6 +5,7 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
 
 	grpcClientsV1 "github.com/absmach/magistrala/api/grpc/clients/v1"
 	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
@@ -111,12 +112,21 @@ func decodeRetrieveEntityRequest(_ context.Context, grpcReq any) (any, error) {
 func encodeRetrieveEntityResponse(_ context.Context, grpcRes any) (any, error) {
 	res := grpcRes.(retrieveEntityRes)
 
+	var metadata []byte
+	if len(res.metadata) > 0 {
+		var err error
+		if metadata, err = json.Marshal(res.metadata); err != nil {
+			return nil, err
+		}
+	}
+
 	return &grpcCommonV1.RetrieveEntityRes{
 		Entity: &grpcCommonV1.EntityBasic{
 			Id:            res.id,
 			DomainId:      res.domain,
 			ParentGroupId: res.parentGroup,
 			Status:        uint32(res.status),
+			Metadata:      metadata,
 		},
 	}, nil
 }
@@ -173,6 +183,7 @@ func decodeAddConnectionsRequest(_ context.Context, grpcReq any) (any, error) {
 			channelID: c.GetChannelId(),
 			domainID:  c.GetDomainId(),
 			connType:  connType,
+			topics:    c.GetTopics(),
 		})
 	}
 	return connectionsReq{
@@ -5,11 +5,13 @@ package http
 
 import (
 	"log/slog"
+	"time"
 
 	"github.com/absmach/magistrala"
 	api "github.com/absmach/magistrala/api/http"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/clients/bulk"
 	smqauthn "github.com/absmach/magistrala/pkg/authn"
 	roleManagerHttp "github.com/absmach/magistrala/pkg/roles/rolemanager/api"
 	"github.com/go-chi/chi/v5"
@@ -17,7 +19,7 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
-func clientsHandler(svc clients.Service, authn smqauthn.AuthNMiddleware, r *chi.Mux, logger *slog.Logger, idp magistrala.IDProvider) *chi.Mux {
+func clientsHandler(svc clients.Service, bsvc bulk.Service, authn smqauthn.AuthNMiddleware, r *chi.Mux, logger *slog.Logger, idp magistrala.IDProvider, maxSecretOverlap time.Duration) *chi.Mux {
 	opts := []kithttp.ServerOption{
 		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, api.EncodeError)),
 	}
@@ -49,6 +51,27 @@ func clientsHandler(svc clients.Service, authn smqauthn.AuthNMiddleware, r *chi.
 				opts...,
 			), "create_clients").ServeHTTP)
 
+			r.Post("/import", otelhttp.NewHandler(kithttp.NewServer(
+				importClientsEndpoint(bsvc),
+				decodeImportClients,
+				api.EncodeResponse,
+				opts...,
+			), "import_clients").ServeHTTP)
+
+			r.Get("/import/{jobID}", otelhttp.NewHandler(kithttp.NewServer(
+				viewImportJobEndpoint(bsvc),
+				decodeViewImportJob,
+				api.EncodeResponse,
+				opts...,
+			), "view_import_job").ServeHTTP)
+
+			r.Get("/export", otelhttp.NewHandler(kithttp.NewServer(
+				exportClientsEndpoint(bsvc),
+				decodeExportClients,
+				encodeExportResponse,
+				opts...,
+			), "export_clients").ServeHTTP)
+
 			r = roleManagerHttp.EntityAvailableActionsRouter(svc, d, r, opts)
 
 			r.Route("/{clientID}", func(r chi.Router) {
@@ -80,6 +103,13 @@ func clientsHandler(svc clients.Service, authn smqauthn.AuthNMiddleware, r *chi.
 					opts...,
 				), "update_client_credentials").ServeHTTP)
 
+				r.Post("/secret/rotate", otelhttp.NewHandler(kithttp.NewServer(
+					rotateClientSecretEndpoint(svc),
+					decodeRotateClientSecret(maxSecretOverlap),
+					api.EncodeResponse,
+					opts...,
+				), "rotate_client_secret").ServeHTTP)
+
 				r.Post("/enable", otelhttp.NewHandler(kithttp.NewServer(
 					enableClientEndpoint(svc),
 					decodeChangeClientStatus,
@@ -6,6 +6,7 @@ package http
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -13,11 +14,24 @@ import (
 	api "github.com/absmach/magistrala/api/http"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/clients/bulk"
 	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/go-chi/chi/v5"
+	kithttp "github.com/go-kit/kit/transport/http"
 )
 
-const clientID = "clientID"
+const (
+	clientID = "clientID"
+	jobID    = "jobID"
+
+	dryRunKey = "dry_run"
+	formatKey = "format"
+
+	// maxImportSize limits the size of the imported data.
+	maxImportSize = 64 * 1024 * 1024
+)
+
+var errImportSize = errors.NewRequestError("import data exceeds the size limit")
 
 func decodeViewClient(_ context.Context, r *http.Request) (any, error) {
 	roles, err := apiutil.ReadBoolQuery(r, api.RolesKey, false)
@@ -141,6 +155,15 @@ func decodeListClients(_ context.Context, r *http.Request) (any, error) {
 		return listClientsReq{}, errors.Wrap(apiutil.ErrValidation, err)
 	}
 
+	var onlinePtr *bool
+	if r.URL.Query().Has(api.OnlineKey) {
+		online, err := apiutil.ReadBoolQuery(r, api.OnlineKey, false)
+		if err != nil {
+			return listClientsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+		onlinePtr = &online
+	}
+
 	cfrom, err := apiutil.ReadStringQuery(r, "created_from", "")
 	if err != nil {
 		return listClientsReq{}, errors.Wrap(apiutil.ErrValidation, err)
@@ -183,6 +206,7 @@ func decodeListClients(_ context.Context, r *http.Request) (any, error) {
 			OnlyTotal:      ot,
 			CreatedFrom:    createdFrom,
 			CreatedTo:      createdTo,
+			Online:         onlinePtr,
 		},
 		userID: userID,
 	}
@@ -234,6 +258,24 @@ func decodeUpdateClientCredentials(_ context.Context, r *http.Request) (any, err
 	return req, nil
 }
 
+func decodeRotateClientSecret(maxOverlap time.Duration) kithttp.DecodeRequestFunc {
+	return func(_ context.Context, r *http.Request) (any, error) {
+		if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+			return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
+		}
+
+		req := rotateClientSecretReq{
+			id:         chi.URLParam(r, clientID),
+			maxOverlap: maxOverlap,
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+		}
+
+		return req, nil
+	}
+}
+
 func decodeCreateClientReq(_ context.Context, r *http.Request) (any, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
 		return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrUnsupportedContentType)
@@ -300,3 +342,48 @@ func decodeDeleteClientReq(_ context.Context, r *http.Request) (any, error) {
 
 	return req, nil
 }
+
+func decodeImportClients(_ context.Context, r *http.Request) (any, error) {
+	var format bulk.Format
+	switch ct, _, _ := strings.Cut(r.Header.Get("Content-Type"), ";"); strings.TrimSpace(ct) {
+	case bulk.CSV.ContentType():
+		format = bulk.CSV
+	case bulk.NDJSON.ContentType():
+		format = bulk.NDJSON
+	default:
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	dryRun, err := apiutil.ReadBoolQuery(r, dryRunKey, false)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxImportSize+1))
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, errors.Wrap(errors.ErrMalformedEntity, err))
+	}
+
+	return importClientsReq{
+		format: format,
+		data:   data,
+		dryRun: dryRun,
+	}, nil
+}
+
+func decodeViewImportJob(_ context.Context, r *http.Request) (any, error) {
+	return viewImportJobReq{id: chi.URLParam(r, jobID)}, nil
+}
+
+func decodeExportClients(_ context.Context, r *http.Request) (any, error) {
+	f, err := apiutil.ReadStringQuery(r, formatKey, bulk.CSV.String())
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	format, err := bulk.ToFormat(f)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return exportClientsReq{format: format}, nil
+}
@@ -4,10 +4,12 @@
 package http
 
 import (
+	"bytes"
 	"context"
 
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/clients/bulk"
 	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/errors"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
@@ -203,6 +205,27 @@ func updateClientSecretEndpoint(svc clients.Service) endpoint.Endpoint {
 	}
 }
 
+func rotateClientSecretEndpoint(svc clients.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(rotateClientSecretReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		client, err := svc.RotateSecret(ctx, session, req.id, req.Secret, req.Overlap)
+		if err != nil {
+			return nil, err
+		}
+
+		return updateClientRes{Client: client}, nil
+	}
+}
+
 func enableClientEndpoint(svc clients.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request any) (any, error) {
 		req := request.(changeClientStatusReq)
@@ -302,3 +325,68 @@ func deleteClientEndpoint(svc clients.Service) endpoint.Endpoint {
 		return deleteClientRes{}, nil
 	}
 }
+
+func importClientsEndpoint(svc bulk.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(importClientsReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		job, err := svc.Import(ctx, session, req.format, req.data, req.dryRun)
+		if err != nil {
+			return nil, err
+		}
+
+		return importJobRes{Job: job, created: !job.DryRun}, nil
+	}
+}
+
+func viewImportJobEndpoint(svc bulk.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(viewImportJobReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		job, err := svc.ViewJob(ctx, session, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return importJobRes{Job: job}, nil
+	}
+}
+
+func exportClientsEndpoint(svc bulk.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(exportClientsReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		// The export is buffered, so that its failure is still returned
+		// as the error response.
+		var buf bytes.Buffer
+		if err := svc.Export(ctx, session, req.format, &buf); err != nil {
+			return nil, err
+		}
+
+		return exportClientsRes{format: req.format, data: buf.Bytes()}, nil
+	}
+}
@@ -19,6 +19,8 @@ import (
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	"github.com/absmach/magistrala/clients"
 	clientsapi "github.com/absmach/magistrala/clients/api/http"
+	"github.com/absmach/magistrala/clients/bulk"
+	bmocks "github.com/absmach/magistrala/clients/bulk/mocks"
 	"github.com/absmach/magistrala/clients/mocks"
 	"github.com/absmach/magistrala/internal/testsutil"
 	mglog "github.com/absmach/magistrala/logger"
@@ -33,7 +35,10 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-const contentType = "application/json"
+const (
+	contentType      = "application/json"
+	maxSecretOverlap = 30 * 24 * time.Hour
+)
 
 var (
 	secret        = "strongsecret"
@@ -94,16 +99,23 @@ func toJSON(data any) string {
 }
 
 func newClientsServer() (*httptest.Server, *mocks.Service, *authnmocks.Authentication) {
+	ts, svc, _, authn := newServer()
+
+	return ts, svc, authn
+}
+
+func newServer() (*httptest.Server, *mocks.Service, *bmocks.Service, *authnmocks.Authentication) {
 	svc := new(mocks.Service)
+	bsvc := new(bmocks.Service)
 	authn := new(authnmocks.Authentication)
 
 	logger := mglog.NewMock()
 	mux := chi.NewRouter()
 	idp := uuid.NewMock()
 	am := smqauthn.NewAuthNMiddleware(authn, smqauthn.WithAllowUnverifiedUser(true))
-	clientsapi.MakeHandler(svc, am, mux, logger, "", idp)
+	clientsapi.MakeHandler(svc, bsvc, am, mux, logger, "", idp, maxSecretOverlap)
 
-	return httptest.NewServer(mux), svc, authn
+	return httptest.NewServer(mux), svc, bsvc, authn
 }
 
 func TestCreateClient(t *testing.T) {
@@ -427,6 +439,8 @@ func TestListClients(t *testing.T) {
 	ts, svc, authn := newClientsServer()
 	defer ts.Close()
 
+	online, offline := true, false
+
 	cases := []struct {
 		desc                string
 		query               string
@@ -858,6 +872,61 @@ func TestListClients(t *testing.T) {
 			status:   http.StatusBadRequest,
 			err:      apiutil.ErrInvalidQueryParams,
 		},
+		{
+			desc:     "list online clients",
+			domainID: domainID,
+			token:    validToken,
+			authnRes: smqauthn.Session{UserID: validID, DomainID: domainID, DomainUserID: domainID + "_" + validID, SuperAdmin: false},
+			pageMeta: clients.Page{
+				Offset:  0,
+				Limit:   10,
+				Order:   api.DefOrder,
+				Dir:     api.DefDir,
+				Actions: []string{},
+				Online:  &online,
+			},
+			listClientsResponse: clients.ClientsPage{
+				Page: clients.Page{
+					Total: 1,
+				},
+				Clients: []clients.Client{client},
+			},
+			query:  "online=true",
+			status: http.StatusOK,
+			err:    nil,
+		},
+		{
+			desc:     "list offline clients",
+			domainID: domainID,
+			token:    validToken,
+			authnRes: smqauthn.Session{UserID: validID, DomainID: domainID, DomainUserID: domainID + "_" + validID, SuperAdmin: false},
+			pageMeta: clients.Page{
+				Offset:  0,
+				Limit:   10,
+				Order:   api.DefOrder,
+				Dir:     api.DefDir,
+				Actions: []string{},
+				Online:  &offline,
+			},
+			listClientsResponse: clients.ClientsPage{
+				Page: clients.Page{
+					Total: 1,
+				},
+				Clients: []clients.Client{client},
+			},
+			query:  "online=false",
+			status: http.StatusOK,
+			err:    nil,
+		},
+		{
+			desc:     "list clients with invalid online",
+			domainID: domainID,
+			token:    validToken,
+			authnRes: smqauthn.Session{UserID: validID, DomainID: domainID, DomainUserID: domainID + "_" + validID, SuperAdmin: false},
+			query:    "online=invalid",
+			status:   http.StatusBadRequest,
+			err:      apiutil.ErrInvalidQueryParams,
+		},
 		{
 			desc:     "list clients with duplicate created_to",
 			domainID: domainID,
@@ -1109,7 +1178,7 @@ func TestUpdateClient(t *testing.T) {
 			}
 
 			if err == nil {
-				assert.Equal(t, tc.clientResponse.ID, resBody.ID, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.clientResponse, resBody.ID))
+				assert.Equal(t, tc.clientResponse.ID, resBody.ID, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.clientResponse.ID, resBody.ID))
 			}
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
@@ -1414,6 +1483,161 @@ func TestUpdateClientSecret(t *testing.T) {
 	}
 }
 
+func TestRotateClientSecret(t *testing.T) {
+	ts, svc, authn := newClientsServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc        string
+		data        string
+		id          string
+		contentType string
+		token       string
+		status      int
+		authnRes    smqauthn.Session
+		authnErr    error
+		err         error
+	}{
+		{
+			desc:        "rotate client secret with valid token",
+			data:        `{"secret": "strongersecret", "overlap": "24h"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusOK,
+			err:         nil,
+		},
+		{
+			desc:        "rotate client secret with empty token",
+			data:        `{"secret": "strongersecret", "overlap": "24h"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       "",
+			status:      http.StatusUnauthorized,
+			err:         apiutil.ErrBearerToken,
+		},
+		{
+			desc:        "rotate client secret with invalid token",
+			data:        `{"secret": "strongersecret", "overlap": "24h"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       inValid,
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "rotate client secret with empty secret",
+			data:        `{"secret": "", "overlap": "24h"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingSecret,
+		},
+		{
+			desc:        "rotate client secret with zero overlap",
+			data:        `{"secret": "strongersecret", "overlap": "0s"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidSecretOverlap,
+		},
+		{
+			desc:        "rotate client secret with negative overlap",
+			data:        `{"secret": "strongersecret", "overlap": "-1h"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidSecretOverlap,
+		},
+		{
+			desc:        "rotate client secret with missing overlap",
+			data:        `{"secret": "strongersecret"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidSecretOverlap,
+		},
+		{
+			desc:        "rotate client secret with overlap above maximum",
+			data:        `{"secret": "strongersecret", "overlap": "721h"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidSecretOverlap,
+		},
+		{
+			desc:        "rotate client secret with invalid overlap",
+			data:        `{"secret": "strongersecret", "overlap": "invalid"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMalformedRequestBody,
+		},
+		{
+			desc:        "rotate client secret with invalid contentype",
+			data:        `{"secret": "strongersecret", "overlap": "24h"}`,
+			id:          client.ID,
+			contentType: "application/xml",
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+		{
+			desc:        "rotate client secret with service error",
+			data:        `{"secret": "strongersecret", "overlap": "24h"}`,
+			id:          client.ID,
+			contentType: contentType,
+			token:       validToken,
+			authnRes:    smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID},
+			status:      http.StatusUnprocessableEntity,
+			err:         svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/%s/clients/%s/secret/rotate", ts.URL, domainID, tc.id),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(tc.data),
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := svc.On("RotateSecret", mock.Anything, tc.authnRes, tc.id, "strongersecret", 24*time.Hour).Return(clients.Client{ID: tc.id}, tc.err)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var resBody respBody
+			err = json.NewDecoder(res.Body).Decode(&resBody)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if resBody.Err != "" || resBody.Message != "" {
+				err = errors.Wrap(errors.New(resBody.Err), errors.New(resBody.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
 func TestEnableClient(t *testing.T) {
 	ts, svc, authn := newClientsServer()
 	defer ts.Close()
@@ -1885,3 +2109,316 @@ type respBody struct {
 	Tags        []string       `json:"tags"`
 	Status      clients.Status `json:"status"`
 }
+
+func TestImportClients(t *testing.T) {
+	ts, _, bsvc, authn := newServer()
+	defer ts.Close()
+
+	session := smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+	csvData := "name,tags,connections\nsensor,tag1;tag2," + validID + "\n"
+	ndjsonData := `{"name":"sensor","connections":[{"channel_id":"` + validID + `"}]}` + "\n"
+	job := bulk.Job{
+		ID:        testsutil.GenerateUUID(t),
+		DomainID:  domainID,
+		CreatedBy: validID,
+		Total:     1,
+	}
+
+	cases := []struct {
+		desc        string
+		domainID    string
+		token       string
+		contentType string
+		query       string
+		data        string
+		format      bulk.Format
+		dryRun      bool
+		authnRes    smqauthn.Session
+		authnErr    error
+		svcRes      bulk.Job
+		svcErr      error
+		status      int
+	}{
+		{
+			desc:        "import CSV clients successfully",
+			domainID:    domainID,
+			token:       validToken,
+			contentType: "text/csv",
+			data:        csvData,
+			format:      bulk.CSV,
+			authnRes:    session,
+			svcRes:      job,
+			status:      http.StatusAccepted,
+		},
+		{
+			desc:        "import NDJSON clients successfully",
+			domainID:    domainID,
+			token:       validToken,
+			contentType: "application/x-ndjson",
+			data:        ndjsonData,
+			format:      bulk.NDJSON,
+			authnRes:    session,
+			svcRes:      job,
+			status:      http.StatusAccepted,
+		},
+		{
+			desc:        "import clients in dry-run mode",
+			domainID:    domainID,
+			token:       validToken,
+			contentType: "text/csv; charset=utf-8",
+			query:       "?dry_run=true",
+			data:        csvData,
+			format:      bulk.CSV,
+			dryRun:      true,
+			authnRes:    session,
+			svcRes:      bulk.Job{ID: job.ID, DryRun: true, Status: bulk.CompletedStatus},
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "import clients with invalid dry run",
+			domainID:    domainID,
+			token:       validToken,
+			contentType: "text/csv",
+			query:       "?dry_run=invalid",
+			data:        csvData,
+			authnRes:    session,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "import clients with unsupported content type",
+			domainID:    domainID,
+			token:       validToken,
+			contentType: contentType,
+			data:        csvData,
+			authnRes:    session,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "import clients with empty data",
+			domainID:    domainID,
+			token:       validToken,
+			contentType: "text/csv",
+			authnRes:    session,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "import clients with malformed data",
+			domainID:    domainID,
+			token:       validToken,
+			contentType: "text/csv",
+			data:        "unknown\nvalue\n",
+			format:      bulk.CSV,
+			authnRes:    session,
+			svcErr:      bulk.ErrMalformedImport,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "import clients with invalid token",
+			domainID:    domainID,
+			token:       inValidToken,
+			contentType: "text/csv",
+			data:        csvData,
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/%s/clients/import%s", ts.URL, tc.domainID, tc.query),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(tc.data),
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := bsvc.On("Import", mock.Anything, tc.authnRes, tc.format, []byte(tc.data), tc.dryRun).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.status == http.StatusAccepted {
+				assert.Equal(t, fmt.Sprintf("/%s/clients/import/%s", job.DomainID, job.ID), res.Header.Get("Location"), fmt.Sprintf("%s: unexpected location", tc.desc))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestViewImportJob(t *testing.T) {
+	ts, _, bsvc, authn := newServer()
+	defer ts.Close()
+
+	session := smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+	job := bulk.Job{
+		ID:        testsutil.GenerateUUID(t),
+		DomainID:  domainID,
+		CreatedBy: validID,
+		Status:    bulk.RunningStatus,
+		Total:     10,
+		Processed: 5,
+		Imported:  4,
+		Failed:    1,
+		Errors:    []bulk.RecordError{{Line: 3, Error: "duplicate client id"}},
+	}
+
+	cases := []struct {
+		desc     string
+		id       string
+		token    string
+		authnRes smqauthn.Session
+		authnErr error
+		svcRes   bulk.Job
+		svcErr   error
+		status   int
+	}{
+		{
+			desc:     "view import job successfully",
+			id:       job.ID,
+			token:    validToken,
+			authnRes: session,
+			svcRes:   job,
+			status:   http.StatusOK,
+		},
+		{
+			desc:     "view import job of another user",
+			id:       job.ID,
+			token:    validToken,
+			authnRes: session,
+			svcErr:   svcerr.ErrAuthorization,
+			status:   http.StatusForbidden,
+		},
+		{
+			desc:     "view non-existing import job",
+			id:       inValid,
+			token:    validToken,
+			authnRes: session,
+			svcErr:   svcerr.ErrNotFound,
+			status:   http.StatusNotFound,
+		},
+		{
+			desc:     "view import job with invalid token",
+			id:       job.ID,
+			token:    inValidToken,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ts.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/%s/clients/import/%s", ts.URL, domainID, tc.id),
+				token:  tc.token,
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := bsvc.On("ViewJob", mock.Anything, tc.authnRes, tc.id).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.status == http.StatusOK {
+				var body map[string]any
+				err := json.NewDecoder(res.Body).Decode(&body)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+				assert.Equal(t, "running", body["status"], fmt.Sprintf("%s: unexpected job status", tc.desc))
+				assert.Equal(t, float64(job.Processed), body["processed"], fmt.Sprintf("%s: unexpected job progress", tc.desc))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestExportClients(t *testing.T) {
+	ts, _, bsvc, authn := newServer()
+	defer ts.Close()
+
+	session := smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+	exported := "id,name\n" + validID + ",sensor\n"
+
+	cases := []struct {
+		desc        string
+		token       string
+		query       string
+		format      bulk.Format
+		authnRes    smqauthn.Session
+		authnErr    error
+		svcErr      error
+		status      int
+		contentType string
+	}{
+		{
+			desc:        "export clients as CSV by default",
+			token:       validToken,
+			format:      bulk.CSV,
+			authnRes:    session,
+			status:      http.StatusOK,
+			contentType: "text/csv",
+		},
+		{
+			desc:        "export clients as NDJSON",
+			token:       validToken,
+			query:       "?format=ndjson",
+			format:      bulk.NDJSON,
+			authnRes:    session,
+			status:      http.StatusOK,
+			contentType: "application/x-ndjson",
+		},
+		{
+			desc:     "export clients with invalid format",
+			token:    validToken,
+			query:    "?format=xml",
+			authnRes: session,
+			status:   http.StatusBadRequest,
+		},
+		{
+			desc:     "export clients with service error",
+			token:    validToken,
+			format:   bulk.CSV,
+			authnRes: session,
+			svcErr:   svcerr.ErrAuthorization,
+			status:   http.StatusForbidden,
+		},
+		{
+			desc:     "export clients with invalid token",
+			token:    inValidToken,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ts.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/%s/clients/export%s", ts.URL, domainID, tc.query),
+				token:  tc.token,
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := bsvc.On("Export", mock.Anything, tc.authnRes, tc.format, mock.Anything).Return(tc.svcErr).Run(func(args mock.Arguments) {
+				if tc.svcErr == nil {
+					_, _ = io.WriteString(args.Get(3).(io.Writer), exported)
+				}
+			})
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.status == http.StatusOK {
+				body, err := io.ReadAll(res.Body)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+				assert.Equal(t, exported, string(body), fmt.Sprintf("%s: unexpected export", tc.desc))
+				assert.Equal(t, tc.contentType, res.Header.Get("Content-Type"), fmt.Sprintf("%s: unexpected content type", tc.desc))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
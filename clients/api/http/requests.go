@@ -4,9 +4,13 @@
 package http
 
 import (
+	"encoding/json"
+	"time"
+
 	api "github.com/absmach/magistrala/api/http"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/clients/bulk"
 )
 
 type createClientReq struct {
@@ -161,6 +165,50 @@ func (req updateClientCredentialsReq) validate() error {
 	return nil
 }
 
+type rotateClientSecretReq struct {
+	id         string
+	maxOverlap time.Duration
+	Secret     string        `json:"secret,omitempty"`
+	Overlap    time.Duration `json:"overlap,omitempty"`
+}
+
+func (req *rotateClientSecretReq) UnmarshalJSON(data []byte) error {
+	var temp struct {
+		Secret  string `json:"secret,omitempty"`
+		Overlap string `json:"overlap,omitempty"`
+	}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+	req.Secret = temp.Secret
+	// Missing overlap is reported by the validation.
+	if temp.Overlap == "" {
+		return nil
+	}
+	overlap, err := time.ParseDuration(temp.Overlap)
+	if err != nil {
+		return err
+	}
+	req.Overlap = overlap
+	return nil
+}
+
+func (req rotateClientSecretReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if req.Secret == "" {
+		return apiutil.ErrMissingSecret
+	}
+
+	if req.Overlap <= 0 || (req.maxOverlap > 0 && req.Overlap > req.maxOverlap) {
+		return apiutil.ErrInvalidSecretOverlap
+	}
+
+	return nil
+}
+
 type changeClientStatusReq struct {
 	id string
 }
@@ -210,3 +258,40 @@ func (req deleteClientReq) validate() error {
 
 	return nil
 }
+
+type importClientsReq struct {
+	format bulk.Format
+	data   []byte
+	dryRun bool
+}
+
+func (req importClientsReq) validate() error {
+	if len(req.data) == 0 {
+		return bulk.ErrEmptyImport
+	}
+	if len(req.data) > maxImportSize {
+		return errImportSize
+	}
+
+	return nil
+}
+
+type viewImportJobReq struct {
+	id string
+}
+
+func (req viewImportJobReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type exportClientsReq struct {
+	format bulk.Format
+}
+
+func (req exportClientsReq) validate() error {
+	return nil
+}
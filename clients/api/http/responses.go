@@ -9,6 +9,7 @@ import (
 
 	"github.com/absmach/magistrala"
 	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/clients/bulk"
 )
 
 var (
@@ -18,6 +19,7 @@ var (
 	_ magistrala.Response = (*clientsPageRes)(nil)
 	_ magistrala.Response = (*changeClientStatusRes)(nil)
 	_ magistrala.Response = (*deleteClientRes)(nil)
+	_ magistrala.Response = (*importJobRes)(nil)
 )
 
 type clientsPageMetaRes struct {
@@ -175,3 +177,35 @@ func (res deleteClientRes) Headers() map[string]string {
 func (res deleteClientRes) Empty() bool {
 	return true
 }
+
+type importJobRes struct {
+	bulk.Job
+	created bool
+}
+
+func (res importJobRes) Code() int {
+	if res.created {
+		return http.StatusAccepted
+	}
+
+	return http.StatusOK
+}
+
+func (res importJobRes) Headers() map[string]string {
+	if res.created {
+		return map[string]string{
+			"Location": fmt.Sprintf("/%s/clients/import/%s", res.DomainID, res.ID),
+		}
+	}
+
+	return map[string]string{}
+}
+
+func (res importJobRes) Empty() bool {
+	return false
+}
+
+type exportClientsRes struct {
+	format bulk.Format
+	data   []byte
+}
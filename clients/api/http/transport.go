@@ -4,22 +4,34 @@
 package http
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/absmach/magistrala"
 	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/clients/bulk"
 	smqauthn "github.com/absmach/magistrala/pkg/authn"
 	"github.com/go-chi/chi/v5"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // MakeHandler returns a HTTP handler for clients and Groups API endpoints.
-func MakeHandler(tsvc clients.Service, authn smqauthn.AuthNMiddleware, mux *chi.Mux, logger *slog.Logger, instanceID string, idp magistrala.IDProvider) http.Handler {
-	mux = clientsHandler(tsvc, authn, mux, logger, idp)
+func MakeHandler(tsvc clients.Service, bsvc bulk.Service, authn smqauthn.AuthNMiddleware, mux *chi.Mux, logger *slog.Logger, instanceID string, idp magistrala.IDProvider, maxSecretOverlap time.Duration) http.Handler {
+	mux = clientsHandler(tsvc, bsvc, authn, mux, logger, idp, maxSecretOverlap)
 
 	mux.Get("/health", magistrala.Health("clients", instanceID))
 	mux.Handle("/metrics", promhttp.Handler())
 
 	return mux
 }
+
+func encodeExportResponse(_ context.Context, w http.ResponseWriter, response any) error {
+	res := response.(exportClientsRes)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=clients.%s", res.format))
+	w.Header().Set("Content-Type", res.format.ContentType())
+	_, err := w.Write(res.data)
+	return err
+}
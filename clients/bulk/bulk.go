@@ -0,0 +1,196 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package bulk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/connections"
+)
+
+// Format is the encoding of the imported and exported clients.
+type Format uint8
+
+const (
+	// CSV encodes one client per row, with the header row naming the columns.
+	CSV Format = iota
+	// NDJSON encodes one client per line as a JSON object.
+	NDJSON
+)
+
+const (
+	csvFormat    = "csv"
+	ndjsonFormat = "ndjson"
+)
+
+// String converts the format to its string representation.
+func (f Format) String() string {
+	switch f {
+	case NDJSON:
+		return ndjsonFormat
+	default:
+		return csvFormat
+	}
+}
+
+// ToFormat converts the string to the format.
+func ToFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case csvFormat:
+		return CSV, nil
+	case ndjsonFormat:
+		return NDJSON, nil
+	default:
+		return CSV, ErrInvalidFormat
+	}
+}
+
+// ContentType returns the HTTP content type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case NDJSON:
+		return "application/x-ndjson"
+	default:
+		return "text/csv"
+	}
+}
+
+// MarshalJSON marshals the format to its string representation.
+func (f Format) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+// JobStatus is the state of an import job.
+type JobStatus uint8
+
+const (
+	// PendingStatus is the state of a job which is not processed yet.
+	PendingStatus JobStatus = iota
+	// RunningStatus is the state of a job which is being processed.
+	RunningStatus
+	// CompletedStatus is the state of a job whose records are all processed.
+	CompletedStatus
+	// InterruptedStatus is the state of a job stopped with the service
+	// before its records are all processed. The imported data is not kept,
+	// so the job is not resumed.
+	InterruptedStatus
+)
+
+const (
+	pending     = "pending"
+	running     = "running"
+	completed   = "completed"
+	interrupted = "interrupted"
+)
+
+// String converts the job status to its string representation.
+func (s JobStatus) String() string {
+	switch s {
+	case RunningStatus:
+		return running
+	case CompletedStatus:
+		return completed
+	case InterruptedStatus:
+		return interrupted
+	default:
+		return pending
+	}
+}
+
+// MarshalJSON marshals the job status to its string representation.
+func (s JobStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// Connection is a channel connection of an imported client. A connection
+// without types connects the client for both publish and subscribe, and a
+// connection without topics is not restricted to any subtopic.
+type Connection struct {
+	ChannelID string                 `json:"channel_id"`
+	Types     []connections.ConnType `json:"types,omitempty"`
+	Topics    []string               `json:"topics,omitempty"`
+}
+
+// Record is a single client of the import or export.
+type Record struct {
+	// Line is the line of the record in the imported data.
+	Line        uint64           `json:"-"`
+	ID          string           `json:"id,omitempty"`
+	Name        string           `json:"name,omitempty"`
+	Secret      string           `json:"secret,omitempty"`
+	Tags        []string         `json:"tags,omitempty"`
+	Metadata    clients.Metadata `json:"metadata,omitempty"`
+	GroupID     string           `json:"group_id,omitempty"`
+	Connections []Connection     `json:"connections,omitempty"`
+}
+
+// RecordError describes the failure of the record on the given line.
+type RecordError struct {
+	Line  uint64 `json:"line"`
+	Error string `json:"error"`
+}
+
+// Job is an import of the clients. The job is processed in the background
+// and its counters report the progress of the import.
+type Job struct {
+	ID        string        `json:"id"`
+	DomainID  string        `json:"domain_id"`
+	CreatedBy string        `json:"created_by"`
+	Format    Format        `json:"format"`
+	DryRun    bool          `json:"dry_run"`
+	Status    JobStatus     `json:"status"`
+	Total     uint64        `json:"total"`
+	Processed uint64        `json:"processed"`
+	Imported  uint64        `json:"imported"`
+	Failed    uint64        `json:"failed"`
+	Errors    []RecordError `json:"errors,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// Service specifies an API for the bulk import and export of the clients.
+type Service interface {
+	// Import validates the clients in the data and starts the job which
+	// imports them. The clients whose IDs are taken, or whose group or
+	// channels are missing from the domain, are rejected. In dry-run mode
+	// the clients are only validated and the returned job is already
+	// completed.
+	Import(ctx context.Context, session authn.Session, format Format, data []byte, dryRun bool) (Job, error)
+
+	// ViewJob retrieves the import job with the given ID.
+	ViewJob(ctx context.Context, session authn.Session, id string) (Job, error)
+
+	// Export writes the clients of the domain to the writer in the given
+	// format. The exported clients can be imported, but their secrets are
+	// not exported.
+	Export(ctx context.Context, session authn.Session, format Format, w io.Writer) error
+}
+
+// Repository specifies the persistence of the import jobs.
+type Repository interface {
+	// SaveJob persists the import job.
+	SaveJob(ctx context.Context, job Job) error
+
+	// UpdateJob updates the status and the progress of the import job.
+	UpdateJob(ctx context.Context, job Job) error
+
+	// RetrieveJob retrieves the import job of the domain.
+	RetrieveJob(ctx context.Context, domainID, id string) (Job, error)
+
+	// InterruptJobs marks the pending and running jobs which are not
+	// updated since before as interrupted.
+	InterruptJobs(ctx context.Context, before time.Time) (uint64, error)
+
+	// RetrieveClientIDs retrieves the given client IDs which are taken.
+	RetrieveClientIDs(ctx context.Context, ids []string) ([]string, error)
+
+	// RetrieveConnections retrieves the channel connections of the clients.
+	RetrieveConnections(ctx context.Context, clientIDs []string) ([]clients.Connection, error)
+}
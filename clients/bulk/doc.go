@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bulk contains the bulk import and export of the clients. The
+// clients are imported from CSV or NDJSON files by the background jobs, which
+// create the clients, assign them to their parent groups and connect them to
+// their channels.
+package bulk
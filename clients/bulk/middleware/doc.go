@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package middleware provides logging, metrics and tracing middleware
+// for the bulk import and export service.
+package middleware
@@ -0,0 +1,93 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/magistrala/clients/bulk"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+var _ bulk.Service = (*loggingMiddleware)(nil)
+
+type loggingMiddleware struct {
+	logger *slog.Logger
+	svc    bulk.Service
+}
+
+// NewLogging adds logging facilities to the bulk service.
+func NewLogging(svc bulk.Service, logger *slog.Logger) bulk.Service {
+	return &loggingMiddleware{
+		logger: logger,
+		svc:    svc,
+	}
+}
+
+func (lm *loggingMiddleware) Import(ctx context.Context, session authn.Session, format bulk.Format, data []byte, dryRun bool) (job bulk.Job, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("job",
+				slog.String("id", job.ID),
+				slog.String("format", format.String()),
+				slog.Bool("dry_run", dryRun),
+				slog.Uint64("total", job.Total),
+				slog.Uint64("failed", job.Failed),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Import clients failed", args...)
+			return
+		}
+		lm.logger.Info("Import clients completed successfully", args...)
+	}(time.Now())
+
+	return lm.svc.Import(ctx, session, format, data, dryRun)
+}
+
+func (lm *loggingMiddleware) ViewJob(ctx context.Context, session authn.Session, id string) (job bulk.Job, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("job_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("View import job failed", args...)
+			return
+		}
+		lm.logger.Info("View import job completed successfully", args...)
+	}(time.Now())
+
+	return lm.svc.ViewJob(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) Export(ctx context.Context, session authn.Session, format bulk.Format, w io.Writer) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("format", format.String()),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Export clients failed", args...)
+			return
+		}
+		lm.logger.Info("Export clients completed successfully", args...)
+	}(time.Now())
+
+	return lm.svc.Export(ctx, session, format, w)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/absmach/magistrala/clients/bulk"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/go-kit/kit/metrics"
+)
+
+var _ bulk.Service = (*metricsMiddleware)(nil)
+
+type metricsMiddleware struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	svc     bulk.Service
+}
+
+// NewMetrics returns a new bulk service with metrics middleware.
+func NewMetrics(svc bulk.Service, counter metrics.Counter, latency metrics.Histogram) bulk.Service {
+	return &metricsMiddleware{
+		counter: counter,
+		latency: latency,
+		svc:     svc,
+	}
+}
+
+func (mm *metricsMiddleware) Import(ctx context.Context, session authn.Session, format bulk.Format, data []byte, dryRun bool) (bulk.Job, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "import_clients").Add(1)
+		mm.latency.With("method", "import_clients").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.Import(ctx, session, format, data, dryRun)
+}
+
+func (mm *metricsMiddleware) ViewJob(ctx context.Context, session authn.Session, id string) (bulk.Job, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "view_import_job").Add(1)
+		mm.latency.With("method", "view_import_job").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.ViewJob(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) Export(ctx context.Context, session authn.Session, format bulk.Format, w io.Writer) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "export_clients").Add(1)
+		mm.latency.With("method", "export_clients").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.Export(ctx, session, format, w)
+}
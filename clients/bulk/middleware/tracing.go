@@ -0,0 +1,59 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"io"
+
+	"github.com/absmach/magistrala/clients/bulk"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ bulk.Service = (*tracingMiddleware)(nil)
+
+type tracingMiddleware struct {
+	tracer trace.Tracer
+	svc    bulk.Service
+}
+
+// NewTracing returns a new bulk service with tracing capabilities.
+func NewTracing(svc bulk.Service, tracer trace.Tracer) bulk.Service {
+	return &tracingMiddleware{
+		tracer: tracer,
+		svc:    svc,
+	}
+}
+
+func (tm *tracingMiddleware) Import(ctx context.Context, session authn.Session, format bulk.Format, data []byte, dryRun bool) (bulk.Job, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_import_clients", trace.WithAttributes(
+		attribute.String("format", format.String()),
+		attribute.Int("size", len(data)),
+		attribute.Bool("dry_run", dryRun),
+	))
+	defer span.End()
+
+	return tm.svc.Import(ctx, session, format, data, dryRun)
+}
+
+func (tm *tracingMiddleware) ViewJob(ctx context.Context, session authn.Session, id string) (bulk.Job, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_view_import_job", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.ViewJob(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) Export(ctx context.Context, session authn.Session, format bulk.Format, w io.Writer) error {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_export_clients", trace.WithAttributes(
+		attribute.String("format", format.String()),
+	))
+	defer span.End()
+
+	return tm.svc.Export(ctx, session, format, w)
+}
@@ -0,0 +1,317 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package bulk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+// The CSV columns of the records. The lists of the tags and the connections
+// are separated with semicolons, and the metadata is a JSON object. A
+// connection is a channel ID, optionally followed by a colon and the
+// connection types separated with pipes, e.g. "<channel_id>:publish", and
+// by another colon and the topics separated with pipes, e.g.
+// "<channel_id>:publish:commands/#|state" or "<channel_id>::state".
+const (
+	idColumn          = "id"
+	nameColumn        = "name"
+	secretColumn      = "secret"
+	tagsColumn        = "tags"
+	metadataColumn    = "metadata"
+	groupColumn       = "group_id"
+	connectionsColumn = "connections"
+
+	listSep  = ";"
+	typesSep = ":"
+	typeSep  = "|"
+)
+
+var columns = []string{idColumn, nameColumn, secretColumn, tagsColumn, metadataColumn, groupColumn, connectionsColumn}
+
+// decodeRecords decodes the records of the data. A record which can not be
+// decoded is reported as the record error of its line, while the data which
+// can not be read at all fails the decoding.
+func decodeRecords(format Format, data []byte) ([]Record, []RecordError, error) {
+	switch format {
+	case NDJSON:
+		return decodeNDJSON(data)
+	default:
+		return decodeCSV(data)
+	}
+}
+
+func decodeCSV(data []byte) ([]Record, []RecordError, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil, ErrEmptyImport
+		}
+		return nil, nil, errors.Wrap(ErrMalformedImport, err)
+	}
+	for i, col := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(col))
+		if !slices.Contains(columns, header[i]) {
+			return nil, nil, errors.Wrap(ErrMalformedImport, fmt.Errorf("unknown column %q", col))
+		}
+	}
+
+	var records []Record
+	var recErrs []RecordError
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := r.FieldPos(0)
+		if err != nil {
+			perr, ok := err.(*csv.ParseError)
+			if !ok {
+				return nil, nil, errors.Wrap(ErrMalformedImport, err)
+			}
+			recErrs = append(recErrs, RecordError{Line: uint64(perr.Line), Error: perr.Err.Error()})
+			continue
+		}
+		rec, err := csvRecord(header, row)
+		if err != nil {
+			recErrs = append(recErrs, RecordError{Line: uint64(line), Error: err.Error()})
+			continue
+		}
+		rec.Line = uint64(line)
+		records = append(records, rec)
+	}
+
+	return records, recErrs, nil
+}
+
+func csvRecord(header, row []string) (Record, error) {
+	if len(row) != len(header) {
+		return Record{}, fmt.Errorf("expected %d columns, got %d", len(header), len(row))
+	}
+
+	var rec Record
+	for i, val := range row {
+		val = strings.TrimSpace(val)
+		if val == "" {
+			continue
+		}
+		switch header[i] {
+		case idColumn:
+			rec.ID = val
+		case nameColumn:
+			rec.Name = val
+		case secretColumn:
+			rec.Secret = val
+		case tagsColumn:
+			rec.Tags = splitList(val)
+		case metadataColumn:
+			if err := json.Unmarshal([]byte(val), &rec.Metadata); err != nil {
+				return Record{}, errors.Wrap(errMalformedMetadata, err)
+			}
+		case groupColumn:
+			rec.GroupID = val
+		case connectionsColumn:
+			conns, err := parseConnections(val)
+			if err != nil {
+				return Record{}, err
+			}
+			rec.Connections = conns
+		}
+	}
+
+	return rec, nil
+}
+
+func parseConnections(val string) ([]Connection, error) {
+	var conns []Connection
+	for _, entry := range splitList(val) {
+		chID, rest, _ := strings.Cut(entry, typesSep)
+		types, topics, _ := strings.Cut(rest, typesSep)
+		conn := Connection{ChannelID: strings.TrimSpace(chID)}
+		if types = strings.TrimSpace(types); types != "" {
+			for _, t := range strings.Split(types, typeSep) {
+				ct, err := connections.ParseConnType(strings.TrimSpace(t))
+				if err != nil {
+					return nil, err
+				}
+				conn.Types = append(conn.Types, ct)
+			}
+		}
+		for _, topic := range strings.Split(topics, typeSep) {
+			if topic = strings.TrimSpace(topic); topic != "" {
+				conn.Topics = append(conn.Topics, topic)
+			}
+		}
+		conns = append(conns, conn)
+	}
+
+	return conns, nil
+}
+
+func splitList(val string) []string {
+	var list []string
+	for _, item := range strings.Split(val, listSep) {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+
+	return list
+}
+
+func decodeNDJSON(data []byte) ([]Record, []RecordError, error) {
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	var records []Record
+	var recErrs []RecordError
+	var line uint64
+	for sc.Scan() {
+		line++
+		raw := bytes.TrimSpace(sc.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		var rec Record
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			recErrs = append(recErrs, RecordError{Line: line, Error: err.Error()})
+			continue
+		}
+		rec.Line = line
+		records = append(records, rec)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, nil, errors.Wrap(ErrMalformedImport, err)
+	}
+	if line == 0 {
+		return nil, nil, ErrEmptyImport
+	}
+
+	return records, recErrs, nil
+}
+
+type encoder interface {
+	Encode(rec Record) error
+	Flush() error
+}
+
+func newEncoder(format Format, w io.Writer) (encoder, error) {
+	switch format {
+	case NDJSON:
+		return &ndjsonEncoder{enc: json.NewEncoder(w)}, nil
+	default:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(columns); err != nil {
+			return nil, err
+		}
+		return &csvEncoder{w: cw}, nil
+	}
+}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func (ce *csvEncoder) Encode(rec Record) error {
+	var metadata string
+	if len(rec.Metadata) > 0 {
+		b, err := json.Marshal(rec.Metadata)
+		if err != nil {
+			return err
+		}
+		metadata = string(b)
+	}
+
+	conns := make([]string, 0, len(rec.Connections))
+	for _, conn := range rec.Connections {
+		entry := conn.ChannelID
+		if len(conn.Types) > 0 || len(conn.Topics) > 0 {
+			types := make([]string, 0, len(conn.Types))
+			for _, t := range conn.Types {
+				types = append(types, strings.ToLower(t.String()))
+			}
+			entry += typesSep + strings.Join(types, typeSep)
+		}
+		if len(conn.Topics) > 0 {
+			entry += typesSep + strings.Join(conn.Topics, typeSep)
+		}
+		conns = append(conns, entry)
+	}
+
+	return ce.w.Write([]string{
+		rec.ID,
+		rec.Name,
+		rec.Secret,
+		strings.Join(rec.Tags, listSep),
+		metadata,
+		rec.GroupID,
+		strings.Join(conns, listSep),
+	})
+}
+
+func (ce *csvEncoder) Flush() error {
+	ce.w.Flush()
+	return ce.w.Error()
+}
+
+type ndjsonEncoder struct {
+	enc *json.Encoder
+}
+
+func (ne *ndjsonEncoder) Encode(rec Record) error {
+	return ne.enc.Encode(rec)
+}
+
+func (ne *ndjsonEncoder) Flush() error {
+	return nil
+}
+
+// toRecord converts the client and its connections to the exported record.
+// The connection for both publish and subscribe with the same topics is
+// exported without types.
+func toRecord(c clients.Client, conns []clients.Connection) Record {
+	rec := Record{
+		ID:       c.ID,
+		Name:     c.Name,
+		Tags:     c.Tags,
+		Metadata: c.Metadata,
+		GroupID:  c.ParentGroup,
+	}
+
+	var keys []string
+	grouped := map[string]Connection{}
+	for _, conn := range conns {
+		key := conn.ChannelID + typesSep + strings.Join(conn.Topics, typeSep)
+		gc, ok := grouped[key]
+		if !ok {
+			keys = append(keys, key)
+			gc = Connection{ChannelID: conn.ChannelID, Topics: conn.Topics}
+		}
+		gc.Types = append(gc.Types, conn.Type)
+		grouped[key] = gc
+	}
+	for _, key := range keys {
+		conn := grouped[key]
+		if len(conn.Types) > 1 {
+			conn.Types = nil
+		}
+		rec.Connections = append(rec.Connections, conn)
+	}
+
+	return rec
+}
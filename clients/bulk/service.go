@@ -0,0 +1,464 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package bulk
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/absmach/magistrala"
+	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
+	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
+	grpcGroupsV1 "github.com/absmach/magistrala/api/grpc/groups/v1"
+	api "github.com/absmach/magistrala/api/http"
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"google.golang.org/grpc"
+)
+
+const (
+	// batchSize is the number of the clients created at once.
+	batchSize = 100
+	// exportLimit is the page size of the exported clients.
+	exportLimit = 100
+	// maxErrors limits the record errors kept with the job, so that the
+	// import of a broken file does not bloat the job.
+	maxErrors = 1000
+	// maxLineSize limits the size of the NDJSON line.
+	maxLineSize = 1024 * 1024
+)
+
+var (
+	// ErrInvalidFormat indicates an unsupported import or export format.
+	ErrInvalidFormat = errors.NewRequestError("invalid bulk format, expected csv or ndjson")
+
+	// ErrMalformedImport indicates the imported data which can not be read.
+	ErrMalformedImport = errors.NewRequestError("malformed import data")
+
+	// ErrEmptyImport indicates the imported data without clients.
+	ErrEmptyImport = errors.NewRequestError("empty import data")
+
+	errMalformedMetadata = errors.New("metadata is not a JSON object")
+	errDuplicateID       = errors.New("duplicate client id")
+	errTakenID           = errors.New("client id already exists")
+	errUnknownGroup      = errors.New("group does not exist in the domain")
+	errUnknownChannel    = errors.New("channel does not exist in the domain")
+	errMissingChannel    = errors.New("missing connection channel id")
+	errAssign            = errors.New("client is created, but not assigned to its group and channels")
+)
+
+// defTypes are the types of the connection without types.
+var defTypes = []connections.ConnType{connections.Publish, connections.Subscribe}
+
+type service struct {
+	clients    clients.Service
+	channels   grpcChannelsV1.ChannelsServiceClient
+	groups     grpcGroupsV1.GroupsServiceClient
+	repo       Repository
+	worker     *Worker
+	idProvider magistrala.IDProvider
+	logger     *slog.Logger
+}
+
+var _ Service = (*service)(nil)
+
+// NewService returns a new bulk import and export service. The clients are
+// created, assigned and exported through the clients service and connected
+// through the channels service, so the permissions of the user apply as in
+// the individual requests. The import jobs are run by the worker.
+func NewService(svc clients.Service, channels grpcChannelsV1.ChannelsServiceClient, groups grpcGroupsV1.GroupsServiceClient, repo Repository, worker *Worker, idp magistrala.IDProvider, logger *slog.Logger) Service {
+	return &service{
+		clients:    svc,
+		channels:   channels,
+		groups:     groups,
+		repo:       repo,
+		worker:     worker,
+		idProvider: idp,
+		logger:     logger,
+	}
+}
+
+func (svc *service) Import(ctx context.Context, session authn.Session, format Format, data []byte, dryRun bool) (Job, error) {
+	records, recErrs, err := decodeRecords(format, data)
+	if err != nil {
+		return Job{}, err
+	}
+
+	id, err := svc.idProvider.ID()
+	if err != nil {
+		return Job{}, errors.Wrap(svcerr.ErrIssueProviderID, err)
+	}
+
+	now := time.Now().UTC()
+	job := Job{
+		ID:        id,
+		DomainID:  session.DomainID,
+		CreatedBy: session.UserID,
+		Format:    format,
+		DryRun:    dryRun,
+		Status:    PendingStatus,
+		Total:     uint64(len(records) + len(recErrs)),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	valid := []Record{}
+	ids := map[string]bool{}
+	for _, rec := range records {
+		if err := validate(rec, ids); err != nil {
+			recErrs = append(recErrs, RecordError{Line: rec.Line, Error: err.Error()})
+			continue
+		}
+		valid = append(valid, rec)
+	}
+	valid, missing, err := svc.checkEntities(ctx, session, valid)
+	if err != nil {
+		return Job{}, err
+	}
+	recErrs = append(recErrs, missing...)
+	slices.SortFunc(recErrs, func(a, b RecordError) int {
+		return cmp.Compare(a.Line, b.Line)
+	})
+	job.fail(recErrs...)
+
+	if dryRun || len(valid) == 0 {
+		job.Processed = job.Total
+		job.Status = CompletedStatus
+	}
+
+	if err := svc.repo.SaveJob(ctx, job); err != nil {
+		return Job{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+
+	if job.Status != CompletedStatus {
+		// The job outlives the request, so it keeps only the request values.
+		rctx := context.WithoutCancel(ctx)
+		if !svc.worker.submit(func(stop context.Context) {
+			svc.run(rctx, stop, session, job, valid)
+		}) {
+			job.Status = InterruptedStatus
+			svc.update(rctx, &job)
+		}
+	}
+
+	return job, nil
+}
+
+func (svc *service) ViewJob(ctx context.Context, session authn.Session, id string) (Job, error) {
+	job, err := svc.repo.RetrieveJob(ctx, session.DomainID, id)
+	if err != nil {
+		return Job{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if job.CreatedBy != session.UserID && !session.SuperAdmin {
+		return Job{}, svcerr.ErrAuthorization
+	}
+
+	return job, nil
+}
+
+func (svc *service) Export(ctx context.Context, session authn.Session, format Format, w io.Writer) error {
+	enc, err := newEncoder(format, w)
+	if err != nil {
+		return err
+	}
+
+	pm := clients.Page{
+		Limit: exportLimit,
+		Order: "created_at",
+		Dir:   api.AscDir,
+	}
+	for {
+		page, err := svc.clients.ListClients(ctx, session, pm)
+		if err != nil {
+			return err
+		}
+		if len(page.Clients) == 0 {
+			break
+		}
+
+		ids := make([]string, 0, len(page.Clients))
+		for _, c := range page.Clients {
+			ids = append(ids, c.ID)
+		}
+		conns, err := svc.repo.RetrieveConnections(ctx, ids)
+		if err != nil {
+			return errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		clientConns := map[string][]clients.Connection{}
+		for _, conn := range conns {
+			clientConns[conn.ClientID] = append(clientConns[conn.ClientID], conn)
+		}
+
+		for _, c := range page.Clients {
+			if err := enc.Encode(toRecord(c, clientConns[c.ID])); err != nil {
+				return err
+			}
+		}
+
+		pm.Offset += uint64(len(page.Clients))
+		if pm.Offset >= page.Total {
+			break
+		}
+	}
+
+	return enc.Flush()
+}
+
+// run imports the valid records of the job in batches and updates the
+// progress of the job after each batch. The job is interrupted before the
+// next batch once the stop context is canceled.
+func (svc *service) run(ctx, stop context.Context, session authn.Session, job Job, records []Record) {
+	job.Status = RunningStatus
+	svc.update(ctx, &job)
+
+	for batch := range slices.Chunk(records, batchSize) {
+		if stop.Err() != nil {
+			job.Status = InterruptedStatus
+			svc.update(ctx, &job)
+			return
+		}
+		svc.importBatch(ctx, session, &job, batch)
+		svc.update(ctx, &job)
+	}
+
+	job.Status = CompletedStatus
+	svc.update(ctx, &job)
+}
+
+// checkEntities rejects the records whose client IDs are taken, or whose
+// group or channels do not exist in the domain of the session.
+func (svc *service) checkEntities(ctx context.Context, session authn.Session, records []Record) ([]Record, []RecordError, error) {
+	var ids []string
+	groups := map[string]error{}
+	channels := map[string]error{}
+	for _, rec := range records {
+		if rec.ID != "" {
+			ids = append(ids, rec.ID)
+		}
+		if rec.GroupID != "" {
+			groups[rec.GroupID] = nil
+		}
+		for _, conn := range rec.Connections {
+			channels[conn.ChannelID] = nil
+		}
+	}
+
+	taken := map[string]bool{}
+	if len(ids) > 0 {
+		existing, err := svc.repo.RetrieveClientIDs(ctx, ids)
+		if err != nil {
+			return nil, nil, errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		for _, id := range existing {
+			taken[id] = true
+		}
+	}
+	if err := lookup(ctx, session.DomainID, groups, errUnknownGroup, svc.groups.RetrieveEntity); err != nil {
+		return nil, nil, err
+	}
+	if err := lookup(ctx, session.DomainID, channels, errUnknownChannel, svc.channels.RetrieveEntity); err != nil {
+		return nil, nil, err
+	}
+
+	valid := []Record{}
+	var recErrs []RecordError
+	for _, rec := range records {
+		err := groups[rec.GroupID]
+		if taken[rec.ID] {
+			err = errTakenID
+		}
+		for _, conn := range rec.Connections {
+			if err == nil {
+				err = channels[conn.ChannelID]
+			}
+		}
+		if err != nil {
+			recErrs = append(recErrs, RecordError{Line: rec.Line, Error: err.Error()})
+			continue
+		}
+		valid = append(valid, rec)
+	}
+
+	return valid, recErrs, nil
+}
+
+type retrieveEntity func(ctx context.Context, req *grpcCommonV1.RetrieveEntityReq, opts ...grpc.CallOption) (*grpcCommonV1.RetrieveEntityRes, error)
+
+// lookup retrieves the entities with the given IDs, and sets the missing
+// error of the entities which do not exist in the domain.
+func lookup(ctx context.Context, domainID string, ids map[string]error, missing error, retrieve retrieveEntity) error {
+	for id := range ids {
+		res, err := retrieve(ctx, &grpcCommonV1.RetrieveEntityReq{Id: id})
+		switch {
+		case errors.Contains(err, svcerr.ErrNotFound):
+			ids[id] = missing
+		case err != nil:
+			return errors.Wrap(svcerr.ErrViewEntity, err)
+		case res.GetEntity().GetDomainId() != domainID:
+			ids[id] = missing
+		}
+	}
+
+	return nil
+}
+
+func (svc *service) importBatch(ctx context.Context, session authn.Session, job *Job, batch []Record) {
+	cls := make([]clients.Client, 0, len(batch))
+	for i := range batch {
+		if batch[i].ID == "" {
+			id, err := svc.idProvider.ID()
+			if err != nil {
+				job.fail(RecordError{Line: batch[i].Line, Error: errors.Wrap(svcerr.ErrIssueProviderID, err).Error()})
+				continue
+			}
+			batch[i].ID = id
+		}
+		cls = append(cls, batch[i].client())
+	}
+	if len(cls) == 0 {
+		return
+	}
+
+	if _, _, err := svc.clients.CreateClients(ctx, session, cls...); err != nil {
+		// The clients of the batch are created at once, so they are
+		// created one by one to find the failing records.
+		for _, rec := range batch {
+			if rec.ID == "" {
+				continue
+			}
+			if _, _, err := svc.clients.CreateClients(ctx, session, rec.client()); err != nil {
+				job.fail(RecordError{Line: rec.Line, Error: err.Error()})
+				continue
+			}
+			svc.assign(ctx, session, job, rec)
+		}
+		return
+	}
+
+	for _, rec := range batch {
+		if rec.ID != "" {
+			svc.assign(ctx, session, job, rec)
+		}
+	}
+}
+
+// assign sets the parent group of the created client and connects it to
+// its channels.
+func (svc *service) assign(ctx context.Context, session authn.Session, job *Job, rec Record) {
+	if rec.GroupID != "" {
+		if err := svc.clients.SetParentGroup(ctx, session, rec.GroupID, rec.ID); err != nil {
+			job.fail(RecordError{Line: rec.Line, Error: errors.Wrap(errAssign, err).Error()})
+			return
+		}
+	}
+
+	// The channels with the same connection types and topics are connected
+	// at once.
+	var keys []string
+	chIDs := map[string][]string{}
+	types := map[string][]connections.ConnType{}
+	topics := map[string][]string{}
+	for _, conn := range rec.Connections {
+		ct := conn.Types
+		if len(ct) == 0 {
+			ct = defTypes
+		}
+		key := fmt.Sprint(ct, conn.Topics)
+		if _, ok := chIDs[key]; !ok {
+			keys = append(keys, key)
+			types[key] = ct
+			topics[key] = conn.Topics
+		}
+		chIDs[key] = append(chIDs[key], conn.ChannelID)
+	}
+	for _, key := range keys {
+		req := &grpcChannelsV1.ConnectReq{
+			DomainId:     session.DomainID,
+			UserId:       session.UserID,
+			DomainUserId: session.DomainUserID,
+			PatId:        session.PatID,
+			SuperAdmin:   session.SuperAdmin,
+			ChannelIds:   chIDs[key],
+			ClientIds:    []string{rec.ID},
+			Topics:       topics[key],
+		}
+		for _, t := range types[key] {
+			req.Types = append(req.Types, uint32(t))
+		}
+		if _, err := svc.channels.Connect(ctx, req); err != nil {
+			job.fail(RecordError{Line: rec.Line, Error: errors.Wrap(errAssign, err).Error()})
+			return
+		}
+	}
+
+	job.Processed++
+	job.Imported++
+}
+
+func (svc *service) update(ctx context.Context, job *Job) {
+	job.UpdatedAt = time.Now().UTC()
+	if err := svc.repo.UpdateJob(ctx, *job); err != nil {
+		svc.logger.Warn(fmt.Sprintf("Failed to update import job %s: %s", job.ID, err))
+	}
+}
+
+// fail records the failed records of the job.
+func (job *Job) fail(recErrs ...RecordError) {
+	for _, re := range recErrs {
+		job.Processed++
+		job.Failed++
+		if len(job.Errors) < maxErrors {
+			job.Errors = append(job.Errors, re)
+		}
+	}
+}
+
+func (rec Record) client() clients.Client {
+	return clients.Client{
+		ID:       rec.ID,
+		Name:     rec.Name,
+		Tags:     rec.Tags,
+		Metadata: rec.Metadata,
+		Credentials: clients.Credentials{
+			Secret: rec.Secret,
+		},
+		Status: clients.EnabledStatus,
+	}
+}
+
+func validate(rec Record, ids map[string]bool) error {
+	if len(rec.Name) > api.MaxNameSize {
+		return apiutil.ErrNameSize
+	}
+	if rec.ID != "" {
+		if err := api.ValidateUUID(rec.ID); err != nil {
+			return err
+		}
+		if ids[rec.ID] {
+			return errDuplicateID
+		}
+		ids[rec.ID] = true
+	}
+	for _, conn := range rec.Connections {
+		if conn.ChannelID == "" {
+			return errMissingChannel
+		}
+		for _, t := range conn.Types {
+			if err := connections.CheckConnType(t); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,448 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package bulk_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
+	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
+	chmocks "github.com/absmach/magistrala/channels/mocks"
+	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/clients/bulk"
+	bmocks "github.com/absmach/magistrala/clients/bulk/mocks"
+	climocks "github.com/absmach/magistrala/clients/mocks"
+	gmocks "github.com/absmach/magistrala/groups/mocks"
+	"github.com/absmach/magistrala/internal/testsutil"
+	mglog "github.com/absmach/magistrala/logger"
+	smqauthn "github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	validID   = testsutil.GenerateUUID(&testing.T{})
+	channelID = testsutil.GenerateUUID(&testing.T{})
+	groupID   = testsutil.GenerateUUID(&testing.T{})
+	session   = smqauthn.Session{DomainUserID: "domain_" + validID, UserID: validID, DomainID: "domain"}
+)
+
+var (
+	csvc  *climocks.Service
+	chsvc *chmocks.ChannelsServiceClient
+	gsvc  *gmocks.GroupsServiceClient
+	repo  *bmocks.Repository
+)
+
+func newService(t *testing.T) bulk.Service {
+	csvc = new(climocks.Service)
+	chsvc = new(chmocks.ChannelsServiceClient)
+	gsvc = new(gmocks.GroupsServiceClient)
+	repo = new(bmocks.Repository)
+	repo.On("InterruptJobs", mock.Anything, mock.Anything).Return(uint64(0), nil)
+
+	worker := bulk.NewWorker(repo, time.Hour, mglog.NewMock())
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		_ = worker.Run(ctx)
+	}()
+
+	return bulk.NewService(csvc, chsvc, gsvc, repo, worker, uuid.NewMock(), mglog.NewMock())
+}
+
+func entity(domainID string) *grpcCommonV1.RetrieveEntityRes {
+	return &grpcCommonV1.RetrieveEntityRes{Entity: &grpcCommonV1.EntityBasic{DomainId: domainID}}
+}
+
+func TestImportDryRun(t *testing.T) {
+	svc := newService(t)
+
+	cases := []struct {
+		desc       string
+		format     bulk.Format
+		data       string
+		taken      []string
+		idsErr     error
+		channelRes *grpcCommonV1.RetrieveEntityRes
+		channelErr error
+		groupRes   *grpcCommonV1.RetrieveEntityRes
+		groupErr   error
+		total      uint64
+		failed     uint64
+		errLines   []uint64
+		saveErr    error
+		err        error
+	}{
+		{
+			desc:   "validate valid CSV clients",
+			format: bulk.CSV,
+			data: "id,name,tags,metadata,connections\n" +
+				validID + `,sensor,a;b,"{""k"":""v""}",` + channelID + ":publish\n" +
+				",actuator,,," + channelID + "::state\n",
+			total: 2,
+		},
+		{
+			desc:   "validate valid NDJSON clients",
+			format: bulk.NDJSON,
+			data: `{"id":"` + validID + `","name":"sensor","connections":[{"channel_id":"` + channelID + `","types":["publish"]}]}` + "\n" +
+				"\n" +
+				`{"name":"actuator","tags":["a"],"connections":[{"channel_id":"` + channelID + `","topics":["state"]}]}` + "\n",
+			total: 2,
+		},
+		{
+			desc:   "validate invalid CSV clients",
+			format: bulk.CSV,
+			data: "id,name,metadata,connections\n" +
+				"invalid,sensor,,\n" +
+				validID + ",sensor,,\n" +
+				validID + ",duplicate,,\n" +
+				",sensor,not-json,\n" +
+				",sensor,," + channelID + ":invalid\n" +
+				",sensor\n",
+			total:    6,
+			failed:   5,
+			errLines: []uint64{2, 4, 5, 6, 7},
+		},
+		{
+			desc:   "validate invalid NDJSON clients",
+			format: bulk.NDJSON,
+			data: `{"name":"sensor"}` + "\n" +
+				`{"name":` + "\n" +
+				`{"connections":[{"types":["publish"]}]}` + "\n",
+			total:    3,
+			failed:   2,
+			errLines: []uint64{2, 3},
+		},
+		{
+			desc:   "validate clients with taken id",
+			format: bulk.CSV,
+			data: "id,name\n" +
+				validID + ",sensor\n" +
+				",actuator\n",
+			taken:    []string{validID},
+			total:    2,
+			failed:   1,
+			errLines: []uint64{2},
+		},
+		{
+			desc:   "validate clients with missing group and channels",
+			format: bulk.CSV,
+			data: "name,group_id,connections\n" +
+				"sensor," + groupID + ",\n" +
+				"actuator,," + channelID + "\n" +
+				"gateway,,\n",
+			channelErr: svcerr.ErrNotFound,
+			groupErr:   svcerr.ErrNotFound,
+			total:      3,
+			failed:     2,
+			errLines:   []uint64{2, 3},
+		},
+		{
+			desc:   "validate clients with group and channel of another domain",
+			format: bulk.CSV,
+			data: "name,group_id,connections\n" +
+				"sensor," + groupID + ",\n" +
+				"actuator,," + channelID + "\n",
+			channelRes: entity("other"),
+			groupRes:   entity("other"),
+			total:      2,
+			failed:     2,
+			errLines:   []uint64{2, 3},
+		},
+		{
+			desc:   "validate clients with failed id retrieval",
+			format: bulk.CSV,
+			data:   "id,name\n" + validID + ",sensor\n",
+			idsErr: repoerr.ErrViewEntity,
+			err:    svcerr.ErrViewEntity,
+		},
+		{
+			desc:       "validate clients with failed channel retrieval",
+			format:     bulk.CSV,
+			data:       "name,connections\nsensor," + channelID + "\n",
+			channelErr: svcerr.ErrAuthorization,
+			err:        svcerr.ErrViewEntity,
+		},
+		{
+			desc:   "validate CSV clients with unknown column",
+			format: bulk.CSV,
+			data:   "name,unknown\nsensor,value\n",
+			err:    bulk.ErrMalformedImport,
+		},
+		{
+			desc:   "validate empty CSV clients",
+			format: bulk.CSV,
+			data:   "",
+			err:    bulk.ErrEmptyImport,
+		},
+		{
+			desc:   "validate empty NDJSON clients",
+			format: bulk.NDJSON,
+			data:   "",
+			err:    bulk.ErrEmptyImport,
+		},
+		{
+			desc:    "validate clients with failed job save",
+			format:  bulk.CSV,
+			data:    "name\nsensor\n",
+			saveErr: repoerr.ErrCreateEntity,
+			err:     svcerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.channelRes == nil {
+				tc.channelRes = entity(session.DomainID)
+			}
+			if tc.groupRes == nil {
+				tc.groupRes = entity(session.DomainID)
+			}
+			repoCall := repo.On("SaveJob", context.Background(), mock.Anything).Return(tc.saveErr)
+			repoCall1 := repo.On("RetrieveClientIDs", context.Background(), mock.Anything).Return(tc.taken, tc.idsErr)
+			chCall := chsvc.On("RetrieveEntity", context.Background(), &grpcCommonV1.RetrieveEntityReq{Id: channelID}).Return(tc.channelRes, tc.channelErr)
+			gCall := gsvc.On("RetrieveEntity", context.Background(), &grpcCommonV1.RetrieveEntityReq{Id: groupID}).Return(tc.groupRes, tc.groupErr)
+			job, err := svc.Import(context.Background(), session, tc.format, []byte(tc.data), true)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.True(t, job.DryRun, fmt.Sprintf("%s: expected dry-run job", tc.desc))
+				assert.Equal(t, bulk.CompletedStatus, job.Status, fmt.Sprintf("%s: expected completed job got %s", tc.desc, job.Status))
+				assert.Equal(t, tc.total, job.Total, fmt.Sprintf("%s: expected %d total got %d", tc.desc, tc.total, job.Total))
+				assert.Equal(t, tc.total, job.Processed, fmt.Sprintf("%s: expected %d processed got %d", tc.desc, tc.total, job.Processed))
+				assert.Equal(t, tc.failed, job.Failed, fmt.Sprintf("%s: expected %d failed got %d", tc.desc, tc.failed, job.Failed))
+				var lines []uint64
+				for _, re := range job.Errors {
+					lines = append(lines, re.Line)
+				}
+				assert.Equal(t, tc.errLines, lines, fmt.Sprintf("%s: expected error lines %v got %v", tc.desc, tc.errLines, lines))
+				csvc.AssertNotCalled(t, "CreateClients", mock.Anything, mock.Anything, mock.Anything)
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			chCall.Unset()
+			gCall.Unset()
+		})
+	}
+}
+
+func TestImport(t *testing.T) {
+	svc := newService(t)
+
+	data := "id,name,group_id,connections\n" +
+		validID + ",sensor," + groupID + "," + channelID + ":subscribe:commands/#|state;" + groupID + "\n" +
+		",actuator,," + channelID + "\n" +
+		"invalid,broken,,\n"
+
+	done := make(chan bulk.Job, 1)
+	repo.On("SaveJob", context.Background(), mock.Anything).Return(nil)
+	repo.On("RetrieveClientIDs", context.Background(), []string{validID}).Return(nil, nil)
+	chsvc.On("RetrieveEntity", context.Background(), mock.Anything).Return(entity(session.DomainID), nil)
+	gsvc.On("RetrieveEntity", context.Background(), &grpcCommonV1.RetrieveEntityReq{Id: groupID}).Return(entity(session.DomainID), nil)
+	repo.On("UpdateJob", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		if job := args.Get(1).(bulk.Job); job.Status == bulk.CompletedStatus {
+			done <- job
+		}
+	})
+	csvc.On("CreateClients", mock.Anything, session, mock.Anything).Return([]clients.Client{}, nil, nil)
+	csvc.On("SetParentGroup", mock.Anything, session, groupID, validID).Return(nil)
+	var topics [][]string
+	chsvc.On("Connect", mock.Anything, mock.MatchedBy(func(req *grpcChannelsV1.ConnectReq) bool {
+		return req.DomainId == session.DomainID && len(req.ChannelIds) == 1
+	})).Return(&grpcChannelsV1.ConnectRes{}, nil).Run(func(args mock.Arguments) {
+		topics = append(topics, args.Get(1).(*grpcChannelsV1.ConnectReq).Topics)
+	})
+
+	job, err := svc.Import(context.Background(), session, bulk.CSV, []byte(data), false)
+	assert.Nil(t, err, fmt.Sprintf("import clients: unexpected error %s", err))
+	assert.Equal(t, bulk.PendingStatus, job.Status, fmt.Sprintf("import clients: expected pending job got %s", job.Status))
+	assert.Equal(t, uint64(3), job.Total, fmt.Sprintf("import clients: expected 3 total got %d", job.Total))
+
+	select {
+	case job = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("import clients: job is not completed")
+	}
+	assert.Equal(t, uint64(3), job.Processed, fmt.Sprintf("import clients: expected 3 processed got %d", job.Processed))
+	assert.Equal(t, uint64(2), job.Imported, fmt.Sprintf("import clients: expected 2 imported got %d", job.Imported))
+	assert.Equal(t, uint64(1), job.Failed, fmt.Sprintf("import clients: expected 1 failed got %d", job.Failed))
+	csvc.AssertNumberOfCalls(t, "CreateClients", 1)
+	chsvc.AssertNumberOfCalls(t, "Connect", 3)
+	assert.Equal(t, [][]string{{"commands/#", "state"}, nil, nil}, topics, fmt.Sprintf("import clients: unexpected connection topics %v", topics))
+}
+
+func TestImportInterrupted(t *testing.T) {
+	svc := newService(t)
+
+	stopped := make(chan struct{})
+	worker := bulk.NewWorker(repo, time.Hour, mglog.NewMock())
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		_ = worker.Run(ctx)
+		close(stopped)
+	}()
+	cancel()
+	<-stopped
+	svc = bulk.NewService(csvc, chsvc, gsvc, repo, worker, uuid.NewMock(), mglog.NewMock())
+
+	repo.On("SaveJob", context.Background(), mock.Anything).Return(nil)
+	repo.On("RetrieveClientIDs", context.Background(), mock.Anything).Return(nil, nil)
+	repo.On("UpdateJob", mock.Anything, mock.Anything).Return(nil)
+
+	job, err := svc.Import(context.Background(), session, bulk.CSV, []byte("name\nsensor\n"), false)
+	assert.Nil(t, err, fmt.Sprintf("import clients with stopped worker: unexpected error %s", err))
+	assert.Equal(t, bulk.InterruptedStatus, job.Status, fmt.Sprintf("import clients with stopped worker: expected interrupted job got %s", job.Status))
+	repo.AssertCalled(t, "InterruptJobs", mock.Anything, mock.Anything)
+	csvc.AssertNotCalled(t, "CreateClients", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestViewJob(t *testing.T) {
+	svc := newService(t)
+
+	job := bulk.Job{
+		ID:        testsutil.GenerateUUID(t),
+		DomainID:  session.DomainID,
+		CreatedBy: validID,
+		Status:    bulk.CompletedStatus,
+	}
+
+	cases := []struct {
+		desc     string
+		session  smqauthn.Session
+		id       string
+		repoResp bulk.Job
+		repoErr  error
+		resp     bulk.Job
+		err      error
+	}{
+		{
+			desc:     "view job successfully",
+			session:  session,
+			id:       job.ID,
+			repoResp: job,
+			resp:     job,
+		},
+		{
+			desc:     "view job as super admin",
+			session:  smqauthn.Session{UserID: testsutil.GenerateUUID(t), DomainID: session.DomainID, SuperAdmin: true},
+			id:       job.ID,
+			repoResp: job,
+			resp:     job,
+		},
+		{
+			desc:     "view job of another user",
+			session:  smqauthn.Session{UserID: testsutil.GenerateUUID(t), DomainID: session.DomainID},
+			id:       job.ID,
+			repoResp: job,
+			err:      svcerr.ErrAuthorization,
+		},
+		{
+			desc:    "view non-existing job",
+			session: session,
+			id:      testsutil.GenerateUUID(t),
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("RetrieveJob", context.Background(), tc.session.DomainID, tc.id).Return(tc.repoResp, tc.repoErr)
+			resp, err := svc.ViewJob(context.Background(), tc.session, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	svc := newService(t)
+
+	client := clients.Client{
+		ID:          validID,
+		Name:        "sensor",
+		Tags:        []string{"a", "b"},
+		Metadata:    clients.Metadata{"k": "v"},
+		ParentGroup: groupID,
+	}
+	conns := []clients.Connection{
+		{ClientID: validID, ChannelID: channelID, Type: connections.Publish},
+		{ClientID: validID, ChannelID: channelID, Type: connections.Subscribe},
+		{ClientID: validID, ChannelID: groupID, Type: connections.Publish},
+		{ClientID: validID, ChannelID: groupID, Type: connections.Subscribe, Topics: []string{"commands/#", "state"}},
+	}
+
+	cases := []struct {
+		desc     string
+		format   bulk.Format
+		page     clients.ClientsPage
+		listErr  error
+		conns    []clients.Connection
+		connsErr error
+		resp     string
+		err      error
+	}{
+		{
+			desc:   "export clients as CSV",
+			format: bulk.CSV,
+			page:   clients.ClientsPage{Page: clients.Page{Total: 1}, Clients: []clients.Client{client}},
+			conns:  conns,
+			resp: "id,name,secret,tags,metadata,group_id,connections\n" +
+				validID + `,sensor,,a;b,"{""k"":""v""}",` + groupID + "," + channelID + ";" + groupID + ":publish;" + groupID + ":subscribe:commands/#|state\n",
+		},
+		{
+			desc:   "export clients as NDJSON",
+			format: bulk.NDJSON,
+			page:   clients.ClientsPage{Page: clients.Page{Total: 1}, Clients: []clients.Client{client}},
+			conns:  conns,
+			resp: `{"id":"` + validID + `","name":"sensor","tags":["a","b"],"metadata":{"k":"v"},"group_id":"` + groupID +
+				`","connections":[{"channel_id":"` + channelID + `"},{"channel_id":"` + groupID + `","types":["Publish"]},` +
+				`{"channel_id":"` + groupID + `","types":["Subscribe"],"topics":["commands/#","state"]}]}` + "\n",
+		},
+		{
+			desc:   "export empty clients as CSV",
+			format: bulk.CSV,
+			page:   clients.ClientsPage{},
+			resp:   "id,name,secret,tags,metadata,group_id,connections\n",
+		},
+		{
+			desc:    "export clients with failed list",
+			format:  bulk.NDJSON,
+			listErr: svcerr.ErrAuthorization,
+			err:     svcerr.ErrAuthorization,
+		},
+		{
+			desc:     "export clients with failed connections retrieval",
+			format:   bulk.NDJSON,
+			page:     clients.ClientsPage{Page: clients.Page{Total: 1}, Clients: []clients.Client{client}},
+			connsErr: repoerr.ErrViewEntity,
+			err:      svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			listCall := csvc.On("ListClients", context.Background(), session, mock.Anything).Return(tc.page, tc.listErr)
+			repoCall := repo.On("RetrieveConnections", context.Background(), []string{validID}).Return(tc.conns, tc.connsErr)
+			var buf bytes.Buffer
+			err := svc.Export(context.Background(), session, tc.format, &buf)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.resp, buf.String(), fmt.Sprintf("%s: unexpected export", tc.desc))
+			}
+			listCall.Unset()
+			repoCall.Unset()
+		})
+	}
+}
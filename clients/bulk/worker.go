@@ -0,0 +1,85 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Worker runs the import jobs in the background. Once the context of Run is
+// canceled, the jobs stop after their current batch and are marked as
+// interrupted.
+type Worker struct {
+	repo    Repository
+	timeout time.Duration
+	logger  *slog.Logger
+	tasks   chan func(stop context.Context)
+	done    chan struct{}
+}
+
+// NewWorker returns a new import jobs worker. The jobs which are not updated
+// for longer than the timeout are left by a stopped service, and are marked
+// as interrupted.
+func NewWorker(repo Repository, timeout time.Duration, logger *slog.Logger) *Worker {
+	return &Worker{
+		repo:    repo,
+		timeout: timeout,
+		logger:  logger,
+		tasks:   make(chan func(stop context.Context)),
+		done:    make(chan struct{}),
+	}
+}
+
+// Run runs the import jobs until the context is canceled, and returns once
+// the running jobs are stopped. The jobs left by a stopped service are
+// marked as interrupted on start and periodically after it.
+func (w *Worker) Run(ctx context.Context) error {
+	defer close(w.done)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	w.interrupt(ctx)
+	ticker := time.NewTicker(w.timeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.interrupt(ctx)
+		case task := <-w.tasks:
+			wg.Go(func() {
+				task(ctx)
+			})
+		}
+	}
+}
+
+// submit hands the task to the worker, and reports whether the worker
+// accepted it before it was stopped.
+func (w *Worker) submit(task func(stop context.Context)) bool {
+	select {
+	case w.tasks <- task:
+		return true
+	case <-w.done:
+		return false
+	}
+}
+
+func (w *Worker) interrupt(ctx context.Context) {
+	count, err := w.repo.InterruptJobs(ctx, time.Now().UTC().Add(-w.timeout))
+	if err != nil {
+		w.logger.Warn(fmt.Sprintf("Failed to interrupt stale import jobs: %s", err))
+		return
+	}
+	if count > 0 {
+		w.logger.Info(fmt.Sprintf("Interrupted %d stale import jobs", count))
+	}
+}
@@ -16,7 +16,11 @@ import (
 
 const (
 	keyPrefix = "client_key"
-	idPrefix  = "client_id"
+	// idPrefix is the prefix of the single client key saved by the
+	// previous versions of the cache. It is removed together with the
+	// client keys until it expires.
+	idPrefix   = "client_id"
+	keysPrefix = "client_keys"
 )
 
 var _ clients.Cache = (*clientCache)(nil)
@@ -43,8 +47,13 @@ func (tc *clientCache) Save(ctx context.Context, clientKey, clientID string) err
 		return errors.Wrap(repoerr.ErrCreateEntity, err)
 	}
 
-	tid := fmt.Sprintf("%s:%s", idPrefix, clientID)
-	if err := tc.client.Set(ctx, tid, clientKey, tc.keyDuration).Err(); err != nil {
+	// A client may have more than one cached key while its previous secret is
+	// valid, so all the keys of the client are kept in a set.
+	tkeys := fmt.Sprintf("%s:%s", keysPrefix, clientID)
+	if err := tc.client.SAdd(ctx, tkeys, clientKey).Err(); err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	if err := tc.client.Expire(ctx, tkeys, tc.keyDuration).Err(); err != nil {
 		return errors.Wrap(repoerr.ErrCreateEntity, err)
 	}
 
@@ -66,18 +75,28 @@ func (tc *clientCache) ID(ctx context.Context, clientKey string) (string, error)
 }
 
 func (tc *clientCache) Remove(ctx context.Context, clientID string) error {
+	tkeys := fmt.Sprintf("%s:%s", keysPrefix, clientID)
+	keys, err := tc.client.SMembers(ctx, tkeys).Result()
+	if err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+
 	tid := fmt.Sprintf("%s:%s", idPrefix, clientID)
 	key, err := tc.client.Get(ctx, tid).Result()
+	switch {
 	// Redis returns Nil Reply when key does not exist.
-	if err == redis.Nil {
-		return nil
-	}
-	if err != nil {
+	case err == redis.Nil:
+	case err != nil:
 		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	default:
+		keys = append(keys, key)
 	}
 
-	tkey := fmt.Sprintf("%s:%s", keyPrefix, key)
-	if err := tc.client.Del(ctx, tkey, tid).Err(); err != nil {
+	dels := []string{tkeys, tid}
+	for _, key := range keys {
+		dels = append(dels, fmt.Sprintf("%s:%s", keyPrefix, key))
+	}
+	if err := tc.client.Del(ctx, dels...).Err(); err != nil {
 		return errors.Wrap(repoerr.ErrRemoveEntity, err)
 	}
 
@@ -177,3 +177,22 @@ func TestRemove(t *testing.T) {
 		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 	}
 }
+
+func TestRemoveAllKeys(t *testing.T) {
+	redisClient.FlushAll(context.Background())
+	tscache := cache.NewCache(redisClient, 1*time.Minute)
+	ctx := context.Background()
+
+	err := tscache.Save(ctx, testKey, testID)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error while trying to save: %s", err))
+	err = tscache.Save(ctx, testKey2, testID)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error while trying to save: %s", err))
+
+	err = tscache.Remove(ctx, testID)
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error while trying to remove: %s", err))
+
+	for _, key := range []string{testKey, testKey2} {
+		_, err := tscache.ID(ctx, key)
+		assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("expected %s for key %s got %s\n", repoerr.ErrNotFound, key, err))
+	}
+}
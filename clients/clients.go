@@ -19,6 +19,8 @@ type Connection struct {
 	ChannelID string
 	DomainID  string
 	Type      connections.ConnType
+	// Topics are the subtopic patterns the connection is restricted to.
+	Topics []string
 }
 
 type ClientRepository struct {
@@ -58,6 +60,14 @@ type Repository interface {
 	// UpdateSecret updates secret for client with given identity.
 	UpdateSecret(ctx context.Context, client Client) (Client, error)
 
+	// RotateSecret replaces the client secret and keeps the replaced secret
+	// as the previous secret until it expires.
+	RotateSecret(ctx context.Context, client Client) (Client, error)
+
+	// DropExpiredSecrets removes the expired previous secrets and returns
+	// the clients whose previous secrets are removed.
+	DropExpiredSecrets(ctx context.Context) ([]Client, error)
+
 	// ChangeStatus changes client status to enabled or disabled
 	ChangeStatus(ctx context.Context, client Client) (Client, error)
 
@@ -70,7 +80,8 @@ type Repository interface {
 
 	// RetrieveBySecret retrieves a client based on the secret (key) and domainID.
 	// Domain ID is required because the key is not globally unique,
-	// but unique on the level of Domain.
+	// but unique on the level of Domain. The previous secret of the client is
+	// accepted until it expires.
 	RetrieveBySecret(ctx context.Context, key, id string, prefix authn.AuthPrefix) (Client, error)
 
 	AddConnections(ctx context.Context, conns []Connection) error
@@ -95,6 +106,31 @@ type Repository interface {
 
 	UnsetParentGroupFromClient(ctx context.Context, parentGroupID string) error
 
+	// AddSession records the open MQTT session of the client. The closed
+	// sessions of the client are removed, since they describe the offline
+	// periods which the new session ends.
+	AddSession(ctx context.Context, session Session) error
+
+	// CloseSession records the disconnect of the MQTT session of the client.
+	CloseSession(ctx context.Context, session Session) error
+
+	// RefreshSession updates the time the MQTT session of the client was
+	// last seen. If the session ID is empty, all the open sessions of the
+	// client are refreshed.
+	RefreshSession(ctx context.Context, session Session) error
+
+	// ExpireSessions closes the open MQTT sessions last seen before the
+	// given time, and returns the number of the closed sessions.
+	ExpireSessions(ctx context.Context, before time.Time) (uint64, error)
+
+	// RetrievePresence retrieves the presence of the client from its MQTT
+	// sessions.
+	RetrievePresence(ctx context.Context, clientID string) (Presence, error)
+
+	// ReportOffline marks the latest sessions of the clients which are
+	// offline since before the given time as reported and returns them.
+	ReportOffline(ctx context.Context, before time.Time) ([]Session, error)
+
 	roles.Repository
 }
 
@@ -105,7 +141,8 @@ type Service interface {
 	// non-nil error value is returned.
 	CreateClients(ctx context.Context, session authn.Session, client ...Client) ([]Client, []roles.RoleProvision, error)
 
-	// View retrieves client info for a given client ID and an authorized token.
+	// View retrieves client info for a given client ID and an authorized token,
+	// together with the presence of the client.
 	View(ctx context.Context, session authn.Session, id string, withRoles bool) (Client, error)
 
 	// ListClients retrieves clients list for given page query.
@@ -123,6 +160,15 @@ type Service interface {
 	// UpdateSecret updates the client's secret
 	UpdateSecret(ctx context.Context, session authn.Session, id, key string) (Client, error)
 
+	// RotateSecret replaces the client's secret. The replaced secret is
+	// accepted for the overlap period, so that the client can be updated
+	// without being disconnected.
+	RotateSecret(ctx context.Context, session authn.Session, id, key string, overlap time.Duration) (Client, error)
+
+	// DropExpiredSecrets removes the previous secrets of the clients once
+	// their overlap period has passed.
+	DropExpiredSecrets(ctx context.Context) ([]Client, error)
+
 	// Enable logically enableds the client identified with the provided ID
 	Enable(ctx context.Context, session authn.Session, id string) (Client, error)
 
@@ -151,6 +197,40 @@ type Cache interface {
 	Remove(ctx context.Context, clientID string) error
 }
 
+// Session represents an open MQTT session of a client. A client with an
+// open session is online.
+type Session struct {
+	// ID is the subscriber ID of the session.
+	ID          string
+	ClientID    string
+	DomainID    string
+	ConnectedAt time.Time
+	// LastSeen is the time of the latest session event. The session missing
+	// its disconnect event is closed once it is not seen for too long.
+	LastSeen         time.Time
+	DisconnectedAt   time.Time
+	DisconnectReason string
+}
+
+// SessionExpiredReason is the disconnect reason of the sessions which are
+// closed because they were not seen for too long.
+const SessionExpiredReason = "session_expired"
+
+// Presence describes the MQTT connectivity of a client, derived from its
+// latest session.
+type Presence struct {
+	Online bool `json:"online"`
+	// Connections is the number of open MQTT sessions of the client.
+	Connections      uint64    `json:"connections"`
+	ConnectedAt      time.Time `json:"connected_at,omitzero"`
+	LastSeen         time.Time `json:"last_seen,omitzero"`
+	DisconnectedAt   time.Time `json:"disconnected_at,omitzero"`
+	DisconnectReason string    `json:"disconnect_reason,omitempty"`
+	// ConnectionDuration is the duration of the latest session in seconds,
+	// up to now while the session is open.
+	ConnectionDuration uint64 `json:"connection_duration"`
+}
+
 // Client Struct represents a client.
 
 type Client struct {
@@ -180,6 +260,7 @@ type Client struct {
 	ConnectionTypes           []connections.ConnType    `json:"connection_types,omitempty"`
 	MemberId                  string                    `json:"member_id,omitempty"`
 	Roles                     []roles.MemberRoleActions `json:"roles,omitempty"`
+	Presence                  *Presence                 `json:"presence,omitempty"`
 }
 
 // ClientsPage contains page related metadata as well as list.
@@ -253,6 +334,8 @@ type Page struct {
 	IDs            []string  `json:"-"`
 	CreatedFrom    time.Time `json:"created_from,omitempty"`
 	CreatedTo      time.Time `json:"created_to,omitempty"`
+	// Online filters the clients by whether they have an open MQTT session.
+	Online *bool `json:"online,omitempty"`
 }
 
 // Metadata represents arbitrary JSON.
@@ -264,4 +347,8 @@ type Metadata map[string]any
 type Credentials struct {
 	Identity string `json:"identity,omitempty"` // username or generated login ID
 	Secret   string `json:"secret,omitempty"`   // password or token
+	// PreviousSecret is the secret replaced by the last rotation, which is
+	// accepted until PreviousSecretExpiresAt.
+	PreviousSecret          string    `json:"previous_secret,omitempty"`
+	PreviousSecretExpiresAt time.Time `json:"previous_secret_expires_at,omitzero"`
 }
@@ -18,6 +18,8 @@ const (
 	clientUpdate       = clientPrefix + "update"
 	clientUpdateTags   = clientPrefix + "update_tags"
 	clientUpdateSecret = clientPrefix + "update_secret"
+	clientRotateSecret = clientPrefix + "rotate_secret"
+	clientDropSecret   = clientPrefix + "drop_secret"
 	clientEnable       = clientPrefix + "enable"
 	clientDisable      = clientPrefix + "disable"
 	clientRemove       = clientPrefix + "remove"
@@ -26,6 +28,7 @@ const (
 	clientListByUser   = clientPrefix + "list_by_user"
 	clientSetParent    = clientPrefix + "set_parent"
 	clientRemoveParent = clientPrefix + "remove_parent"
+	clientOffline      = clientPrefix + "offline"
 )
 
 var (
@@ -38,6 +41,8 @@ var (
 	_ events.Event = (*removeClientEvent)(nil)
 	_ events.Event = (*setParentGroupEvent)(nil)
 	_ events.Event = (*removeParentGroupEvent)(nil)
+	_ events.Event = (*dropSecretEvent)(nil)
+	_ events.Event = (*offlineClientEvent)(nil)
 )
 
 type createClientEvent struct {
@@ -122,6 +127,9 @@ func (uce updateClientEvent) Encode() (map[string]any, error) {
 	if uce.Status.String() != "" {
 		val["status"] = uce.Status.String()
 	}
+	if !uce.Credentials.PreviousSecretExpiresAt.IsZero() {
+		val["previous_secret_expires_at"] = uce.Credentials.PreviousSecretExpiresAt
+	}
 
 	return val, nil
 }
@@ -349,3 +357,37 @@ func (rpge removeParentGroupEvent) Encode() (map[string]any, error) {
 		"request_id":  rpge.requestID,
 	}, nil
 }
+
+type dropSecretEvent struct {
+	id        string
+	domainID  string
+	expiredAt time.Time
+}
+
+func (dse dropSecretEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation":                  clientDropSecret,
+		"id":                         dse.id,
+		"domain":                     dse.domainID,
+		"previous_secret_expires_at": dse.expiredAt,
+	}, nil
+}
+
+type offlineClientEvent struct {
+	clients.Session
+}
+
+func (oce offlineClientEvent) Encode() (map[string]any, error) {
+	val := map[string]any{
+		"operation":       clientOffline,
+		"id":              oce.ClientID,
+		"domain":          oce.DomainID,
+		"connected_at":    oce.ConnectedAt,
+		"disconnected_at": oce.DisconnectedAt,
+	}
+	if oce.DisconnectReason != "" {
+		val["disconnect_reason"] = oce.DisconnectReason
+	}
+
+	return val, nil
+}
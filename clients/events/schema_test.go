@@ -0,0 +1,147 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/pkg/roles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSchemas(t *testing.T) {
+	now := time.Now().UTC()
+	session := authn.Session{
+		Type:     authn.AccessToken,
+		UserID:   testsutil.GenerateUUID(t),
+		DomainID: testsutil.GenerateUUID(t),
+	}
+	client := clients.Client{
+		ID:              testsutil.GenerateUUID(t),
+		Name:            "client",
+		Tags:            []string{"tag"},
+		Domain:          session.DomainID,
+		Credentials:     clients.Credentials{Identity: "identity", PreviousSecretExpiresAt: now},
+		Metadata:        clients.Metadata{"key": "value"},
+		PrivateMetadata: clients.Metadata{"key": "value"},
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		UpdatedBy:       session.UserID,
+		Status:          clients.EnabledStatus,
+	}
+	page := clients.Page{
+		Total:    1,
+		Limit:    10,
+		Name:     "client",
+		Order:    "name",
+		Dir:      "asc",
+		Metadata: clients.Metadata{"key": "value"},
+		Tags:     clients.TagsQuery{Elements: []string{"tag"}},
+		IDs:      []string{client.ID},
+		Identity: "identity",
+	}
+	requestID := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc  string
+		event events.Event
+	}{
+		{
+			desc: "create client",
+			event: createClientEvent{
+				Client:           client,
+				rolesProvisioned: []roles.RoleProvision{{Role: roles.Role{ID: testsutil.GenerateUUID(t), Name: "admin"}, OptionalActions: []string{"read"}}},
+				Session:          session,
+				requestID:        requestID,
+			},
+		},
+		{
+			desc:  "create client with minimal fields",
+			event: createClientEvent{Client: clients.Client{ID: client.ID, CreatedAt: now}, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update client",
+			event: updateClientEvent{Client: client, operation: clientUpdate, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update client tags",
+			event: updateClientEvent{Client: client, operation: clientUpdateTags, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update client secret",
+			event: updateClientEvent{Client: client, operation: clientUpdateSecret, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "rotate client secret",
+			event: updateClientEvent{Client: client, operation: clientRotateSecret, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "drop client secret",
+			event: dropSecretEvent{id: client.ID, domainID: client.Domain, expiredAt: now},
+		},
+		{
+			desc:  "enable client",
+			event: changeClientStatusEvent{id: client.ID, operation: clientEnable, status: clients.EnabledStatus.String(), updatedAt: now, updatedBy: session.UserID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "disable client",
+			event: changeClientStatusEvent{id: client.ID, operation: clientDisable, status: clients.DisabledStatus.String(), updatedAt: now, updatedBy: session.UserID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "view client",
+			event: viewClientEvent{Client: client, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "list clients",
+			event: listClientEvent{Page: page, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "list user clients",
+			event: listUserClientEvent{userID: session.UserID, Page: page, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "remove client",
+			event: removeClientEvent{id: client.ID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "set parent group",
+			event: setParentGroupEvent{id: client.ID, parentGroupID: testsutil.GenerateUUID(t), Session: session, requestID: requestID},
+		},
+		{
+			desc:  "remove parent group",
+			event: removeParentGroupEvent{id: client.ID, Session: session, requestID: requestID},
+		},
+	}
+
+	covered := make(map[string]bool)
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, s, err := schema.ValidateEvent(tc.event)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			covered[s.Operation] = true
+		})
+	}
+
+	// Offline clients are reported by the journal, role events are published
+	// by the roles manager and user clients are listed with the list operation.
+	skipped := []string{clientPrefix + "offline", clientListByUser}
+	for _, s := range schema.Schemas() {
+		op := s.Operation
+		if !strings.HasPrefix(op, clientPrefix) || slices.Contains(skipped, op) ||
+			strings.HasPrefix(op, clientPrefix+"role") || strings.HasPrefix(op, clientPrefix+"members") {
+			continue
+		}
+		assert.True(t, covered[op], fmt.Sprintf("expected emitted event for schema %s", op))
+	}
+}
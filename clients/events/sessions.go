@@ -0,0 +1,207 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/pkg/events/store"
+)
+
+const (
+	sessionsStream = "events.magistrala.mqtt.*"
+	publishStream  = "events.magistrala.publish"
+
+	mqttConnect      = "mqtt.client_connect"
+	mqttDisconnect   = "mqtt.client_disconnect"
+	mqttSubscribe    = "mqtt.client_subscribe"
+	messagingPublish = "messaging.client_publish"
+)
+
+var (
+	errConnectEvent    = errors.New("failed to consume client connect event")
+	errDisconnectEvent = errors.New("failed to consume client disconnect event")
+	errSubscribeEvent  = errors.New("failed to consume client subscribe event")
+	errPublishEvent    = errors.New("failed to consume client publish event")
+	errPublishOffline  = errors.New("failed to publish client offline event")
+)
+
+type sessionsHandler struct {
+	repo clients.Repository
+}
+
+// SessionsSubscribe subscribes to the MQTT sessions events of the broker,
+// which keep track of the online clients, and to the publish events of the
+// messaging adapters, which refresh the sessions of the publishing clients.
+func SessionsSubscribe(ctx context.Context, repo clients.Repository, esURL, esConsumerName string, logger *slog.Logger) error {
+	subscriber, err := store.NewSubscriber(ctx, esURL, "clients-sessions-es-sub", logger)
+	if err != nil {
+		return err
+	}
+
+	for _, stream := range []string{sessionsStream, publishStream} {
+		subConfig := events.SubscriberConfig{
+			Stream:   stream,
+			Consumer: esConsumerName,
+			Handler:  NewSessionsHandler(repo),
+			Ordered:  true,
+		}
+		if err := subscriber.Subscribe(ctx, subConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewSessionsHandler returns an event handler which records the MQTT
+// sessions of the clients.
+func NewSessionsHandler(repo clients.Repository) events.EventHandler {
+	return &sessionsHandler{repo: repo}
+}
+
+func (sh *sessionsHandler) Handle(ctx context.Context, event events.Event) error {
+	msg, err := event.Encode()
+	if err != nil {
+		return err
+	}
+
+	switch msg[schema.OperationKey] {
+	case mqttConnect:
+		session, err := decodeSession(msg)
+		if err != nil {
+			return errors.Wrap(errConnectEvent, err)
+		}
+		if err := sh.repo.AddSession(ctx, session); err != nil {
+			return errors.Wrap(errConnectEvent, err)
+		}
+	case mqttDisconnect:
+		session, err := decodeSession(msg)
+		if err != nil {
+			return errors.Wrap(errDisconnectEvent, err)
+		}
+		if err := sh.repo.CloseSession(ctx, session); err != nil {
+			return errors.Wrap(errDisconnectEvent, err)
+		}
+	case mqttSubscribe:
+		session, err := decodeSession(msg)
+		if err != nil {
+			return errors.Wrap(errSubscribeEvent, err)
+		}
+		if err := sh.repo.RefreshSession(ctx, session); err != nil {
+			return errors.Wrap(errSubscribeEvent, err)
+		}
+	case messagingPublish:
+		// The publish events do not carry the subscriber ID, so all the
+		// open sessions of the client are refreshed.
+		session, err := decodeSession(msg)
+		if err != nil {
+			return errors.Wrap(errPublishEvent, err)
+		}
+		if err := sh.repo.RefreshSession(ctx, session); err != nil {
+			return errors.Wrap(errPublishEvent, err)
+		}
+	}
+
+	return nil
+}
+
+func decodeSession(msg map[string]any) (clients.Session, error) {
+	record, err := schema.Decode(msg)
+	if err != nil {
+		return clients.Session{}, err
+	}
+
+	session := clients.Session{
+		ID:       record.String("subscriber_id"),
+		ClientID: record.String("client_id"),
+		LastSeen: record.OccurredAt.UTC(),
+	}
+	switch record.Operation {
+	case mqttConnect:
+		session.ConnectedAt = record.OccurredAt.UTC()
+	case mqttDisconnect:
+		session.DisconnectedAt = record.OccurredAt.UTC()
+		session.DisconnectReason = record.String("reason")
+	}
+
+	return session, nil
+}
+
+// ExpireSessions periodically closes the sessions which are not seen for
+// longer than the TTL until the context is canceled. The broker does not
+// publish the disconnect event if it stops before the session is closed, so
+// such a client would be online forever.
+func ExpireSessions(ctx context.Context, repo clients.Repository, ttl, interval time.Duration, logger *slog.Logger) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			expired, err := repo.ExpireSessions(ctx, time.Now().UTC().Add(-ttl))
+			if err != nil {
+				logger.Warn("failed to expire client sessions", slog.Any("error", err))
+				continue
+			}
+			if expired > 0 {
+				logger.Info("expired client sessions", slog.Uint64("count", expired))
+			}
+		}
+	}
+}
+
+// ReportOfflineClients publishes an event for each client which has been
+// offline for longer than offlineAfter, and returns the number of reported
+// clients. Each offline period is reported once. If the publisher is
+// transactional, the clients are marked as reported only together with
+// their events.
+func ReportOfflineClients(ctx context.Context, repo clients.Repository, publisher events.Publisher, offlineAfter time.Duration) (uint64, error) {
+	var count uint64
+	err := events.Atomic(ctx, publisher, func(ctx context.Context) error {
+		sessions, err := repo.ReportOffline(ctx, time.Now().UTC().Add(-offlineAfter))
+		if err != nil {
+			return err
+		}
+		for _, s := range sessions {
+			if err := publisher.Publish(ctx, offlineStream, offlineClientEvent{s}); err != nil {
+				return errors.Wrap(errPublishOffline, err)
+			}
+		}
+		count = uint64(len(sessions))
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ReportOffline periodically reports the offline clients until the context
+// is canceled.
+func ReportOffline(ctx context.Context, repo clients.Repository, publisher events.Publisher, offlineAfter, interval time.Duration, logger *slog.Logger) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := ReportOfflineClients(ctx, repo, publisher, offlineAfter); err != nil {
+				logger.Warn("failed to report offline clients", slog.Any("error", err))
+			}
+		}
+	}
+}
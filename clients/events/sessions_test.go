@@ -0,0 +1,218 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/clients/events"
+	"github.com/absmach/magistrala/clients/mocks"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	evmocks "github.com/absmach/magistrala/pkg/events/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type sessionEvent map[string]any
+
+func (se sessionEvent) Encode() (map[string]any, error) {
+	return se, nil
+}
+
+func TestSessionsHandler(t *testing.T) {
+	repo := new(mocks.Repository)
+	handler := events.NewSessionsHandler(repo)
+
+	occurredAt := time.Now().UTC().Truncate(time.Second)
+	subscriberID := testsutil.GenerateUUID(t)
+	clientID := testsutil.GenerateUUID(t)
+	event := func(operation string) sessionEvent {
+		return sessionEvent{
+			"operation":     operation,
+			"occurred_at":   float64(occurredAt.UnixNano()),
+			"subscriber_id": subscriberID,
+			"client_id":     clientID,
+			"domain_id":     testsutil.GenerateUUID(t),
+			"channel_id":    testsutil.GenerateUUID(t),
+			"subtopic":      "",
+		}
+	}
+	disconnect := event("mqtt.client_disconnect")
+	disconnect["reason"] = "keep_alive_timeout"
+	publish := event("messaging.client_publish")
+	delete(publish, "subscriber_id")
+
+	connected := clients.Session{ID: subscriberID, ClientID: clientID, ConnectedAt: occurredAt, LastSeen: occurredAt}
+	disconnected := clients.Session{ID: subscriberID, ClientID: clientID, LastSeen: occurredAt, DisconnectedAt: occurredAt, DisconnectReason: "keep_alive_timeout"}
+	seen := clients.Session{ID: subscriberID, ClientID: clientID, LastSeen: occurredAt}
+
+	cases := []struct {
+		desc    string
+		event   sessionEvent
+		method  string
+		session clients.Session
+		repoErr error
+		err     error
+	}{
+		{
+			desc:    "handle connect event successfully",
+			event:   event("mqtt.client_connect"),
+			method:  "AddSession",
+			session: connected,
+		},
+		{
+			desc:    "handle connect event with repo error",
+			event:   event("mqtt.client_connect"),
+			method:  "AddSession",
+			session: connected,
+			repoErr: repoerr.ErrCreateEntity,
+			err:     repoerr.ErrCreateEntity,
+		},
+		{
+			desc:    "handle disconnect event successfully",
+			event:   disconnect,
+			method:  "CloseSession",
+			session: disconnected,
+		},
+		{
+			desc:    "handle disconnect event with repo error",
+			event:   disconnect,
+			method:  "CloseSession",
+			session: disconnected,
+			repoErr: repoerr.ErrUpdateEntity,
+			err:     repoerr.ErrUpdateEntity,
+		},
+		{
+			desc: "handle connect event without client",
+			event: sessionEvent{
+				"operation":     "mqtt.client_connect",
+				"occurred_at":   float64(occurredAt.UnixNano()),
+				"subscriber_id": subscriberID,
+			},
+			err: errors.New("failed to consume client connect event"),
+		},
+		{
+			desc:    "handle subscribe event successfully",
+			event:   event("mqtt.client_subscribe"),
+			method:  "RefreshSession",
+			session: seen,
+		},
+		{
+			desc:    "handle subscribe event with repo error",
+			event:   event("mqtt.client_subscribe"),
+			method:  "RefreshSession",
+			session: seen,
+			repoErr: repoerr.ErrUpdateEntity,
+			err:     repoerr.ErrUpdateEntity,
+		},
+		{
+			desc: "handle subscribe event without channel",
+			event: sessionEvent{
+				"operation":     "mqtt.client_subscribe",
+				"occurred_at":   float64(occurredAt.UnixNano()),
+				"subscriber_id": subscriberID,
+				"client_id":     clientID,
+			},
+			err: errors.New("failed to consume client subscribe event"),
+		},
+		{
+			desc:    "handle publish event successfully",
+			event:   publish,
+			method:  "RefreshSession",
+			session: clients.Session{ClientID: clientID, LastSeen: occurredAt},
+		},
+		{
+			desc:    "handle publish event with repo error",
+			event:   publish,
+			method:  "RefreshSession",
+			session: clients.Session{ClientID: clientID, LastSeen: occurredAt},
+			repoErr: repoerr.ErrUpdateEntity,
+			err:     repoerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var repoCall *mock.Call
+			if tc.method != "" {
+				repoCall = repo.On(tc.method, context.Background(), tc.session).Return(tc.repoErr)
+			}
+			err := handler.Handle(context.Background(), tc.event)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if repoCall != nil {
+				repo.AssertCalled(t, tc.method, context.Background(), tc.session)
+				repoCall.Unset()
+			}
+			repo.AssertExpectations(t)
+			repo.Calls = nil
+		})
+	}
+}
+
+func TestReportOfflineClients(t *testing.T) {
+	repo := new(mocks.Repository)
+	publisher := new(evmocks.Publisher)
+
+	sessions := []clients.Session{
+		{
+			ID:             testsutil.GenerateUUID(t),
+			ClientID:       testsutil.GenerateUUID(t),
+			DomainID:       testsutil.GenerateUUID(t),
+			ConnectedAt:    time.Now().UTC().Add(-time.Hour),
+			DisconnectedAt: time.Now().UTC().Add(-10 * time.Minute),
+		},
+	}
+
+	cases := []struct {
+		desc       string
+		sessions   []clients.Session
+		reportErr  error
+		publishErr error
+		count      uint64
+		err        error
+	}{
+		{
+			desc:     "report offline clients successfully",
+			sessions: sessions,
+			count:    1,
+		},
+		{
+			desc:  "report without offline clients",
+			count: 0,
+		},
+		{
+			desc:      "report offline clients with repo error",
+			reportErr: repoerr.ErrUpdateEntity,
+			err:       repoerr.ErrUpdateEntity,
+		},
+		{
+			desc:       "report offline clients with publish error",
+			sessions:   sessions,
+			publishErr: errors.New("failed to publish"),
+			err:        errors.New("failed to publish client offline event"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("ReportOffline", mock.Anything, mock.Anything).Return(tc.sessions, tc.reportErr)
+			pubCall := publisher.On("Publish", mock.Anything, "magistrala.client.offline", mock.Anything).Return(tc.publishErr)
+			count, err := events.ReportOfflineClients(context.Background(), repo, publisher, 5*time.Minute)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.count, count, fmt.Sprintf("%s: expected count %d got %d\n", tc.desc, tc.count, count))
+			if tc.reportErr == nil {
+				publisher.AssertNumberOfCalls(t, "Publish", len(tc.sessions))
+			}
+			repoCall.Unset()
+			pubCall.Unset()
+			publisher.Calls = nil
+		})
+	}
+}
@@ -5,11 +5,11 @@ package events
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/magistrala/clients"
 	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/events"
-	"github.com/absmach/magistrala/pkg/events/store"
 	"github.com/absmach/magistrala/pkg/roles"
 	rmEvents "github.com/absmach/magistrala/pkg/roles/rolemanager/events"
 	"github.com/go-chi/chi/v5/middleware"
@@ -21,6 +21,8 @@ const (
 	updateStream       = magistralaPrefix + clientUpdate
 	updateTagsStream   = magistralaPrefix + clientUpdateTags
 	updateSecretStream = magistralaPrefix + clientUpdateSecret
+	rotateSecretStream = magistralaPrefix + clientRotateSecret
+	dropSecretStream   = magistralaPrefix + clientDropSecret
 	enableStream       = magistralaPrefix + clientEnable
 	disableStream      = magistralaPrefix + clientDisable
 	removeStream       = magistralaPrefix + clientRemove
@@ -29,6 +31,7 @@ const (
 	listByUserStream   = magistralaPrefix + clientListByUser
 	setParentStream    = magistralaPrefix + clientSetParent
 	removeParentStream = magistralaPrefix + clientRemoveParent
+	offlineStream      = magistralaPrefix + clientOffline
 )
 
 var _ clients.Service = (*eventStore)(nil)
@@ -40,70 +43,116 @@ type eventStore struct {
 }
 
 // NewEventStoreMiddleware returns wrapper around clients service that sends
-// events to event store.
-func NewEventStoreMiddleware(ctx context.Context, svc clients.Service, url string) (clients.Service, error) {
-	publisher, err := store.NewPublisher(ctx, url, "clients-es-pub")
-	if err != nil {
-		return nil, err
-	}
+// events to the publisher. If the publisher is transactional, such as the
+// PostgreSQL outbox, the events of each change are stored in the same
+// transaction as the change itself.
+func NewEventStoreMiddleware(svc clients.Service, publisher events.Publisher) clients.Service {
 	res := rmEvents.NewRoleManagerEventStore("clients", clientPrefix, svc, publisher)
 
 	return &eventStore{
 		svc:                   svc,
 		Publisher:             publisher,
 		RoleManagerEventStore: res,
-	}, nil
+	}
 }
 
-func (es *eventStore) CreateClients(ctx context.Context, session authn.Session, clients ...clients.Client) ([]clients.Client, []roles.RoleProvision, error) {
-	clis, rps, err := es.svc.CreateClients(ctx, session, clients...)
-	if err != nil {
-		return clis, rps, err
-	}
+func (es *eventStore) CreateClients(ctx context.Context, session authn.Session, clients ...clients.Client) (clis []clients.Client, rps []roles.RoleProvision, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		clis, rps, err = es.svc.CreateClients(ctx, session, clients...)
+		if err != nil {
+			return err
+		}
 
-	for _, cli := range clis {
-		event := createClientEvent{
-			Client:           cli,
-			rolesProvisioned: rps,
-			Session:          session,
-			requestID:        middleware.GetReqID(ctx),
+		for _, cli := range clis {
+			event := createClientEvent{
+				Client:           cli,
+				rolesProvisioned: rps,
+				Session:          session,
+				requestID:        middleware.GetReqID(ctx),
+			}
+			if err := es.Publish(ctx, createStream, event); err != nil {
+				return err
+			}
 		}
-		if err := es.Publish(ctx, createStream, event); err != nil {
-			return clis, rps, err
+
+		return nil
+	})
+
+	return clis, rps, err
+}
+
+func (es *eventStore) Update(ctx context.Context, session authn.Session, client clients.Client) (cli clients.Client, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if cli, err = es.svc.Update(ctx, session, client); err != nil {
+			return err
 		}
-	}
 
-	return clis, rps, nil
+		return es.update(ctx, session, clientUpdate, updateStream, cli)
+	})
+
+	return cli, err
 }
 
-func (es *eventStore) Update(ctx context.Context, session authn.Session, client clients.Client) (clients.Client, error) {
-	cli, err := es.svc.Update(ctx, session, client)
-	if err != nil {
-		return cli, err
-	}
+func (es *eventStore) UpdateTags(ctx context.Context, session authn.Session, client clients.Client) (cli clients.Client, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if cli, err = es.svc.UpdateTags(ctx, session, client); err != nil {
+			return err
+		}
 
-	return es.update(ctx, session, clientUpdate, updateStream, cli)
+		return es.update(ctx, session, clientUpdateTags, updateTagsStream, cli)
+	})
+
+	return cli, err
 }
 
-func (es *eventStore) UpdateTags(ctx context.Context, session authn.Session, client clients.Client) (clients.Client, error) {
-	cli, err := es.svc.UpdateTags(ctx, session, client)
-	if err != nil {
-		return cli, err
-	}
+func (es *eventStore) UpdateSecret(ctx context.Context, session authn.Session, id, key string) (cli clients.Client, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if cli, err = es.svc.UpdateSecret(ctx, session, id, key); err != nil {
+			return err
+		}
 
-	return es.update(ctx, session, clientUpdateTags, updateTagsStream, cli)
+		return es.update(ctx, session, clientUpdateSecret, updateSecretStream, cli)
+	})
+
+	return cli, err
 }
 
-func (es *eventStore) UpdateSecret(ctx context.Context, session authn.Session, id, key string) (clients.Client, error) {
-	cli, err := es.svc.UpdateSecret(ctx, session, id, key)
-	if err != nil {
-		return cli, err
-	}
+func (es *eventStore) RotateSecret(ctx context.Context, session authn.Session, id, key string, overlap time.Duration) (cli clients.Client, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if cli, err = es.svc.RotateSecret(ctx, session, id, key, overlap); err != nil {
+			return err
+		}
+
+		return es.update(ctx, session, clientRotateSecret, rotateSecretStream, cli)
+	})
 
-	return es.update(ctx, session, clientUpdateSecret, updateSecretStream, cli)
+	return cli, err
 }
 
-func (es *eventStore) update(ctx context.Context, session authn.Session, operation, stream string, client clients.Client) (clients.Client, error) {
+func (es *eventStore) DropExpiredSecrets(ctx context.Context) (clis []clients.Client, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if clis, err = es.svc.DropExpiredSecrets(ctx); err != nil {
+			return err
+		}
+
+		for _, cli := range clis {
+			event := dropSecretEvent{
+				id:        cli.ID,
+				domainID:  cli.Domain,
+				expiredAt: cli.Credentials.PreviousSecretExpiresAt,
+			}
+			if err := es.Publish(ctx, dropSecretStream, event); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return clis, err
+}
+
+func (es *eventStore) update(ctx context.Context, session authn.Session, operation, stream string, client clients.Client) error {
 	event := updateClientEvent{
 		Client:    client,
 		operation: operation,
@@ -111,11 +160,7 @@ func (es *eventStore) update(ctx context.Context, session authn.Session, operati
 		requestID: middleware.GetReqID(ctx),
 	}
 
-	if err := es.Publish(ctx, stream, event); err != nil {
-		return client, err
-	}
-
-	return client, nil
+	return es.Publish(ctx, stream, event)
 }
 
 func (es *eventStore) View(ctx context.Context, session authn.Session, id string, withRoles bool) (clients.Client, error) {
@@ -171,25 +216,31 @@ func (es *eventStore) ListUserClients(ctx context.Context, session authn.Session
 	return cp, nil
 }
 
-func (es *eventStore) Enable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
-	cli, err := es.svc.Enable(ctx, session, id)
-	if err != nil {
-		return cli, err
-	}
+func (es *eventStore) Enable(ctx context.Context, session authn.Session, id string) (cli clients.Client, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if cli, err = es.svc.Enable(ctx, session, id); err != nil {
+			return err
+		}
+
+		return es.changeStatus(ctx, session, clientEnable, enableStream, cli)
+	})
 
-	return es.changeStatus(ctx, session, clientEnable, enableStream, cli)
+	return cli, err
 }
 
-func (es *eventStore) Disable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
-	cli, err := es.svc.Disable(ctx, session, id)
-	if err != nil {
-		return cli, err
-	}
+func (es *eventStore) Disable(ctx context.Context, session authn.Session, id string) (cli clients.Client, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if cli, err = es.svc.Disable(ctx, session, id); err != nil {
+			return err
+		}
 
-	return es.changeStatus(ctx, session, clientDisable, disableStream, cli)
+		return es.changeStatus(ctx, session, clientDisable, disableStream, cli)
+	})
+
+	return cli, err
 }
 
-func (es *eventStore) changeStatus(ctx context.Context, session authn.Session, operation, stream string, cli clients.Client) (clients.Client, error) {
+func (es *eventStore) changeStatus(ctx context.Context, session authn.Session, operation, stream string, cli clients.Client) error {
 	event := changeClientStatusEvent{
 		id:        cli.ID,
 		operation: operation,
@@ -199,64 +250,55 @@ func (es *eventStore) changeStatus(ctx context.Context, session authn.Session, o
 		Session:   session,
 		requestID: middleware.GetReqID(ctx),
 	}
-	if err := es.Publish(ctx, stream, event); err != nil {
-		return cli, err
-	}
 
-	return cli, nil
+	return es.Publish(ctx, stream, event)
 }
 
 func (es *eventStore) Delete(ctx context.Context, session authn.Session, id string) error {
-	if err := es.svc.Delete(ctx, session, id); err != nil {
-		return err
-	}
-
-	event := removeClientEvent{
-		id:        id,
-		Session:   session,
-		requestID: middleware.GetReqID(ctx),
-	}
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.Delete(ctx, session, id); err != nil {
+			return err
+		}
 
-	if err := es.Publish(ctx, removeStream, event); err != nil {
-		return err
-	}
+		event := removeClientEvent{
+			id:        id,
+			Session:   session,
+			requestID: middleware.GetReqID(ctx),
+		}
 
-	return nil
+		return es.Publish(ctx, removeStream, event)
+	})
 }
 
 func (es *eventStore) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) (err error) {
-	if err := es.svc.SetParentGroup(ctx, session, parentGroupID, id); err != nil {
-		return err
-	}
-
-	event := setParentGroupEvent{
-		parentGroupID: parentGroupID,
-		id:            id,
-		Session:       session,
-		requestID:     middleware.GetReqID(ctx),
-	}
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.SetParentGroup(ctx, session, parentGroupID, id); err != nil {
+			return err
+		}
 
-	if err := es.Publish(ctx, setParentStream, event); err != nil {
-		return err
-	}
+		event := setParentGroupEvent{
+			parentGroupID: parentGroupID,
+			id:            id,
+			Session:       session,
+			requestID:     middleware.GetReqID(ctx),
+		}
 
-	return nil
+		return es.Publish(ctx, setParentStream, event)
+	})
 }
 
 func (es *eventStore) RemoveParentGroup(ctx context.Context, session authn.Session, id string) (err error) {
-	if err := es.svc.RemoveParentGroup(ctx, session, id); err != nil {
-		return err
-	}
-
-	event := removeParentGroupEvent{
-		id:        id,
-		Session:   session,
-		requestID: middleware.GetReqID(ctx),
-	}
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.RemoveParentGroup(ctx, session, id); err != nil {
+			return err
+		}
 
-	if err := es.Publish(ctx, removeParentStream, event); err != nil {
-		return err
-	}
+		event := removeParentGroupEvent{
+			id:        id,
+			Session:   session,
+			requestID: middleware.GetReqID(ctx),
+		}
 
-	return nil
+		return es.Publish(ctx, removeParentStream, event)
+	})
 }
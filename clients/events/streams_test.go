@@ -17,6 +17,7 @@ import (
 	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/errors"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/events/store"
 	"github.com/absmach/magistrala/pkg/roles"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/redis/go-redis/v9"
@@ -44,8 +45,9 @@ var (
 
 func newEventStoreMiddleware(t *testing.T) (*mocks.Service, clients.Service) {
 	svc := new(mocks.Service)
-	nsvc, err := events.NewEventStoreMiddleware(context.Background(), svc, storeURL)
-	require.Nil(t, err, fmt.Sprintf("create events store middleware failed with unexpected error: %s", err))
+	publisher, err := store.NewPublisher(context.Background(), storeURL, "clients-es-pub")
+	require.Nil(t, err, fmt.Sprintf("create events store publisher failed with unexpected error: %s", err))
+	nsvc := events.NewEventStoreMiddleware(svc, publisher)
 
 	return svc, nsvc
 }
@@ -303,6 +305,113 @@ func TestUpdateSecret(t *testing.T) {
 	}
 }
 
+func TestRotateSecret(t *testing.T) {
+	svc, nsvc := newEventStoreMiddleware(t)
+
+	validCtx := context.WithValue(context.Background(), middleware.RequestIDKey, testsutil.GenerateUUID(t))
+
+	rotatedClient := validClient
+	rotatedClient.Credentials.Secret = "newSecret"
+	rotatedClient.Credentials.PreviousSecretExpiresAt = time.Now().Add(time.Hour).UTC()
+
+	cases := []struct {
+		desc      string
+		session   authn.Session
+		clientID  string
+		newSecret string
+		overlap   time.Duration
+		svcRes    clients.Client
+		svcErr    error
+		resp      clients.Client
+		err       error
+	}{
+		{
+			desc:      "publish successfully",
+			session:   validSession,
+			clientID:  validClient.ID,
+			newSecret: "newSecret",
+			overlap:   time.Hour,
+			svcRes:    rotatedClient,
+			svcErr:    nil,
+			resp:      rotatedClient,
+			err:       nil,
+		},
+		{
+			desc:      "failed to publish with service error",
+			session:   validSession,
+			clientID:  validClient.ID,
+			newSecret: "newSecret",
+			overlap:   time.Hour,
+			svcRes:    clients.Client{},
+			svcErr:    svcerr.ErrUpdateEntity,
+			resp:      clients.Client{},
+			err:       svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("RotateSecret", validCtx, tc.session, tc.clientID, tc.newSecret, tc.overlap).Return(tc.svcRes, tc.svcErr)
+			resp, err := nsvc.RotateSecret(validCtx, tc.session, tc.clientID, tc.newSecret, tc.overlap)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			svcCall.Unset()
+		})
+	}
+}
+
+func TestDropExpiredSecrets(t *testing.T) {
+	svc, nsvc := newEventStoreMiddleware(t)
+
+	droppedClient := clients.Client{
+		ID:     validClient.ID,
+		Domain: validClient.Domain,
+		Credentials: clients.Credentials{
+			PreviousSecretExpiresAt: time.Now().Add(-time.Minute).UTC(),
+		},
+	}
+
+	cases := []struct {
+		desc   string
+		svcRes []clients.Client
+		svcErr error
+		resp   []clients.Client
+		err    error
+	}{
+		{
+			desc:   "publish successfully",
+			svcRes: []clients.Client{droppedClient},
+			svcErr: nil,
+			resp:   []clients.Client{droppedClient},
+			err:    nil,
+		},
+		{
+			desc:   "publish successfully without dropped secrets",
+			svcRes: []clients.Client{},
+			svcErr: nil,
+			resp:   []clients.Client{},
+			err:    nil,
+		},
+		{
+			desc:   "failed to publish with service error",
+			svcRes: nil,
+			svcErr: svcerr.ErrUpdateEntity,
+			resp:   nil,
+			err:    svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("DropExpiredSecrets", context.Background()).Return(tc.svcRes, tc.svcErr)
+			resp, err := nsvc.DropExpiredSecrets(context.Background())
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.resp, resp, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, resp))
+			svcCall.Unset()
+		})
+	}
+}
+
 func TestEnable(t *testing.T) {
 	svc, nsvc := newEventStoreMiddleware(t)
 
@@ -5,6 +5,7 @@ package middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/magistrala/auth"
 	"github.com/absmach/magistrala/clients"
@@ -158,6 +159,24 @@ func (am *authorizationMiddleware) UpdateSecret(ctx context.Context, session aut
 	return am.svc.UpdateSecret(ctx, session, id, key)
 }
 
+func (am *authorizationMiddleware) RotateSecret(ctx context.Context, session authn.Session, id, key string, overlap time.Duration) (clients.Client, error) {
+	if err := am.authorize(ctx, session, policies.ClientType, operations.OpUpdateClientSecret, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		Subject:     session.DomainUserID,
+		ObjectType:  policies.ClientType,
+		Object:      id,
+	}); err != nil {
+		return clients.Client{}, errors.Wrap(err, errUpdateSecret)
+	}
+
+	return am.svc.RotateSecret(ctx, session, id, key, overlap)
+}
+
+func (am *authorizationMiddleware) DropExpiredSecrets(ctx context.Context) ([]clients.Client, error) {
+	return am.svc.DropExpiredSecrets(ctx)
+}
+
 func (am *authorizationMiddleware) Enable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
 	if err := am.authorize(ctx, session, policies.ClientType, operations.OpEnableClient, smqauthz.PolicyReq{
 		Domain:      session.DomainID,
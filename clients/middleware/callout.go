@@ -132,6 +132,23 @@ func (cm *calloutMiddleware) UpdateSecret(ctx context.Context, session authn.Ses
 	return cm.svc.UpdateSecret(ctx, session, id, key)
 }
 
+func (cm *calloutMiddleware) RotateSecret(ctx context.Context, session authn.Session, id, key string, overlap time.Duration) (clients.Client, error) {
+	params := map[string]any{
+		"entity_id": id,
+		"overlap":   overlap.String(),
+	}
+
+	if err := cm.callOut(ctx, session, policies.ClientType, operations.OpUpdateClientSecret, params); err != nil {
+		return clients.Client{}, err
+	}
+
+	return cm.svc.RotateSecret(ctx, session, id, key, overlap)
+}
+
+func (cm *calloutMiddleware) DropExpiredSecrets(ctx context.Context) ([]clients.Client, error) {
+	return cm.svc.DropExpiredSecrets(ctx)
+}
+
 func (cm *calloutMiddleware) Enable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
 	params := map[string]any{
 		"entity_id": id,
@@ -182,6 +182,46 @@ func (lm *loggingMiddleware) UpdateSecret(ctx context.Context, session authn.Ses
 	return lm.svc.UpdateSecret(ctx, session, oldSecret, newSecret)
 }
 
+func (lm *loggingMiddleware) RotateSecret(ctx context.Context, session authn.Session, id, key string, overlap time.Duration) (c clients.Client, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("client",
+				slog.String("id", id),
+				slog.String("name", c.Name),
+			),
+			slog.String("overlap", overlap.String()),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Rotate client secret failed", args...)
+			return
+		}
+		lm.logger.Info("Rotate client secret completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RotateSecret(ctx, session, id, key, overlap)
+}
+
+func (lm *loggingMiddleware) DropExpiredSecrets(ctx context.Context) (cs []clients.Client, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Int("clients", len(cs)),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Drop expired client secrets failed", args...)
+			return
+		}
+		if len(cs) > 0 {
+			lm.logger.Info("Drop expired client secrets completed successfully", args...)
+		}
+	}(time.Now())
+	return lm.svc.DropExpiredSecrets(ctx)
+}
+
 func (lm *loggingMiddleware) Enable(ctx context.Context, session authn.Session, id string) (c clients.Client, err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -89,6 +89,22 @@ func (ms *metricsMiddleware) UpdateSecret(ctx context.Context, session authn.Ses
 	return ms.svc.UpdateSecret(ctx, session, oldSecret, newSecret)
 }
 
+func (ms *metricsMiddleware) RotateSecret(ctx context.Context, session authn.Session, id, key string, overlap time.Duration) (clients.Client, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "rotate_client_secret").Add(1)
+		ms.latency.With("method", "rotate_client_secret").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.RotateSecret(ctx, session, id, key, overlap)
+}
+
+func (ms *metricsMiddleware) DropExpiredSecrets(ctx context.Context) ([]clients.Client, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "drop_expired_client_secrets").Add(1)
+		ms.latency.With("method", "drop_expired_client_secrets").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	return ms.svc.DropExpiredSecrets(ctx)
+}
+
 func (ms *metricsMiddleware) Enable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "enable_client").Add(1)
@@ -5,6 +5,7 @@ package middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/magistrala/clients"
 	"github.com/absmach/magistrala/pkg/authn"
@@ -87,6 +88,20 @@ func (tm *tracingMiddleware) UpdateSecret(ctx context.Context, session authn.Ses
 	return tm.svc.UpdateSecret(ctx, session, oldSecret, newSecret)
 }
 
+func (tm *tracingMiddleware) RotateSecret(ctx context.Context, session authn.Session, id, key string, overlap time.Duration) (clients.Client, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_rotate_client_secret", trace.WithAttributes(attribute.String("id", id), attribute.String("overlap", overlap.String())))
+	defer span.End()
+
+	return tm.svc.RotateSecret(ctx, session, id, key, overlap)
+}
+
+func (tm *tracingMiddleware) DropExpiredSecrets(ctx context.Context) ([]clients.Client, error) {
+	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_drop_expired_client_secrets")
+	defer span.End()
+
+	return tm.svc.DropExpiredSecrets(ctx)
+}
+
 // Enable traces the "Enable" operation of the wrapped clients.Service.
 func (tm *tracingMiddleware) Enable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
 	ctx, span := tracing.StartSpan(ctx, tm.tracer, "svc_enable_client", trace.WithAttributes(attribute.String("id", id)))
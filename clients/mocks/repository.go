@@ -10,6 +10,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/magistrala/clients"
 	"github.com/absmach/magistrala/pkg/authn"
@@ -169,6 +170,63 @@ func (_c *Repository_AddRoles_Call) RunAndReturn(run func(ctx context.Context, r
 	return _c
 }
 
+// AddSession provides a mock function for the type Repository
+func (_mock *Repository) AddSession(ctx context.Context, session clients.Session) error {
+	ret := _mock.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, clients.Session) error); ok {
+		r0 = returnFunc(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_AddSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSession'
+type Repository_AddSession_Call struct {
+	*mock.Call
+}
+
+// AddSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session clients.Session
+func (_e *Repository_Expecter) AddSession(ctx interface{}, session interface{}) *Repository_AddSession_Call {
+	return &Repository_AddSession_Call{Call: _e.mock.On("AddSession", ctx, session)}
+}
+
+func (_c *Repository_AddSession_Call) Run(run func(ctx context.Context, session clients.Session)) *Repository_AddSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 clients.Session
+		if args[1] != nil {
+			arg1 = args[1].(clients.Session)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_AddSession_Call) Return(err error) *Repository_AddSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_AddSession_Call) RunAndReturn(run func(ctx context.Context, session clients.Session) error) *Repository_AddSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ChangeStatus provides a mock function for the type Repository
 func (_mock *Repository) ChangeStatus(ctx context.Context, client clients.Client) (clients.Client, error) {
 	ret := _mock.Called(ctx, client)
@@ -301,6 +359,63 @@ func (_c *Repository_ClientConnectionsCount_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// CloseSession provides a mock function for the type Repository
+func (_mock *Repository) CloseSession(ctx context.Context, session clients.Session) error {
+	ret := _mock.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, clients.Session) error); ok {
+		r0 = returnFunc(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_CloseSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseSession'
+type Repository_CloseSession_Call struct {
+	*mock.Call
+}
+
+// CloseSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session clients.Session
+func (_e *Repository_Expecter) CloseSession(ctx interface{}, session interface{}) *Repository_CloseSession_Call {
+	return &Repository_CloseSession_Call{Call: _e.mock.On("CloseSession", ctx, session)}
+}
+
+func (_c *Repository_CloseSession_Call) Run(run func(ctx context.Context, session clients.Session)) *Repository_CloseSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 clients.Session
+		if args[1] != nil {
+			arg1 = args[1].(clients.Session)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_CloseSession_Call) Return(err error) *Repository_CloseSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_CloseSession_Call) RunAndReturn(run func(ctx context.Context, session clients.Session) error) *Repository_CloseSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type Repository
 func (_mock *Repository) Delete(ctx context.Context, clientIDs ...string) error {
 	var tmpRet mock.Arguments
@@ -433,6 +548,134 @@ func (_c *Repository_DoesClientHaveConnections_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// DropExpiredSecrets provides a mock function for the type Repository
+func (_mock *Repository) DropExpiredSecrets(ctx context.Context) ([]clients.Client, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropExpiredSecrets")
+	}
+
+	var r0 []clients.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]clients.Client, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []clients.Client); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clients.Client)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_DropExpiredSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropExpiredSecrets'
+type Repository_DropExpiredSecrets_Call struct {
+	*mock.Call
+}
+
+// DropExpiredSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Repository_Expecter) DropExpiredSecrets(ctx interface{}) *Repository_DropExpiredSecrets_Call {
+	return &Repository_DropExpiredSecrets_Call{Call: _e.mock.On("DropExpiredSecrets", ctx)}
+}
+
+func (_c *Repository_DropExpiredSecrets_Call) Run(run func(ctx context.Context)) *Repository_DropExpiredSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_DropExpiredSecrets_Call) Return(clients1 []clients.Client, err error) *Repository_DropExpiredSecrets_Call {
+	_c.Call.Return(clients1, err)
+	return _c
+}
+
+func (_c *Repository_DropExpiredSecrets_Call) RunAndReturn(run func(ctx context.Context) ([]clients.Client, error)) *Repository_DropExpiredSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireSessions provides a mock function for the type Repository
+func (_mock *Repository) ExpireSessions(ctx context.Context, before time.Time) (uint64, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireSessions")
+	}
+
+	var r0 uint64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (uint64, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) uint64); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ExpireSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireSessions'
+type Repository_ExpireSessions_Call struct {
+	*mock.Call
+}
+
+// ExpireSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *Repository_Expecter) ExpireSessions(ctx interface{}, before interface{}) *Repository_ExpireSessions_Call {
+	return &Repository_ExpireSessions_Call{Call: _e.mock.On("ExpireSessions", ctx, before)}
+}
+
+func (_c *Repository_ExpireSessions_Call) Run(run func(ctx context.Context, before time.Time)) *Repository_ExpireSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ExpireSessions_Call) Return(v uint64, err error) *Repository_ExpireSessions_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *Repository_ExpireSessions_Call) RunAndReturn(run func(ctx context.Context, before time.Time) (uint64, error)) *Repository_ExpireSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListEntityMembers provides a mock function for the type Repository
 func (_mock *Repository) ListEntityMembers(ctx context.Context, entityID string, pageQuery roles.MembersRolePageQuery) (roles.MembersRolePage, error) {
 	ret := _mock.Called(ctx, entityID, pageQuery)
@@ -505,6 +748,63 @@ func (_c *Repository_ListEntityMembers_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// RefreshSession provides a mock function for the type Repository
+func (_mock *Repository) RefreshSession(ctx context.Context, session clients.Session) error {
+	ret := _mock.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, clients.Session) error); ok {
+		r0 = returnFunc(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RefreshSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshSession'
+type Repository_RefreshSession_Call struct {
+	*mock.Call
+}
+
+// RefreshSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session clients.Session
+func (_e *Repository_Expecter) RefreshSession(ctx interface{}, session interface{}) *Repository_RefreshSession_Call {
+	return &Repository_RefreshSession_Call{Call: _e.mock.On("RefreshSession", ctx, session)}
+}
+
+func (_c *Repository_RefreshSession_Call) Run(run func(ctx context.Context, session clients.Session)) *Repository_RefreshSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 clients.Session
+		if args[1] != nil {
+			arg1 = args[1].(clients.Session)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RefreshSession_Call) Return(err error) *Repository_RefreshSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RefreshSession_Call) RunAndReturn(run func(ctx context.Context, session clients.Session) error) *Repository_RefreshSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveChannelConnections provides a mock function for the type Repository
 func (_mock *Repository) RemoveChannelConnections(ctx context.Context, channelID string) error {
 	ret := _mock.Called(ctx, channelID)
@@ -910,6 +1210,74 @@ func (_c *Repository_RemoveRoles_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// ReportOffline provides a mock function for the type Repository
+func (_mock *Repository) ReportOffline(ctx context.Context, before time.Time) ([]clients.Session, error) {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportOffline")
+	}
+
+	var r0 []clients.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]clients.Session, error)); ok {
+		return returnFunc(ctx, before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []clients.Session); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clients.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ReportOffline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportOffline'
+type Repository_ReportOffline_Call struct {
+	*mock.Call
+}
+
+// ReportOffline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *Repository_Expecter) ReportOffline(ctx interface{}, before interface{}) *Repository_ReportOffline_Call {
+	return &Repository_ReportOffline_Call{Call: _e.mock.On("ReportOffline", ctx, before)}
+}
+
+func (_c *Repository_ReportOffline_Call) Run(run func(ctx context.Context, before time.Time)) *Repository_ReportOffline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ReportOffline_Call) Return(sessions []clients.Session, err error) *Repository_ReportOffline_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *Repository_ReportOffline_Call) RunAndReturn(run func(ctx context.Context, before time.Time) ([]clients.Session, error)) *Repository_ReportOffline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveAll provides a mock function for the type Repository
 func (_mock *Repository) RetrieveAll(ctx context.Context, pm clients.Page) (clients.ClientsPage, error) {
 	ret := _mock.Called(ctx, pm)
@@ -1552,6 +1920,72 @@ func (_c *Repository_RetrieveParentGroupClients_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// RetrievePresence provides a mock function for the type Repository
+func (_mock *Repository) RetrievePresence(ctx context.Context, clientID string) (clients.Presence, error) {
+	ret := _mock.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrievePresence")
+	}
+
+	var r0 clients.Presence
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (clients.Presence, error)); ok {
+		return returnFunc(ctx, clientID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) clients.Presence); ok {
+		r0 = returnFunc(ctx, clientID)
+	} else {
+		r0 = ret.Get(0).(clients.Presence)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RetrievePresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrievePresence'
+type Repository_RetrievePresence_Call struct {
+	*mock.Call
+}
+
+// RetrievePresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+func (_e *Repository_Expecter) RetrievePresence(ctx interface{}, clientID interface{}) *Repository_RetrievePresence_Call {
+	return &Repository_RetrievePresence_Call{Call: _e.mock.On("RetrievePresence", ctx, clientID)}
+}
+
+func (_c *Repository_RetrievePresence_Call) Run(run func(ctx context.Context, clientID string)) *Repository_RetrievePresence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RetrievePresence_Call) Return(presence clients.Presence, err error) *Repository_RetrievePresence_Call {
+	_c.Call.Return(presence, err)
+	return _c
+}
+
+func (_c *Repository_RetrievePresence_Call) RunAndReturn(run func(ctx context.Context, clientID string) (clients.Presence, error)) *Repository_RetrievePresence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveRole provides a mock function for the type Repository
 func (_mock *Repository) RetrieveRole(ctx context.Context, roleID string) (roles.Role, error) {
 	ret := _mock.Called(ctx, roleID)
@@ -2374,6 +2808,72 @@ func (_c *Repository_RoleRemoveMembers_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// RotateSecret provides a mock function for the type Repository
+func (_mock *Repository) RotateSecret(ctx context.Context, client clients.Client) (clients.Client, error) {
+	ret := _mock.Called(ctx, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSecret")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, clients.Client) (clients.Client, error)); ok {
+		return returnFunc(ctx, client)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, clients.Client) clients.Client); ok {
+		r0 = returnFunc(ctx, client)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, clients.Client) error); ok {
+		r1 = returnFunc(ctx, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RotateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSecret'
+type Repository_RotateSecret_Call struct {
+	*mock.Call
+}
+
+// RotateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - client clients.Client
+func (_e *Repository_Expecter) RotateSecret(ctx interface{}, client interface{}) *Repository_RotateSecret_Call {
+	return &Repository_RotateSecret_Call{Call: _e.mock.On("RotateSecret", ctx, client)}
+}
+
+func (_c *Repository_RotateSecret_Call) Run(run func(ctx context.Context, client clients.Client)) *Repository_RotateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 clients.Client
+		if args[1] != nil {
+			arg1 = args[1].(clients.Client)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RotateSecret_Call) Return(client1 clients.Client, err error) *Repository_RotateSecret_Call {
+	_c.Call.Return(client1, err)
+	return _c
+}
+
+func (_c *Repository_RotateSecret_Call) RunAndReturn(run func(ctx context.Context, client clients.Client) (clients.Client, error)) *Repository_RotateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function for the type Repository
 func (_mock *Repository) Save(ctx context.Context, client ...clients.Client) ([]clients.Client, error) {
 	var tmpRet mock.Arguments
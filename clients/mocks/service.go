@@ -10,6 +10,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/magistrala/clients"
 	"github.com/absmach/magistrala/pkg/authn"
@@ -360,6 +361,68 @@ func (_c *Service_Disable_Call) RunAndReturn(run func(ctx context.Context, sessi
 	return _c
 }
 
+// DropExpiredSecrets provides a mock function for the type Service
+func (_mock *Service) DropExpiredSecrets(ctx context.Context) ([]clients.Client, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropExpiredSecrets")
+	}
+
+	var r0 []clients.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]clients.Client, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []clients.Client); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clients.Client)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_DropExpiredSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropExpiredSecrets'
+type Service_DropExpiredSecrets_Call struct {
+	*mock.Call
+}
+
+// DropExpiredSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Service_Expecter) DropExpiredSecrets(ctx interface{}) *Service_DropExpiredSecrets_Call {
+	return &Service_DropExpiredSecrets_Call{Call: _e.mock.On("DropExpiredSecrets", ctx)}
+}
+
+func (_c *Service_DropExpiredSecrets_Call) Run(run func(ctx context.Context)) *Service_DropExpiredSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_DropExpiredSecrets_Call) Return(clients1 []clients.Client, err error) *Service_DropExpiredSecrets_Call {
+	_c.Call.Return(clients1, err)
+	return _c
+}
+
+func (_c *Service_DropExpiredSecrets_Call) RunAndReturn(run func(ctx context.Context) ([]clients.Client, error)) *Service_DropExpiredSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Enable provides a mock function for the type Service
 func (_mock *Service) Enable(ctx context.Context, session authn.Session, id string) (clients.Client, error) {
 	ret := _mock.Called(ctx, session, id)
@@ -1952,6 +2015,90 @@ func (_c *Service_RoleRemoveMembers_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// RotateSecret provides a mock function for the type Service
+func (_mock *Service) RotateSecret(ctx context.Context, session authn.Session, id string, key string, overlap time.Duration) (clients.Client, error) {
+	ret := _mock.Called(ctx, session, id, key, overlap)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSecret")
+	}
+
+	var r0 clients.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, time.Duration) (clients.Client, error)); ok {
+		return returnFunc(ctx, session, id, key, overlap)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, string, time.Duration) clients.Client); ok {
+		r0 = returnFunc(ctx, session, id, key, overlap)
+	} else {
+		r0 = ret.Get(0).(clients.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, string, time.Duration) error); ok {
+		r1 = returnFunc(ctx, session, id, key, overlap)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RotateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSecret'
+type Service_RotateSecret_Call struct {
+	*mock.Call
+}
+
+// RotateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+//   - key string
+//   - overlap time.Duration
+func (_e *Service_Expecter) RotateSecret(ctx interface{}, session interface{}, id interface{}, key interface{}, overlap interface{}) *Service_RotateSecret_Call {
+	return &Service_RotateSecret_Call{Call: _e.mock.On("RotateSecret", ctx, session, id, key, overlap)}
+}
+
+func (_c *Service_RotateSecret_Call) Run(run func(ctx context.Context, session authn.Session, id string, key string, overlap time.Duration)) *Service_RotateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 time.Duration
+		if args[4] != nil {
+			arg4 = args[4].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RotateSecret_Call) Return(client clients.Client, err error) *Service_RotateSecret_Call {
+	_c.Call.Return(client, err)
+	return _c
+}
+
+func (_c *Service_RotateSecret_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string, key string, overlap time.Duration) (clients.Client, error)) *Service_RotateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetParentGroup provides a mock function for the type Service
 func (_mock *Service) SetParentGroup(ctx context.Context, session authn.Session, parentGroupID string, id string) error {
 	ret := _mock.Called(ctx, session, parentGroupID, id)
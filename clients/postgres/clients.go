@@ -94,7 +94,7 @@ func (repo *clientRepo) Save(ctx context.Context, cls ...clients.Client) ([]clie
 func (repo *clientRepo) RetrieveBySecret(ctx context.Context, key, id string, prefix authn.AuthPrefix) (clients.Client, error) {
 	q := fmt.Sprintf(`SELECT id, name, tags, COALESCE(domain_id, '') AS domain_id,  COALESCE(parent_group_id, '') AS parent_group_id, identity, secret, metadata, private_metadata, created_at, updated_at, updated_by, status
         FROM clients
-        WHERE secret = :secret AND status = %d`, clients.EnabledStatus)
+        WHERE (secret = :secret OR (previous_secret = :secret AND previous_secret_expires_at > NOW())) AND status = %d`, clients.EnabledStatus)
 	switch prefix {
 	case authn.DomainAuth:
 		q += " AND domain_id = :domain_id"
@@ -103,6 +103,8 @@ func (repo *clientRepo) RetrieveBySecret(ctx context.Context, key, id string, pr
 	default:
 		return clients.Client{}, repoerr.ErrNotFound
 	}
+	// The current secret takes precedence over the previous secret of another client.
+	q += " ORDER BY secret = :secret DESC"
 
 	dbc := DBClient{
 		Secret: key,
@@ -174,13 +176,53 @@ func (repo *clientRepo) UpdateIdentity(ctx context.Context, client clients.Clien
 }
 
 func (repo *clientRepo) UpdateSecret(ctx context.Context, client clients.Client) (clients.Client, error) {
-	q := `UPDATE clients SET secret = :secret, updated_at = :updated_at, updated_by = :updated_by
+	q := `UPDATE clients SET secret = :secret, previous_secret = NULL, previous_secret_expires_at = NULL, updated_at = :updated_at, updated_by = :updated_by
         WHERE id = :id AND status = :status
         RETURNING id, name, tags, identity, metadata, private_metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by`
 	client.Status = clients.EnabledStatus
 	return repo.update(ctx, client, q)
 }
 
+func (repo *clientRepo) RotateSecret(ctx context.Context, client clients.Client) (clients.Client, error) {
+	q := `UPDATE clients SET previous_secret = secret, previous_secret_expires_at = :previous_secret_expires_at, secret = :secret, updated_at = :updated_at, updated_by = :updated_by
+        WHERE id = :id AND status = :status
+        RETURNING id, name, tags, identity, metadata, private_metadata, COALESCE(domain_id, '') AS domain_id, COALESCE(parent_group_id, '') AS parent_group_id, status, created_at, updated_at, updated_by, previous_secret_expires_at`
+	client.Status = clients.EnabledStatus
+	return repo.update(ctx, client, q)
+}
+
+func (repo *clientRepo) DropExpiredSecrets(ctx context.Context) ([]clients.Client, error) {
+	q := `UPDATE clients AS c SET previous_secret = NULL, previous_secret_expires_at = NULL
+        FROM (
+            SELECT id, previous_secret_expires_at FROM clients
+            WHERE previous_secret_expires_at <= NOW()
+            FOR UPDATE
+        ) AS e
+        WHERE c.id = e.id
+        RETURNING c.id, COALESCE(c.domain_id, '') AS domain_id, c.status, c.created_at, e.previous_secret_expires_at`
+
+	rows, err := repo.DB.NamedQueryContext(ctx, q, map[string]any{})
+	if err != nil {
+		return nil, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	defer rows.Close()
+
+	var items []clients.Client
+	for rows.Next() {
+		dbc := DBClient{}
+		if err := rows.StructScan(&dbc); err != nil {
+			return nil, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+		}
+		c, err := ToClient(dbc)
+		if err != nil {
+			return nil, errors.Wrap(repoerr.ErrFailedOpDB, err)
+		}
+		items = append(items, c)
+	}
+
+	return items, nil
+}
+
 func (repo *clientRepo) ChangeStatus(ctx context.Context, client clients.Client) (clients.Client, error) {
 	q := `UPDATE clients SET status = :status, updated_at = :updated_at, updated_by = :updated_by
 		WHERE id = :id
@@ -1015,6 +1057,9 @@ func (repo *clientRepo) update(ctx context.Context, client clients.Client, query
 
 		return ToClient(dbc)
 	}
+	if err := row.Err(); err != nil {
+		return clients.Client{}, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
 
 	return clients.Client{}, repoerr.ErrNotFound
 }
@@ -1044,6 +1089,8 @@ type DBClient struct {
 	Domain                    string           `db:"domain_id"`
 	ParentGroup               sql.NullString   `db:"parent_group_id,omitempty"`
 	Secret                    string           `db:"secret"`
+	PreviousSecret            sql.NullString   `db:"previous_secret,omitempty"`
+	PreviousSecretExpiresAt   sql.NullTime     `db:"previous_secret_expires_at,omitempty"`
 	Metadata                  []byte           `db:"metadata,omitempty"`
 	PrivateMetadata           []byte           `db:"private_metadata,omitempty"`
 	CreatedAt                 time.Time        `db:"created_at,omitempty"`
@@ -1094,21 +1141,27 @@ func ToDBClient(c clients.Client) (DBClient, error) {
 	if c.UpdatedAt != (time.Time{}) {
 		updatedAt = sql.NullTime{Time: c.UpdatedAt, Valid: true}
 	}
+	var previousSecretExpiresAt sql.NullTime
+	if !c.Credentials.PreviousSecretExpiresAt.IsZero() {
+		previousSecretExpiresAt = sql.NullTime{Time: c.Credentials.PreviousSecretExpiresAt, Valid: true}
+	}
 
 	return DBClient{
-		ID:              c.ID,
-		Name:            c.Name,
-		Tags:            tags,
-		Domain:          c.Domain,
-		ParentGroup:     toNullString(c.ParentGroup),
-		Identity:        c.Credentials.Identity,
-		Secret:          c.Credentials.Secret,
-		Metadata:        metadata,
-		PrivateMetadata: privateMetadata,
-		CreatedAt:       c.CreatedAt,
-		UpdatedAt:       updatedAt,
-		UpdatedBy:       updatedBy,
-		Status:          c.Status,
+		ID:                      c.ID,
+		Name:                    c.Name,
+		Tags:                    tags,
+		Domain:                  c.Domain,
+		ParentGroup:             toNullString(c.ParentGroup),
+		Identity:                c.Credentials.Identity,
+		Secret:                  c.Credentials.Secret,
+		PreviousSecret:          toNullString(c.Credentials.PreviousSecret),
+		PreviousSecretExpiresAt: previousSecretExpiresAt,
+		Metadata:                metadata,
+		PrivateMetadata:         privateMetadata,
+		CreatedAt:               c.CreatedAt,
+		UpdatedAt:               updatedAt,
+		UpdatedBy:               updatedBy,
+		Status:                  c.Status,
 	}, nil
 }
 
@@ -1140,6 +1193,11 @@ func ToClient(t DBClient) (clients.Client, error) {
 		updatedAt = t.UpdatedAt.Time.UTC()
 	}
 
+	var previousSecretExpiresAt time.Time
+	if t.PreviousSecretExpiresAt.Valid {
+		previousSecretExpiresAt = t.PreviousSecretExpiresAt.Time.UTC()
+	}
+
 	var connTypes []connections.ConnType
 	for _, ct := range t.ConnectionTypes {
 		connType, err := connections.NewType(uint(ct))
@@ -1163,8 +1221,10 @@ func ToClient(t DBClient) (clients.Client, error) {
 		Domain:      t.Domain,
 		ParentGroup: toString(t.ParentGroup),
 		Credentials: clients.Credentials{
-			Identity: t.Identity,
-			Secret:   t.Secret,
+			Identity:                t.Identity,
+			Secret:                  t.Secret,
+			PreviousSecret:          toString(t.PreviousSecret),
+			PreviousSecretExpiresAt: previousSecretExpiresAt,
 		},
 		Metadata:                  metadata,
 		PrivateMetadata:           privateMetadata,
@@ -1310,6 +1370,13 @@ func PageQuery(pm clients.Page) (string, error) {
 	if !pm.CreatedTo.IsZero() {
 		query = append(query, "c.created_at <= :created_to")
 	}
+	if pm.Online != nil {
+		online := "EXISTS (SELECT 1 FROM client_sessions cs WHERE cs.client_id = c.id AND cs.disconnected_at IS NULL)"
+		if !*pm.Online {
+			online = "NOT " + online
+		}
+		query = append(query, online)
+	}
 
 	var emq string
 	if len(query) > 0 {
@@ -1421,8 +1488,8 @@ func (repo *clientRepo) RetrieveByIds(ctx context.Context, ids []string) (client
 
 func (repo *clientRepo) AddConnections(ctx context.Context, conns []clients.Connection) error {
 	dbConns := toDBConnections(conns)
-	q := `INSERT INTO connections (channel_id, domain_id, client_id, type)
-			VALUES (:channel_id, :domain_id, :client_id, :type);`
+	q := `INSERT INTO connections (channel_id, domain_id, client_id, type, topics)
+			VALUES (:channel_id, :domain_id, :client_id, :type, :topics);`
 	if _, err := repo.DB.NamedExecContext(ctx, q, dbConns); err != nil {
 		return postgres.HandleError(repoerr.ErrCreateEntity, err)
 	}
@@ -1572,11 +1639,183 @@ func (repo *clientRepo) UnsetParentGroupFromClient(ctx context.Context, parentGr
 	return nil
 }
 
+func (repo *clientRepo) AddSession(ctx context.Context, session clients.Session) error {
+	// Sessions of unknown clients are skipped. A reused subscriber ID
+	// replaces the session it was used by before.
+	q := `WITH closed AS (
+			DELETE FROM client_sessions
+			WHERE client_id = :client_id AND id <> :id AND disconnected_at IS NOT NULL
+		)
+		INSERT INTO client_sessions (id, client_id, connected_at, last_seen)
+		SELECT :id, :client_id, :connected_at, :last_seen FROM clients WHERE id = :client_id
+		ON CONFLICT (id) DO UPDATE SET client_id = EXCLUDED.client_id, connected_at = EXCLUDED.connected_at, last_seen = EXCLUDED.last_seen,
+			disconnected_at = NULL, disconnect_reason = NULL, offline_reported = FALSE;`
+
+	if _, err := repo.DB.NamedExecContext(ctx, q, toDBSession(session)); err != nil {
+		return repo.eh.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *clientRepo) CloseSession(ctx context.Context, session clients.Session) error {
+	q := `UPDATE client_sessions SET disconnected_at = :disconnected_at, disconnect_reason = :disconnect_reason
+		WHERE id = :id AND client_id = :client_id AND disconnected_at IS NULL;`
+
+	if _, err := repo.DB.NamedExecContext(ctx, q, toDBSession(session)); err != nil {
+		return repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *clientRepo) RefreshSession(ctx context.Context, session clients.Session) error {
+	// The events may be consumed out of order, so the last seen time never
+	// goes back.
+	q := `UPDATE client_sessions SET last_seen = :last_seen
+		WHERE (id = :id OR :id = '') AND client_id = :client_id AND disconnected_at IS NULL AND last_seen < :last_seen;`
+
+	if _, err := repo.DB.NamedExecContext(ctx, q, toDBSession(session)); err != nil {
+		return repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *clientRepo) ExpireSessions(ctx context.Context, before time.Time) (uint64, error) {
+	q := `UPDATE client_sessions SET disconnected_at = last_seen, disconnect_reason = :disconnect_reason
+		WHERE disconnected_at IS NULL AND last_seen < :last_seen;`
+
+	dbs := dbSession{LastSeen: before, DisconnectReason: toNullString(clients.SessionExpiredReason)}
+	result, err := repo.DB.NamedExecContext(ctx, q, dbs)
+	if err != nil {
+		return 0, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	return uint64(rows), nil
+}
+
+func (repo *clientRepo) RetrievePresence(ctx context.Context, clientID string) (clients.Presence, error) {
+	// The latest open session describes the presence of an online client,
+	// and the latest closed one of an offline client.
+	q := `SELECT
+			(SELECT COUNT(*) FROM client_sessions WHERE client_id = :client_id AND disconnected_at IS NULL) AS connections,
+			s.connected_at, s.last_seen, s.disconnected_at, s.disconnect_reason
+		FROM client_sessions s
+		WHERE s.client_id = :client_id
+		ORDER BY (s.disconnected_at IS NULL) DESC, s.connected_at DESC
+		LIMIT 1;`
+
+	rows, err := repo.DB.NamedQueryContext(ctx, q, dbSession{ClientID: clientID})
+	if err != nil {
+		return clients.Presence{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return clients.Presence{}, nil
+	}
+
+	var dbp dbPresence
+	if err := rows.StructScan(&dbp); err != nil {
+		return clients.Presence{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return clients.Presence{
+		Online:           dbp.Connections > 0,
+		Connections:      dbp.Connections,
+		ConnectedAt:      dbp.ConnectedAt,
+		LastSeen:         dbp.LastSeen,
+		DisconnectedAt:   dbp.DisconnectedAt.Time,
+		DisconnectReason: dbp.DisconnectReason.String,
+	}, nil
+}
+
+func (repo *clientRepo) ReportOffline(ctx context.Context, before time.Time) ([]clients.Session, error) {
+	// Only the latest session of a client without open sessions is
+	// reported, so a reconnect starts a new offline period.
+	q := `UPDATE client_sessions s
+		SET offline_reported = TRUE
+		FROM clients c
+		WHERE c.id = s.client_id AND NOT s.offline_reported AND s.disconnected_at < :disconnected_at
+			AND NOT EXISTS (
+				SELECT 1 FROM client_sessions o
+				WHERE o.client_id = s.client_id AND o.id <> s.id
+					AND (o.disconnected_at IS NULL OR o.disconnected_at > s.disconnected_at)
+			)
+		RETURNING s.id, s.client_id, c.domain_id, s.connected_at, s.last_seen, s.disconnected_at, s.disconnect_reason;`
+
+	dbs := dbSession{DisconnectedAt: sql.NullTime{Time: before, Valid: true}}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, dbs)
+	if err != nil {
+		return nil, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	defer rows.Close()
+
+	var sessions []clients.Session
+	for rows.Next() {
+		dbs = dbSession{}
+		if err := rows.StructScan(&dbs); err != nil {
+			return nil, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+		}
+		sessions = append(sessions, toSession(dbs))
+	}
+
+	return sessions, nil
+}
+
+type dbSession struct {
+	ID               string         `db:"id"`
+	ClientID         string         `db:"client_id"`
+	DomainID         string         `db:"domain_id"`
+	ConnectedAt      time.Time      `db:"connected_at"`
+	LastSeen         time.Time      `db:"last_seen"`
+	DisconnectedAt   sql.NullTime   `db:"disconnected_at"`
+	DisconnectReason sql.NullString `db:"disconnect_reason"`
+}
+
+type dbPresence struct {
+	Connections      uint64         `db:"connections"`
+	ConnectedAt      time.Time      `db:"connected_at"`
+	LastSeen         time.Time      `db:"last_seen"`
+	DisconnectedAt   sql.NullTime   `db:"disconnected_at"`
+	DisconnectReason sql.NullString `db:"disconnect_reason"`
+}
+
+func toDBSession(session clients.Session) dbSession {
+	return dbSession{
+		ID:               session.ID,
+		ClientID:         session.ClientID,
+		DomainID:         session.DomainID,
+		ConnectedAt:      session.ConnectedAt,
+		LastSeen:         session.LastSeen,
+		DisconnectedAt:   sql.NullTime{Time: session.DisconnectedAt, Valid: !session.DisconnectedAt.IsZero()},
+		DisconnectReason: toNullString(session.DisconnectReason),
+	}
+}
+
+func toSession(dbs dbSession) clients.Session {
+	return clients.Session{
+		ID:               dbs.ID,
+		ClientID:         dbs.ClientID,
+		DomainID:         dbs.DomainID,
+		ConnectedAt:      dbs.ConnectedAt,
+		LastSeen:         dbs.LastSeen,
+		DisconnectedAt:   dbs.DisconnectedAt.Time,
+		DisconnectReason: dbs.DisconnectReason.String,
+	}
+}
+
 type dbConnection struct {
 	ClientID  string               `db:"client_id"`
 	ChannelID string               `db:"channel_id"`
 	DomainID  string               `db:"domain_id"`
 	Type      connections.ConnType `db:"type"`
+	Topics    pgtype.TextArray     `db:"topics"`
 }
 
 func toDBConnections(conns []clients.Connection) []dbConnection {
@@ -1588,10 +1827,15 @@ func toDBConnections(conns []clients.Connection) []dbConnection {
 }
 
 func toDBConnection(conn clients.Connection) dbConnection {
+	var topics pgtype.TextArray
+	// Set does not fail for string slices, and stores nil slices as NULL.
+	_ = topics.Set(conn.Topics)
+
 	return dbConnection{
 		ClientID:  conn.ClientID,
 		ChannelID: conn.ChannelID,
 		DomainID:  conn.DomainID,
 		Type:      conn.Type,
+		Topics:    topics,
 	}
 }
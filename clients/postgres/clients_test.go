@@ -912,6 +912,216 @@ func TestUpdateSecret(t *testing.T) {
 	}
 }
 
+func TestRotateSecret(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	client1 := generateClient(t, clients.EnabledStatus, repo)
+	client2 := generateClient(t, clients.DisabledStatus, repo)
+
+	cases := []struct {
+		desc      string
+		client    clients.Client
+		oldSecret string
+		err       error
+	}{
+		{
+			desc: "for enabled client",
+			client: clients.Client{
+				ID: client1.ID,
+				Credentials: clients.Credentials{
+					Secret:                  "newpassword",
+					PreviousSecretExpiresAt: time.Now().Add(time.Hour).UTC().Truncate(time.Millisecond),
+				},
+			},
+			oldSecret: client1.Credentials.Secret,
+			err:       nil,
+		},
+		{
+			desc: "for disabled client",
+			client: clients.Client{
+				ID: client2.ID,
+				Credentials: clients.Credentials{
+					Secret:                  "newpassword",
+					PreviousSecretExpiresAt: time.Now().Add(time.Hour).UTC(),
+				},
+			},
+			err: repoerr.ErrNotFound,
+		},
+		{
+			desc: "for invalid client",
+			client: clients.Client{
+				ID: testsutil.GenerateUUID(t),
+				Credentials: clients.Credentials{
+					Secret:                  "newpassword",
+					PreviousSecretExpiresAt: time.Now().Add(time.Hour).UTC(),
+				},
+			},
+			err: repoerr.ErrNotFound,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			c.client.UpdatedAt = time.Now().UTC().Truncate(time.Millisecond)
+			c.client.UpdatedBy = testsutil.GenerateUUID(t)
+			rc, err := repo.RotateSecret(context.Background(), c.client)
+			assert.True(t, errors.Contains(err, c.err), fmt.Sprintf("expected %s to contain %s\n", err, c.err))
+			if err == nil {
+				assert.Equal(t, c.client.Credentials.PreviousSecretExpiresAt, rc.Credentials.PreviousSecretExpiresAt)
+				for _, secret := range []string{c.client.Credentials.Secret, c.oldSecret} {
+					rc, err := repo.RetrieveBySecret(context.Background(), secret, c.client.ID, authn.BasicAuth)
+					require.Nil(t, err, fmt.Sprintf("retrieve client by secret during rotation of secret unexpected error: %s", err))
+					assert.Equal(t, c.client.ID, rc.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestDropExpiredSecrets(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	expired := generateClient(t, clients.EnabledStatus, repo)
+	valid := generateClient(t, clients.EnabledStatus, repo)
+
+	expiresAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Millisecond)
+	for _, c := range []struct {
+		client    clients.Client
+		expiresAt time.Time
+	}{
+		{client: expired, expiresAt: expiresAt},
+		{client: valid, expiresAt: time.Now().Add(time.Hour).UTC()},
+	} {
+		_, err := repo.RotateSecret(context.Background(), clients.Client{
+			ID: c.client.ID,
+			Credentials: clients.Credentials{
+				Secret:                  testsutil.GenerateUUID(t),
+				PreviousSecretExpiresAt: c.expiresAt,
+			},
+			UpdatedAt: time.Now().UTC(),
+			UpdatedBy: testsutil.GenerateUUID(t),
+		})
+		require.Nil(t, err, fmt.Sprintf("rotate client secret unexpected error: %s", err))
+	}
+
+	_, err := repo.RetrieveBySecret(context.Background(), expired.Credentials.Secret, expired.ID, authn.BasicAuth)
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("expected %s got %s\n", repoerr.ErrNotFound, err))
+
+	dropped, err := repo.DropExpiredSecrets(context.Background())
+	require.Nil(t, err, fmt.Sprintf("drop expired secrets unexpected error: %s", err))
+	require.Len(t, dropped, 1)
+	assert.Equal(t, expired.ID, dropped[0].ID)
+	assert.Equal(t, expiresAt, dropped[0].Credentials.PreviousSecretExpiresAt)
+
+	dropped, err = repo.DropExpiredSecrets(context.Background())
+	require.Nil(t, err, fmt.Sprintf("drop expired secrets unexpected error: %s", err))
+	assert.Empty(t, dropped)
+
+	_, err = repo.RetrieveBySecret(context.Background(), valid.Credentials.Secret, valid.ID, authn.BasicAuth)
+	assert.Nil(t, err, fmt.Sprintf("retrieve client by previous secret unexpected error: %s", err))
+}
+
+func TestPreviousSecretReuse(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	domainID := testsutil.GenerateUUID(t)
+	newClient := func(domainID, secret string) clients.Client {
+		return clients.Client{
+			ID:          testsutil.GenerateUUID(t),
+			Name:        namegen.Generate(),
+			Domain:      domainID,
+			Credentials: clients.Credentials{Secret: secret},
+			Metadata:    clients.Metadata{},
+			Status:      clients.EnabledStatus,
+			CreatedAt:   time.Now().UTC().Truncate(time.Millisecond),
+		}
+	}
+	rotated := newClient(domainID, testsutil.GenerateUUID(t))
+	other := newClient(domainID, testsutil.GenerateUUID(t))
+	_, err := repo.Save(context.Background(), rotated, other)
+	require.Nil(t, err, fmt.Sprintf("add new clients: expected nil got %s\n", err))
+	previousSecret := rotated.Credentials.Secret
+	_, err = repo.RotateSecret(context.Background(), clients.Client{
+		ID: rotated.ID,
+		Credentials: clients.Credentials{
+			Secret:                  testsutil.GenerateUUID(t),
+			PreviousSecretExpiresAt: time.Now().Add(time.Hour).UTC(),
+		},
+		UpdatedAt: time.Now().UTC(),
+	})
+	require.Nil(t, err, fmt.Sprintf("rotate secret: expected nil got %s\n", err))
+
+	cases := []struct {
+		desc string
+		op   func() error
+		err  error
+	}{
+		{
+			desc: "save client with previous secret in the domain",
+			op: func() error {
+				_, err := repo.Save(context.Background(), newClient(domainID, previousSecret))
+				return err
+			},
+			err: errClientSecretNotAvailable,
+		},
+		{
+			desc: "save client with previous secret in another domain",
+			op: func() error {
+				_, err := repo.Save(context.Background(), newClient(testsutil.GenerateUUID(t), previousSecret))
+				return err
+			},
+			err: nil,
+		},
+		{
+			desc: "update secret to previous secret in the domain",
+			op: func() error {
+				_, err := repo.UpdateSecret(context.Background(), clients.Client{
+					ID:          other.ID,
+					Credentials: clients.Credentials{Secret: previousSecret},
+					UpdatedAt:   time.Now().UTC(),
+				})
+				return err
+			},
+			err: errClientSecretNotAvailable,
+		},
+		{
+			desc: "rotate secret to previous secret in the domain",
+			op: func() error {
+				_, err := repo.RotateSecret(context.Background(), clients.Client{
+					ID: other.ID,
+					Credentials: clients.Credentials{
+						Secret:                  previousSecret,
+						PreviousSecretExpiresAt: time.Now().Add(time.Hour).UTC(),
+					},
+					UpdatedAt: time.Now().UTC(),
+				})
+				return err
+			},
+			err: errClientSecretNotAvailable,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.op()
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
+
 func TestChangeStatus(t *testing.T) {
 	t.Cleanup(func() {
 		_, err := db.Exec("DELETE FROM clients")
@@ -3992,6 +4202,170 @@ func TestUnsetParentGroupFromClients(t *testing.T) {
 	}
 }
 
+func TestClientSessions(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	online := generateClient(t, clients.EnabledStatus, repo)
+	offline := generateClient(t, clients.EnabledStatus, repo)
+	session := clients.Session{
+		ID:          testsutil.GenerateUUID(t),
+		ClientID:    online.ID,
+		ConnectedAt: validTimestamp,
+		LastSeen:    validTimestamp,
+	}
+
+	err := repo.AddSession(context.Background(), session)
+	require.Nil(t, err, fmt.Sprintf("add session: expected nil got %s\n", err))
+	err = repo.AddSession(context.Background(), clients.Session{
+		ID:          testsutil.GenerateUUID(t),
+		ClientID:    testsutil.GenerateUUID(t),
+		ConnectedAt: validTimestamp,
+		LastSeen:    validTimestamp,
+	})
+	require.Nil(t, err, fmt.Sprintf("add session of unknown client: expected nil got %s\n", err))
+
+	listIDs := func(isOnline bool) []string {
+		page, err := repo.RetrieveAll(context.Background(), clients.Page{Offset: 0, Limit: 10, Status: clients.AllStatus, Online: &isOnline})
+		require.Nil(t, err, fmt.Sprintf("retrieve clients: expected nil got %s\n", err))
+		ids := []string{}
+		for _, c := range page.Clients {
+			ids = append(ids, c.ID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{online.ID}, listIDs(true))
+	assert.Equal(t, []string{offline.ID}, listIDs(false))
+
+	closed := session
+	closed.DisconnectedAt = validTimestamp.Add(time.Minute)
+	err = repo.CloseSession(context.Background(), closed)
+	require.Nil(t, err, fmt.Sprintf("close session: expected nil got %s\n", err))
+
+	assert.Empty(t, listIDs(true))
+	assert.ElementsMatch(t, []string{online.ID, offline.ID}, listIDs(false))
+
+	// The session without the disconnect event is closed once it is not
+	// seen for longer than the TTL.
+	stale := clients.Session{
+		ID:          testsutil.GenerateUUID(t),
+		ClientID:    offline.ID,
+		ConnectedAt: validTimestamp,
+		LastSeen:    validTimestamp,
+	}
+	err = repo.AddSession(context.Background(), stale)
+	require.Nil(t, err, fmt.Sprintf("add stale session: expected nil got %s\n", err))
+	err = repo.AddSession(context.Background(), session)
+	require.Nil(t, err, fmt.Sprintf("add session: expected nil got %s\n", err))
+
+	session.LastSeen = validTimestamp.Add(2 * time.Hour)
+	err = repo.RefreshSession(context.Background(), session)
+	require.Nil(t, err, fmt.Sprintf("refresh session: expected nil got %s\n", err))
+	err = repo.RefreshSession(context.Background(), clients.Session{ID: session.ID, ClientID: session.ClientID, LastSeen: validTimestamp})
+	require.Nil(t, err, fmt.Sprintf("refresh session with older time: expected nil got %s\n", err))
+
+	expired, err := repo.ExpireSessions(context.Background(), validTimestamp.Add(time.Hour))
+	require.Nil(t, err, fmt.Sprintf("expire sessions: expected nil got %s\n", err))
+	assert.Equal(t, uint64(1), expired)
+	assert.Equal(t, []string{online.ID}, listIDs(true))
+	assert.Equal(t, []string{offline.ID}, listIDs(false))
+
+	// The publish events refresh all the open sessions of the client.
+	err = repo.RefreshSession(context.Background(), clients.Session{ClientID: session.ClientID, LastSeen: validTimestamp.Add(4 * time.Hour)})
+	require.Nil(t, err, fmt.Sprintf("refresh client sessions: expected nil got %s\n", err))
+	expired, err = repo.ExpireSessions(context.Background(), validTimestamp.Add(3*time.Hour))
+	require.Nil(t, err, fmt.Sprintf("expire sessions: expected nil got %s\n", err))
+	assert.Equal(t, uint64(0), expired)
+	assert.Equal(t, []string{online.ID}, listIDs(true))
+}
+
+func TestClientPresence(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	client := generateClient(t, clients.EnabledStatus, repo)
+	connectedAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Millisecond)
+	disconnectedAt := connectedAt.Add(10 * time.Minute)
+	session := clients.Session{
+		ID:          testsutil.GenerateUUID(t),
+		ClientID:    client.ID,
+		ConnectedAt: connectedAt,
+		LastSeen:    connectedAt,
+	}
+
+	presence, err := repo.RetrievePresence(context.Background(), client.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve presence without sessions: expected nil got %s\n", err))
+	assert.Equal(t, clients.Presence{}, presence)
+
+	err = repo.AddSession(context.Background(), session)
+	require.Nil(t, err, fmt.Sprintf("add session: expected nil got %s\n", err))
+
+	presence, err = repo.RetrievePresence(context.Background(), client.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve online presence: expected nil got %s\n", err))
+	assert.True(t, presence.Online)
+	assert.Equal(t, uint64(1), presence.Connections)
+	assert.Equal(t, connectedAt, presence.ConnectedAt.UTC())
+
+	sessions, err := repo.ReportOffline(context.Background(), time.Now().UTC())
+	require.Nil(t, err, fmt.Sprintf("report offline of online client: expected nil got %s\n", err))
+	assert.Empty(t, sessions)
+
+	closed := session
+	closed.DisconnectedAt = disconnectedAt
+	closed.DisconnectReason = "keep_alive_timeout"
+	err = repo.CloseSession(context.Background(), closed)
+	require.Nil(t, err, fmt.Sprintf("close session: expected nil got %s\n", err))
+
+	presence, err = repo.RetrievePresence(context.Background(), client.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve offline presence: expected nil got %s\n", err))
+	assert.False(t, presence.Online)
+	assert.Equal(t, disconnectedAt, presence.DisconnectedAt.UTC())
+	assert.Equal(t, closed.DisconnectReason, presence.DisconnectReason)
+
+	sessions, err = repo.ReportOffline(context.Background(), disconnectedAt.Add(-time.Minute))
+	require.Nil(t, err, fmt.Sprintf("report offline before threshold: expected nil got %s\n", err))
+	assert.Empty(t, sessions)
+
+	sessions, err = repo.ReportOffline(context.Background(), time.Now().UTC())
+	require.Nil(t, err, fmt.Sprintf("report offline client: expected nil got %s\n", err))
+	require.Len(t, sessions, 1)
+	assert.Equal(t, session.ID, sessions[0].ID)
+	assert.Equal(t, client.Domain, sessions[0].DomainID)
+	assert.Equal(t, closed.DisconnectReason, sessions[0].DisconnectReason)
+
+	sessions, err = repo.ReportOffline(context.Background(), time.Now().UTC())
+	require.Nil(t, err, fmt.Sprintf("report reported offline client: expected nil got %s\n", err))
+	assert.Empty(t, sessions)
+
+	// A new session ends the offline period and removes the closed sessions.
+	reconnected := clients.Session{
+		ID:          testsutil.GenerateUUID(t),
+		ClientID:    client.ID,
+		ConnectedAt: disconnectedAt.Add(time.Minute),
+		LastSeen:    disconnectedAt.Add(time.Minute),
+	}
+	err = repo.AddSession(context.Background(), reconnected)
+	require.Nil(t, err, fmt.Sprintf("add session: expected nil got %s\n", err))
+
+	presence, err = repo.RetrievePresence(context.Background(), client.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve reconnected presence: expected nil got %s\n", err))
+	assert.True(t, presence.Online)
+	assert.Equal(t, uint64(1), presence.Connections)
+	var count int
+	err = db.Get(&count, "SELECT COUNT(*) FROM client_sessions WHERE client_id = $1", client.ID)
+	require.Nil(t, err, fmt.Sprintf("count sessions: expected nil got %s\n", err))
+	assert.Equal(t, 1, count)
+}
+
 func generateClient(t *testing.T, status clients.Status, repo clients.Repository) clients.Client {
 	client := clients.Client{
 		ID:   testsutil.GenerateUUID(t),
@@ -12,7 +12,7 @@ type duplicateErrors struct{}
 // GetError maps constraint names to known errors.
 func (d duplicateErrors) GetError(constraint string) (error, bool) {
 	switch constraint {
-	case "clients_domain_id_secret_key":
+	case "clients_domain_id_secret_key", "clients_domain_id_previous_secret_key":
 		return errors.NewRequestError("client key is not available"), true
 	default:
 		return nil, false
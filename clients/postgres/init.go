@@ -112,6 +112,123 @@ func Migration() (*migrate.MemoryMigrationSource, error) {
 					`DROP INDEX IF EXISTS idx_connections_client_id;`,
 				},
 			},
+			{
+				Id: "clients_07",
+				Up: []string{
+					`ALTER TABLE clients ADD COLUMN IF NOT EXISTS previous_secret VARCHAR(4096);`,
+					`ALTER TABLE clients ADD COLUMN IF NOT EXISTS previous_secret_expires_at TIMESTAMPTZ;`,
+					`CREATE INDEX IF NOT EXISTS idx_clients_domain_id_previous_secret ON clients(domain_id, previous_secret) WHERE previous_secret IS NOT NULL;`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS idx_clients_domain_id_previous_secret;`,
+					`ALTER TABLE clients DROP COLUMN IF EXISTS previous_secret_expires_at;`,
+					`ALTER TABLE clients DROP COLUMN IF EXISTS previous_secret;`,
+				},
+			},
+			{
+				Id: "clients_08",
+				Up: []string{
+					`ALTER TABLE connections ADD COLUMN IF NOT EXISTS topics TEXT[];`,
+				},
+				Down: []string{
+					`ALTER TABLE connections DROP COLUMN IF EXISTS topics;`,
+				},
+			},
+			{
+				Id: "clients_09",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS client_sessions (
+						id              VARCHAR(1024) PRIMARY KEY,
+						client_id       VARCHAR(36) NOT NULL,
+						connected_at    TIMESTAMPTZ NOT NULL,
+						FOREIGN KEY (client_id) REFERENCES clients (id) ON DELETE CASCADE ON UPDATE CASCADE
+					)`,
+					`CREATE INDEX IF NOT EXISTS idx_client_sessions_client_id ON client_sessions (client_id);`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS client_sessions;`,
+				},
+			},
+			{
+				Id: "clients_10",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS client_import_jobs (
+						id          VARCHAR(36) PRIMARY KEY,
+						domain_id   VARCHAR(36) NOT NULL,
+						created_by  VARCHAR(254) NOT NULL,
+						format      SMALLINT NOT NULL,
+						dry_run     BOOLEAN NOT NULL DEFAULT FALSE,
+						status      SMALLINT NOT NULL DEFAULT 0,
+						total       BIGINT NOT NULL DEFAULT 0,
+						processed   BIGINT NOT NULL DEFAULT 0,
+						imported    BIGINT NOT NULL DEFAULT 0,
+						failed      BIGINT NOT NULL DEFAULT 0,
+						errors      JSONB,
+						created_at  TIMESTAMPTZ NOT NULL,
+						updated_at  TIMESTAMPTZ NOT NULL
+					)`,
+					`CREATE INDEX IF NOT EXISTS idx_client_import_jobs_domain_id ON client_import_jobs (domain_id);`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS client_import_jobs;`,
+				},
+			},
+			{
+				Id: "clients_11",
+				Up: []string{
+					`CREATE OR REPLACE FUNCTION check_client_previous_secret() RETURNS TRIGGER AS $$
+					BEGIN
+						IF EXISTS (
+							SELECT 1 FROM clients
+							WHERE domain_id = NEW.domain_id AND id <> NEW.id AND previous_secret = NEW.secret AND previous_secret_expires_at > NOW()
+						) THEN
+							RAISE EXCEPTION 'client secret matches a previous secret in the domain'
+								USING ERRCODE = 'unique_violation', CONSTRAINT = 'clients_domain_id_previous_secret_key';
+						END IF;
+						RETURN NEW;
+					END;
+					$$ LANGUAGE plpgsql;`,
+					`CREATE TRIGGER clients_previous_secret_check BEFORE INSERT OR UPDATE OF secret ON clients
+						FOR EACH ROW EXECUTE FUNCTION check_client_previous_secret();`,
+				},
+				Down: []string{
+					`DROP TRIGGER IF EXISTS clients_previous_secret_check ON clients;`,
+					`DROP FUNCTION IF EXISTS check_client_previous_secret;`,
+				},
+			},
+			{
+				Id: "clients_12",
+				Up: []string{
+					`ALTER TABLE client_sessions ADD COLUMN IF NOT EXISTS last_seen TIMESTAMPTZ;`,
+					`UPDATE client_sessions SET last_seen = connected_at WHERE last_seen IS NULL;`,
+					`ALTER TABLE client_sessions ALTER COLUMN last_seen SET NOT NULL;`,
+					`CREATE INDEX IF NOT EXISTS idx_client_sessions_last_seen ON client_sessions (last_seen);`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS idx_client_sessions_last_seen;`,
+					`ALTER TABLE client_sessions DROP COLUMN IF EXISTS last_seen;`,
+				},
+			},
+			{
+				Id: "clients_13",
+				Up: []string{
+					`ALTER TABLE client_sessions ADD COLUMN IF NOT EXISTS disconnected_at TIMESTAMPTZ;`,
+					`ALTER TABLE client_sessions ADD COLUMN IF NOT EXISTS disconnect_reason TEXT;`,
+					`ALTER TABLE client_sessions ADD COLUMN IF NOT EXISTS offline_reported BOOLEAN NOT NULL DEFAULT FALSE;`,
+					`DROP INDEX IF EXISTS idx_client_sessions_last_seen;`,
+					`CREATE INDEX IF NOT EXISTS idx_client_sessions_open ON client_sessions (last_seen) WHERE disconnected_at IS NULL;`,
+					`CREATE INDEX IF NOT EXISTS idx_client_sessions_offline ON client_sessions (disconnected_at) WHERE NOT offline_reported;`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS idx_client_sessions_offline;`,
+					`DROP INDEX IF EXISTS idx_client_sessions_open;`,
+					`DELETE FROM client_sessions WHERE disconnected_at IS NOT NULL;`,
+					`CREATE INDEX IF NOT EXISTS idx_client_sessions_last_seen ON client_sessions (last_seen);`,
+					`ALTER TABLE client_sessions DROP COLUMN IF EXISTS offline_reported;`,
+					`ALTER TABLE client_sessions DROP COLUMN IF EXISTS disconnect_reason;`,
+					`ALTER TABLE client_sessions DROP COLUMN IF EXISTS disconnected_at;`,
+				},
+			},
 		},
 	}
 
@@ -0,0 +1,247 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/clients/bulk"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/postgres"
+	"github.com/jackc/pgtype"
+)
+
+var _ bulk.Repository = (*jobsRepo)(nil)
+
+type jobsRepo struct {
+	db postgres.Database
+}
+
+// NewJobsRepository instantiates a PostgreSQL implementation of the bulk
+// import jobs repository.
+func NewJobsRepository(db postgres.Database) bulk.Repository {
+	return &jobsRepo{db: db}
+}
+
+func (repo *jobsRepo) SaveJob(ctx context.Context, job bulk.Job) error {
+	q := `INSERT INTO client_import_jobs (id, domain_id, created_by, format, dry_run, status, total, processed, imported, failed, errors, created_at, updated_at)
+		VALUES (:id, :domain_id, :created_by, :format, :dry_run, :status, :total, :processed, :imported, :failed, :errors, :created_at, :updated_at);`
+
+	dbj, err := toDBJob(job)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	if _, err := repo.db.NamedExecContext(ctx, q, dbj); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *jobsRepo) UpdateJob(ctx context.Context, job bulk.Job) error {
+	q := `UPDATE client_import_jobs
+		SET status = :status, processed = :processed, imported = :imported, failed = :failed, errors = :errors, updated_at = :updated_at
+		WHERE id = :id;`
+
+	dbj, err := toDBJob(job)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	res, err := repo.db.NamedExecContext(ctx, q, dbj)
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	if cnt, err := res.RowsAffected(); err != nil || cnt == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (repo *jobsRepo) RetrieveJob(ctx context.Context, domainID, id string) (bulk.Job, error) {
+	q := `SELECT id, domain_id, created_by, format, dry_run, status, total, processed, imported, failed, errors, created_at, updated_at
+		FROM client_import_jobs WHERE id = :id AND domain_id = :domain_id;`
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, dbJob{ID: id, DomainID: domainID})
+	if err != nil {
+		return bulk.Job{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return bulk.Job{}, repoerr.ErrNotFound
+	}
+
+	var dbj dbJob
+	if err := rows.StructScan(&dbj); err != nil {
+		return bulk.Job{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return toJob(dbj)
+}
+
+func (repo *jobsRepo) InterruptJobs(ctx context.Context, before time.Time) (uint64, error) {
+	q := `UPDATE client_import_jobs
+		SET status = :status, updated_at = :now
+		WHERE status IN (:pending, :running) AND updated_at < :before;`
+
+	params := map[string]any{
+		"status":  uint8(bulk.InterruptedStatus),
+		"pending": uint8(bulk.PendingStatus),
+		"running": uint8(bulk.RunningStatus),
+		"before":  before,
+		"now":     time.Now().UTC(),
+	}
+	res, err := repo.db.NamedExecContext(ctx, q, params)
+	if err != nil {
+		return 0, postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return 0, postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	return uint64(cnt), nil
+}
+
+func (repo *jobsRepo) RetrieveClientIDs(ctx context.Context, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	q := `SELECT id FROM clients WHERE id = ANY(:ids);`
+
+	var arr pgtype.TextArray
+	if err := arr.Set(ids); err != nil {
+		return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	rows, err := repo.db.NamedQueryContext(ctx, q, map[string]any{"ids": arr})
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var existing []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+		existing = append(existing, id)
+	}
+
+	return existing, nil
+}
+
+func (repo *jobsRepo) RetrieveConnections(ctx context.Context, clientIDs []string) ([]clients.Connection, error) {
+	if len(clientIDs) == 0 {
+		return nil, nil
+	}
+
+	q := `SELECT channel_id, domain_id, client_id, type, topics FROM connections
+		WHERE client_id = ANY(:client_ids) ORDER BY client_id, channel_id, type;`
+
+	var ids pgtype.TextArray
+	if err := ids.Set(clientIDs); err != nil {
+		return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	rows, err := repo.db.NamedQueryContext(ctx, q, map[string]any{"client_ids": ids})
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var conns []clients.Connection
+	for rows.Next() {
+		var dbc dbConnection
+		if err := rows.StructScan(&dbc); err != nil {
+			return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+		}
+		var topics []string
+		for _, e := range dbc.Topics.Elements {
+			topics = append(topics, e.String)
+		}
+		conns = append(conns, clients.Connection{
+			ClientID:  dbc.ClientID,
+			ChannelID: dbc.ChannelID,
+			DomainID:  dbc.DomainID,
+			Type:      dbc.Type,
+			Topics:    topics,
+		})
+	}
+
+	return conns, nil
+}
+
+type dbJob struct {
+	ID        string    `db:"id"`
+	DomainID  string    `db:"domain_id"`
+	CreatedBy string    `db:"created_by"`
+	Format    uint8     `db:"format"`
+	DryRun    bool      `db:"dry_run"`
+	Status    uint8     `db:"status"`
+	Total     uint64    `db:"total"`
+	Processed uint64    `db:"processed"`
+	Imported  uint64    `db:"imported"`
+	Failed    uint64    `db:"failed"`
+	Errors    []byte    `db:"errors"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func toDBJob(job bulk.Job) (dbJob, error) {
+	var recErrs []byte
+	if len(job.Errors) > 0 {
+		b, err := json.Marshal(job.Errors)
+		if err != nil {
+			return dbJob{}, err
+		}
+		recErrs = b
+	}
+
+	return dbJob{
+		ID:        job.ID,
+		DomainID:  job.DomainID,
+		CreatedBy: job.CreatedBy,
+		Format:    uint8(job.Format),
+		DryRun:    job.DryRun,
+		Status:    uint8(job.Status),
+		Total:     job.Total,
+		Processed: job.Processed,
+		Imported:  job.Imported,
+		Failed:    job.Failed,
+		Errors:    recErrs,
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}, nil
+}
+
+func toJob(dbj dbJob) (bulk.Job, error) {
+	var recErrs []bulk.RecordError
+	if len(dbj.Errors) > 0 {
+		if err := json.Unmarshal(dbj.Errors, &recErrs); err != nil {
+			return bulk.Job{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+	}
+
+	return bulk.Job{
+		ID:        dbj.ID,
+		DomainID:  dbj.DomainID,
+		CreatedBy: dbj.CreatedBy,
+		Format:    bulk.Format(dbj.Format),
+		DryRun:    dbj.DryRun,
+		Status:    bulk.JobStatus(dbj.Status),
+		Total:     dbj.Total,
+		Processed: dbj.Processed,
+		Imported:  dbj.Imported,
+		Failed:    dbj.Failed,
+		Errors:    recErrs,
+		CreatedAt: dbj.CreatedAt.UTC(),
+		UpdatedAt: dbj.UpdatedAt.UTC(),
+	}, nil
+}
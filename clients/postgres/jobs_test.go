@@ -0,0 +1,305 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/clients/bulk"
+	"github.com/absmach/magistrala/clients/postgres"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveJob(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM client_import_jobs")
+		require.Nil(t, err, fmt.Sprintf("clean import jobs unexpected error: %s", err))
+	})
+	repo := postgres.NewJobsRepository(database)
+
+	job := newJob(t)
+
+	cases := []struct {
+		desc string
+		job  bulk.Job
+		err  error
+	}{
+		{
+			desc: "save job successfully",
+			job:  job,
+			err:  nil,
+		},
+		{
+			desc: "save job with duplicate id",
+			job:  job,
+			err:  repoerr.ErrConflict,
+		},
+		{
+			desc: "save job with record errors",
+			job: func() bulk.Job {
+				j := newJob(t)
+				j.Failed = 1
+				j.Errors = []bulk.RecordError{{Line: 2, Error: "duplicate client id"}}
+				return j
+			}(),
+			err: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.SaveJob(context.Background(), tc.job)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				job, err := repo.RetrieveJob(context.Background(), tc.job.DomainID, tc.job.ID)
+				require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+				assert.Equal(t, tc.job, job, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.job, job))
+			}
+		})
+	}
+}
+
+func TestUpdateJob(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM client_import_jobs")
+		require.Nil(t, err, fmt.Sprintf("clean import jobs unexpected error: %s", err))
+	})
+	repo := postgres.NewJobsRepository(database)
+
+	job := newJob(t)
+	err := repo.SaveJob(context.Background(), job)
+	require.Nil(t, err, fmt.Sprintf("save job unexpected error: %s", err))
+
+	updated := job
+	updated.Status = bulk.CompletedStatus
+	updated.Processed = job.Total
+	updated.Imported = job.Total - 1
+	updated.Failed = 1
+	updated.Errors = []bulk.RecordError{{Line: 3, Error: "entity already exists"}}
+	updated.UpdatedAt = job.UpdatedAt.Add(time.Minute)
+
+	cases := []struct {
+		desc string
+		job  bulk.Job
+		err  error
+	}{
+		{
+			desc: "update job successfully",
+			job:  updated,
+			err:  nil,
+		},
+		{
+			desc: "update non-existing job",
+			job:  newJob(t),
+			err:  repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.UpdateJob(context.Background(), tc.job)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				job, err := repo.RetrieveJob(context.Background(), tc.job.DomainID, tc.job.ID)
+				require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+				assert.Equal(t, tc.job, job, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.job, job))
+			}
+		})
+	}
+}
+
+func TestRetrieveJob(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM client_import_jobs")
+		require.Nil(t, err, fmt.Sprintf("clean import jobs unexpected error: %s", err))
+	})
+	repo := postgres.NewJobsRepository(database)
+
+	job := newJob(t)
+	err := repo.SaveJob(context.Background(), job)
+	require.Nil(t, err, fmt.Sprintf("save job unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		domainID string
+		id       string
+		job      bulk.Job
+		err      error
+	}{
+		{
+			desc:     "retrieve job successfully",
+			domainID: job.DomainID,
+			id:       job.ID,
+			job:      job,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve job of another domain",
+			domainID: testsutil.GenerateUUID(t),
+			id:       job.ID,
+			err:      repoerr.ErrNotFound,
+		},
+		{
+			desc:     "retrieve non-existing job",
+			domainID: job.DomainID,
+			id:       testsutil.GenerateUUID(t),
+			err:      repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			job, err := repo.RetrieveJob(context.Background(), tc.domainID, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.job, job, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.job, job))
+		})
+	}
+}
+
+func TestInterruptJobs(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM client_import_jobs")
+		require.Nil(t, err, fmt.Sprintf("clean import jobs unexpected error: %s", err))
+	})
+	repo := postgres.NewJobsRepository(database)
+
+	stale := newJob(t)
+	stale.UpdatedAt = stale.UpdatedAt.Add(-time.Hour)
+	pending := newJob(t)
+	pending.Status = bulk.PendingStatus
+	pending.UpdatedAt = pending.UpdatedAt.Add(-time.Hour)
+	completed := newJob(t)
+	completed.Status = bulk.CompletedStatus
+	completed.UpdatedAt = completed.UpdatedAt.Add(-time.Hour)
+	recent := newJob(t)
+	for _, job := range []bulk.Job{stale, pending, completed, recent} {
+		err := repo.SaveJob(context.Background(), job)
+		require.Nil(t, err, fmt.Sprintf("save job unexpected error: %s", err))
+	}
+
+	count, err := repo.InterruptJobs(context.Background(), time.Now().UTC().Add(-time.Minute))
+	assert.Nil(t, err, fmt.Sprintf("interrupt jobs unexpected error: %s", err))
+	assert.Equal(t, uint64(2), count, fmt.Sprintf("interrupt jobs: expected 2 interrupted got %d", count))
+
+	statuses := map[string]bulk.JobStatus{
+		stale.ID:     bulk.InterruptedStatus,
+		pending.ID:   bulk.InterruptedStatus,
+		completed.ID: bulk.CompletedStatus,
+		recent.ID:    bulk.RunningStatus,
+	}
+	for _, job := range []bulk.Job{stale, pending, completed, recent} {
+		got, err := repo.RetrieveJob(context.Background(), job.DomainID, job.ID)
+		require.Nil(t, err, fmt.Sprintf("retrieve job unexpected error: %s", err))
+		assert.Equal(t, statuses[job.ID], got.Status, fmt.Sprintf("interrupt jobs: expected %s got %s", statuses[job.ID], got.Status))
+	}
+}
+
+func TestRetrieveClientIDs(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+	crepo := postgres.NewRepository(database)
+	repo := postgres.NewJobsRepository(database)
+
+	client := generateClient(t, clients.EnabledStatus, crepo)
+
+	cases := []struct {
+		desc string
+		ids  []string
+		resp []string
+	}{
+		{
+			desc: "retrieve taken client ids",
+			ids:  []string{client.ID, testsutil.GenerateUUID(t)},
+			resp: []string{client.ID},
+		},
+		{
+			desc: "retrieve free client ids",
+			ids:  []string{testsutil.GenerateUUID(t)},
+		},
+		{
+			desc: "retrieve client ids without ids",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ids, err := repo.RetrieveClientIDs(context.Background(), tc.ids)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.resp, ids, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.resp, ids))
+		})
+	}
+}
+
+func TestRetrieveConnections(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients")
+		require.Nil(t, err, fmt.Sprintf("clean clients unexpected error: %s", err))
+	})
+	crepo := postgres.NewRepository(database)
+	repo := postgres.NewJobsRepository(database)
+
+	client := generateClient(t, clients.EnabledStatus, crepo)
+	other := generateClient(t, clients.EnabledStatus, crepo)
+	channelID := testsutil.GenerateUUID(t)
+	conns := []clients.Connection{
+		{ClientID: client.ID, ChannelID: channelID, DomainID: client.Domain, Type: connections.Publish},
+		{ClientID: client.ID, ChannelID: channelID, DomainID: client.Domain, Type: connections.Subscribe, Topics: []string{"commands/#", "state"}},
+		{ClientID: other.ID, ChannelID: channelID, DomainID: other.Domain, Type: connections.Publish},
+	}
+	err := crepo.AddConnections(context.Background(), conns)
+	require.Nil(t, err, fmt.Sprintf("add connections unexpected error: %s", err))
+
+	cases := []struct {
+		desc      string
+		clientIDs []string
+		conns     []clients.Connection
+	}{
+		{
+			desc:      "retrieve connections with topics",
+			clientIDs: []string{client.ID},
+			conns:     conns[:2],
+		},
+		{
+			desc:      "retrieve connections of clients without connections",
+			clientIDs: []string{testsutil.GenerateUUID(t)},
+		},
+		{
+			desc: "retrieve connections without clients",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			conns, err := repo.RetrieveConnections(context.Background(), tc.clientIDs)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.conns, conns, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.conns, conns))
+		})
+	}
+}
+
+func newJob(t *testing.T) bulk.Job {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	return bulk.Job{
+		ID:        testsutil.GenerateUUID(t),
+		DomainID:  testsutil.GenerateUUID(t),
+		CreatedBy: testsutil.GenerateUUID(t),
+		Format:    bulk.NDJSON,
+		Status:    bulk.RunningStatus,
+		Total:     10,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
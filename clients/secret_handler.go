@@ -0,0 +1,48 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// The SecretHandler is a job that runs periodically to drop the previous client secrets
+// whose overlap period has passed. The secrets are dropped using the service, so that
+// the cached keys are removed and the events are published for the dropped secrets.
+
+package clients
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type secretHandler struct {
+	svc           Service
+	checkInterval time.Duration
+	logger        *slog.Logger
+}
+
+func NewSecretHandler(ctx context.Context, svc Service, checkInterval time.Duration, logger *slog.Logger) {
+	handler := &secretHandler{
+		svc:           svc,
+		checkInterval: checkInterval,
+		logger:        logger,
+	}
+
+	go func() {
+		ticker := time.NewTicker(handler.checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				handler.handle(ctx)
+			}
+		}
+	}()
+}
+
+func (h *secretHandler) handle(ctx context.Context) {
+	if _, err := h.svc.DropExpiredSecrets(ctx); err != nil {
+		h.logger.Error("failed to drop expired client secrets", slog.Any("error", err))
+	}
+}
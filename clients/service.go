@@ -14,6 +14,7 @@ import (
 	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/errors"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/events"
 	"github.com/absmach/magistrala/pkg/policies"
 	"github.com/absmach/magistrala/pkg/roles"
 )
@@ -135,6 +136,20 @@ func (svc service) View(ctx context.Context, session authn.Session, id string, w
 	if err != nil {
 		return Client{}, errors.Wrap(svcerr.ErrViewEntity, err)
 	}
+
+	presence, err := svc.repo.RetrievePresence(ctx, id)
+	if err != nil {
+		return Client{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	end := presence.DisconnectedAt
+	if presence.Online {
+		end = time.Now().UTC()
+	}
+	if !presence.ConnectedAt.IsZero() && end.After(presence.ConnectedAt) {
+		presence.ConnectionDuration = uint64(end.Sub(presence.ConnectedAt).Seconds())
+	}
+	client.Presence = &presence
+
 	return client, nil
 }
 
@@ -208,12 +223,47 @@ func (svc service) UpdateSecret(ctx context.Context, session authn.Session, id,
 	if err != nil {
 		return Client{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
 	}
-	if err := svc.cache.Remove(ctx, client.ID); err != nil {
+	if err := svc.removeCache(ctx, client.ID); err != nil {
 		return client, errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 	return client, nil
 }
 
+func (svc service) RotateSecret(ctx context.Context, session authn.Session, id, key string, overlap time.Duration) (Client, error) {
+	now := time.Now().UTC()
+	client := Client{
+		ID: id,
+		Credentials: Credentials{
+			Secret:                  key,
+			PreviousSecretExpiresAt: now.Add(overlap),
+		},
+		UpdatedAt: now,
+		UpdatedBy: session.UserID,
+		Status:    EnabledStatus,
+	}
+	client, err := svc.repo.RotateSecret(ctx, client)
+	if err != nil {
+		return Client{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	if err := svc.removeCache(ctx, client.ID); err != nil {
+		return client, errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+	return client, nil
+}
+
+func (svc service) DropExpiredSecrets(ctx context.Context) ([]Client, error) {
+	clients, err := svc.repo.DropExpiredSecrets(ctx)
+	if err != nil {
+		return nil, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	for _, c := range clients {
+		if err := svc.removeCache(ctx, c.ID); err != nil {
+			return clients, errors.Wrap(svcerr.ErrRemoveEntity, err)
+		}
+	}
+	return clients, nil
+}
+
 func (svc service) Enable(ctx context.Context, session authn.Session, id string) (Client, error) {
 	client := Client{
 		ID:        id,
@@ -239,7 +289,7 @@ func (svc service) Disable(ctx context.Context, session authn.Session, id string
 		return Client{}, errors.Wrap(ErrDisableClient, err)
 	}
 
-	if err := svc.cache.Remove(ctx, client.ID); err != nil {
+	if err := svc.removeCache(ctx, client.ID); err != nil {
 		return client, errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 
@@ -352,7 +402,7 @@ func (svc service) Delete(ctx context.Context, session authn.Session, id string)
 		return errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 
-	if err := svc.cache.Remove(ctx, id); err != nil {
+	if err := svc.removeCache(ctx, id); err != nil {
 		return errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 
@@ -404,3 +454,16 @@ func (svc service) changeClientStatus(ctx context.Context, session authn.Session
 	}
 	return client, nil
 }
+
+// removeCache removes the client from the cache, and again once the change
+// made with ctx is committed, so that a concurrent authentication can not
+// keep the client cached as it was before the change.
+func (svc service) removeCache(ctx context.Context, id string) error {
+	if err := svc.cache.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	return events.AfterCommit(ctx, func(ctx context.Context) error {
+		return svc.cache.Remove(ctx, id)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
 	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
@@ -20,6 +21,7 @@ import (
 	"github.com/absmach/magistrala/pkg/errors"
 	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/events"
 	policysvc "github.com/absmach/magistrala/pkg/policies"
 	policymocks "github.com/absmach/magistrala/pkg/policies/mocks"
 	"github.com/absmach/magistrala/pkg/roles"
@@ -56,9 +58,10 @@ var (
 			},
 		},
 	}
-	validToken = "token"
-	validID    = "d4ebb847-5d0e-4e46-bdd9-b6aceaaa3a22"
-	wrongID    = testsutil.GenerateUUID(&testing.T{})
+	validToken     = "token"
+	validID        = "d4ebb847-5d0e-4e46-bdd9-b6aceaaa3a22"
+	wrongID        = testsutil.GenerateUUID(&testing.T{})
+	validTimestamp = time.Now().UTC().Truncate(time.Second)
 )
 
 var (
@@ -345,7 +348,9 @@ func TestViewClient(t *testing.T) {
 		clientID    string
 		withRoles   bool
 		response    clients.Client
+		presence    clients.Presence
 		retrieveErr error
+		presenceErr error
 		err         error
 	}{
 		{
@@ -355,6 +360,19 @@ func TestViewClient(t *testing.T) {
 			clientID:  client.ID,
 			err:       nil,
 		},
+		{
+			desc:     "view client successfully with presence",
+			response: client,
+			presence: clients.Presence{
+				ConnectedAt:        validTimestamp,
+				DisconnectedAt:     validTimestamp.Add(time.Minute),
+				DisconnectReason:   "keep_alive_timeout",
+				ConnectionDuration: 60,
+			},
+			withRoles: false,
+			clientID:  client.ID,
+			err:       nil,
+		},
 		{
 			desc:      "view client successfully with roles",
 			response:  clientWithRoles,
@@ -384,12 +402,21 @@ func TestViewClient(t *testing.T) {
 			clientID:  wrongID,
 			err:       svcerr.ErrAuthorization,
 		},
+		{
+			desc:        "view client with failed to retrieve presence",
+			response:    clients.Client{},
+			withRoles:   false,
+			clientID:    client.ID,
+			presenceErr: repoerr.ErrViewEntity,
+			err:         svcerr.ErrViewEntity,
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
 			repoCall := repo.On("RetrieveByID", context.Background(), tc.clientID).Return(tc.response, tc.err)
 			repoCall1 := repo.On("RetrieveByIDWithRoles", context.Background(), tc.clientID, mock.Anything).Return(tc.response, tc.err)
+			repoCall2 := repo.On("RetrievePresence", context.Background(), tc.clientID).Return(tc.presence, tc.presenceErr)
 			rClient, err := svc.View(context.Background(), smqauthn.Session{}, tc.clientID, tc.withRoles)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 
@@ -403,9 +430,14 @@ func TestViewClient(t *testing.T) {
 				assert.True(t, ok, fmt.Sprintf("RetrieveByID was not called on %s", tc.desc))
 			}
 
-			assert.Equal(t, tc.response, rClient, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.response, rClient))
+			expected := tc.response
+			if err == nil {
+				expected.Presence = &tc.presence
+			}
+			assert.Equal(t, expected, rClient, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, expected, rClient))
 			repoCall.Unset()
 			repoCall1.Unset()
+			repoCall2.Unset()
 		})
 	}
 }
@@ -768,6 +800,204 @@ func TestUpdateSecret(t *testing.T) {
 	}
 }
 
+// txPublisher records when the transactions it runs are committed.
+type txPublisher struct {
+	calls *[]string
+}
+
+func (tp txPublisher) Publish(ctx context.Context, stream string, event events.Event) error {
+	return nil
+}
+
+func (tp txPublisher) Close() error {
+	return nil
+}
+
+func (tp txPublisher) Atomic(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		*tp.calls = append(*tp.calls, "rollback")
+		return err
+	}
+	*tp.calls = append(*tp.calls, "commit")
+
+	return nil
+}
+
+func TestUpdateSecretRemovesCacheAfterCommit(t *testing.T) {
+	svc := newService()
+
+	cases := []struct {
+		desc      string
+		updateErr error
+		calls     []string
+		err       error
+	}{
+		{
+			desc:  "remove cache before and after commit",
+			calls: []string{"remove", "commit", "remove"},
+			err:   nil,
+		},
+		{
+			desc:      "do not remove cache after rollback",
+			updateErr: repoerr.ErrMalformedEntity,
+			calls:     []string{"rollback"},
+			err:       svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			calls := []string{}
+			repoCall := repo.On("UpdateSecret", mock.Anything, mock.Anything).Return(client, tc.updateErr)
+			cacheCall := cache.On("Remove", mock.Anything, client.ID).Return(nil).Run(func(args mock.Arguments) {
+				calls = append(calls, "remove")
+			})
+			err := events.Atomic(context.Background(), txPublisher{calls: &calls}, func(ctx context.Context) error {
+				_, err := svc.UpdateSecret(ctx, smqauthn.Session{UserID: validID}, client.ID, "newSecret")
+				return err
+			})
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.calls, calls, fmt.Sprintf("%s: expected calls %v got %v\n", tc.desc, tc.calls, calls))
+			repoCall.Unset()
+			cacheCall.Unset()
+		})
+	}
+}
+
+func TestRotateSecret(t *testing.T) {
+	svc := newService()
+
+	rotatedClient := clients.Client{
+		ID: client.ID,
+		Credentials: clients.Credentials{
+			Identity:                client.Credentials.Identity,
+			Secret:                  "newSecret",
+			PreviousSecretExpiresAt: time.Now().Add(time.Hour).UTC(),
+		},
+	}
+
+	cases := []struct {
+		desc                 string
+		client               clients.Client
+		newSecret            string
+		overlap              time.Duration
+		rotateSecretResponse clients.Client
+		session              smqauthn.Session
+		rotateErr            error
+		removeErr            error
+		err                  error
+	}{
+		{
+			desc:                 "rotate client secret successfully",
+			client:               client,
+			newSecret:            "newSecret",
+			overlap:              time.Hour,
+			session:              smqauthn.Session{UserID: validID},
+			rotateSecretResponse: rotatedClient,
+			err:                  nil,
+		},
+		{
+			desc:                 "rotate client secret with failed to rotate in repo",
+			client:               client,
+			newSecret:            "newSecret",
+			overlap:              time.Hour,
+			session:              smqauthn.Session{UserID: validID},
+			rotateSecretResponse: clients.Client{},
+			rotateErr:            repoerr.ErrNotFound,
+			err:                  svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:                 "rotate client secret with failed to remove cache",
+			client:               client,
+			newSecret:            "newSecret",
+			overlap:              time.Hour,
+			session:              smqauthn.Session{UserID: validID},
+			rotateSecretResponse: rotatedClient,
+			removeErr:            repoerr.ErrRemoveEntity,
+			err:                  svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			before := time.Now().UTC()
+			var rotated clients.Client
+			repoCall := repo.On("RotateSecret", context.Background(), mock.Anything).Run(func(args mock.Arguments) {
+				rotated = args.Get(1).(clients.Client)
+			}).Return(tc.rotateSecretResponse, tc.rotateErr)
+			var cacheCall *mock.Call
+			if tc.rotateErr == nil {
+				cacheCall = cache.On("Remove", context.Background(), tc.rotateSecretResponse.ID).Return(tc.removeErr)
+			}
+			rotatedClient, err := svc.RotateSecret(context.Background(), tc.session, tc.client.ID, tc.newSecret, tc.overlap)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.rotateSecretResponse, rotatedClient, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.rotateSecretResponse, rotatedClient))
+			assert.Equal(t, tc.newSecret, rotated.Credentials.Secret, fmt.Sprintf("%s: expected secret %s got %s\n", tc.desc, tc.newSecret, rotated.Credentials.Secret))
+			assert.False(t, rotated.Credentials.PreviousSecretExpiresAt.Before(before.Add(tc.overlap)), fmt.Sprintf("%s: expected previous secret to expire after the overlap period, got %v\n", tc.desc, rotated.Credentials.PreviousSecretExpiresAt))
+			repoCall.Unset()
+			if cacheCall != nil {
+				cacheCall.Unset()
+			}
+		})
+	}
+}
+
+func TestDropExpiredSecrets(t *testing.T) {
+	svc := newService()
+
+	expired := []clients.Client{
+		{ID: testsutil.GenerateUUID(t), Domain: validID, Credentials: clients.Credentials{PreviousSecretExpiresAt: time.Now().Add(-time.Minute).UTC()}},
+		{ID: testsutil.GenerateUUID(t), Domain: validID, Credentials: clients.Credentials{PreviousSecretExpiresAt: time.Now().Add(-time.Hour).UTC()}},
+	}
+
+	cases := []struct {
+		desc      string
+		dropRes   []clients.Client
+		dropErr   error
+		removeErr error
+		err       error
+	}{
+		{
+			desc:    "drop expired secrets successfully",
+			dropRes: expired,
+			err:     nil,
+		},
+		{
+			desc:    "drop expired secrets without expired secrets",
+			dropRes: []clients.Client{},
+			err:     nil,
+		},
+		{
+			desc:    "drop expired secrets with failed to drop in repo",
+			dropErr: repoerr.ErrUpdateEntity,
+			err:     svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:      "drop expired secrets with failed to remove cache",
+			dropRes:   expired,
+			removeErr: repoerr.ErrRemoveEntity,
+			err:       svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("DropExpiredSecrets", context.Background()).Return(tc.dropRes, tc.dropErr)
+			cacheCall := cache.On("Remove", context.Background(), mock.Anything).Return(tc.removeErr)
+			dropped, err := svc.DropExpiredSecrets(context.Background())
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.dropRes, dropped, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.dropRes, dropped))
+			if tc.dropErr == nil && tc.removeErr == nil {
+				for _, c := range tc.dropRes {
+					cache.AssertCalled(t, "Remove", context.Background(), c.ID)
+				}
+			}
+			repoCall.Unset()
+			cacheCall.Unset()
+		})
+	}
+}
+
 func TestEnable(t *testing.T) {
 	svc := newService()
 
@@ -28,6 +28,7 @@ import (
 	"github.com/absmach/magistrala/pkg/jaeger"
 	"github.com/absmach/magistrala/pkg/messaging"
 	brokerstracing "github.com/absmach/magistrala/pkg/messaging/brokers/tracing"
+	"github.com/absmach/magistrala/pkg/messaging/compression"
 	"github.com/absmach/magistrala/pkg/permissions"
 	"github.com/absmach/magistrala/pkg/postgres"
 	"github.com/absmach/magistrala/pkg/prometheus"
@@ -232,6 +233,12 @@ func main() {
 		return
 	}
 	defer pubSub.Close()
+	compBytes, compRatio := prometheus.MakeCompressionMetrics("alarms", "message_compression")
+	if pubSub, err = compression.NewPubSub(compression.Config{}, pubSub, compBytes, compRatio); err != nil {
+		logger.Error(fmt.Sprintf("failed to create message decompression: %s", err))
+		exitCode = 1
+		return
+	}
 	pubSub = brokerstracing.NewPubSub(httpServerConfig, tracer, pubSub)
 
 	consumer := consumer.NewHandler(svc, logger)
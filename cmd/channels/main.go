@@ -44,6 +44,8 @@ import (
 	pkgDomains "github.com/absmach/magistrala/pkg/domains"
 	dconsumer "github.com/absmach/magistrala/pkg/domains/events/consumer"
 	domainsAuthz "github.com/absmach/magistrala/pkg/domains/grpcclient"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/pkg/events/store"
 	gconsumer "github.com/absmach/magistrala/pkg/groups/events/consumer"
 	"github.com/absmach/magistrala/pkg/grpcclient"
 	jaegerclient "github.com/absmach/magistrala/pkg/jaeger"
@@ -82,6 +84,7 @@ const (
 	envPrefixGroups         = "MG_GROUPS_GRPC_"
 	envPrefixDomains        = "MG_DOMAINS_GRPC_"
 	envPrefixChannelCallout = "MG_CHANNELS_CALLOUT_"
+	envPrefixOutbox         = "MG_CHANNELS_OUTBOX_"
 	defDB                   = "channels"
 	defSvcHTTPPort          = "9005"
 	defSvcGRPCPort          = "7005"
@@ -146,6 +149,7 @@ func main() {
 		exitCode = 1
 		return
 	}
+	migrations.Migrations = append(migrations.Migrations, pgclient.OutboxMigrations()...)
 	db, err := pgclient.Setup(dbConfig, *migrations)
 	if err != nil {
 		logger.Error(err.Error())
@@ -303,6 +307,21 @@ func main() {
 		return
 	}
 
+	relayConfig := pgclient.RelayConfig{}
+	if err := env.ParseWithOptions(&relayConfig, env.Options{Prefix: envPrefixOutbox}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s outbox relay configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	esPublisher, err := store.NewPublisher(ctx, cfg.ESURL, "channels-es-pub")
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create event store publisher : %s", err))
+		exitCode = 1
+		return
+	}
+	defer esPublisher.Close()
+	relay := pgclient.NewRelay(pg.NewDatabase(db, dbConfig, tracer), esPublisher, relayConfig, logger)
+
 	ddatabase := pg.NewDatabase(db, dbConfig, tracer)
 	drepo := dpostgres.NewRepository(ddatabase)
 
@@ -358,6 +377,10 @@ func main() {
 		return gs.Start()
 	})
 
+	g.Go(func() error {
+		return relay.Start(ctx)
+	})
+
 	g.Go(func() error {
 		return server.StopSignalHandler(ctx, cancel, logger, svcName, httpSvc)
 	})
@@ -391,10 +414,7 @@ func newService(ctx context.Context, db *sqlx.DB, dbConfig pgclient.Config, cach
 		return nil, nil, err
 	}
 
-	svc, err = events.NewEventStoreMiddleware(ctx, svc, cfg.ESURL)
-	if err != nil {
-		return nil, nil, err
-	}
+	svc = events.NewEventStoreMiddleware(svc, schema.NewPublisher(pg.NewOutbox(database)))
 
 	svc = middleware.NewTracing(svc, tracer)
 
@@ -456,7 +476,7 @@ func newService(ctx context.Context, db *sqlx.DB, dbConfig pgclient.Config, cach
 
 	svc = middleware.NewLogging(svc, logger)
 
-	psvc := pChannels.New(repo, cache, pe, ps, da)
+	psvc := pChannels.New(repo, cache, pe, ps, da, svc)
 	return svc, psvc, err
 }
 
@@ -45,6 +45,8 @@ func main() {
 	invitationsCmd := cli.NewInvitationsCmd()
 	journalCmd := cli.NewJournalCmd()
 	certsCmd := cli.NewCertsCmd()
+	rulesCmd := cli.NewRulesCmd()
+	commandsCmd := cli.NewCommandsCmd()
 
 	// Root Commands
 	rootCmd.AddCommand(healthCmd)
@@ -58,6 +60,8 @@ func main() {
 	rootCmd.AddCommand(invitationsCmd)
 	rootCmd.AddCommand(journalCmd)
 	rootCmd.AddCommand(certsCmd)
+	rootCmd.AddCommand(rulesCmd)
+	rootCmd.AddCommand(commandsCmd)
 
 	// Root Flags
 	rootCmd.PersistentFlags().StringVarP(
@@ -108,6 +112,22 @@ func main() {
 		"Certs service URL",
 	)
 
+	rootCmd.PersistentFlags().StringVarP(
+		&sdkConf.RulesEngineURL,
+		"rules-engine-url",
+		"",
+		sdkConf.RulesEngineURL,
+		"Rules engine service URL",
+	)
+
+	rootCmd.PersistentFlags().StringVarP(
+		&sdkConf.CommandsURL,
+		"commands-url",
+		"",
+		sdkConf.CommandsURL,
+		"Commands service URL",
+	)
+
 	rootCmd.PersistentFlags().StringVarP(
 		&sdkConf.HostURL,
 		"host-url",
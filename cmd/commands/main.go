@@ -0,0 +1,219 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main contains commands main function to start the commands service.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/absmach/magistrala/commands"
+	httpAPI "github.com/absmach/magistrala/commands/api"
+	"github.com/absmach/magistrala/commands/brokers"
+	"github.com/absmach/magistrala/commands/consumer"
+	"github.com/absmach/magistrala/commands/middleware"
+	commandsRepo "github.com/absmach/magistrala/commands/postgres"
+	mglog "github.com/absmach/magistrala/logger"
+	smqauthn "github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/authn/authsvc"
+	"github.com/absmach/magistrala/pkg/grpcclient"
+	"github.com/absmach/magistrala/pkg/jaeger"
+	"github.com/absmach/magistrala/pkg/messaging"
+	smqbrokers "github.com/absmach/magistrala/pkg/messaging/brokers"
+	brokerstracing "github.com/absmach/magistrala/pkg/messaging/brokers/tracing"
+	"github.com/absmach/magistrala/pkg/messaging/compression"
+	"github.com/absmach/magistrala/pkg/postgres"
+	"github.com/absmach/magistrala/pkg/prometheus"
+	"github.com/absmach/magistrala/pkg/server"
+	httpserver "github.com/absmach/magistrala/pkg/server/http"
+	"github.com/absmach/magistrala/pkg/uuid"
+	"github.com/caarlos0/env/v11"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName           = "commands"
+	envPrefixDB       = "MG_COMMANDS_DB_"
+	envPrefixHTTP     = "MG_COMMANDS_HTTP_"
+	envPrefixAuth     = "MG_AUTH_GRPC_"
+	envPrefixChannels = "MG_CHANNELS_GRPC_"
+	defDB             = "commands"
+	defSvcHTTPPort    = "9022"
+)
+
+type config struct {
+	LogLevel       string        `env:"MG_COMMANDS_LOG_LEVEL"       envDefault:"info"`
+	BrokerURL      string        `env:"MG_MESSAGE_BROKER_URL"       envDefault:"nats://localhost:4222"`
+	InstanceID     string        `env:"MG_COMMANDS_INSTANCE_ID"     envDefault:""`
+	JaegerURL      url.URL       `env:"MG_JAEGER_URL"               envDefault:"http://localhost:4318/v1/traces"`
+	TraceRatio     float64       `env:"MG_JAEGER_TRACE_RATIO"       envDefault:"1.0"`
+	Timeout        time.Duration `env:"MG_COMMANDS_TIMEOUT"         envDefault:"30s"`
+	ExpireInterval time.Duration `env:"MG_COMMANDS_EXPIRE_INTERVAL" envDefault:"5s"`
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	cfg := config{}
+	if err := env.Parse(&cfg); err != nil {
+		log.Fatalf("failed to load %s configuration : %s", svcName, err.Error())
+	}
+
+	logger, err := mglog.New(os.Stdout, cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("failed to init logger: %s", err.Error())
+	}
+
+	var exitCode int
+	defer mglog.ExitWithError(&exitCode)
+
+	tp, err := jaeger.NewProvider(ctx, svcName, cfg.JaegerURL, cfg.InstanceID, cfg.TraceRatio)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to init Jaeger: %s", err))
+		exitCode = 1
+		return
+	}
+	defer func() {
+		if err := tp.Shutdown(ctx); err != nil {
+			logger.Error(fmt.Sprintf("error shutting down tracer provider: %v", err))
+		}
+	}()
+	tracer := tp.Tracer(svcName)
+
+	dbConfig := postgres.Config{Name: defDB}
+	if err := env.ParseWithOptions(&dbConfig, env.Options{Prefix: envPrefixDB}); err != nil {
+		logger.Error(err.Error())
+	}
+	db, err := postgres.Setup(dbConfig, *commandsRepo.Migration())
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	defer db.Close()
+
+	repo := commandsRepo.NewRepository(postgres.NewDatabase(db, dbConfig, tracer))
+
+	authConfig := grpcclient.Config{}
+	if err := env.ParseWithOptions(&authConfig, env.Options{Prefix: envPrefixAuth}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s auth configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	authn, authnClient, err := authsvc.NewAuthentication(ctx, authConfig)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	am := smqauthn.NewAuthNMiddleware(authn)
+	defer authnClient.Close()
+	logger.Info("AuthN  successfully connected to auth gRPC server " + authnClient.Secure())
+
+	channelsClientCfg := grpcclient.Config{}
+	if err := env.ParseWithOptions(&channelsClientCfg, env.Options{Prefix: envPrefixChannels}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load channels gRPC client configuration : %s", err))
+		exitCode = 1
+		return
+	}
+	channelsClient, channelsHandler, err := grpcclient.SetupChannelsClient(ctx, channelsClientCfg)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	defer channelsHandler.Close()
+	logger.Info("Channels service gRPC client successfully connected to channels gRPC server " + channelsHandler.Secure())
+
+	httpServerConfig := server.Config{Port: defSvcHTTPPort}
+	if err := env.ParseWithOptions(&httpServerConfig, env.Options{Prefix: envPrefixHTTP}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s HTTP server configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+
+	msgPubSub, err := smqbrokers.NewPubSub(ctx, cfg.BrokerURL, logger, smqbrokers.ConnectionName("commands-msg-pubsub"))
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+	defer msgPubSub.Close()
+	// Messages on the default topic reach devices, so they are decompressed but never compressed.
+	compBytes, compRatio := prometheus.MakeCompressionMetrics(svcName, "message_compression")
+	if msgPubSub, err = compression.NewPubSub(compression.Config{}, msgPubSub, compBytes, compRatio); err != nil {
+		logger.Error(fmt.Sprintf("failed to create message decompression: %s", err))
+		exitCode = 1
+		return
+	}
+	msgPubSub = brokerstracing.NewPubSub(httpServerConfig, tracer, msgPubSub)
+
+	idp := uuid.New()
+
+	svc := commands.NewService(idp, repo, msgPubSub, cfg.Timeout)
+	svc = middleware.NewAuthorizationMiddleware(svc, channelsClient)
+	svc = middleware.NewLoggingMiddleware(logger, svc)
+	counter, latency := prometheus.MakeMetrics(svcName, "api")
+	svc = middleware.NewMetricsMiddleware(counter, latency, svc)
+	svc = middleware.NewTracingMiddleware(tracer, svc)
+
+	if err := msgPubSub.Subscribe(ctx, messaging.SubscriberConfig{
+		ID:             svcName,
+		Topic:          consumer.ResponsesTopic,
+		DeliveryPolicy: messaging.DeliverAllPolicy,
+		Handler:        consumer.NewResponsesHandler(svc),
+	}); err != nil {
+		logger.Error(fmt.Sprintf("failed to subscribe to command responses: %s", err))
+		exitCode = 1
+		return
+	}
+
+	rulesPubSub, err := brokers.NewPubSub(ctx, cfg.BrokerURL, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+	defer rulesPubSub.Close()
+	if rulesPubSub, err = compression.NewPubSub(compression.Config{}, rulesPubSub, compBytes, compRatio); err != nil {
+		logger.Error(fmt.Sprintf("failed to create message decompression: %s", err))
+		exitCode = 1
+		return
+	}
+	rulesPubSub = brokerstracing.NewPubSub(httpServerConfig, tracer, rulesPubSub)
+
+	if err := rulesPubSub.Subscribe(ctx, messaging.SubscriberConfig{
+		ID:             svcName,
+		Topic:          brokers.AllTopic,
+		DeliveryPolicy: messaging.DeliverAllPolicy,
+		Handler:        consumer.NewRulesHandler(svc),
+	}); err != nil {
+		logger.Error(fmt.Sprintf("failed to subscribe to rules commands: %s", err))
+		exitCode = 1
+		return
+	}
+
+	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpAPI.MakeHandler(svc, logger, idp, cfg.InstanceID, am), logger)
+
+	g.Go(func() error {
+		return commands.Expire(ctx, svc, cfg.ExpireInterval, logger)
+	})
+
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	g.Go(func() error {
+		return server.StopSignalHandler(ctx, cancel, logger, svcName, hs)
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("%s service terminated: %s", svcName, err))
+	}
+}
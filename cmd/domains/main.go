@@ -35,6 +35,8 @@ import (
 	authsvcAuthz "github.com/absmach/magistrala/pkg/authz/authsvc"
 	"github.com/absmach/magistrala/pkg/callout"
 	domainsAuthz "github.com/absmach/magistrala/pkg/domains/psvc"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/pkg/events/store"
 	"github.com/absmach/magistrala/pkg/grpcclient"
 	"github.com/absmach/magistrala/pkg/jaeger"
 	"github.com/absmach/magistrala/pkg/permissions"
@@ -68,6 +70,7 @@ const (
 	envPrefixDB            = "MG_DOMAINS_DB_"
 	envPrefixAuth          = "MG_AUTH_GRPC_"
 	envPrefixDomainCallout = "MG_DOMAINS_CALLOUT_"
+	envPrefixOutbox        = "MG_DOMAINS_OUTBOX_"
 	defDB                  = "domains"
 	defSvcHTTPPort         = "9004"
 	defSvcGRPCPort         = "7004"
@@ -127,6 +130,7 @@ func main() {
 		exitCode = 1
 		return
 	}
+	dm.Migrations = append(dm.Migrations, pgclient.OutboxMigrations()...)
 
 	db, err := pgclient.Setup(dbConfig, *dm)
 	if err != nil {
@@ -235,13 +239,28 @@ func main() {
 		return
 	}
 
-	svc, err := newDomainService(ctx, domainsRepo, cache, tracer, cfg, authz, policyService, logger, call)
+	svc, err := newDomainService(ctx, database, domainsRepo, cache, tracer, cfg, authz, policyService, logger, call)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create %s service: %s", svcName, err.Error()))
 		exitCode = 1
 		return
 	}
 
+	relayConfig := pgclient.RelayConfig{}
+	if err := env.ParseWithOptions(&relayConfig, env.Options{Prefix: envPrefixOutbox}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s outbox relay configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	esPublisher, err := store.NewPublisher(ctx, cfg.ESURL, "domains-es-pub")
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create event store publisher : %s", err))
+		exitCode = 1
+		return
+	}
+	defer esPublisher.Close()
+	relay := pgclient.NewRelay(database, esPublisher, relayConfig, logger)
+
 	grpcServerConfig := server.Config{Port: defSvcGRPCPort}
 	if err := env.ParseWithOptions(&grpcServerConfig, env.Options{Prefix: envPrefixGrpc}); err != nil {
 		logger.Error(fmt.Sprintf("failed to load %s gRPC server configuration : %s", svcName, err.Error()))
@@ -278,6 +297,10 @@ func main() {
 		go chc.CallHome(ctx)
 	}
 
+	g.Go(func() error {
+		return relay.Start(ctx)
+	})
+
 	g.Go(func() error {
 		return server.StopSignalHandler(ctx, cancel, logger, svcName, hs, gs)
 	})
@@ -287,7 +310,7 @@ func main() {
 	}
 }
 
-func newDomainService(ctx context.Context, domainsRepo domainsSvc.Repository, cache domainsSvc.Cache, tracer trace.Tracer, cfg config, authz authz.Authorization, policiessvc policies.Service, logger *slog.Logger, callout callout.Callout) (domains.Service, error) {
+func newDomainService(ctx context.Context, database postgres.Database, domainsRepo domainsSvc.Repository, cache domainsSvc.Cache, tracer trace.Tracer, cfg config, authz authz.Authorization, policiessvc policies.Service, logger *slog.Logger, callout callout.Callout) (domains.Service, error) {
 	idProvider := uuid.New()
 	sidProvider, err := sid.New()
 	if err != nil {
@@ -308,10 +331,7 @@ func newDomainService(ctx context.Context, domainsRepo domainsSvc.Repository, ca
 	if err != nil {
 		return nil, fmt.Errorf("failed to init domain service: %w", err)
 	}
-	svc, err = events.NewEventStoreMiddleware(ctx, svc, cfg.ESURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to init domain event store middleware: %w", err)
-	}
+	svc = events.NewEventStoreMiddleware(svc, schema.NewPublisher(postgres.NewOutbox(database)))
 
 	domainOps, domainRoleOps, err := permConfig.GetEntityPermissions("domains")
 	if err != nil {
@@ -38,6 +38,8 @@ import (
 	"github.com/absmach/magistrala/pkg/callout"
 	dconsumer "github.com/absmach/magistrala/pkg/domains/events/consumer"
 	domainsAuthz "github.com/absmach/magistrala/pkg/domains/grpcclient"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/pkg/events/store"
 	"github.com/absmach/magistrala/pkg/grpcclient"
 	jaegerclient "github.com/absmach/magistrala/pkg/jaeger"
 	"github.com/absmach/magistrala/pkg/permissions"
@@ -75,6 +77,7 @@ const (
 	envPrefixChannels     = "MG_CHANNELS_GRPC_"
 	envPrefixClients      = "MG_CLIENTS_GRPC_"
 	envPrefixGroupCallout = "MG_GROUPS_CALLOUT_"
+	envPrefixOutbox       = "MG_GROUPS_OUTBOX_"
 	defDB                 = "groups"
 	defSvcHTTPPort        = "9004"
 	defSvcgRPCPort        = "7004"
@@ -134,6 +137,7 @@ func main() {
 		exitCode = 1
 		return
 	}
+	gm.Migrations = append(gm.Migrations, pgclient.OutboxMigrations()...)
 	db, err := pgclient.Setup(dbConfig, *gm)
 	if err != nil {
 		logger.Error(err.Error())
@@ -281,6 +285,21 @@ func main() {
 		return
 	}
 
+	relayConfig := pgclient.RelayConfig{}
+	if err := env.ParseWithOptions(&relayConfig, env.Options{Prefix: envPrefixOutbox}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s outbox relay configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	esPublisher, err := store.NewPublisher(ctx, cfg.ESURL, "groups-es-pub")
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create event store publisher : %s", err))
+		exitCode = 1
+		return
+	}
+	defer esPublisher.Close()
+	relay := pgclient.NewRelay(pg.NewDatabase(db, dbConfig, tracer), esPublisher, relayConfig, logger)
+
 	ddatabase := pg.NewDatabase(db, dbConfig, tracer)
 	drepo := dpostgres.NewRepository(ddatabase)
 
@@ -327,6 +346,10 @@ func main() {
 		return httpSrv.Start()
 	})
 
+	g.Go(func() error {
+		return relay.Start(ctx)
+	})
+
 	g.Go(func() error {
 		return server.StopSignalHandler(ctx, cancel, logger, svcName, httpSrv)
 	})
@@ -358,10 +381,7 @@ func newService(ctx context.Context, authz smqauthz.Authorization, policy polici
 	if err != nil {
 		return nil, nil, err
 	}
-	svc, err = events.New(ctx, svc, c.ESURL)
-	if err != nil {
-		return nil, nil, err
-	}
+	svc = events.New(svc, schema.NewPublisher(pg.NewOutbox(database)))
 
 	groupOps, groupRoleOps, err := permConfig.GetEntityPermissions("groups")
 	if err != nil {
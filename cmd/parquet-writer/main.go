@@ -0,0 +1,214 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package main contains parquet-writer main function to start the parquet-writer service.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/url"
+	"os"
+	"time"
+
+	chclient "github.com/absmach/callhome/pkg/client"
+	"github.com/absmach/magistrala"
+	"github.com/absmach/magistrala/consumers"
+	consumertracing "github.com/absmach/magistrala/consumers/tracing"
+	httpapi "github.com/absmach/magistrala/consumers/writers/api"
+	"github.com/absmach/magistrala/consumers/writers/batch"
+	"github.com/absmach/magistrala/consumers/writers/brokers"
+	"github.com/absmach/magistrala/consumers/writers/parquet"
+	mglog "github.com/absmach/magistrala/logger"
+	esstore "github.com/absmach/magistrala/pkg/events/store"
+	"github.com/absmach/magistrala/pkg/grpcclient"
+	jaegerclient "github.com/absmach/magistrala/pkg/jaeger"
+	brokerstracing "github.com/absmach/magistrala/pkg/messaging/brokers/tracing"
+	"github.com/absmach/magistrala/pkg/messaging/compression"
+	"github.com/absmach/magistrala/pkg/prometheus"
+	"github.com/absmach/magistrala/pkg/server"
+	httpserver "github.com/absmach/magistrala/pkg/server/http"
+	chtransformers "github.com/absmach/magistrala/pkg/transformers/channels"
+	"github.com/absmach/magistrala/pkg/uuid"
+	"github.com/caarlos0/env/v11"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName           = "parquet-writer"
+	envPrefixHTTP     = "MG_PARQUET_WRITER_HTTP_"
+	envPrefixChannels = "MG_CHANNELS_GRPC_"
+	envPrefixS3       = "MG_PARQUET_WRITER_S3_"
+	defSvcHTTPPort    = "9014"
+)
+
+type config struct {
+	LogLevel            string        `env:"MG_PARQUET_WRITER_LOG_LEVEL"             envDefault:"info"`
+	ConfigPath          string        `env:"MG_PARQUET_WRITER_CONFIG_PATH"           envDefault:"/config.toml"`
+	BrokerURL           string        `env:"MG_MESSAGE_BROKER_URL"                   envDefault:"nats://localhost:4222"`
+	JaegerURL           url.URL       `env:"MG_JAEGER_URL"                           envDefault:"http://localhost:4318/v1/traces"`
+	SendTelemetry       bool          `env:"MG_SEND_TELEMETRY"                       envDefault:"true"`
+	InstanceID          string        `env:"MG_PARQUET_WRITER_INSTANCE_ID"           envDefault:""`
+	TraceRatio          float64       `env:"MG_JAEGER_TRACE_RATIO"                   envDefault:"1.0"`
+	BatchSize           int           `env:"MG_PARQUET_WRITER_BATCH_SIZE"            envDefault:"10000"`
+	BatchInterval       time.Duration `env:"MG_PARQUET_WRITER_BATCH_INTERVAL"        envDefault:"10s"`
+	Prefix              string        `env:"MG_PARQUET_WRITER_PREFIX"                envDefault:""`
+	ChannelTransformers bool          `env:"MG_PARQUET_WRITER_CHANNEL_TRANSFORMERS"  envDefault:"false"`
+	RejectInterval      time.Duration `env:"MG_PARQUET_WRITER_REJECT_INTERVAL"       envDefault:"10s"`
+	ESURL               string        `env:"MG_ES_URL"                               envDefault:"nats://localhost:4222"`
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	cfg := config{}
+	if err := env.Parse(&cfg); err != nil {
+		log.Fatalf("failed to load %s configuration : %s", svcName, err)
+	}
+
+	logger, err := mglog.New(os.Stdout, cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("failed to init logger: %s", err.Error())
+	}
+
+	var exitCode int
+	defer mglog.ExitWithError(&exitCode)
+
+	if cfg.InstanceID == "" {
+		if cfg.InstanceID, err = uuid.New().ID(); err != nil {
+			logger.Error(fmt.Sprintf("failed to generate instanceID: %s", err))
+			exitCode = 1
+			return
+		}
+	}
+
+	httpServerConfig := server.Config{Port: defSvcHTTPPort}
+	if err := env.ParseWithOptions(&httpServerConfig, env.Options{Prefix: envPrefixHTTP}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s HTTP server configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+
+	s3Config := parquet.S3Config{}
+	if err := env.ParseWithOptions(&s3Config, env.Options{Prefix: envPrefixS3}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load %s object storage configuration : %s", svcName, err))
+		exitCode = 1
+		return
+	}
+	store, err := parquet.NewS3Store(ctx, s3Config)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+
+	tp, err := jaegerclient.NewProvider(ctx, svcName, cfg.JaegerURL, cfg.InstanceID, cfg.TraceRatio)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to init Jaeger: %s", err))
+		exitCode = 1
+		return
+	}
+	defer func() {
+		if err := tp.Shutdown(ctx); err != nil {
+			logger.Error(fmt.Sprintf("Error shutting down tracer provider: %v", err))
+		}
+	}()
+	tracer := tp.Tracer(svcName)
+
+	pubSub, err := brokers.NewPubSub(ctx, cfg.BrokerURL, logger)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to message broker: %s", err))
+		exitCode = 1
+		return
+	}
+	defer pubSub.Close()
+	compBytes, compRatio := prometheus.MakeCompressionMetrics("parquet", "message_compression")
+	if pubSub, err = compression.NewPubSub(compression.Config{}, pubSub, compBytes, compRatio); err != nil {
+		logger.Error(fmt.Sprintf("failed to create message decompression: %s", err))
+		exitCode = 1
+		return
+	}
+	pubSub = brokerstracing.NewPubSub(httpServerConfig, tracer, pubSub)
+
+	repo := consumertracing.NewAsync(tracer, newService(ctx, store, cfg, logger), httpServerConfig)
+
+	var channelTransformers *chtransformers.Registry
+	if cfg.ChannelTransformers {
+		channelsClientCfg := grpcclient.Config{}
+		if err := env.ParseWithOptions(&channelsClientCfg, env.Options{Prefix: envPrefixChannels}); err != nil {
+			logger.Error(fmt.Sprintf("failed to load channels gRPC client configuration : %s", err))
+			exitCode = 1
+			return
+		}
+		channelsClient, channelsHandler, err := grpcclient.SetupChannelsClient(ctx, channelsClientCfg)
+		if err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+		defer channelsHandler.Close()
+		logger.Info("Channels gRPC client connected " + channelsHandler.Secure())
+
+		esPublisher, err := esstore.NewPublisher(ctx, cfg.ESURL, svcName+"-es-pub")
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to create event store publisher: %s", err))
+			exitCode = 1
+			return
+		}
+		defer esPublisher.Close()
+
+		rejects := prometheus.MakeRejectMetrics("parquet", "payload_schema")
+		channelTransformers = chtransformers.NewRegistry(channelsClient, esPublisher, rejects, logger)
+		channelTransformers.Start(ctx, cfg.RejectInterval)
+		if err := chtransformers.Subscribe(ctx, channelTransformers, cfg.ESURL, fmt.Sprintf("%s-%s", svcName, cfg.InstanceID), logger); err != nil {
+			logger.Error(fmt.Sprintf("failed to subscribe to channel events: %s", err))
+			exitCode = 1
+			return
+		}
+	}
+
+	if err = consumers.Start(ctx, svcName, pubSub, repo, cfg.ConfigPath, brokers.AllTopic, channelTransformers, logger); err != nil {
+		logger.Error(fmt.Sprintf("failed to create Parquet writer: %s", err))
+		exitCode = 1
+		return
+	}
+
+	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(svcName, cfg.InstanceID), logger)
+
+	if cfg.SendTelemetry {
+		chc := chclient.New(svcName, magistrala.Version, logger, cancel)
+		go chc.CallHome(ctx)
+	}
+
+	g.Go(func() error {
+		return hs.Start()
+	})
+
+	g.Go(func() error {
+		return server.StopSignalHandler(ctx, cancel, logger, svcName, hs)
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("Parquet writer service terminated: %s", err))
+	}
+}
+
+func newService(ctx context.Context, store parquet.ObjectStore, cfg config, logger *slog.Logger) consumers.AsyncConsumer {
+	svc := batch.New(ctx, parquet.New(store, cfg.Prefix), batch.Config{Size: cfg.BatchSize, Interval: cfg.BatchInterval})
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-svc.Errors():
+				if err != nil {
+					logger.Warn(fmt.Sprintf("Async consumer failed to consume messages: %s", err))
+				}
+			}
+		}
+	}()
+	return svc
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/absmach/magistrala"
 	abrokers "github.com/absmach/magistrala/alarms/brokers"
 	grpcReadersV1 "github.com/absmach/magistrala/api/grpc/readers/v1"
+	cbrokers "github.com/absmach/magistrala/commands/brokers"
 	"github.com/absmach/magistrala/consumers/writers/brokers"
 	dpostgres "github.com/absmach/magistrala/domains/postgres"
 	"github.com/absmach/magistrala/internal/email"
@@ -35,6 +36,7 @@ import (
 	"github.com/absmach/magistrala/pkg/messaging"
 	smqbrokers "github.com/absmach/magistrala/pkg/messaging/brokers"
 	brokerstracing "github.com/absmach/magistrala/pkg/messaging/brokers/tracing"
+	"github.com/absmach/magistrala/pkg/messaging/compression"
 	"github.com/absmach/magistrala/pkg/permissions"
 	"github.com/absmach/magistrala/pkg/policies"
 	"github.com/absmach/magistrala/pkg/policies/spicedb"
@@ -81,21 +83,23 @@ const (
 const channBuffer = 256
 
 type config struct {
-	LogLevel            string        `env:"MG_RE_LOG_LEVEL"             envDefault:"info"`
-	InstanceID          string        `env:"MG_RE_INSTANCE_ID"           envDefault:""`
-	JaegerURL           url.URL       `env:"MG_JAEGER_URL"              envDefault:"http://localhost:4318/v1/traces"`
-	SendTelemetry       bool          `env:"MG_SEND_TELEMETRY"          envDefault:"true"`
-	ESURL               string        `env:"MG_ES_URL"                  envDefault:"nats://localhost:4222"`
-	ESConsumerName      string        `env:"MG_RE_EVENT_CONSUMER"        envDefault:"rules_engine"`
-	CacheURL            string        `env:"MG_RE_CACHE_URL"             envDefault:"redis://localhost:6379/0"`
-	CacheKeyDuration    time.Duration `env:"MG_RE_CACHE_KEY_DURATION"    envDefault:"10m"`
-	TraceRatio          float64       `env:"MG_JAEGER_TRACE_RATIO"      envDefault:"1.0"`
-	BrokerURL           string        `env:"MG_MESSAGE_BROKER_URL"      envDefault:"nats://localhost:4222"`
-	SpicedbHost         string        `env:"MG_SPICEDB_HOST"            envDefault:"localhost"`
-	SpicedbPort         string        `env:"MG_SPICEDB_PORT"            envDefault:"50051"`
-	SpicedbPreSharedKey string        `env:"MG_SPICEDB_PRE_SHARED_KEY"  envDefault:"12345678"`
-	SpicedbSchemaFile   string        `env:"MG_SPICEDB_SCHEMA_FILE"     envDefault:"schema.zed"`
-	PermissionsFile     string        `env:"MG_PERMISSIONS_FILE"        envDefault:"permission.yaml"`
+	LogLevel            string        `env:"MG_RE_LOG_LEVEL"                        envDefault:"info"`
+	InstanceID          string        `env:"MG_RE_INSTANCE_ID"                      envDefault:""`
+	JaegerURL           url.URL       `env:"MG_JAEGER_URL"                          envDefault:"http://localhost:4318/v1/traces"`
+	SendTelemetry       bool          `env:"MG_SEND_TELEMETRY"                      envDefault:"true"`
+	ESURL               string        `env:"MG_ES_URL"                              envDefault:"nats://localhost:4222"`
+	ESConsumerName      string        `env:"MG_RE_EVENT_CONSUMER"                   envDefault:"rules_engine"`
+	CacheURL            string        `env:"MG_RE_CACHE_URL"                        envDefault:"redis://localhost:6379/0"`
+	CacheKeyDuration    time.Duration `env:"MG_RE_CACHE_KEY_DURATION"               envDefault:"10m"`
+	TraceRatio          float64       `env:"MG_JAEGER_TRACE_RATIO"                  envDefault:"1.0"`
+	BrokerURL           string        `env:"MG_MESSAGE_BROKER_URL"                  envDefault:"nats://localhost:4222"`
+	BrokerCompression   string        `env:"MG_MESSAGE_BROKER_COMPRESSION"          envDefault:"none"`
+	BrokerCompressMin   int           `env:"MG_MESSAGE_BROKER_COMPRESSION_MIN_SIZE" envDefault:"1024"`
+	SpicedbHost         string        `env:"MG_SPICEDB_HOST"                        envDefault:"localhost"`
+	SpicedbPort         string        `env:"MG_SPICEDB_PORT"                        envDefault:"50051"`
+	SpicedbPreSharedKey string        `env:"MG_SPICEDB_PRE_SHARED_KEY"              envDefault:"12345678"`
+	SpicedbSchemaFile   string        `env:"MG_SPICEDB_SCHEMA_FILE"                 envDefault:"schema.zed"`
+	PermissionsFile     string        `env:"MG_PERMISSIONS_FILE"                    envDefault:"permission.yaml"`
 }
 
 func main() {
@@ -199,8 +203,18 @@ func main() {
 		return
 	}
 	defer msgSub.Close()
+	// Messages on the default topic may reach devices, so they are decompressed but never compressed.
+	compBytes, compRatio := prometheus.MakeCompressionMetrics("re", "message_compression")
+	if msgSub, err = compression.NewPubSub(compression.Config{}, msgSub, compBytes, compRatio); err != nil {
+		logger.Error(fmt.Sprintf("failed to create message decompression: %s", err))
+		exitCode = 1
+
+		return
+	}
 	msgSub = brokerstracing.NewPubSub(httpServerConfig, tracer, msgSub)
 
+	compCfg := compression.Config{Encoding: cfg.BrokerCompression, MinSize: cfg.BrokerCompressMin}
+
 	writersPub, err := brokers.NewPublisher(ctx, cfg.BrokerURL)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to connect to message broker for writers publisher: %s", err))
@@ -209,6 +223,12 @@ func main() {
 		return
 	}
 	defer writersPub.Close()
+	if writersPub, err = compression.NewPublisher(compCfg, writersPub, compBytes, compRatio); err != nil {
+		logger.Error(fmt.Sprintf("failed to create message compression for writers publisher: %s", err))
+		exitCode = 1
+
+		return
+	}
 	writersPub = brokerstracing.NewPublisher(httpServerConfig, tracer, writersPub)
 
 	alarmsPub, err := abrokers.NewPublisher(ctx, cfg.BrokerURL)
@@ -219,8 +239,30 @@ func main() {
 		return
 	}
 	defer alarmsPub.Close()
+	if alarmsPub, err = compression.NewPublisher(compCfg, alarmsPub, compBytes, compRatio); err != nil {
+		logger.Error(fmt.Sprintf("failed to create message compression for alarms publisher: %s", err))
+		exitCode = 1
+
+		return
+	}
 	alarmsPub = brokerstracing.NewPublisher(httpServerConfig, tracer, alarmsPub)
 
+	commandsPub, err := cbrokers.NewPublisher(ctx, cfg.BrokerURL)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to message broker for commands publisher: %s", err))
+		exitCode = 1
+
+		return
+	}
+	defer commandsPub.Close()
+	if commandsPub, err = compression.NewPublisher(compCfg, commandsPub, compBytes, compRatio); err != nil {
+		logger.Error(fmt.Sprintf("failed to create message compression for commands publisher: %s", err))
+		exitCode = 1
+
+		return
+	}
+	commandsPub = brokerstracing.NewPublisher(httpServerConfig, tracer, commandsPub)
+
 	grpcCfg := grpcclient.Config{}
 	if err := env.ParseWithOptions(&grpcCfg, env.Options{Prefix: envPrefixAuth}); err != nil {
 		logger.Error(fmt.Sprintf("failed to load auth gRPC client configuration : %s", err))
@@ -292,7 +334,7 @@ func main() {
 	readersClient := grpcClient.NewReadersClient(client.Connection(), regrpcCfg.Timeout)
 	logger.Info("Readers gRPC client successfully connected to readers gRPC server " + client.Secure())
 
-	svc, err := newService(ctx, cfg, database, runInfo, msgSub, writersPub, alarmsPub, authz, ec, logger, readersClient, callout, tracer)
+	svc, err := newService(ctx, cfg, database, runInfo, msgSub, writersPub, alarmsPub, commandsPub, authz, ec, logger, readersClient, callout, tracer)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create services: %s", err))
 		exitCode = 1
@@ -344,7 +386,7 @@ func main() {
 	}
 }
 
-func newService(ctx context.Context, cfg config, db pgclient.Database, runInfo chan pkglog.RunInfo, rePubSub messaging.PubSub, writersPub, alarmsPub messaging.Publisher, authz mgauthz.Authorization, ec email.Config, logger *slog.Logger, readersClient grpcReadersV1.ReadersServiceClient, callout callout.Callout, tracer trace.Tracer) (re.Service, error) {
+func newService(ctx context.Context, cfg config, db pgclient.Database, runInfo chan pkglog.RunInfo, rePubSub messaging.PubSub, writersPub, alarmsPub, commandsPub messaging.Publisher, authz mgauthz.Authorization, ec email.Config, logger *slog.Logger, readersClient grpcReadersV1.ReadersServiceClient, callout callout.Callout, tracer trace.Tracer) (re.Service, error) {
 	repo := repg.NewRepository(db)
 	idp := uuid.New()
 
@@ -364,7 +406,7 @@ func newService(ctx context.Context, cfg config, db pgclient.Database, runInfo c
 		return nil, fmt.Errorf("failed to get available actions and built-in roles: %w", err)
 	}
 
-	csvc, err := re.NewService(repo, runInfo, policyService, idp, rePubSub, writersPub, alarmsPub, ticker.NewTicker(time.Second*30), emailerClient, readersClient, availableActions, builtInRoles)
+	csvc, err := re.NewService(repo, runInfo, policyService, idp, rePubSub, writersPub, alarmsPub, commandsPub, ticker.NewTicker(time.Second*30), emailerClient, readersClient, availableActions, builtInRoles)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create RE service: %w", err)
 	}
@@ -11,21 +11,34 @@ import (
 	"log/slog"
 	"net/url"
 	"os"
+	"time"
 
 	chclient "github.com/absmach/callhome/pkg/client"
 	"github.com/absmach/magistrala"
+	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
 	"github.com/absmach/magistrala/consumers"
 	consumertracing "github.com/absmach/magistrala/consumers/tracing"
 	httpapi "github.com/absmach/magistrala/consumers/writers/api"
+	"github.com/absmach/magistrala/consumers/writers/batch"
 	"github.com/absmach/magistrala/consumers/writers/brokers"
+	"github.com/absmach/magistrala/consumers/writers/deadletter"
+	dlapi "github.com/absmach/magistrala/consumers/writers/deadletter/api"
+	dlpg "github.com/absmach/magistrala/consumers/writers/deadletter/postgres"
 	"github.com/absmach/magistrala/consumers/writers/timescale"
 	mglog "github.com/absmach/magistrala/logger"
+	smqauthn "github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/authn/authsvc"
+	"github.com/absmach/magistrala/pkg/events/store"
+	"github.com/absmach/magistrala/pkg/grpcclient"
 	jaegerclient "github.com/absmach/magistrala/pkg/jaeger"
 	brokerstracing "github.com/absmach/magistrala/pkg/messaging/brokers/tracing"
+	"github.com/absmach/magistrala/pkg/messaging/compression"
 	pgclient "github.com/absmach/magistrala/pkg/postgres"
 	"github.com/absmach/magistrala/pkg/prometheus"
+	"github.com/absmach/magistrala/pkg/retention"
 	"github.com/absmach/magistrala/pkg/server"
 	httpserver "github.com/absmach/magistrala/pkg/server/http"
+	chtransformers "github.com/absmach/magistrala/pkg/transformers/channels"
 	"github.com/absmach/magistrala/pkg/uuid"
 	"github.com/caarlos0/env/v11"
 	"github.com/jmoiron/sqlx"
@@ -33,21 +46,34 @@ import (
 )
 
 const (
-	svcName        = "timescaledb-writer"
-	envPrefixDB    = "MG_TIMESCALE_"
-	envPrefixHTTP  = "MG_TIMESCALE_WRITER_HTTP_"
-	defDB          = "messages"
-	defSvcHTTPPort = "9012"
+	svcName           = "timescaledb-writer"
+	envPrefixDB       = "MG_TIMESCALE_"
+	envPrefixHTTP     = "MG_TIMESCALE_WRITER_HTTP_"
+	envPrefixChannels = "MG_CHANNELS_GRPC_"
+	envPrefixDomains  = "MG_DOMAINS_GRPC_"
+	envPrefixAuth     = "MG_AUTH_GRPC_"
+	defDB             = "messages"
+	defSvcHTTPPort    = "9012"
 )
 
 type config struct {
-	LogLevel      string  `env:"MG_TIMESCALE_WRITER_LOG_LEVEL"     envDefault:"info"`
-	ConfigPath    string  `env:"MG_TIMESCALE_WRITER_CONFIG_PATH"   envDefault:"/config.toml"`
-	BrokerURL     string  `env:"MG_MESSAGE_BROKER_URL"            envDefault:"nats://localhost:4222"`
-	JaegerURL     url.URL `env:"MG_JAEGER_URL"                    envDefault:"http://localhost:4318/v1/traces"`
-	SendTelemetry bool    `env:"MG_SEND_TELEMETRY"                envDefault:"true"`
-	InstanceID    string  `env:"MG_TIMESCALE_WRITER_INSTANCE_ID"   envDefault:""`
-	TraceRatio    float64 `env:"MG_JAEGER_TRACE_RATIO"            envDefault:"1.0"`
+	LogLevel            string        `env:"MG_TIMESCALE_WRITER_LOG_LEVEL"             envDefault:"info"`
+	ConfigPath          string        `env:"MG_TIMESCALE_WRITER_CONFIG_PATH"           envDefault:"/config.toml"`
+	BrokerURL           string        `env:"MG_MESSAGE_BROKER_URL"                     envDefault:"nats://localhost:4222"`
+	JaegerURL           url.URL       `env:"MG_JAEGER_URL"                             envDefault:"http://localhost:4318/v1/traces"`
+	SendTelemetry       bool          `env:"MG_SEND_TELEMETRY"                         envDefault:"true"`
+	InstanceID          string        `env:"MG_TIMESCALE_WRITER_INSTANCE_ID"           envDefault:""`
+	TraceRatio          float64       `env:"MG_JAEGER_TRACE_RATIO"                     envDefault:"1.0"`
+	BatchSize           int           `env:"MG_TIMESCALE_WRITER_BATCH_SIZE"            envDefault:"0"`
+	BatchInterval       time.Duration `env:"MG_TIMESCALE_WRITER_BATCH_INTERVAL"        envDefault:"1s"`
+	BatchMaxBuffered    int           `env:"MG_TIMESCALE_WRITER_BATCH_MAX_BUFFERED"    envDefault:"0"`
+	DeadLetterAttempts  uint64        `env:"MG_TIMESCALE_WRITER_DEADLETTER_ATTEMPTS"   envDefault:"5"`
+	ChannelTransformers bool          `env:"MG_TIMESCALE_WRITER_CHANNEL_TRANSFORMERS"  envDefault:"false"`
+	RejectInterval      time.Duration `env:"MG_TIMESCALE_WRITER_REJECT_INTERVAL"       envDefault:"10s"`
+	Retention           bool          `env:"MG_TIMESCALE_WRITER_RETENTION"             envDefault:"false"`
+	RetentionPeriod     string        `env:"MG_TIMESCALE_WRITER_RETENTION_PERIOD"      envDefault:""`
+	RetentionInterval   time.Duration `env:"MG_TIMESCALE_WRITER_RETENTION_INTERVAL"    envDefault:"1h"`
+	ESURL               string        `env:"MG_ES_URL"                                 envDefault:"nats://localhost:4222"`
 }
 
 func main() {
@@ -88,7 +114,9 @@ func main() {
 		exitCode = 1
 		return
 	}
-	db, err := pgclient.Setup(dbConfig, *timescale.Migration())
+	migration := timescale.Migration()
+	migration.Migrations = append(migration.Migrations, dlpg.Migration().Migrations...)
+	db, err := pgclient.Setup(dbConfig, *migration)
 	if err != nil {
 		logger.Error(err.Error())
 		exitCode = 1
@@ -109,8 +137,10 @@ func main() {
 	}()
 	tracer := tp.Tracer(svcName)
 
-	repo := newService(db, logger)
-	repo = consumertracing.NewBlocking(tracer, repo, httpServerConfig)
+	var repo any = consumertracing.NewBlocking(tracer, newService(db, logger), httpServerConfig)
+	if cfg.BatchSize > 0 {
+		repo = consumertracing.NewAsync(tracer, newBatchService(ctx, db, cfg, logger), httpServerConfig)
+	}
 
 	pubSub, err := brokers.NewPubSub(ctx, cfg.BrokerURL, logger)
 	if err != nil {
@@ -119,15 +149,114 @@ func main() {
 		return
 	}
 	defer pubSub.Close()
+	compBytes, compRatio := prometheus.MakeCompressionMetrics("timescale", "message_compression")
+	if pubSub, err = compression.NewPubSub(compression.Config{}, pubSub, compBytes, compRatio); err != nil {
+		logger.Error(fmt.Sprintf("failed to create message decompression: %s", err))
+		exitCode = 1
+		return
+	}
 	pubSub = brokerstracing.NewPubSub(httpServerConfig, tracer, pubSub)
 
-	if err = consumers.Start(ctx, svcName, pubSub, repo, cfg.ConfigPath, brokers.AllTopic, logger); err != nil {
+	dlRepo := dlpg.New(pgclient.NewDatabase(db, dbConfig, tracer))
+	dlSub := deadletter.NewSubscriber(pubSub, dlRepo, uuid.New(), cfg.DeadLetterAttempts, logger)
+	dlSvc := deadletter.New(dlRepo, dlSub)
+
+	authnCfg := grpcclient.Config{}
+	if err := env.ParseWithOptions(&authnCfg, env.Options{Prefix: envPrefixAuth}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load auth gRPC client configuration : %s", err))
+		exitCode = 1
+		return
+	}
+	authn, authnHandler, err := authsvc.NewAuthentication(ctx, authnCfg)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	defer authnHandler.Close()
+	logger.Info("authn successfully connected to auth gRPC server " + authnHandler.Secure())
+	am := smqauthn.NewAuthNMiddleware(authn)
+
+	var channelsClient grpcChannelsV1.ChannelsServiceClient
+	if cfg.ChannelTransformers || cfg.Retention {
+		channelsClientCfg := grpcclient.Config{}
+		if err := env.ParseWithOptions(&channelsClientCfg, env.Options{Prefix: envPrefixChannels}); err != nil {
+			logger.Error(fmt.Sprintf("failed to load channels gRPC client configuration : %s", err))
+			exitCode = 1
+			return
+		}
+		var channelsHandler grpcclient.Handler
+		channelsClient, channelsHandler, err = grpcclient.SetupChannelsClient(ctx, channelsClientCfg)
+		if err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+		defer channelsHandler.Close()
+		logger.Info("Channels gRPC client connected " + channelsHandler.Secure())
+	}
+
+	var channelTransformers *chtransformers.Registry
+	if cfg.ChannelTransformers {
+		esPublisher, err := store.NewPublisher(ctx, cfg.ESURL, svcName+"-es-pub")
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to create event store publisher: %s", err))
+			exitCode = 1
+			return
+		}
+		defer esPublisher.Close()
+
+		rejects := prometheus.MakeRejectMetrics("timescale", "payload_schema")
+		channelTransformers = chtransformers.NewRegistry(channelsClient, esPublisher, rejects, logger)
+		channelTransformers.Start(ctx, cfg.RejectInterval)
+		if err := chtransformers.Subscribe(ctx, channelTransformers, cfg.ESURL, fmt.Sprintf("%s-%s", svcName, cfg.InstanceID), logger); err != nil {
+			logger.Error(fmt.Sprintf("failed to subscribe to channel events: %s", err))
+			exitCode = 1
+			return
+		}
+	}
+
+	if err = consumers.Start(ctx, svcName, dlSub, repo, cfg.ConfigPath, brokers.AllTopic, channelTransformers, logger); err != nil {
 		logger.Error(fmt.Sprintf("failed to create Timescale writer: %s", err))
 		exitCode = 1
 		return
 	}
 
-	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(svcName, cfg.InstanceID), logger)
+	if cfg.Retention {
+		period, err := retention.ParsePeriod(cfg.RetentionPeriod)
+		if err != nil {
+			logger.Error(fmt.Sprintf("failed to parse retention period : %s", err))
+			exitCode = 1
+			return
+		}
+		if cfg.RetentionInterval <= 0 {
+			logger.Error(fmt.Sprintf("invalid retention interval : %s", cfg.RetentionInterval))
+			exitCode = 1
+			return
+		}
+
+		domainsClientCfg := grpcclient.Config{}
+		if err := env.ParseWithOptions(&domainsClientCfg, env.Options{Prefix: envPrefixDomains}); err != nil {
+			logger.Error(fmt.Sprintf("failed to load domains gRPC client configuration : %s", err))
+			exitCode = 1
+			return
+		}
+		domainsClient, domainsHandler, err := grpcclient.SetupDomainsClient(ctx, domainsClientCfg)
+		if err != nil {
+			logger.Error(err.Error())
+			exitCode = 1
+			return
+		}
+		defer domainsHandler.Close()
+		logger.Info("Domains gRPC client connected " + domainsHandler.Secure())
+
+		enforcer := retention.NewEnforcer(timescale.NewRetentionStore(db), channelsClient, domainsClient, retention.Policy{Period: period}, logger)
+		g.Go(func() error {
+			return enforcer.Run(ctx, cfg.RetentionInterval)
+		})
+	}
+
+	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, dlapi.MakeHandler(dlSvc, am, logger, svcName, cfg.InstanceID), logger)
 
 	if cfg.SendTelemetry {
 		chc := chclient.New(svcName, magistrala.Version, logger, cancel)
@@ -154,3 +283,20 @@ func newService(db *sqlx.DB, logger *slog.Logger) consumers.BlockingConsumer {
 	svc = httpapi.MetricsMiddleware(svc, counter, latency)
 	return svc
 }
+
+func newBatchService(ctx context.Context, db *sqlx.DB, cfg config, logger *slog.Logger) consumers.AsyncConsumer {
+	svc := batch.New(ctx, timescale.NewBatch(db), batch.Config{Size: cfg.BatchSize, Interval: cfg.BatchInterval, MaxBuffered: cfg.BatchMaxBuffered})
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-svc.Errors():
+				if err != nil {
+					logger.Warn(fmt.Sprintf("Async consumer failed to consume messages: %s", err))
+				}
+			}
+		}
+	}()
+	return svc
+}
@@ -0,0 +1,78 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/commands"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func sendCommandEndpoint(svc commands.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(sendCommandReq)
+		if err := req.validate(); err != nil {
+			return commandRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return commandRes{}, svcerr.ErrAuthorization
+		}
+
+		cmd, err := svc.SendCommand(ctx, session, req.command())
+		if err != nil {
+			return commandRes{}, err
+		}
+
+		return commandRes{Command: cmd, created: true}, nil
+	}
+}
+
+func viewCommandEndpoint(svc commands.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(viewCommandReq)
+		if err := req.validate(); err != nil {
+			return commandRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return commandRes{}, svcerr.ErrAuthorization
+		}
+
+		cmd, err := svc.ViewCommand(ctx, session, req.id)
+		if err != nil {
+			return commandRes{}, err
+		}
+
+		return commandRes{Command: cmd}, nil
+	}
+}
+
+func listCommandsEndpoint(svc commands.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(listCommandsReq)
+		if err := req.validate(); err != nil {
+			return commandsPageRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return commandsPageRes{}, svcerr.ErrAuthorization
+		}
+
+		page, err := svc.ListCommands(ctx, session, req.PageMetadata)
+		if err != nil {
+			return commandsPageRes{}, err
+		}
+
+		return commandsPageRes{CommandsPage: page}, nil
+	}
+}
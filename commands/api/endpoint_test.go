@@ -0,0 +1,402 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/commands"
+	"github.com/absmach/magistrala/commands/api"
+	"github.com/absmach/magistrala/commands/mocks"
+	"github.com/absmach/magistrala/internal/testsutil"
+	mglog "github.com/absmach/magistrala/logger"
+	smqauthn "github.com/absmach/magistrala/pkg/authn"
+	authnmocks "github.com/absmach/magistrala/pkg/authn/mocks"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const contentType = "application/json"
+
+var (
+	domainID     = testsutil.GenerateUUID(&testing.T{})
+	userID       = testsutil.GenerateUUID(&testing.T{})
+	channelID    = testsutil.GenerateUUID(&testing.T{})
+	clientID     = testsutil.GenerateUUID(&testing.T{})
+	validToken   = "valid"
+	invalidToken = "invalid"
+	session      = smqauthn.Session{UserID: userID, DomainID: domainID}
+	command      = commands.Command{
+		ID:        testsutil.GenerateUUID(&testing.T{}),
+		DomainID:  domainID,
+		ChannelID: channelID,
+		ClientID:  clientID,
+		Name:      "reboot",
+		Payload:   json.RawMessage(`{"delay":5}`),
+		Status:    commands.PublishedStatus,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+		CreatedAt: time.Now().UTC(),
+		CreatedBy: userID,
+	}
+)
+
+type testRequest struct {
+	client      *http.Client
+	method      string
+	url         string
+	contentType string
+	token       string
+	body        io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
+
+	return tr.client.Do(req)
+}
+
+type respBody struct {
+	Err     string          `json:"error"`
+	Message string          `json:"message"`
+	ID      string          `json:"id"`
+	Status  commands.Status `json:"status"`
+	Total   uint64          `json:"total"`
+}
+
+func newCommandsServer() (*httptest.Server, *mocks.Service, *authnmocks.Authentication) {
+	svc := new(mocks.Service)
+	authn := new(authnmocks.Authentication)
+
+	logger := mglog.NewMock()
+	am := smqauthn.NewAuthNMiddleware(authn, smqauthn.WithAllowUnverifiedUser(true))
+	mux := api.MakeHandler(svc, logger, uuid.NewMock(), "", am)
+
+	return httptest.NewServer(mux), svc, authn
+}
+
+func TestSendCommandEndpoint(t *testing.T) {
+	ts, svc, authn := newCommandsServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc        string
+		data        string
+		token       string
+		contentType string
+		authnErr    error
+		cmd         commands.Command
+		svcErr      error
+		status      int
+		err         error
+	}{
+		{
+			desc:        "send command successfully",
+			data:        fmt.Sprintf(`{"channel_id":"%s","client_id":"%s","name":"reboot","payload":{"delay":5}}`, channelID, clientID),
+			token:       validToken,
+			contentType: contentType,
+			cmd:         commands.Command{ChannelID: channelID, ClientID: clientID, Name: "reboot", Payload: json.RawMessage(`{"delay":5}`)},
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "send command with invalid token",
+			data:        fmt.Sprintf(`{"channel_id":"%s","client_id":"%s","name":"reboot"}`, channelID, clientID),
+			token:       invalidToken,
+			contentType: contentType,
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "send command with empty token",
+			data:        fmt.Sprintf(`{"channel_id":"%s","client_id":"%s","name":"reboot"}`, channelID, clientID),
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+			err:         apiutil.ErrBearerToken,
+		},
+		{
+			desc:        "send command with invalid content type",
+			data:        fmt.Sprintf(`{"channel_id":"%s","client_id":"%s","name":"reboot"}`, channelID, clientID),
+			token:       validToken,
+			contentType: "application/xml",
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+		{
+			desc:        "send command with malformed body",
+			data:        `{"channel_id":`,
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMalformedRequestBody,
+		},
+		{
+			desc:        "send command without channel",
+			data:        fmt.Sprintf(`{"client_id":"%s","name":"reboot"}`, clientID),
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingChannelID,
+		},
+		{
+			desc:        "send command without client",
+			data:        fmt.Sprintf(`{"channel_id":"%s","name":"reboot"}`, channelID),
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingClientID,
+		},
+		{
+			desc:        "send command without name",
+			data:        fmt.Sprintf(`{"channel_id":"%s","client_id":"%s"}`, channelID, clientID),
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingCommandName,
+		},
+		{
+			desc:        "send command with timeout over maximum",
+			data:        fmt.Sprintf(`{"channel_id":"%s","client_id":"%s","name":"reboot","timeout":86401}`, channelID, clientID),
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidCommandTimeout,
+		},
+		{
+			desc:        "send command with service error",
+			data:        fmt.Sprintf(`{"channel_id":"%s","client_id":"%s","name":"reboot"}`, channelID, clientID),
+			token:       validToken,
+			contentType: contentType,
+			cmd:         commands.Command{ChannelID: channelID, ClientID: clientID, Name: "reboot"},
+			svcErr:      svcerr.ErrCreateEntity,
+			status:      http.StatusUnprocessableEntity,
+			err:         svcerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/%s/commands", ts.URL, domainID),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(tc.data),
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(session, tc.authnErr)
+			svcCall := svc.On("SendCommand", mock.Anything, session, tc.cmd).Return(command, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var resBody respBody
+			err = json.NewDecoder(res.Body).Decode(&resBody)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if resBody.Err != "" || resBody.Message != "" {
+				err = errors.Wrap(errors.New(resBody.Err), errors.New(resBody.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				assert.Equal(t, command.ID, resBody.ID)
+				assert.Equal(t, fmt.Sprintf("/%s/commands/%s", domainID, command.ID), res.Header.Get("Location"))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestViewCommandEndpoint(t *testing.T) {
+	ts, svc, authn := newCommandsServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc     string
+		id       string
+		token    string
+		authnErr error
+		svcErr   error
+		status   int
+		err      error
+	}{
+		{
+			desc:   "view command successfully",
+			id:     command.ID,
+			token:  validToken,
+			status: http.StatusOK,
+		},
+		{
+			desc:     "view command with invalid token",
+			id:       command.ID,
+			token:    invalidToken,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:   "view non-existing command",
+			id:     testsutil.GenerateUUID(t),
+			token:  validToken,
+			svcErr: svcerr.ErrNotFound,
+			status: http.StatusNotFound,
+			err:    svcerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ts.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/%s/commands/%s", ts.URL, domainID, tc.id),
+				token:  tc.token,
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(session, tc.authnErr)
+			svcCall := svc.On("ViewCommand", mock.Anything, session, tc.id).Return(command, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var resBody respBody
+			err = json.NewDecoder(res.Body).Decode(&resBody)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if resBody.Err != "" || resBody.Message != "" {
+				err = errors.Wrap(errors.New(resBody.Err), errors.New(resBody.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				assert.Equal(t, command.ID, resBody.ID)
+				assert.Equal(t, commands.PublishedStatus, resBody.Status)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestListCommandsEndpoint(t *testing.T) {
+	ts, svc, authn := newCommandsServer()
+	defer ts.Close()
+
+	page := commands.CommandsPage{Total: 1, Limit: 10, Commands: []commands.Command{command}}
+
+	cases := []struct {
+		desc     string
+		query    string
+		token    string
+		authnErr error
+		pm       commands.PageMetadata
+		svcErr   error
+		status   int
+		err      error
+	}{
+		{
+			desc:   "list commands successfully",
+			token:  validToken,
+			pm:     commands.PageMetadata{Limit: 10, Status: commands.AllStatus, Order: "created_at", Dir: "desc"},
+			status: http.StatusOK,
+		},
+		{
+			desc:   "list commands with filters",
+			query:  fmt.Sprintf("?client_id=%s&channel_id=%s&name=reboot&status=published&dir=asc&offset=1&limit=5", clientID, channelID),
+			token:  validToken,
+			pm:     commands.PageMetadata{Offset: 1, Limit: 5, ClientID: clientID, ChannelID: channelID, Name: "reboot", Status: commands.PublishedStatus, Order: "created_at", Dir: "asc"},
+			status: http.StatusOK,
+		},
+		{
+			desc:     "list commands with invalid token",
+			token:    invalidToken,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:   "list commands with invalid status",
+			query:  "?status=delivered",
+			token:  validToken,
+			status: http.StatusBadRequest,
+			err:    svcerr.ErrInvalidStatus,
+		},
+		{
+			desc:   "list commands with invalid limit",
+			query:  "?limit=1000",
+			token:  validToken,
+			status: http.StatusBadRequest,
+			err:    apiutil.ErrLimitSize,
+		},
+		{
+			desc:   "list commands with invalid order",
+			query:  "?order=name",
+			token:  validToken,
+			status: http.StatusBadRequest,
+			err:    apiutil.ErrInvalidOrder,
+		},
+		{
+			desc:   "list commands with invalid direction",
+			query:  "?dir=up",
+			token:  validToken,
+			status: http.StatusBadRequest,
+			err:    apiutil.ErrInvalidDirection,
+		},
+		{
+			desc:   "list commands with service error",
+			token:  validToken,
+			pm:     commands.PageMetadata{Limit: 10, Status: commands.AllStatus, Order: "created_at", Dir: "desc"},
+			svcErr: svcerr.ErrViewEntity,
+			status: http.StatusUnprocessableEntity,
+			err:    svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ts.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/%s/commands%s", ts.URL, domainID, tc.query),
+				token:  tc.token,
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(session, tc.authnErr)
+			svcCall := svc.On("ListCommands", mock.Anything, session, tc.pm).Return(page, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var resBody respBody
+			err = json.NewDecoder(res.Body).Decode(&resBody)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if resBody.Err != "" || resBody.Message != "" {
+				err = errors.Wrap(errors.New(resBody.Err), errors.New(resBody.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				assert.Equal(t, page.Total, resBody.Total)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"time"
+
+	api "github.com/absmach/magistrala/api/http"
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/commands"
+)
+
+// maxTimeout is the longest time the command can wait for the response.
+const maxTimeout = 24 * time.Hour
+
+type sendCommandReq struct {
+	ChannelID string          `json:"channel_id"`
+	ClientID  string          `json:"client_id"`
+	Name      string          `json:"name"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+	// Timeout is the number of seconds to wait for the client response.
+	Timeout uint64 `json:"timeout,omitempty"`
+}
+
+func (req sendCommandReq) validate() error {
+	if req.ChannelID == "" {
+		return apiutil.ErrMissingChannelID
+	}
+	if req.ClientID == "" {
+		return apiutil.ErrMissingClientID
+	}
+	if req.Name == "" {
+		return apiutil.ErrMissingCommandName
+	}
+	if time.Duration(req.Timeout)*time.Second > maxTimeout {
+		return apiutil.ErrInvalidCommandTimeout
+	}
+
+	return nil
+}
+
+func (req sendCommandReq) command() commands.Command {
+	cmd := commands.Command{
+		ChannelID: req.ChannelID,
+		ClientID:  req.ClientID,
+		Name:      req.Name,
+		Payload:   req.Payload,
+	}
+	if req.Timeout > 0 {
+		cmd.ExpiresAt = time.Now().UTC().Add(time.Duration(req.Timeout) * time.Second)
+	}
+
+	return cmd
+}
+
+type viewCommandReq struct {
+	id string
+}
+
+func (req viewCommandReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type listCommandsReq struct {
+	commands.PageMetadata
+}
+
+func (req listCommandsReq) validate() error {
+	if req.Limit > api.MaxLimitSize || req.Limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+	if req.Order != "" && req.Order != api.UpdatedAtOrder && req.Order != api.CreatedAtOrder {
+		return apiutil.ErrInvalidOrder
+	}
+	if req.Dir != api.AscDir && req.Dir != api.DescDir {
+		return apiutil.ErrInvalidDirection
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/absmach/magistrala"
+	"github.com/absmach/magistrala/commands"
+)
+
+var (
+	_ magistrala.Response = (*commandRes)(nil)
+	_ magistrala.Response = (*commandsPageRes)(nil)
+)
+
+type commandRes struct {
+	commands.Command `json:",inline"`
+	created          bool
+}
+
+func (res commandRes) Headers() map[string]string {
+	if res.created {
+		return map[string]string{
+			"Location": fmt.Sprintf("/%s/commands/%s", res.DomainID, res.ID),
+		}
+	}
+
+	return map[string]string{}
+}
+
+func (res commandRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res commandRes) Empty() bool {
+	return false
+}
+
+type commandsPageRes struct {
+	commands.CommandsPage `json:",inline"`
+}
+
+func (res commandsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res commandsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res commandsPageRes) Empty() bool {
+	return false
+}
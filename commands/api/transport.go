@@ -0,0 +1,136 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/absmach/magistrala"
+	api "github.com/absmach/magistrala/api/http"
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/commands"
+	smqauthn "github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/go-chi/chi/v5"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+func MakeHandler(svc commands.Service, logger *slog.Logger, idp magistrala.IDProvider, instanceID string, authn smqauthn.AuthNMiddleware) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, api.EncodeError)),
+	}
+
+	mux := chi.NewRouter()
+
+	mux.Route("/{domainID}/commands", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authn.WithOptions(smqauthn.WithDomainCheck(true)).Middleware())
+			r.Use(api.RequestIDMiddleware(idp))
+
+			r.Post("/", otelhttp.NewHandler(kithttp.NewServer(
+				sendCommandEndpoint(svc),
+				decodeSendCommandReq,
+				api.EncodeResponse,
+				opts...,
+			), "send_command").ServeHTTP)
+			r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+				listCommandsEndpoint(svc),
+				decodeListCommandsReq,
+				api.EncodeResponse,
+				opts...,
+			), "list_commands").ServeHTTP)
+			r.Get("/{commandID}", otelhttp.NewHandler(kithttp.NewServer(
+				viewCommandEndpoint(svc),
+				decodeViewCommandReq,
+				api.EncodeResponse,
+				opts...,
+			), "view_command").ServeHTTP)
+		})
+	})
+
+	mux.Get("/health", magistrala.Health("commands", instanceID))
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return mux
+}
+
+func decodeSendCommandReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return sendCommandReq{}, apiutil.ErrUnsupportedContentType
+	}
+
+	var req sendCommandReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return sendCommandReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
+func decodeViewCommandReq(_ context.Context, r *http.Request) (any, error) {
+	return viewCommandReq{id: chi.URLParam(r, "commandID")}, nil
+}
+
+func decodeListCommandsReq(_ context.Context, r *http.Request) (any, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return listCommandsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return listCommandsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	channelID, err := apiutil.ReadStringQuery(r, "channel_id", "")
+	if err != nil {
+		return listCommandsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	clientID, err := apiutil.ReadStringQuery(r, "client_id", "")
+	if err != nil {
+		return listCommandsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	ruleID, err := apiutil.ReadStringQuery(r, "rule_id", "")
+	if err != nil {
+		return listCommandsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	name, err := apiutil.ReadStringQuery(r, api.NameKey, "")
+	if err != nil {
+		return listCommandsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	s, err := apiutil.ReadStringQuery(r, api.StatusKey, commands.All)
+	if err != nil {
+		return listCommandsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	status, err := commands.ToStatus(s)
+	if err != nil {
+		return listCommandsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	order, err := apiutil.ReadStringQuery(r, api.OrderKey, api.CreatedAtOrder)
+	if err != nil {
+		return listCommandsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	dir, err := apiutil.ReadStringQuery(r, api.DirKey, api.DescDir)
+	if err != nil {
+		return listCommandsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return listCommandsReq{
+		PageMetadata: commands.PageMetadata{
+			Offset:    offset,
+			Limit:     limit,
+			ChannelID: channelID,
+			ClientID:  clientID,
+			RuleID:    ruleID,
+			Name:      name,
+			Status:    status,
+			Order:     order,
+			Dir:       dir,
+		},
+	}, nil
+}
@@ -0,0 +1,178 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/authn"
+)
+
+const (
+	// SubtopicPrefix is the first level of the command subtopics.
+	SubtopicPrefix = "commands"
+
+	requestLevel  = "req"
+	responseLevel = "res"
+)
+
+var (
+	// ErrExpired indicates a response to a command which already timed out.
+	ErrExpired = errors.New("command timed out")
+
+	// ErrInvalidResponse indicates a response which does not match the command.
+	ErrInvalidResponse = errors.New("invalid command response")
+)
+
+// Command represents a command sent to the client over its channel.
+type Command struct {
+	ID             string          `json:"id"`
+	DomainID       string          `json:"domain_id"`
+	ChannelID      string          `json:"channel_id"`
+	ClientID       string          `json:"client_id"`
+	RuleID         string          `json:"rule_id,omitempty"`
+	Name           string          `json:"name"`
+	Payload        json.RawMessage `json:"payload,omitempty"`
+	Response       json.RawMessage `json:"response,omitempty"`
+	Status         Status          `json:"status"`
+	ExpiresAt      time.Time       `json:"expires_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+	CreatedBy      string          `json:"created_by,omitempty"`
+	PublishedAt    time.Time       `json:"published_at,omitempty"`
+	AcknowledgedAt time.Time       `json:"acknowledged_at,omitempty"`
+	UpdatedAt      time.Time       `json:"updated_at,omitempty"`
+}
+
+// Request is the message payload the client receives for a command.
+type Request struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+	ExpiresAt time.Time       `json:"expires_at"`
+}
+
+// Response is the client response to a command.
+type Response struct {
+	CommandID  string
+	DomainID   string
+	ChannelID  string
+	ClientID   string
+	Payload    json.RawMessage
+	ReceivedAt time.Time
+}
+
+type CommandsPage struct {
+	Offset   uint64    `json:"offset"`
+	Limit    uint64    `json:"limit"`
+	Total    uint64    `json:"total"`
+	Commands []Command `json:"commands"`
+}
+
+type PageMetadata struct {
+	Offset    uint64 `json:"offset"     db:"offset"`
+	Limit     uint64 `json:"limit"      db:"limit"`
+	DomainID  string `json:"domain_id"  db:"domain_id"`
+	ChannelID string `json:"channel_id" db:"channel_id"`
+	ClientID  string `json:"client_id"  db:"client_id"`
+	RuleID    string `json:"rule_id"    db:"rule_id"`
+	Name      string `json:"name"       db:"name"`
+	Status    Status `json:"status"     db:"status"`
+	Dir       string `json:"dir"        db:"dir"`
+	Order     string `json:"order"      db:"order"`
+}
+
+func (c Command) Validate() error {
+	if c.DomainID == "" {
+		return errors.New("domain_id is required")
+	}
+	if c.ChannelID == "" {
+		return errors.New("channel_id is required")
+	}
+	if c.ClientID == "" {
+		return errors.New("client_id is required")
+	}
+	if c.Name == "" {
+		return errors.New("name is required")
+	}
+	if len(c.Payload) > 0 && !json.Valid(c.Payload) {
+		return errors.New("payload must be valid JSON")
+	}
+
+	return nil
+}
+
+// RequestSubtopic returns the subtopic the client receives the command on.
+// Clients subscribe to "commands/<client_id>/req/+" to receive commands.
+func RequestSubtopic(clientID, commandID string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", SubtopicPrefix, clientID, requestLevel, commandID)
+}
+
+// RequestFilter returns the subtopic filter which matches all the client
+// command requests.
+func RequestFilter(clientID string) string {
+	return fmt.Sprintf("%s/%s/%s/+", SubtopicPrefix, clientID, requestLevel)
+}
+
+// ResponseSubtopic returns the subtopic the client publishes the command
+// response to.
+func ResponseSubtopic(clientID, commandID string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", SubtopicPrefix, clientID, responseLevel, commandID)
+}
+
+// ParseResponseSubtopic returns the client and command IDs of the response
+// subtopic.
+func ParseResponseSubtopic(subtopic string) (clientID, commandID string, ok bool) {
+	levels := strings.Split(subtopic, "/")
+	if len(levels) != 4 || levels[0] != SubtopicPrefix || levels[2] != responseLevel {
+		return "", "", false
+	}
+	if levels[1] == "" || levels[3] == "" {
+		return "", "", false
+	}
+
+	return levels[1], levels[3], true
+}
+
+// Service specifies an API that must be fulfilled by the domain service.
+type Service interface {
+	// SendCommand sends the command to the client on behalf of the user.
+	SendCommand(ctx context.Context, session authn.Session, cmd Command) (Command, error)
+
+	// CreateCommand sends the command triggered by a rule.
+	CreateCommand(ctx context.Context, cmd Command) (Command, error)
+
+	// ViewCommand retrieves the command by ID.
+	ViewCommand(ctx context.Context, session authn.Session, id string) (Command, error)
+
+	// ListCommands retrieves the domain commands.
+	ListCommands(ctx context.Context, session authn.Session, pm PageMetadata) (CommandsPage, error)
+
+	// HandleResponse correlates the client response with the command and
+	// acknowledges it.
+	HandleResponse(ctx context.Context, res Response) error
+
+	// ExpireCommands marks the commands the clients did not respond to
+	// before they expired as timed out and returns the number of commands
+	// marked.
+	ExpireCommands(ctx context.Context) (uint64, error)
+}
+
+type Repository interface {
+	Save(ctx context.Context, cmd Command) (Command, error)
+	// MarkPublished marks the pending command as published to the client.
+	MarkPublished(ctx context.Context, cmd Command) (Command, error)
+	RetrieveByID(ctx context.Context, domainID, id string) (Command, error)
+	RetrieveAll(ctx context.Context, pm PageMetadata) (CommandsPage, error)
+	// Acknowledge acknowledges the command unless it is already acknowledged
+	// or timed out.
+	Acknowledge(ctx context.Context, cmd Command) (Command, error)
+	// Expire marks the commands expired before the given time as timed out
+	// and returns the number of commands marked.
+	Expire(ctx context.Context, before time.Time) (uint64, error)
+}
@@ -0,0 +1,132 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/absmach/magistrala/commands"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+// ResponsesTopic matches the command responses the clients publish to their
+// channels.
+var ResponsesTopic = fmt.Sprintf("%c/+/%c/+/%s", messaging.MsgTopicPrefix, messaging.ChannelTopicPrefix, commands.ResponseSubtopic("+", "+"))
+
+var (
+	errEmptyMessage  = errors.New("message is empty")
+	errEmptyPayload  = errors.New("message payload is empty")
+	errFailedDecode  = errors.New("failed to decode command")
+	errInvalidClient = errors.New("response client does not match the subtopic")
+)
+
+type responsesHandler struct {
+	svc commands.Service
+}
+
+// NewResponsesHandler returns the handler of the client command responses.
+func NewResponsesHandler(svc commands.Service) messaging.MessageHandler {
+	return &responsesHandler{svc: svc}
+}
+
+func (h responsesHandler) Handle(msg *messaging.Message) error {
+	if msg == nil {
+		return errEmptyMessage
+	}
+	clientID, commandID, ok := commands.ParseResponseSubtopic(msg.GetSubtopic())
+	if !ok {
+		return nil
+	}
+	if clientID != msg.ClientIdentity() {
+		return messaging.NewError(errInvalidClient, messaging.Term)
+	}
+
+	res := commands.Response{
+		CommandID:  commandID,
+		DomainID:   msg.GetDomain(),
+		ChannelID:  msg.GetChannel(),
+		ClientID:   clientID,
+		Payload:    responsePayload(msg.GetPayload()),
+		ReceivedAt: time.Unix(0, msg.GetCreated()).UTC(),
+	}
+	if msg.GetCreated() == 0 {
+		res.ReceivedAt = time.Now().UTC()
+	}
+
+	err := h.svc.HandleResponse(context.Background(), res)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Contains(err, commands.ErrExpired),
+		errors.Contains(err, commands.ErrInvalidResponse),
+		errors.Contains(err, repoerr.ErrNotFound):
+		// Retrying the response does not change the outcome.
+		return messaging.NewError(err, messaging.Term)
+	default:
+		return err
+	}
+}
+
+func (h responsesHandler) Cancel() error {
+	return nil
+}
+
+// responsePayload stores the non-JSON responses as JSON strings.
+func responsePayload(payload []byte) json.RawMessage {
+	if len(payload) == 0 {
+		return nil
+	}
+	if json.Valid(payload) {
+		return payload
+	}
+	data, err := json.Marshal(string(payload))
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+type rulesHandler struct {
+	svc commands.Service
+}
+
+// NewRulesHandler returns the handler of the commands the rules engine
+// triggers.
+func NewRulesHandler(svc commands.Service) messaging.MessageHandler {
+	return &rulesHandler{svc: svc}
+}
+
+func (h rulesHandler) Handle(msg *messaging.Message) error {
+	if msg == nil {
+		return errEmptyMessage
+	}
+	if msg.GetPayload() == nil {
+		return errEmptyPayload
+	}
+
+	var cmd commands.Command
+	if err := gob.NewDecoder(bytes.NewReader(msg.GetPayload())).Decode(&cmd); err != nil {
+		return messaging.NewError(errors.Wrap(errFailedDecode, err), messaging.Term)
+	}
+	cmd.DomainID = msg.GetDomain()
+	if err := cmd.Validate(); err != nil {
+		return messaging.NewError(err, messaging.Term)
+	}
+
+	_, err := h.svc.CreateCommand(context.Background(), cmd)
+
+	return err
+}
+
+func (h rulesHandler) Cancel() error {
+	return nil
+}
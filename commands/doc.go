@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package commands contains domain concept definitions needed to support
+// Commands service feature, i.e. sending commands to the clients over their
+// channels and tracking the command responses.
+package commands
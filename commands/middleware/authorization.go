@@ -0,0 +1,99 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+
+	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
+	"github.com/absmach/magistrala/commands"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/policies"
+)
+
+var (
+	errChannelPublish = errors.New("not authorized to publish to channel")
+	errClientConnect  = errors.New("client is not connected to receive commands on channel")
+)
+
+type authorizationMiddleware struct {
+	svc      commands.Service
+	channels grpcChannelsV1.ChannelsServiceClient
+}
+
+var _ commands.Service = (*authorizationMiddleware)(nil)
+
+// NewAuthorizationMiddleware returns the authorization middleware. The users
+// can send commands to the channels they can publish to, and only to the
+// clients connected to the channel to receive the commands. Viewing and
+// listing commands is restricted to the domain members by the authentication.
+func NewAuthorizationMiddleware(svc commands.Service, channels grpcChannelsV1.ChannelsServiceClient) commands.Service {
+	return &authorizationMiddleware{
+		svc:      svc,
+		channels: channels,
+	}
+}
+
+func (am *authorizationMiddleware) SendCommand(ctx context.Context, session authn.Session, cmd commands.Command) (commands.Command, error) {
+	userID := session.DomainUserID
+	if session.SuperAdmin {
+		userID = session.UserID
+	}
+	if err := am.authorize(ctx, &grpcChannelsV1.AuthzReq{
+		DomainId:   session.DomainID,
+		ClientId:   userID,
+		ClientType: policies.UserType,
+		ChannelId:  cmd.ChannelID,
+		Type:       uint32(connections.Publish),
+	}); err != nil {
+		return commands.Command{}, errors.Wrap(errChannelPublish, err)
+	}
+	if err := am.authorize(ctx, &grpcChannelsV1.AuthzReq{
+		DomainId:   session.DomainID,
+		ClientId:   cmd.ClientID,
+		ClientType: policies.ClientType,
+		ChannelId:  cmd.ChannelID,
+		Type:       uint32(connections.Subscribe),
+		Subtopic:   commands.RequestFilter(cmd.ClientID),
+	}); err != nil {
+		return commands.Command{}, errors.Wrap(errClientConnect, err)
+	}
+
+	return am.svc.SendCommand(ctx, session, cmd)
+}
+
+func (am *authorizationMiddleware) CreateCommand(ctx context.Context, cmd commands.Command) (commands.Command, error) {
+	return am.svc.CreateCommand(ctx, cmd)
+}
+
+func (am *authorizationMiddleware) ViewCommand(ctx context.Context, session authn.Session, id string) (commands.Command, error) {
+	return am.svc.ViewCommand(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) ListCommands(ctx context.Context, session authn.Session, pm commands.PageMetadata) (commands.CommandsPage, error) {
+	return am.svc.ListCommands(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) HandleResponse(ctx context.Context, res commands.Response) error {
+	return am.svc.HandleResponse(ctx, res)
+}
+
+func (am *authorizationMiddleware) ExpireCommands(ctx context.Context) (uint64, error) {
+	return am.svc.ExpireCommands(ctx)
+}
+
+func (am *authorizationMiddleware) authorize(ctx context.Context, req *grpcChannelsV1.AuthzReq) error {
+	res, err := am.channels.Authorize(ctx, req)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrAuthorization, err)
+	}
+	if !res.GetAuthorized() {
+		return svcerr.ErrAuthorization
+	}
+
+	return nil
+}
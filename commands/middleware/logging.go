@@ -0,0 +1,161 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/magistrala/commands"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+type loggingMiddleware struct {
+	logger  *slog.Logger
+	service commands.Service
+}
+
+var _ commands.Service = (*loggingMiddleware)(nil)
+
+func NewLoggingMiddleware(logger *slog.Logger, service commands.Service) commands.Service {
+	return &loggingMiddleware{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (lm *loggingMiddleware) SendCommand(ctx context.Context, session authn.Session, cmd commands.Command) (sent commands.Command, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("command",
+				slog.String("id", sent.ID),
+				slog.String("name", cmd.Name),
+				slog.String("channel_id", cmd.ChannelID),
+				slog.String("client_id", cmd.ClientID),
+				slog.String("status", sent.Status.String()),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Send command failed", args...)
+			return
+		}
+		lm.logger.Info("Send command completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.SendCommand(ctx, session, cmd)
+}
+
+func (lm *loggingMiddleware) CreateCommand(ctx context.Context, cmd commands.Command) (sent commands.Command, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("command",
+				slog.String("id", sent.ID),
+				slog.String("name", cmd.Name),
+				slog.String("rule_id", cmd.RuleID),
+				slog.String("domain_id", cmd.DomainID),
+				slog.String("channel_id", cmd.ChannelID),
+				slog.String("client_id", cmd.ClientID),
+				slog.String("status", sent.Status.String()),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Create command failed", args...)
+			return
+		}
+		lm.logger.Info("Create command completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.CreateCommand(ctx, cmd)
+}
+
+func (lm *loggingMiddleware) ViewCommand(ctx context.Context, session authn.Session, id string) (cmd commands.Command, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("id", id),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("View command failed", args...)
+			return
+		}
+		lm.logger.Info("View command completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ViewCommand(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) ListCommands(ctx context.Context, session authn.Session, pm commands.PageMetadata) (page commands.CommandsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("page",
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("limit", pm.Limit),
+				slog.String("channel_id", pm.ChannelID),
+				slog.String("client_id", pm.ClientID),
+				slog.String("status", pm.Status.String()),
+				slog.Uint64("total", page.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("List commands failed", args...)
+			return
+		}
+		lm.logger.Info("List commands completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ListCommands(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) HandleResponse(ctx context.Context, res commands.Response) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("response",
+				slog.String("command_id", res.CommandID),
+				slog.String("domain_id", res.DomainID),
+				slog.String("channel_id", res.ChannelID),
+				slog.String("client_id", res.ClientID),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Handle command response failed", args...)
+			return
+		}
+		lm.logger.Info("Handle command response completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.HandleResponse(ctx, res)
+}
+
+func (lm *loggingMiddleware) ExpireCommands(ctx context.Context) (count uint64, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Uint64("count", count),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Expire commands failed", args...)
+			return
+		}
+		if count > 0 {
+			lm.logger.Info("Expire commands completed successfully", args...)
+		}
+	}(time.Now())
+
+	return lm.service.ExpireCommands(ctx)
+}
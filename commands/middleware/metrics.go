@@ -0,0 +1,83 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/magistrala/commands"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/go-kit/kit/metrics"
+)
+
+type metricsMiddleware struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	service commands.Service
+}
+
+var _ commands.Service = (*metricsMiddleware)(nil)
+
+func NewMetricsMiddleware(counter metrics.Counter, latency metrics.Histogram, service commands.Service) commands.Service {
+	return &metricsMiddleware{
+		counter: counter,
+		latency: latency,
+		service: service,
+	}
+}
+
+func (mm *metricsMiddleware) SendCommand(ctx context.Context, session authn.Session, cmd commands.Command) (commands.Command, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "send_command").Add(1)
+		mm.latency.With("method", "send_command").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.SendCommand(ctx, session, cmd)
+}
+
+func (mm *metricsMiddleware) CreateCommand(ctx context.Context, cmd commands.Command) (commands.Command, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "create_command").Add(1)
+		mm.latency.With("method", "create_command").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.CreateCommand(ctx, cmd)
+}
+
+func (mm *metricsMiddleware) ViewCommand(ctx context.Context, session authn.Session, id string) (commands.Command, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "view_command").Add(1)
+		mm.latency.With("method", "view_command").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ViewCommand(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) ListCommands(ctx context.Context, session authn.Session, pm commands.PageMetadata) (commands.CommandsPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "list_commands").Add(1)
+		mm.latency.With("method", "list_commands").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ListCommands(ctx, session, pm)
+}
+
+func (mm *metricsMiddleware) HandleResponse(ctx context.Context, res commands.Response) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "handle_response").Add(1)
+		mm.latency.With("method", "handle_response").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.HandleResponse(ctx, res)
+}
+
+func (mm *metricsMiddleware) ExpireCommands(ctx context.Context) (uint64, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "expire_commands").Add(1)
+		mm.latency.With("method", "expire_commands").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ExpireCommands(ctx)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/absmach/magistrala/commands"
+	"github.com/absmach/magistrala/pkg/authn"
+	smqTracing "github.com/absmach/magistrala/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type tracingMiddleware struct {
+	tracer trace.Tracer
+	svc    commands.Service
+}
+
+var _ commands.Service = (*tracingMiddleware)(nil)
+
+func NewTracingMiddleware(tracer trace.Tracer, svc commands.Service) commands.Service {
+	return &tracingMiddleware{
+		tracer: tracer,
+		svc:    svc,
+	}
+}
+
+func (tm *tracingMiddleware) SendCommand(ctx context.Context, session authn.Session, cmd commands.Command) (commands.Command, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "send_command", trace.WithAttributes(
+		attribute.String("name", cmd.Name),
+		attribute.String("channel_id", cmd.ChannelID),
+		attribute.String("client_id", cmd.ClientID),
+	))
+	defer span.End()
+
+	return tm.svc.SendCommand(ctx, session, cmd)
+}
+
+func (tm *tracingMiddleware) CreateCommand(ctx context.Context, cmd commands.Command) (commands.Command, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "create_command", trace.WithAttributes(
+		attribute.String("name", cmd.Name),
+		attribute.String("rule_id", cmd.RuleID),
+		attribute.String("domain_id", cmd.DomainID),
+		attribute.String("channel_id", cmd.ChannelID),
+		attribute.String("client_id", cmd.ClientID),
+	))
+	defer span.End()
+
+	return tm.svc.CreateCommand(ctx, cmd)
+}
+
+func (tm *tracingMiddleware) ViewCommand(ctx context.Context, session authn.Session, id string) (commands.Command, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "view_command", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.ViewCommand(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) ListCommands(ctx context.Context, session authn.Session, pm commands.PageMetadata) (commands.CommandsPage, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_commands", trace.WithAttributes(
+		attribute.Int64("offset", int64(pm.Offset)),
+		attribute.Int64("limit", int64(pm.Limit)),
+		attribute.String("channel_id", pm.ChannelID),
+		attribute.String("client_id", pm.ClientID),
+		attribute.String("status", pm.Status.String()),
+	))
+	defer span.End()
+
+	return tm.svc.ListCommands(ctx, session, pm)
+}
+
+func (tm *tracingMiddleware) HandleResponse(ctx context.Context, res commands.Response) error {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "handle_response", trace.WithAttributes(
+		attribute.String("command_id", res.CommandID),
+		attribute.String("domain_id", res.DomainID),
+		attribute.String("channel_id", res.ChannelID),
+		attribute.String("client_id", res.ClientID),
+	))
+	defer span.End()
+
+	return tm.svc.HandleResponse(ctx, res)
+}
+
+func (tm *tracingMiddleware) ExpireCommands(ctx context.Context) (uint64, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "expire_commands")
+	defer span.End()
+
+	return tm.svc.ExpireCommands(ctx)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/absmach/magistrala/api/http"
+	"github.com/absmach/magistrala/commands"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/postgres"
+)
+
+const columns = `id, domain_id, channel_id, client_id, rule_id, name, payload, response, status,
+	expires_at, created_at, created_by, published_at, acknowledged_at, updated_at`
+
+type repository struct {
+	db postgres.Database
+	eh errors.Handler
+}
+
+var _ commands.Repository = (*repository)(nil)
+
+func NewRepository(db postgres.Database) commands.Repository {
+	return &repository{
+		db: db,
+		eh: postgres.NewErrorHandler(),
+	}
+}
+
+func (repo *repository) Save(ctx context.Context, cmd commands.Command) (commands.Command, error) {
+	q := fmt.Sprintf(`INSERT INTO commands (%s)
+		VALUES (:id, :domain_id, :channel_id, :client_id, :rule_id, :name, :payload, :response, :status,
+			:expires_at, :created_at, :created_by, :published_at, :acknowledged_at, :updated_at)
+		RETURNING %s;`, columns, columns)
+
+	return repo.query(ctx, q, cmd, repoerr.ErrCreateEntity)
+}
+
+func (repo *repository) MarkPublished(ctx context.Context, cmd commands.Command) (commands.Command, error) {
+	q := fmt.Sprintf(`UPDATE commands SET status = :status, published_at = :published_at, updated_at = :updated_at
+		WHERE id = :id AND domain_id = :domain_id AND status = %d
+		RETURNING %s;`, commands.PendingStatus, columns)
+
+	return repo.query(ctx, q, cmd, repoerr.ErrUpdateEntity)
+}
+
+func (repo *repository) Acknowledge(ctx context.Context, cmd commands.Command) (commands.Command, error) {
+	q := fmt.Sprintf(`UPDATE commands SET status = :status, response = :response, acknowledged_at = :acknowledged_at, updated_at = :updated_at
+		WHERE id = :id AND domain_id = :domain_id AND status IN (%d, %d)
+		RETURNING %s;`, commands.PendingStatus, commands.PublishedStatus, columns)
+
+	return repo.query(ctx, q, cmd, repoerr.ErrUpdateEntity)
+}
+
+func (repo *repository) RetrieveByID(ctx context.Context, domainID, id string) (commands.Command, error) {
+	q := fmt.Sprintf(`SELECT %s FROM commands WHERE id = :id AND domain_id = :domain_id;`, columns)
+
+	return repo.query(ctx, q, commands.Command{ID: id, DomainID: domainID}, repoerr.ErrViewEntity)
+}
+
+func (repo *repository) RetrieveAll(ctx context.Context, pm commands.PageMetadata) (commands.CommandsPage, error) {
+	query := pageQuery(pm)
+
+	dir := api.DescDir
+	if pm.Dir == api.AscDir {
+		dir = api.AscDir
+	}
+	order := "created_at"
+	if pm.Order == api.UpdatedAtOrder {
+		order = "COALESCE(updated_at, created_at)"
+	}
+
+	q := fmt.Sprintf(`SELECT %s FROM commands %s ORDER BY %s %s, id %s LIMIT :limit OFFSET :offset;`, columns, query, order, dir, dir)
+	rows, err := repo.db.NamedQueryContext(ctx, q, pm)
+	if err != nil {
+		return commands.CommandsPage{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	items := []commands.Command{}
+	for rows.Next() {
+		var dbc dbCommand
+		if err := rows.StructScan(&dbc); err != nil {
+			return commands.CommandsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		items = append(items, toCommand(dbc))
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) AS total_count FROM commands %s;`, query)
+	total, err := postgres.Total(ctx, repo.db, cq, pm)
+	if err != nil {
+		return commands.CommandsPage{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return commands.CommandsPage{
+		Offset:   pm.Offset,
+		Limit:    pm.Limit,
+		Total:    total,
+		Commands: items,
+	}, nil
+}
+
+func (repo *repository) Expire(ctx context.Context, before time.Time) (uint64, error) {
+	q := fmt.Sprintf(`UPDATE commands SET status = %d, updated_at = :before
+		WHERE status IN (%d, %d) AND expires_at < :before;`, commands.TimedOutStatus, commands.PendingStatus, commands.PublishedStatus)
+
+	res, err := repo.db.NamedExecContext(ctx, q, map[string]any{"before": before})
+	if err != nil {
+		return 0, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	return uint64(count), nil
+}
+
+func (repo *repository) query(ctx context.Context, q string, cmd commands.Command, wrapErr error) (commands.Command, error) {
+	row, err := repo.db.NamedQueryContext(ctx, q, toDBCommand(cmd))
+	if err != nil {
+		return commands.Command{}, repo.eh.HandleError(wrapErr, err)
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		return commands.Command{}, repoerr.ErrNotFound
+	}
+
+	var dbc dbCommand
+	if err := row.StructScan(&dbc); err != nil {
+		return commands.Command{}, errors.Wrap(wrapErr, err)
+	}
+
+	return toCommand(dbc), nil
+}
+
+func pageQuery(pm commands.PageMetadata) string {
+	query := []string{"domain_id = :domain_id"}
+	if pm.ChannelID != "" {
+		query = append(query, "channel_id = :channel_id")
+	}
+	if pm.ClientID != "" {
+		query = append(query, "client_id = :client_id")
+	}
+	if pm.RuleID != "" {
+		query = append(query, "rule_id = :rule_id")
+	}
+	if pm.Name != "" {
+		query = append(query, "name = :name")
+	}
+	if pm.Status != commands.AllStatus {
+		query = append(query, "status = :status")
+	}
+
+	return fmt.Sprintf("WHERE %s", strings.Join(query, " AND "))
+}
+
+type dbCommand struct {
+	ID             string         `db:"id"`
+	DomainID       string         `db:"domain_id"`
+	ChannelID      string         `db:"channel_id"`
+	ClientID       string         `db:"client_id"`
+	RuleID         sql.NullString `db:"rule_id"`
+	Name           string         `db:"name"`
+	Payload        []byte         `db:"payload"`
+	Response       []byte         `db:"response"`
+	Status         uint8          `db:"status"`
+	ExpiresAt      time.Time      `db:"expires_at"`
+	CreatedAt      time.Time      `db:"created_at"`
+	CreatedBy      sql.NullString `db:"created_by"`
+	PublishedAt    sql.NullTime   `db:"published_at"`
+	AcknowledgedAt sql.NullTime   `db:"acknowledged_at"`
+	UpdatedAt      sql.NullTime   `db:"updated_at"`
+}
+
+func toDBCommand(cmd commands.Command) dbCommand {
+	return dbCommand{
+		ID:             cmd.ID,
+		DomainID:       cmd.DomainID,
+		ChannelID:      cmd.ChannelID,
+		ClientID:       cmd.ClientID,
+		RuleID:         toNullString(cmd.RuleID),
+		Name:           cmd.Name,
+		Payload:        toJSONB(cmd.Payload),
+		Response:       toJSONB(cmd.Response),
+		Status:         uint8(cmd.Status),
+		ExpiresAt:      cmd.ExpiresAt,
+		CreatedAt:      cmd.CreatedAt,
+		CreatedBy:      toNullString(cmd.CreatedBy),
+		PublishedAt:    toNullTime(cmd.PublishedAt),
+		AcknowledgedAt: toNullTime(cmd.AcknowledgedAt),
+		UpdatedAt:      toNullTime(cmd.UpdatedAt),
+	}
+}
+
+func toCommand(dbc dbCommand) commands.Command {
+	return commands.Command{
+		ID:             dbc.ID,
+		DomainID:       dbc.DomainID,
+		ChannelID:      dbc.ChannelID,
+		ClientID:       dbc.ClientID,
+		RuleID:         dbc.RuleID.String,
+		Name:           dbc.Name,
+		Payload:        dbc.Payload,
+		Response:       dbc.Response,
+		Status:         commands.Status(dbc.Status),
+		ExpiresAt:      dbc.ExpiresAt.UTC(),
+		CreatedAt:      dbc.CreatedAt.UTC(),
+		CreatedBy:      dbc.CreatedBy.String,
+		PublishedAt:    fromNullTime(dbc.PublishedAt),
+		AcknowledgedAt: fromNullTime(dbc.AcknowledgedAt),
+		UpdatedAt:      fromNullTime(dbc.UpdatedAt),
+	}
+}
+
+// toJSONB stores the empty JSON documents as NULL.
+func toJSONB(data []byte) []byte {
+	if len(data) == 0 {
+		return nil
+	}
+	return data
+}
+
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func toNullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func fromNullTime(t sql.NullTime) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time.UTC()
+}
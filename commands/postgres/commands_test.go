@@ -0,0 +1,414 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/0x6flab/namegenerator"
+	"github.com/absmach/magistrala/commands"
+	"github.com/absmach/magistrala/commands/postgres"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var namegen = namegenerator.NewGenerator()
+
+func generateCommand(t *testing.T, domainID string) commands.Command {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	return commands.Command{
+		ID:        testsutil.GenerateUUID(t),
+		DomainID:  domainID,
+		ChannelID: testsutil.GenerateUUID(t),
+		ClientID:  testsutil.GenerateUUID(t),
+		Name:      namegen.Generate(),
+		Payload:   json.RawMessage(`{"state": "on"}`),
+		Status:    commands.PendingStatus,
+		ExpiresAt: now.Add(time.Minute),
+		CreatedAt: now,
+		CreatedBy: testsutil.GenerateUUID(t),
+	}
+}
+
+func saveCommand(t *testing.T, repo commands.Repository, cmd commands.Command) commands.Command {
+	saved, err := repo.Save(context.Background(), cmd)
+	require.Nil(t, err, fmt.Sprintf("save command unexpected error: %s", err))
+
+	return saved
+}
+
+func TestSave(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM commands")
+		require.Nil(t, err, fmt.Sprintf("clean commands unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	cmd := generateCommand(t, testsutil.GenerateUUID(t))
+	ruleCmd := generateCommand(t, testsutil.GenerateUUID(t))
+	ruleCmd.RuleID = testsutil.GenerateUUID(t)
+	ruleCmd.CreatedBy = ""
+	ruleCmd.Payload = nil
+
+	cases := []struct {
+		desc string
+		cmd  commands.Command
+		err  error
+	}{
+		{
+			desc: "save command",
+			cmd:  cmd,
+			err:  nil,
+		},
+		{
+			desc: "save command created by rule",
+			cmd:  ruleCmd,
+			err:  nil,
+		},
+		{
+			desc: "save duplicate command",
+			cmd:  cmd,
+			err:  repoerr.ErrCreateEntity,
+		},
+		{
+			desc: "save command with invalid payload",
+			cmd: func() commands.Command {
+				c := generateCommand(t, testsutil.GenerateUUID(t))
+				c.Payload = json.RawMessage(`{invalid`)
+				return c
+			}(),
+			err: repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			saved, err := repo.Save(context.Background(), tc.cmd)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.cmd, saved, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.cmd, saved))
+			}
+		})
+	}
+}
+
+func TestMarkPublished(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM commands")
+		require.Nil(t, err, fmt.Sprintf("clean commands unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	pending := saveCommand(t, repo, generateCommand(t, testsutil.GenerateUUID(t)))
+	acknowledged := generateCommand(t, testsutil.GenerateUUID(t))
+	acknowledged.Status = commands.AcknowledgedStatus
+	acknowledged = saveCommand(t, repo, acknowledged)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	published := func(cmd commands.Command) commands.Command {
+		cmd.Status = commands.PublishedStatus
+		cmd.PublishedAt = now
+		cmd.UpdatedAt = now
+		return cmd
+	}
+
+	cases := []struct {
+		desc string
+		cmd  commands.Command
+		err  error
+	}{
+		{
+			desc: "mark pending command as published",
+			cmd:  published(pending),
+			err:  nil,
+		},
+		{
+			desc: "mark published command as published",
+			cmd:  published(pending),
+			err:  repoerr.ErrNotFound,
+		},
+		{
+			desc: "mark acknowledged command as published",
+			cmd:  published(acknowledged),
+			err:  repoerr.ErrNotFound,
+		},
+		{
+			desc: "mark command from another domain as published",
+			cmd: func() commands.Command {
+				c := published(pending)
+				c.DomainID = testsutil.GenerateUUID(t)
+				return c
+			}(),
+			err: repoerr.ErrNotFound,
+		},
+		{
+			desc: "mark non-existing command as published",
+			cmd:  published(generateCommand(t, testsutil.GenerateUUID(t))),
+			err:  repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cmd, err := repo.MarkPublished(context.Background(), tc.cmd)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.cmd, cmd, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.cmd, cmd))
+			}
+		})
+	}
+}
+
+func TestAcknowledge(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM commands")
+		require.Nil(t, err, fmt.Sprintf("clean commands unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	pending := saveCommand(t, repo, generateCommand(t, testsutil.GenerateUUID(t)))
+	published := generateCommand(t, testsutil.GenerateUUID(t))
+	published.Status = commands.PublishedStatus
+	published = saveCommand(t, repo, published)
+	timedOut := generateCommand(t, testsutil.GenerateUUID(t))
+	timedOut.Status = commands.TimedOutStatus
+	timedOut = saveCommand(t, repo, timedOut)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	acknowledged := func(cmd commands.Command) commands.Command {
+		cmd.Status = commands.AcknowledgedStatus
+		cmd.Response = json.RawMessage(`{"state": "on"}`)
+		cmd.AcknowledgedAt = now
+		cmd.UpdatedAt = now
+		return cmd
+	}
+
+	cases := []struct {
+		desc string
+		cmd  commands.Command
+		err  error
+	}{
+		{
+			desc: "acknowledge published command",
+			cmd:  acknowledged(published),
+			err:  nil,
+		},
+		{
+			desc: "acknowledge pending command",
+			cmd:  acknowledged(pending),
+			err:  nil,
+		},
+		{
+			desc: "acknowledge acknowledged command",
+			cmd:  acknowledged(published),
+			err:  repoerr.ErrNotFound,
+		},
+		{
+			desc: "acknowledge timed out command",
+			cmd:  acknowledged(timedOut),
+			err:  repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cmd, err := repo.Acknowledge(context.Background(), tc.cmd)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.cmd.Status, cmd.Status)
+				assert.Equal(t, tc.cmd.AcknowledgedAt, cmd.AcknowledgedAt)
+				assert.JSONEq(t, string(tc.cmd.Response), string(cmd.Response))
+			}
+		})
+	}
+}
+
+func TestRetrieveByID(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM commands")
+		require.Nil(t, err, fmt.Sprintf("clean commands unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	cmd := saveCommand(t, repo, generateCommand(t, testsutil.GenerateUUID(t)))
+
+	cases := []struct {
+		desc     string
+		domainID string
+		id       string
+		cmd      commands.Command
+		err      error
+	}{
+		{
+			desc:     "retrieve command",
+			domainID: cmd.DomainID,
+			id:       cmd.ID,
+			cmd:      cmd,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve command from another domain",
+			domainID: testsutil.GenerateUUID(t),
+			id:       cmd.ID,
+			err:      repoerr.ErrNotFound,
+		},
+		{
+			desc:     "retrieve non-existing command",
+			domainID: cmd.DomainID,
+			id:       testsutil.GenerateUUID(t),
+			err:      repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cmd, err := repo.RetrieveByID(context.Background(), tc.domainID, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.cmd, cmd, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.cmd, cmd))
+		})
+	}
+}
+
+func TestRetrieveAll(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM commands")
+		require.Nil(t, err, fmt.Sprintf("clean commands unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	domainID := testsutil.GenerateUUID(t)
+	num := 10
+	var items []commands.Command
+	for i := range num {
+		cmd := generateCommand(t, domainID)
+		cmd.CreatedAt = cmd.CreatedAt.Add(time.Duration(i) * time.Second)
+		if i%2 == 0 {
+			cmd.Status = commands.PublishedStatus
+		}
+		items = append(items, saveCommand(t, repo, cmd))
+	}
+	saveCommand(t, repo, generateCommand(t, testsutil.GenerateUUID(t)))
+
+	published := []commands.Command{}
+	for i := len(items) - 1; i >= 0; i-- {
+		if items[i].Status == commands.PublishedStatus {
+			published = append(published, items[i])
+		}
+	}
+
+	cases := []struct {
+		desc     string
+		pm       commands.PageMetadata
+		total    uint64
+		commands []commands.Command
+	}{
+		{
+			desc:     "retrieve all commands",
+			pm:       commands.PageMetadata{DomainID: domainID, Limit: uint64(num), Status: commands.AllStatus},
+			total:    uint64(num),
+			commands: []commands.Command{items[9], items[8], items[7], items[6], items[5], items[4], items[3], items[2], items[1], items[0]},
+		},
+		{
+			desc:     "retrieve commands with offset and limit",
+			pm:       commands.PageMetadata{DomainID: domainID, Offset: 2, Limit: 2, Status: commands.AllStatus},
+			total:    uint64(num),
+			commands: []commands.Command{items[7], items[6]},
+		},
+		{
+			desc:     "retrieve commands in ascending order",
+			pm:       commands.PageMetadata{DomainID: domainID, Limit: 2, Status: commands.AllStatus, Dir: "asc"},
+			total:    uint64(num),
+			commands: []commands.Command{items[0], items[1]},
+		},
+		{
+			desc:     "retrieve commands by status",
+			pm:       commands.PageMetadata{DomainID: domainID, Limit: uint64(num), Status: commands.PublishedStatus},
+			total:    uint64(len(published)),
+			commands: published,
+		},
+		{
+			desc:     "retrieve commands by client",
+			pm:       commands.PageMetadata{DomainID: domainID, Limit: uint64(num), ClientID: items[3].ClientID, Status: commands.AllStatus},
+			total:    1,
+			commands: []commands.Command{items[3]},
+		},
+		{
+			desc:     "retrieve commands by channel and name",
+			pm:       commands.PageMetadata{DomainID: domainID, Limit: uint64(num), ChannelID: items[4].ChannelID, Name: items[4].Name, Status: commands.AllStatus},
+			total:    1,
+			commands: []commands.Command{items[4]},
+		},
+		{
+			desc:     "retrieve commands from another domain",
+			pm:       commands.PageMetadata{DomainID: testsutil.GenerateUUID(t), Limit: uint64(num), Status: commands.AllStatus},
+			total:    0,
+			commands: []commands.Command{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := repo.RetrieveAll(context.Background(), tc.pm)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.total, page.Total)
+			assert.Equal(t, tc.commands, page.Commands)
+		})
+	}
+}
+
+func TestExpire(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM commands")
+		require.Nil(t, err, fmt.Sprintf("clean commands unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	domainID := testsutil.GenerateUUID(t)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	expired := map[string]bool{}
+	for _, c := range []struct {
+		status    commands.Status
+		expiresAt time.Time
+		expired   bool
+	}{
+		{commands.PendingStatus, now.Add(-time.Minute), true},
+		{commands.PublishedStatus, now.Add(-time.Minute), true},
+		{commands.AcknowledgedStatus, now.Add(-time.Minute), false},
+		{commands.PublishedStatus, now.Add(time.Minute), false},
+	} {
+		cmd := generateCommand(t, domainID)
+		cmd.Status = c.status
+		cmd.ExpiresAt = c.expiresAt
+		cmd = saveCommand(t, repo, cmd)
+		expired[cmd.ID] = c.expired
+	}
+
+	count, err := repo.Expire(context.Background(), now)
+	require.Nil(t, err, fmt.Sprintf("expire commands unexpected error: %s", err))
+	assert.Equal(t, uint64(2), count)
+
+	for id, exp := range expired {
+		cmd, err := repo.RetrieveByID(context.Background(), domainID, id)
+		require.Nil(t, err, fmt.Sprintf("retrieve command unexpected error: %s", err))
+		assert.Equal(t, exp, cmd.Status == commands.TimedOutStatus, fmt.Sprintf("command %s: unexpected status %s", id, cmd.Status))
+	}
+
+	count, err = repo.Expire(context.Background(), now)
+	require.Nil(t, err, fmt.Sprintf("expire commands unexpected error: %s", err))
+	assert.Equal(t, uint64(0), count)
+}
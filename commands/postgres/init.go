@@ -0,0 +1,46 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+// Migration of Commands service.
+func Migration() *migrate.MemoryMigrationSource {
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "commands_01",
+				// VARCHAR(36) for columns with IDs as UUIDS have a maximum of 36 characters
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS commands (
+						id              VARCHAR(36) PRIMARY KEY,
+						domain_id       VARCHAR(36) NOT NULL,
+						channel_id      VARCHAR(36) NOT NULL,
+						client_id       VARCHAR(36) NOT NULL,
+						rule_id         VARCHAR(36),
+						name            TEXT NOT NULL,
+						payload         JSONB,
+						response        JSONB,
+						status          SMALLINT NOT NULL DEFAULT 0 CHECK (status >= 0),
+						expires_at      TIMESTAMPTZ NOT NULL,
+						created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+						created_by      VARCHAR(254),
+						published_at    TIMESTAMPTZ,
+						acknowledged_at TIMESTAMPTZ,
+						updated_at      TIMESTAMPTZ
+					)`,
+					`CREATE INDEX IF NOT EXISTS idx_commands_domain ON commands (domain_id, created_at DESC)`,
+					`CREATE INDEX IF NOT EXISTS idx_commands_client ON commands (domain_id, client_id, created_at DESC)`,
+					`CREATE INDEX IF NOT EXISTS idx_commands_pending ON commands (expires_at) WHERE status < 2`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS commands`,
+				},
+			},
+		},
+	}
+}
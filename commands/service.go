@@ -0,0 +1,207 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/magistrala"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+const (
+	protocol    = "commands"
+	contentType = "application/json"
+
+	correlationIDHeader = "correlation-id"
+	replyToHeader       = "reply-to"
+)
+
+var errPublish = errors.New("failed to publish command")
+
+type service struct {
+	idp     magistrala.IDProvider
+	repo    Repository
+	pub     messaging.Publisher
+	timeout time.Duration
+}
+
+var _ Service = (*service)(nil)
+
+// NewService returns a new Commands service. The commands sent without the
+// expiration time expire after the timeout.
+func NewService(idp magistrala.IDProvider, repo Repository, pub messaging.Publisher, timeout time.Duration) Service {
+	return &service{
+		idp:     idp,
+		repo:    repo,
+		pub:     pub,
+		timeout: timeout,
+	}
+}
+
+func (s *service) SendCommand(ctx context.Context, session authn.Session, cmd Command) (Command, error) {
+	cmd.DomainID = session.DomainID
+	cmd.CreatedBy = session.UserID
+	cmd.RuleID = ""
+
+	return s.send(ctx, cmd)
+}
+
+func (s *service) CreateCommand(ctx context.Context, cmd Command) (Command, error) {
+	return s.send(ctx, cmd)
+}
+
+func (s *service) send(ctx context.Context, cmd Command) (Command, error) {
+	id, err := s.idp.ID()
+	if err != nil {
+		return Command{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+	cmd.ID = id
+	cmd.Status = PendingStatus
+	cmd.CreatedAt = time.Now().UTC()
+	if cmd.ExpiresAt.IsZero() {
+		cmd.ExpiresAt = cmd.CreatedAt.Add(s.timeout)
+	}
+	if err := cmd.Validate(); err != nil {
+		return Command{}, errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+
+	cmd, err = s.repo.Save(ctx, cmd)
+	if err != nil {
+		return Command{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+
+	if err := s.publish(ctx, cmd); err != nil {
+		// The command stays pending and times out.
+		return Command{}, errors.Wrap(errPublish, err)
+	}
+
+	cmd.Status = PublishedStatus
+	cmd.PublishedAt = time.Now().UTC()
+	cmd.UpdatedAt = cmd.PublishedAt
+	published, err := s.repo.MarkPublished(ctx, cmd)
+	switch {
+	case err == nil:
+		return published, nil
+	case errors.Contains(err, repoerr.ErrNotFound):
+		// The client responded before the command was marked as published.
+		return s.repo.RetrieveByID(ctx, cmd.DomainID, cmd.ID)
+	default:
+		return Command{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+}
+
+func (s *service) publish(ctx context.Context, cmd Command) error {
+	payload, err := json.Marshal(Request{
+		ID:        cmd.ID,
+		Name:      cmd.Name,
+		Payload:   cmd.Payload,
+		ExpiresAt: cmd.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	subtopic := RequestSubtopic(cmd.ClientID, cmd.ID)
+	msg := &messaging.Message{
+		Domain:      cmd.DomainID,
+		Channel:     cmd.ChannelID,
+		Subtopic:    subtopic,
+		Protocol:    protocol,
+		Created:     cmd.CreatedAt.UnixNano(),
+		Payload:     payload,
+		ContentType: contentType,
+		Headers: map[string]string{
+			correlationIDHeader: cmd.ID,
+			replyToHeader:       messaging.EncodeTopic(cmd.DomainID, cmd.ChannelID, ResponseSubtopic(cmd.ClientID, cmd.ID)),
+		},
+	}
+
+	return s.pub.Publish(ctx, messaging.EncodeTopicSuffix(cmd.DomainID, cmd.ChannelID, subtopic), msg)
+}
+
+func (s *service) ViewCommand(ctx context.Context, session authn.Session, id string) (Command, error) {
+	cmd, err := s.repo.RetrieveByID(ctx, session.DomainID, id)
+	if err != nil {
+		return Command{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return cmd, nil
+}
+
+func (s *service) ListCommands(ctx context.Context, session authn.Session, pm PageMetadata) (CommandsPage, error) {
+	pm.DomainID = session.DomainID
+	page, err := s.repo.RetrieveAll(ctx, pm)
+	if err != nil {
+		return CommandsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return page, nil
+}
+
+func (s *service) HandleResponse(ctx context.Context, res Response) error {
+	cmd, err := s.repo.RetrieveByID(ctx, res.DomainID, res.CommandID)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if cmd.ChannelID != res.ChannelID || cmd.ClientID != res.ClientID {
+		return ErrInvalidResponse
+	}
+
+	switch {
+	case cmd.Status == AcknowledgedStatus:
+		// Duplicate response.
+		return nil
+	case cmd.Status == TimedOutStatus, res.ReceivedAt.After(cmd.ExpiresAt):
+		return ErrExpired
+	}
+
+	cmd.Status = AcknowledgedStatus
+	cmd.Response = res.Payload
+	cmd.AcknowledgedAt = res.ReceivedAt
+	cmd.UpdatedAt = time.Now().UTC()
+	if _, err := s.repo.Acknowledge(ctx, cmd); err != nil {
+		if errors.Contains(err, repoerr.ErrNotFound) {
+			// The command timed out or was acknowledged in the meantime.
+			return nil
+		}
+		return errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return nil
+}
+
+func (s *service) ExpireCommands(ctx context.Context) (uint64, error) {
+	count, err := s.repo.Expire(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return count, nil
+}
+
+// Expire periodically marks the expired commands as timed out until the
+// context is canceled.
+func Expire(ctx context.Context, svc Service, interval time.Duration, logger *slog.Logger) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := svc.ExpireCommands(ctx); err != nil {
+				logger.Warn("failed to expire commands", slog.Any("error", err))
+			}
+		}
+	}
+}
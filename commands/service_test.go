@@ -0,0 +1,324 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package commands_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/commands"
+	"github.com/absmach/magistrala/commands/mocks"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/messaging"
+	msgmocks "github.com/absmach/magistrala/pkg/messaging/mocks"
+	"github.com/absmach/magistrala/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	idp       = uuid.New()
+	timeout   = 30 * time.Second
+	domainID  = testsutil.GenerateUUID(&testing.T{})
+	channelID = testsutil.GenerateUUID(&testing.T{})
+	clientID  = testsutil.GenerateUUID(&testing.T{})
+	session   = authn.Session{DomainID: domainID, UserID: testsutil.GenerateUUID(&testing.T{})}
+)
+
+func newService(t *testing.T) (commands.Service, *mocks.Repository, *msgmocks.PubSub) {
+	repo := new(mocks.Repository)
+	pub := msgmocks.NewPubSub(t)
+
+	return commands.NewService(idp, repo, pub, timeout), repo, pub
+}
+
+func TestSendCommand(t *testing.T) {
+	svc, repo, pub := newService(t)
+
+	cmd := commands.Command{
+		ChannelID: channelID,
+		ClientID:  clientID,
+		Name:      "reboot",
+		Payload:   json.RawMessage(`{"delay":5}`),
+	}
+
+	cases := []struct {
+		desc       string
+		cmd        commands.Command
+		saveErr    error
+		publishErr error
+		markErr    error
+		status     commands.Status
+		err        error
+	}{
+		{
+			desc:   "send command successfully",
+			cmd:    cmd,
+			status: commands.PublishedStatus,
+			err:    nil,
+		},
+		{
+			desc: "send command without name",
+			cmd: commands.Command{
+				ChannelID: channelID,
+				ClientID:  clientID,
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "send command with invalid payload",
+			cmd: commands.Command{
+				ChannelID: channelID,
+				ClientID:  clientID,
+				Name:      "reboot",
+				Payload:   json.RawMessage(`{"delay":`),
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:    "send command with failed save",
+			cmd:     cmd,
+			saveErr: repoerr.ErrCreateEntity,
+			err:     svcerr.ErrCreateEntity,
+		},
+		{
+			desc:       "send command with failed publish",
+			cmd:        cmd,
+			publishErr: errors.New("publish failed"),
+			err:        errors.New("failed to publish command"),
+		},
+		{
+			desc:    "send command acknowledged before marked as published",
+			cmd:     cmd,
+			markErr: repoerr.ErrNotFound,
+			status:  commands.AcknowledgedStatus,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var published *messaging.Message
+			var saved commands.Command
+			saveCall := repo.On("Save", context.Background(), mock.Anything).Run(func(args mock.Arguments) {
+				saved = args.Get(1).(commands.Command)
+			}).Return(func(_ context.Context, c commands.Command) commands.Command { return c }, tc.saveErr)
+			pubCall := pub.On("Publish", context.Background(), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				published = args.Get(2).(*messaging.Message)
+			}).Return(tc.publishErr)
+			markCall := repo.On("MarkPublished", context.Background(), mock.Anything).Return(func(_ context.Context, c commands.Command) commands.Command { return c }, tc.markErr)
+			retrieveCall := repo.On("RetrieveByID", context.Background(), domainID, mock.Anything).Return(commands.Command{Status: commands.AcknowledgedStatus}, nil)
+
+			sent, err := svc.SendCommand(context.Background(), session, tc.cmd)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, tc.status, sent.Status, fmt.Sprintf("%s: expected status %s got %s\n", tc.desc, tc.status, sent.Status))
+				assert.Equal(t, domainID, saved.DomainID)
+				assert.Equal(t, session.UserID, saved.CreatedBy)
+				assert.Equal(t, saved.CreatedAt.Add(timeout), saved.ExpiresAt)
+				assert.Equal(t, commands.RequestSubtopic(clientID, saved.ID), published.GetSubtopic())
+				assert.Equal(t, channelID, published.GetChannel())
+				var req commands.Request
+				assert.Nil(t, json.Unmarshal(published.GetPayload(), &req))
+				assert.Equal(t, saved.ID, req.ID)
+				assert.Equal(t, tc.cmd.Name, req.Name)
+				assert.JSONEq(t, string(tc.cmd.Payload), string(req.Payload))
+			}
+			saveCall.Unset()
+			pubCall.Unset()
+			markCall.Unset()
+			retrieveCall.Unset()
+		})
+	}
+}
+
+func TestHandleResponse(t *testing.T) {
+	svc, repo, _ := newService(t)
+
+	now := time.Now().UTC()
+	commandID := testsutil.GenerateUUID(t)
+	published := commands.Command{
+		ID:        commandID,
+		DomainID:  domainID,
+		ChannelID: channelID,
+		ClientID:  clientID,
+		Name:      "reboot",
+		Status:    commands.PublishedStatus,
+		ExpiresAt: now.Add(timeout),
+	}
+	res := commands.Response{
+		CommandID:  commandID,
+		DomainID:   domainID,
+		ChannelID:  channelID,
+		ClientID:   clientID,
+		Payload:    json.RawMessage(`{"ok":true}`),
+		ReceivedAt: now,
+	}
+	acknowledged := published
+	acknowledged.Status = commands.AcknowledgedStatus
+	timedOut := published
+	timedOut.Status = commands.TimedOutStatus
+	expired := published
+	expired.ExpiresAt = now.Add(-time.Second)
+	otherClient := res
+	otherClient.ClientID = testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc        string
+		res         commands.Response
+		cmd         commands.Command
+		retrieveErr error
+		ackErr      error
+		acknowledge bool
+		err         error
+	}{
+		{
+			desc:        "handle response successfully",
+			res:         res,
+			cmd:         published,
+			acknowledge: true,
+			err:         nil,
+		},
+		{
+			desc:        "handle response to non-existing command",
+			res:         res,
+			retrieveErr: repoerr.ErrNotFound,
+			err:         repoerr.ErrNotFound,
+		},
+		{
+			desc: "handle response from other client",
+			res:  otherClient,
+			cmd:  published,
+			err:  commands.ErrInvalidResponse,
+		},
+		{
+			desc: "handle duplicate response",
+			res:  res,
+			cmd:  acknowledged,
+			err:  nil,
+		},
+		{
+			desc: "handle response to timed out command",
+			res:  res,
+			cmd:  timedOut,
+			err:  commands.ErrExpired,
+		},
+		{
+			desc: "handle response to expired command",
+			res:  res,
+			cmd:  expired,
+			err:  commands.ErrExpired,
+		},
+		{
+			desc:        "handle response with failed acknowledge",
+			res:         res,
+			cmd:         published,
+			acknowledge: true,
+			ackErr:      repoerr.ErrUpdateEntity,
+			err:         svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			retrieveCall := repo.On("RetrieveByID", context.Background(), tc.res.DomainID, tc.res.CommandID).Return(tc.cmd, tc.retrieveErr)
+			var ack commands.Command
+			ackCall := repo.On("Acknowledge", context.Background(), mock.Anything).Run(func(args mock.Arguments) {
+				ack = args.Get(1).(commands.Command)
+			}).Return(acknowledged, tc.ackErr)
+
+			err := svc.HandleResponse(context.Background(), tc.res)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.acknowledge {
+				assert.Equal(t, commands.AcknowledgedStatus, ack.Status)
+				assert.Equal(t, string(tc.res.Payload), string(ack.Response))
+				assert.Equal(t, tc.res.ReceivedAt, ack.AcknowledgedAt)
+			}
+			retrieveCall.Unset()
+			ackCall.Unset()
+		})
+	}
+}
+
+func TestListCommands(t *testing.T) {
+	svc, repo, _ := newService(t)
+
+	page := commands.CommandsPage{
+		Total:    1,
+		Limit:    10,
+		Commands: []commands.Command{{ID: testsutil.GenerateUUID(t), DomainID: domainID}},
+	}
+
+	cases := []struct {
+		desc    string
+		pm      commands.PageMetadata
+		page    commands.CommandsPage
+		repoErr error
+		err     error
+	}{
+		{
+			desc: "list commands successfully",
+			pm:   commands.PageMetadata{Limit: 10, Status: commands.AllStatus},
+			page: page,
+			err:  nil,
+		},
+		{
+			desc:    "list commands with failed retrieve",
+			pm:      commands.PageMetadata{Limit: 10, Status: commands.AllStatus},
+			repoErr: repoerr.ErrViewEntity,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			pm := tc.pm
+			pm.DomainID = domainID
+			repoCall := repo.On("RetrieveAll", context.Background(), pm).Return(tc.page, tc.repoErr)
+			page, err := svc.ListCommands(context.Background(), session, tc.pm)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.page, page, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.page, page))
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestExpireCommands(t *testing.T) {
+	svc, repo, _ := newService(t)
+
+	cases := []struct {
+		desc    string
+		count   uint64
+		repoErr error
+		err     error
+	}{
+		{
+			desc:  "expire commands successfully",
+			count: 3,
+			err:   nil,
+		},
+		{
+			desc:    "expire commands with failed update",
+			repoErr: repoerr.ErrUpdateEntity,
+			err:     svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("Expire", context.Background(), mock.Anything).Return(tc.count, tc.repoErr)
+			count, err := svc.ExpireCommands(context.Background())
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.count, count, fmt.Sprintf("%s: expected %d got %d\n", tc.desc, tc.count, count))
+			repoCall.Unset()
+		})
+	}
+}
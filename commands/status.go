@@ -0,0 +1,93 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"encoding/json"
+	"strings"
+
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+)
+
+type Status uint8
+
+const (
+	// PendingStatus is the status of the stored commands which are not yet
+	// published to the client channel.
+	PendingStatus Status = iota
+	// PublishedStatus is the status of the commands published to the client
+	// channel which are waiting for the client response. The broker does not
+	// report the delivery, so only the response confirms that the client
+	// received the command.
+	PublishedStatus
+	// AcknowledgedStatus is the status of the commands the client responded to.
+	AcknowledgedStatus
+	// TimedOutStatus is the status of the commands the client did not respond
+	// to before they expired.
+	TimedOutStatus
+
+	// AllStatus is used for querying purposes to list commands irrespective
+	// of their status. It is never stored in the database as the actual
+	// Command status and should always be the largest value in this enumeration.
+	AllStatus
+)
+
+const (
+	Pending      = "pending"
+	Published    = "published"
+	Acknowledged = "acknowledged"
+	TimedOut     = "timed_out"
+	Unknown      = "unknown"
+	All          = "all"
+)
+
+// String converts command status to string literal.
+func (s Status) String() string {
+	switch s {
+	case PendingStatus:
+		return Pending
+	case PublishedStatus:
+		return Published
+	case AcknowledgedStatus:
+		return Acknowledged
+	case TimedOutStatus:
+		return TimedOut
+	case AllStatus:
+		return All
+	default:
+		return Unknown
+	}
+}
+
+// ToStatus converts string value to a valid Command status.
+func ToStatus(status string) (Status, error) {
+	switch strings.ToLower(status) {
+	case Pending:
+		return PendingStatus, nil
+	case Published:
+		return PublishedStatus, nil
+	case Acknowledged:
+		return AcknowledgedStatus, nil
+	case TimedOut:
+		return TimedOutStatus, nil
+	case All:
+		return AllStatus, nil
+	default:
+		return Status(0), svcerr.ErrInvalidStatus
+	}
+}
+
+// Custom Marshaller for Command status.
+func (s Status) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// Custom Unmarshaler for Command status.
+func (s *Status) UnmarshalJSON(data []byte) error {
+	str := strings.Trim(string(data), "\"")
+	val, err := ToStatus(str)
+	*s = val
+
+	return err
+}
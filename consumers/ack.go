@@ -0,0 +1,56 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package consumers
+
+import (
+	"context"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+type ackKey struct{}
+
+type deferredAck struct {
+	ack     messaging.AckFunc
+	claimed bool
+}
+
+// AckFunc acknowledges a consumed message. A nil error acknowledges the
+// message, messaging.Error sets the acknowledgement type explicitly and any
+// other error leaves the message unacknowledged.
+type AckFunc func(err error)
+
+// ClaimAck takes over the acknowledgement of the message consumed using ctx.
+// AsyncConsumer implementations which complete processing after ConsumeAsync
+// returns, such as batching writers, use it to acknowledge the message only
+// once it is persisted. It must be called before ConsumeAsync returns and the
+// returned function must be called exactly once. If the message source does
+// not support deferred acknowledgement, ok is false and the message has
+// already been acknowledged.
+func ClaimAck(ctx context.Context) (ack AckFunc, ok bool) {
+	da, ok := ctx.Value(ackKey{}).(*deferredAck)
+	if !ok || da.claimed {
+		return nil, false
+	}
+	da.claimed = true
+
+	return func(err error) {
+		da.ack(ackType(err), err)
+	}, true
+}
+
+func withAck(ctx context.Context, ack messaging.AckFunc) (context.Context, *deferredAck) {
+	da := &deferredAck{ack: ack}
+	return context.WithValue(ctx, ackKey{}, da), da
+}
+
+func ackType(err error) messaging.AckType {
+	if err == nil {
+		return messaging.Ack
+	}
+	if e, ok := err.(messaging.Error); ok && e != nil {
+		return e.Ack()
+	}
+	return messaging.NoAck
+}
@@ -14,7 +14,10 @@ import (
 	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/absmach/magistrala/pkg/messaging"
 	"github.com/absmach/magistrala/pkg/transformers"
+	"github.com/absmach/magistrala/pkg/transformers/cbor"
+	chtransformers "github.com/absmach/magistrala/pkg/transformers/channels"
 	"github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/absmach/magistrala/pkg/transformers/protobuf"
 	"github.com/absmach/magistrala/pkg/transformers/senml"
 	"github.com/pelletier/go-toml"
 )
@@ -31,14 +34,19 @@ var (
 
 // Start method starts consuming messages received from Message broker.
 // This method transforms messages to SenML format before
-// using MessageRepository to store them.
-func Start(ctx context.Context, id string, sub messaging.Subscriber, consumer any, configPath string, defaultTopic string, logger *slog.Logger) error {
+// using MessageRepository to store them. If channel transformers are
+// provided, messages of channels with transformer settings are transformed
+// according to the channel settings instead.
+func Start(ctx context.Context, id string, sub messaging.Subscriber, consumer any, configPath string, defaultTopic string, channelTransformers *chtransformers.Registry, logger *slog.Logger) error {
 	cfg, err := loadConfig(configPath, defaultTopic)
 	if err != nil {
 		logger.Warn(fmt.Sprintf("Failed to load consumer config: %s", err))
 	}
 
 	transformer := makeTransformer(cfg.TransformerCfg, logger)
+	if channelTransformers != nil {
+		transformer = channelTransformers.Transformer(transformer)
+	}
 
 	for _, topic := range cfg.SubscriberCfg.Topics {
 		subCfg := messaging.SubscriberConfig{
@@ -78,22 +86,59 @@ func handleSync(ctx context.Context, t transformers.Transformer, sc BlockingCons
 	}
 }
 
-func handleAsync(ctx context.Context, t transformers.Transformer, ac AsyncConsumer) handleFunc {
-	return func(msg *messaging.Message) error {
-		m := any(msg)
-		var err error
-		if t != nil {
-			m, err = t.Transform(msg)
-			if err != nil {
-				return err
-			}
-		}
+func handleAsync(ctx context.Context, t transformers.Transformer, ac AsyncConsumer) messaging.MessageHandler {
+	return &asyncHandler{
+		ctx:         ctx,
+		transformer: t,
+		consumer:    ac,
+	}
+}
 
-		ac.ConsumeAsync(ctx, m)
-		return nil
+// asyncHandler passes messages to an AsyncConsumer. When the subscriber
+// supports deferred acknowledgement, the consumer may claim the message
+// acknowledgement using ClaimAck; otherwise the message is acknowledged as
+// soon as it is passed to the consumer.
+type asyncHandler struct {
+	ctx         context.Context
+	transformer transformers.Transformer
+	consumer    AsyncConsumer
+}
+
+func (h *asyncHandler) Handle(msg *messaging.Message) error {
+	m, err := h.transform(msg)
+	if err != nil {
+		return err
+	}
+
+	h.consumer.ConsumeAsync(h.ctx, m)
+	return nil
+}
+
+func (h *asyncHandler) HandleDeferred(msg *messaging.Message, ack messaging.AckFunc) {
+	m, err := h.transform(msg)
+	if err != nil {
+		ack(ackType(err), err)
+		return
+	}
+
+	ctx, da := withAck(h.ctx, ack)
+	h.consumer.ConsumeAsync(ctx, m)
+	if !da.claimed {
+		ack(messaging.Ack, nil)
 	}
 }
 
+func (h *asyncHandler) Cancel() error {
+	return nil
+}
+
+func (h *asyncHandler) transform(msg *messaging.Message) (any, error) {
+	if h.transformer == nil {
+		return msg, nil
+	}
+	return h.transformer.Transform(msg)
+}
+
 type handleFunc func(msg *messaging.Message) error
 
 func (h handleFunc) Handle(msg *messaging.Message) error {
@@ -109,9 +154,10 @@ type subscriberConfig struct {
 }
 
 type transformerConfig struct {
-	Format      string           `toml:"format"`
-	ContentType string           `toml:"content_type"`
-	TimeFields  []json.TimeField `toml:"time_fields"`
+	Format      string                `toml:"format"`
+	ContentType string                `toml:"content_type"`
+	TimeFields  []json.TimeField      `toml:"time_fields"`
+	Protobuf    []protobuf.Descriptor `toml:"protobuf"`
 }
 
 type config struct {
@@ -142,16 +188,44 @@ func loadConfig(configPath, defaultTopic string) (config, error) {
 	return cfg, nil
 }
 
+// makeTransformer creates the transformer configured by format. Messages
+// which carry a known content type are transformed according to their content
+// type instead, so SenML, JSON, CBOR and protobuf payloads can share a
+// subscription.
 func makeTransformer(cfg transformerConfig, logger *slog.Logger) transformers.Transformer {
+	registry := protobuf.NewRegistry()
+	for _, d := range cfg.Protobuf {
+		if err := registry.RegisterFile(d); err != nil {
+			logger.Warn(fmt.Sprintf("Failed to load protobuf descriptor for channel %s: %s", d.Channel, err))
+		}
+	}
+	pb := protobuf.New(cfg.TimeFields, registry)
+
+	var t transformers.Transformer
 	switch strings.ToUpper(cfg.Format) {
 	case "SENML":
 		logger.Info("Using SenML transformer")
-		return senml.New(cfg.ContentType)
+		t = senml.New(cfg.ContentType)
 	case "JSON":
 		logger.Info("Using JSON transformer")
-		return json.New(cfg.TimeFields)
+		t = json.New(cfg.TimeFields)
+	case "CBOR":
+		logger.Info("Using CBOR transformer")
+		t = cbor.New(cfg.TimeFields)
+	case "PROTOBUF":
+		logger.Info("Using protobuf transformer")
+		t = pb
 	default:
 		logger.Warn(fmt.Sprintf("No transformer created: unknown transformer type %s", cfg.Format))
 		return nil
 	}
+
+	return transformers.ByContentType(t, map[string]transformers.Transformer{
+		senml.JSON:            senml.New(senml.JSON),
+		senml.CBOR:            senml.New(senml.CBOR),
+		json.ContentType:      json.New(cfg.TimeFields),
+		cbor.ContentType:      cbor.New(cfg.TimeFields),
+		protobuf.ContentType:  pb,
+		protobuf.XContentType: pb,
+	})
 }
@@ -0,0 +1,222 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package batch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/absmach/magistrala/consumers"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	smqjson "github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+)
+
+const (
+	defSize     = 500
+	defInterval = time.Second
+
+	// defBufferedBatches is the default number of batches buffered
+	// while the repository is saving a batch.
+	defBufferedBatches = 10
+)
+
+var (
+	errSaveBatch = errors.New("failed to save messages batch")
+	errStopped   = errors.New("batch consumer stopped")
+)
+
+var _ consumers.AsyncConsumer = (*batcher)(nil)
+
+// Repository persists a batch of transformed messages atomically.
+type Repository interface {
+	// SaveBatch saves all the messages in a single transaction. Each batch
+	// element holds the transformed representation of one broker message.
+	SaveBatch(ctx context.Context, batch []any) error
+}
+
+// Config defines the batch size and flush interval. MaxBuffered limits the
+// number of records buffered while a batch is being saved, and defaults to
+// ten times the batch size.
+type Config struct {
+	Size        int
+	Interval    time.Duration
+	MaxBuffered int
+}
+
+type batcher struct {
+	repo        Repository
+	size        int
+	interval    time.Duration
+	maxBuffered int
+	mu          sync.Mutex
+	messages    []any
+	acks        []consumers.AckFunc
+	count       int
+	closed      bool
+	flushed     chan struct{}
+	full        chan struct{}
+	errs        chan error
+	done        <-chan struct{}
+}
+
+// New returns an AsyncConsumer which buffers messages and saves them using
+// the repository once the buffer holds the configured number of records or
+// the flush interval elapses. Messages are acknowledged only after the batch
+// containing them is committed. Once the buffer holds the maximum number of
+// records, consuming blocks until the buffer is flushed, so that a slow
+// repository slows down the delivery instead of growing the buffer.
+// Buffered messages are flushed once ctx is done, and the messages consumed
+// after it are rejected for redelivery.
+func New(ctx context.Context, repo Repository, cfg Config) consumers.AsyncConsumer {
+	if cfg.Size <= 0 {
+		cfg.Size = defSize
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = defInterval
+	}
+	if cfg.MaxBuffered < cfg.Size {
+		cfg.MaxBuffered = defBufferedBatches * cfg.Size
+	}
+	b := &batcher{
+		repo:        repo,
+		size:        cfg.Size,
+		interval:    cfg.Interval,
+		maxBuffered: cfg.MaxBuffered,
+		flushed:     make(chan struct{}),
+		full:        make(chan struct{}, 1),
+		errs:        make(chan error, 1),
+		done:        ctx.Done(),
+	}
+	go b.run(ctx)
+
+	return b
+}
+
+func (b *batcher) ConsumeAsync(ctx context.Context, messages any) {
+	ack, _ := consumers.ClaimAck(ctx)
+
+	b.mu.Lock()
+	for !b.closed && b.count >= b.maxBuffered {
+		flushed := b.flushed
+		b.mu.Unlock()
+		select {
+		case <-flushed:
+		case <-ctx.Done():
+			if ack != nil {
+				ack(messaging.NewError(ctx.Err(), messaging.Nack))
+			}
+			return
+		case <-b.done:
+			if ack != nil {
+				ack(messaging.NewError(errStopped, messaging.Nack))
+			}
+			return
+		}
+		b.mu.Lock()
+	}
+	// The messages consumed after the final flush would never be saved.
+	if b.closed {
+		b.mu.Unlock()
+		if ack != nil {
+			ack(messaging.NewError(errStopped, messaging.Nack))
+		}
+		return
+	}
+	b.messages = append(b.messages, messages)
+	b.acks = append(b.acks, ack)
+	b.count += records(messages)
+	full := b.count >= b.size
+	b.mu.Unlock()
+
+	if full {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Errors returns the channel of batch write errors. Errors are dropped
+// while the channel is not drained.
+func (b *batcher) Errors() <-chan error {
+	return b.errs
+}
+
+func (b *batcher) run(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			b.mu.Lock()
+			b.closed = true
+			b.mu.Unlock()
+			b.flush(context.WithoutCancel(ctx))
+			return
+		case <-ticker.C:
+			b.flush(ctx)
+		case <-b.full:
+			b.flush(ctx)
+		}
+	}
+}
+
+func (b *batcher) flush(ctx context.Context) {
+	b.mu.Lock()
+	messages, acks := b.messages, b.acks
+	b.messages, b.acks, b.count = nil, nil, 0
+	close(b.flushed)
+	b.flushed = make(chan struct{})
+	b.mu.Unlock()
+
+	if len(messages) == 0 {
+		return
+	}
+
+	b.save(ctx, messages, acks)
+}
+
+func (b *batcher) save(ctx context.Context, messages []any, acks []consumers.AckFunc) {
+	err := b.repo.SaveBatch(ctx, messages)
+	if err != nil {
+		// A batch rejected due to invalid messages is split so that
+		// only the offending messages are terminated.
+		if e, ok := err.(messaging.Error); ok && e.Ack() == messaging.Term && len(messages) > 1 {
+			for i := range messages {
+				b.save(ctx, messages[i:i+1], acks[i:i+1])
+			}
+			return
+		}
+		select {
+		case b.errs <- errors.Wrap(errSaveBatch, err):
+		default:
+		}
+		// Failed batches are redelivered unless the repository
+		// explicitly sets the acknowledgement type.
+		if _, ok := err.(messaging.Error); !ok {
+			err = messaging.NewError(err, messaging.Nack)
+		}
+	}
+
+	for _, ack := range acks {
+		if ack != nil {
+			ack(err)
+		}
+	}
+}
+
+func records(messages any) int {
+	switch m := messages.(type) {
+	case []senml.Message:
+		return len(m)
+	case smqjson.Messages:
+		return len(m.Data)
+	default:
+		return 1
+	}
+}
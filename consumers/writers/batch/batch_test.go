@@ -0,0 +1,265 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package batch_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/consumers"
+	"github.com/absmach/magistrala/consumers/writers/batch"
+	"github.com/absmach/magistrala/internal/testsutil"
+	mglog "github.com/absmach/magistrala/logger"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	validPayload   = `[{"n":"temperature","v":21.5}]`
+	invalidPayload = `[{"n":"invalid","v":0}]`
+	invalidName    = "invalid"
+)
+
+var errSave = errors.New("failed to save")
+
+type repository struct {
+	mu      sync.Mutex
+	batches [][]any
+	err     error
+}
+
+func (r *repository) SaveBatch(_ context.Context, msgs []any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, msgs)
+	if r.err != nil {
+		return r.err
+	}
+	for _, msg := range msgs {
+		for _, m := range msg.([]senml.Message) {
+			if m.Name == invalidName {
+				return messaging.NewError(errSave, messaging.Term)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *repository) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.batches)
+}
+
+type subscriber struct {
+	messaging.Subscriber
+	handler messaging.MessageHandler
+}
+
+func (s *subscriber) Subscribe(_ context.Context, cfg messaging.SubscriberConfig) error {
+	s.handler = cfg.Handler
+	return nil
+}
+
+type acks struct {
+	mu   sync.Mutex
+	acks []messaging.AckType
+}
+
+func (a *acks) ack() messaging.AckFunc {
+	return func(at messaging.AckType, _ error) {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		a.acks = append(a.acks, at)
+	}
+}
+
+func (a *acks) get() []messaging.AckType {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]messaging.AckType{}, a.acks...)
+}
+
+func TestConsumeAsync(t *testing.T) {
+	cases := []struct {
+		desc     string
+		cfg      batch.Config
+		payloads []string
+		err      error
+		acks     []messaging.AckType
+		batches  int
+		saveErr  bool
+	}{
+		{
+			desc:     "flush full batch",
+			cfg:      batch.Config{Size: 2, Interval: time.Hour},
+			payloads: []string{validPayload, validPayload},
+			acks:     []messaging.AckType{messaging.Ack, messaging.Ack},
+			batches:  1,
+		},
+		{
+			desc:     "flush batch on interval",
+			cfg:      batch.Config{Size: 100, Interval: 10 * time.Millisecond},
+			payloads: []string{validPayload},
+			acks:     []messaging.AckType{messaging.Ack},
+			batches:  1,
+		},
+		{
+			desc:     "flush batch with repository error",
+			cfg:      batch.Config{Size: 2, Interval: time.Hour},
+			payloads: []string{validPayload, validPayload},
+			err:      errSave,
+			acks:     []messaging.AckType{messaging.Nack, messaging.Nack},
+			batches:  1,
+			saveErr:  true,
+		},
+		{
+			desc:     "flush batch with invalid message",
+			cfg:      batch.Config{Size: 2, Interval: time.Hour},
+			payloads: []string{validPayload, invalidPayload},
+			acks:     []messaging.AckType{messaging.Ack, messaging.Term},
+			batches:  3,
+			saveErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			repo := &repository{err: tc.err}
+			consumer := batch.New(ctx, repo, tc.cfg)
+			sub := &subscriber{}
+			err := consumers.Start(ctx, "batch", sub, consumer, "", "channels.>", nil, mglog.NewMock())
+			require.Nil(t, err, "unexpected error starting consumer")
+			handler, ok := sub.handler.(messaging.DeferredAckHandler)
+			require.True(t, ok, "expected handler supporting deferred acknowledgement")
+
+			a := &acks{}
+			for _, p := range tc.payloads {
+				msg := &messaging.Message{
+					Channel:   testsutil.GenerateUUID(t),
+					Publisher: testsutil.GenerateUUID(t),
+					Payload:   []byte(p),
+				}
+				handler.HandleDeferred(msg, a.ack())
+			}
+
+			assert.Eventually(t, func() bool {
+				return len(a.get()) == len(tc.acks)
+			}, time.Second, time.Millisecond, "expected all messages to be acknowledged")
+			assert.ElementsMatch(t, tc.acks, a.get())
+			assert.Equal(t, tc.batches, repo.count())
+			if tc.saveErr {
+				select {
+				case err := <-consumer.Errors():
+					assert.NotNil(t, err, "expected batch error")
+				default:
+					t.Error("expected batch error to be reported")
+				}
+			}
+		})
+	}
+}
+
+type blockingRepository struct {
+	repository
+	release chan struct{}
+}
+
+func (r *blockingRepository) SaveBatch(ctx context.Context, msgs []any) error {
+	<-r.release
+	return r.repository.SaveBatch(ctx, msgs)
+}
+
+func TestConsumeAsyncBackpressure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := &blockingRepository{release: make(chan struct{})}
+	consumer := batch.New(ctx, repo, batch.Config{Size: 1, Interval: time.Hour, MaxBuffered: 2})
+	sub := &subscriber{}
+	err := consumers.Start(ctx, "batch", sub, consumer, "", "channels.>", nil, mglog.NewMock())
+	require.Nil(t, err, "unexpected error starting consumer")
+	handler, ok := sub.handler.(messaging.DeferredAckHandler)
+	require.True(t, ok, "expected handler supporting deferred acknowledgement")
+
+	a := &acks{}
+	handle := func() {
+		handler.HandleDeferred(&messaging.Message{
+			Channel:   testsutil.GenerateUUID(t),
+			Publisher: testsutil.GenerateUUID(t),
+			Payload:   []byte(validPayload),
+		}, a.ack())
+	}
+
+	// The first message is flushed and blocks in the repository, while
+	// the next two fill the buffer.
+	handle()
+	time.Sleep(10 * time.Millisecond)
+	handle()
+	handle()
+
+	blocked := make(chan struct{})
+	go func() {
+		handle()
+		close(blocked)
+	}()
+	select {
+	case <-blocked:
+		t.Fatal("expected consuming to block while the buffer is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(repo.release)
+	select {
+	case <-blocked:
+	case <-time.After(time.Second):
+		t.Fatal("expected consuming to resume after the buffer is flushed")
+	}
+
+	assert.Eventually(t, func() bool {
+		return len(a.get()) == 4
+	}, time.Second, time.Millisecond, "expected all messages to be acknowledged")
+}
+
+func TestConsumeAsyncAfterClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := &repository{}
+	consumer := batch.New(ctx, repo, batch.Config{Size: 100, Interval: time.Hour})
+	sub := &subscriber{}
+	err := consumers.Start(ctx, "batch", sub, consumer, "", "channels.>", nil, mglog.NewMock())
+	require.Nil(t, err, "unexpected error starting consumer")
+	handler, ok := sub.handler.(messaging.DeferredAckHandler)
+	require.True(t, ok, "expected handler supporting deferred acknowledgement")
+
+	a := &acks{}
+	handle := func() {
+		handler.HandleDeferred(&messaging.Message{
+			Channel:   testsutil.GenerateUUID(t),
+			Publisher: testsutil.GenerateUUID(t),
+			Payload:   []byte(validPayload),
+		}, a.ack())
+	}
+
+	// The buffered message is saved by the final flush, and the message
+	// consumed after it is rejected.
+	handle()
+	cancel()
+	assert.Eventually(t, func() bool {
+		return len(a.get()) == 1
+	}, time.Second, time.Millisecond, "expected buffered message to be flushed")
+	handle()
+
+	assert.Equal(t, []messaging.AckType{messaging.Ack, messaging.Nack}, a.get())
+	assert.Equal(t, 1, repo.count())
+}
@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package batch contains an asynchronous consumer which buffers messages
+// and persists them in batches using the underlying writer repository.
+package batch
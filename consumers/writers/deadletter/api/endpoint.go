@@ -0,0 +1,138 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/consumers/writers/deadletter"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func listEntriesEndpoint(svc deadletter.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		req := request.(listEntriesReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		pm := deadletter.PageMetadata{
+			Offset:  req.offset,
+			Limit:   req.limit,
+			Domain:  req.domain,
+			Channel: req.channel,
+		}
+		page, err := svc.ListEntries(ctx, session, pm)
+		if err != nil {
+			return nil, err
+		}
+
+		res := listEntriesRes{
+			Offset:  page.Offset,
+			Limit:   page.Limit,
+			Total:   page.Total,
+			Entries: []entryRes{},
+		}
+		for _, entry := range page.Entries {
+			res.Entries = append(res.Entries, toEntryRes(entry))
+		}
+
+		return res, nil
+	}
+}
+
+func viewEntryEndpoint(svc deadletter.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		req := request.(entryReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		entry, err := svc.ViewEntry(ctx, session, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return toEntryRes(entry), nil
+	}
+}
+
+func updateEntryEndpoint(svc deadletter.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		req := request.(updateEntryReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		upd := deadletter.EntryUpdate{
+			Subtopic: req.Subtopic,
+			Payload:  req.Payload,
+		}
+		entry, err := svc.UpdateEntry(ctx, session, req.id, upd)
+		if err != nil {
+			return nil, err
+		}
+
+		return toEntryRes(entry), nil
+	}
+}
+
+func replayEntryEndpoint(svc deadletter.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		req := request.(entryReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		if err := svc.ReplayEntry(ctx, session, req.id); err != nil {
+			return nil, err
+		}
+
+		return replayEntryRes{}, nil
+	}
+}
+
+func removeEntryEndpoint(svc deadletter.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthentication
+		}
+
+		req := request.(entryReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		if err := svc.RemoveEntry(ctx, session, req.id); err != nil {
+			return nil, err
+		}
+
+		return removeEntryRes{}, nil
+	}
+}
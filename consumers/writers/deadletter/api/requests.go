@@ -0,0 +1,53 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	api "github.com/absmach/magistrala/api/http"
+	apiutil "github.com/absmach/magistrala/api/http/util"
+)
+
+type listEntriesReq struct {
+	offset  uint64
+	limit   uint64
+	domain  string
+	channel string
+}
+
+func (req listEntriesReq) validate() error {
+	if req.limit > api.MaxLimitSize || req.limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type entryReq struct {
+	id string
+}
+
+func (req entryReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type updateEntryReq struct {
+	id       string
+	Subtopic *string `json:"subtopic,omitempty"`
+	Payload  []byte  `json:"payload,omitempty"`
+}
+
+func (req updateEntryReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.Subtopic == nil && req.Payload == nil {
+		return apiutil.ErrMalformedRequestBody
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/absmach/magistrala"
+	"github.com/absmach/magistrala/consumers/writers/deadletter"
+)
+
+var (
+	_ magistrala.Response = (*entryRes)(nil)
+	_ magistrala.Response = (*listEntriesRes)(nil)
+	_ magistrala.Response = (*replayEntryRes)(nil)
+	_ magistrala.Response = (*removeEntryRes)(nil)
+)
+
+type messageRes struct {
+	Domain    string `json:"domain,omitempty"`
+	Channel   string `json:"channel,omitempty"`
+	Subtopic  string `json:"subtopic,omitempty"`
+	Publisher string `json:"publisher,omitempty"`
+	Protocol  string `json:"protocol,omitempty"`
+	Payload   []byte `json:"payload,omitempty"`
+	Created   int64  `json:"created,omitempty"`
+}
+
+type entryRes struct {
+	ID        string     `json:"id"`
+	Topic     string     `json:"topic"`
+	Message   messageRes `json:"message"`
+	Error     string     `json:"error,omitempty"`
+	Attempts  uint64     `json:"attempts"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+func toEntryRes(entry deadletter.Entry) entryRes {
+	msg := entry.Message
+	return entryRes{
+		ID:    entry.ID,
+		Topic: entry.Topic,
+		Message: messageRes{
+			Domain:    msg.GetDomain(),
+			Channel:   msg.GetChannel(),
+			Subtopic:  msg.GetSubtopic(),
+			Publisher: msg.GetPublisher(),
+			Protocol:  msg.GetProtocol(),
+			Payload:   msg.GetPayload(),
+			Created:   msg.GetCreated(),
+		},
+		Error:     entry.Error,
+		Attempts:  entry.Attempts,
+		CreatedAt: entry.CreatedAt,
+		UpdatedAt: entry.UpdatedAt,
+	}
+}
+
+func (res entryRes) Code() int {
+	return http.StatusOK
+}
+
+func (res entryRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res entryRes) Empty() bool {
+	return false
+}
+
+type listEntriesRes struct {
+	Offset  uint64     `json:"offset"`
+	Limit   uint64     `json:"limit"`
+	Total   uint64     `json:"total"`
+	Entries []entryRes `json:"entries"`
+}
+
+func (res listEntriesRes) Code() int {
+	return http.StatusOK
+}
+
+func (res listEntriesRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res listEntriesRes) Empty() bool {
+	return false
+}
+
+type replayEntryRes struct{}
+
+func (res replayEntryRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res replayEntryRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res replayEntryRes) Empty() bool {
+	return true
+}
+
+type removeEntryRes struct{}
+
+func (res removeEntryRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res removeEntryRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res removeEntryRes) Empty() bool {
+	return true
+}
@@ -0,0 +1,124 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/absmach/magistrala"
+	api "github.com/absmach/magistrala/api/http"
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/consumers/writers/deadletter"
+	smqauthn "github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/go-chi/chi/v5"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const entryIDKey = "entryID"
+
+// MakeHandler returns a HTTP handler for dead-letter API endpoints, health check and metrics.
+func MakeHandler(svc deadletter.Service, authn smqauthn.AuthNMiddleware, logger *slog.Logger, svcName, instanceID string) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, api.EncodeError)),
+	}
+
+	mux := chi.NewRouter()
+
+	mux.Group(func(r chi.Router) {
+		r.Use(authn.WithOptions(smqauthn.WithDomainCheck(false)).Middleware())
+		r.Route("/deadletters", func(r chi.Router) {
+			r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+				listEntriesEndpoint(svc),
+				decodeListEntries,
+				api.EncodeResponse,
+				opts...,
+			), "list_dead_letters").ServeHTTP)
+
+			r.Route("/{entryID}", func(r chi.Router) {
+				r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+					viewEntryEndpoint(svc),
+					decodeEntry,
+					api.EncodeResponse,
+					opts...,
+				), "view_dead_letter").ServeHTTP)
+
+				r.Patch("/", otelhttp.NewHandler(kithttp.NewServer(
+					updateEntryEndpoint(svc),
+					decodeUpdateEntry,
+					api.EncodeResponse,
+					opts...,
+				), "update_dead_letter").ServeHTTP)
+
+				r.Post("/replay", otelhttp.NewHandler(kithttp.NewServer(
+					replayEntryEndpoint(svc),
+					decodeEntry,
+					api.EncodeResponse,
+					opts...,
+				), "replay_dead_letter").ServeHTTP)
+
+				r.Delete("/", otelhttp.NewHandler(kithttp.NewServer(
+					removeEntryEndpoint(svc),
+					decodeEntry,
+					api.EncodeResponse,
+					opts...,
+				), "remove_dead_letter").ServeHTTP)
+			})
+		})
+	})
+
+	mux.Get("/health", magistrala.Health(svcName, instanceID))
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return mux
+}
+
+func decodeListEntries(_ context.Context, r *http.Request) (any, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	domain, err := apiutil.ReadStringQuery(r, api.DomainKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	channel, err := apiutil.ReadStringQuery(r, api.ChannelKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return listEntriesReq{
+		offset:  offset,
+		limit:   limit,
+		domain:  domain,
+		channel: channel,
+	}, nil
+}
+
+func decodeEntry(_ context.Context, r *http.Request) (any, error) {
+	return entryReq{id: chi.URLParam(r, entryIDKey)}, nil
+}
+
+func decodeUpdateEntry(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateEntryReq{id: chi.URLParam(r, entryIDKey)}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
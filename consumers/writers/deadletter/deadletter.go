@@ -0,0 +1,90 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package deadletter
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+// Entry represents a message which a writer failed to consume.
+type Entry struct {
+	ID        string
+	Topic     string
+	Message   *messaging.Message
+	Error     string
+	Attempts  uint64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// EntryUpdate contains the message fields which can be fixed before replay.
+// Nil fields are left unchanged.
+type EntryUpdate struct {
+	Subtopic *string
+	Payload  []byte
+}
+
+// Page represents page metadata with content.
+type Page struct {
+	PageMetadata
+	Total   uint64
+	Entries []Entry
+}
+
+// PageMetadata contains page metadata that helps navigation.
+type PageMetadata struct {
+	Offset  uint64
+	Limit   uint64
+	Domain  string
+	Channel string
+}
+
+// Repository specifies a dead-letter entries persistence API.
+type Repository interface {
+	// Save persists the dead-letter entry.
+	Save(ctx context.Context, entry Entry) error
+
+	// Retrieve retrieves the entry for the given id.
+	Retrieve(ctx context.Context, id string) (Entry, error)
+
+	// RetrieveAll retrieves the entries for the given page metadata.
+	RetrieveAll(ctx context.Context, pm PageMetadata) (Page, error)
+
+	// Update updates the entry message, error and attempt count.
+	Update(ctx context.Context, entry Entry) error
+
+	// Remove removes the entry for the given id.
+	Remove(ctx context.Context, id string) error
+}
+
+// Replayer passes messages to the handler subscribed to the topic.
+type Replayer interface {
+	// Replay handles the message using the handler subscribed to the topic
+	// and returns the handling error, if any.
+	Replay(ctx context.Context, topic string, msg *messaging.Message) error
+}
+
+// Service specifies an API for managing dead-lettered messages.
+type Service interface {
+	// ListEntries lists dead-letter entries matching the page metadata.
+	ListEntries(ctx context.Context, session authn.Session, pm PageMetadata) (Page, error)
+
+	// ViewEntry retrieves the dead-letter entry for the given id.
+	ViewEntry(ctx context.Context, session authn.Session, id string) (Entry, error)
+
+	// UpdateEntry fixes the dead-lettered message before it is replayed.
+	UpdateEntry(ctx context.Context, session authn.Session, id string, upd EntryUpdate) (Entry, error)
+
+	// ReplayEntry passes the dead-lettered message to the writer again.
+	// The entry is removed once the message is consumed; otherwise its
+	// attempt count and error are updated.
+	ReplayEntry(ctx context.Context, session authn.Session, id string) error
+
+	// RemoveEntry discards the dead-letter entry for the given id.
+	RemoveEntry(ctx context.Context, session authn.Session, id string) error
+}
@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deadletter contains the dead-letter store used by writers to keep
+// messages which failed to be consumed, so that they can be inspected, fixed
+// and replayed.
+package deadletter
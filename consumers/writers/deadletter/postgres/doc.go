@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package postgres contains the dead-letter repository implementation using
+// PostgreSQL as the underlying database.
+package postgres
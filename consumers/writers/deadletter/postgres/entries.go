@@ -0,0 +1,197 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/consumers/writers/deadletter"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/postgres"
+	"google.golang.org/protobuf/proto"
+)
+
+const entryColumns = `id, topic, domain, channel, message, error, attempts, created_at, updated_at`
+
+var _ deadletter.Repository = (*repository)(nil)
+
+type repository struct {
+	db postgres.Database
+}
+
+// New instantiates a PostgreSQL implementation of dead-letter repository.
+func New(db postgres.Database) deadletter.Repository {
+	return &repository{db: db}
+}
+
+func (repo *repository) Save(ctx context.Context, entry deadletter.Entry) error {
+	q := fmt.Sprintf(`INSERT INTO dead_letters (%s)
+		VALUES (:id, :topic, :domain, :channel, :message, :error, :attempts, :created_at, :updated_at)`, entryColumns)
+
+	dbe, err := toDBEntry(entry)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	if _, err := repo.db.NamedExecContext(ctx, q, dbe); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *repository) Retrieve(ctx context.Context, id string) (deadletter.Entry, error) {
+	q := fmt.Sprintf(`SELECT %s FROM dead_letters WHERE id = $1`, entryColumns)
+
+	var dbe dbEntry
+	if err := repo.db.QueryRowxContext(ctx, q, id).StructScan(&dbe); err != nil {
+		if err == sql.ErrNoRows {
+			return deadletter.Entry{}, errors.Wrap(repoerr.ErrNotFound, err)
+		}
+		return deadletter.Entry{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return toEntry(dbe)
+}
+
+func (repo *repository) RetrieveAll(ctx context.Context, pm deadletter.PageMetadata) (deadletter.Page, error) {
+	var conds []string
+	if pm.Domain != "" {
+		conds = append(conds, "domain = :domain")
+	}
+	if pm.Channel != "" {
+		conds = append(conds, "channel = :channel")
+	}
+	var where string
+	if len(conds) > 0 {
+		where = fmt.Sprintf("WHERE %s", strings.Join(conds, " AND "))
+	}
+
+	q := fmt.Sprintf(`SELECT %s FROM dead_letters %s ORDER BY created_at LIMIT :limit OFFSET :offset`, entryColumns, where)
+	params := map[string]any{
+		"domain":  pm.Domain,
+		"channel": pm.Channel,
+		"limit":   pm.Limit,
+		"offset":  pm.Offset,
+	}
+
+	rows, err := repo.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return deadletter.Page{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	entries := []deadletter.Entry{}
+	for rows.Next() {
+		var dbe dbEntry
+		if err := rows.StructScan(&dbe); err != nil {
+			return deadletter.Page{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		entry, err := toEntry(dbe)
+		if err != nil {
+			return deadletter.Page{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM dead_letters %s`, where)
+	total, err := postgres.Total(ctx, repo.db, cq, params)
+	if err != nil {
+		return deadletter.Page{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return deadletter.Page{
+		PageMetadata: pm,
+		Total:        total,
+		Entries:      entries,
+	}, nil
+}
+
+func (repo *repository) Update(ctx context.Context, entry deadletter.Entry) error {
+	q := `UPDATE dead_letters SET message = :message, error = :error,
+		attempts = :attempts, updated_at = :updated_at WHERE id = :id`
+
+	dbe, err := toDBEntry(entry)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	res, err := repo.db.NamedExecContext(ctx, q, dbe)
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	if cnt, err := res.RowsAffected(); err == nil && cnt == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (repo *repository) Remove(ctx context.Context, id string) error {
+	res, err := repo.db.ExecContext(ctx, `DELETE FROM dead_letters WHERE id = $1`, id)
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if cnt, err := res.RowsAffected(); err == nil && cnt == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+type dbEntry struct {
+	ID        string         `db:"id"`
+	Topic     string         `db:"topic"`
+	Domain    sql.NullString `db:"domain"`
+	Channel   sql.NullString `db:"channel"`
+	Message   []byte         `db:"message"`
+	Error     sql.NullString `db:"error"`
+	Attempts  uint64         `db:"attempts"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+func toDBEntry(entry deadletter.Entry) (dbEntry, error) {
+	msg, err := proto.Marshal(entry.Message)
+	if err != nil {
+		return dbEntry{}, err
+	}
+
+	return dbEntry{
+		ID:        entry.ID,
+		Topic:     entry.Topic,
+		Domain:    toNullString(entry.Message.GetDomain()),
+		Channel:   toNullString(entry.Message.GetChannel()),
+		Message:   msg,
+		Error:     toNullString(entry.Error),
+		Attempts:  entry.Attempts,
+		CreatedAt: entry.CreatedAt,
+		UpdatedAt: entry.UpdatedAt,
+	}, nil
+}
+
+func toEntry(dbe dbEntry) (deadletter.Entry, error) {
+	var msg messaging.Message
+	if err := proto.Unmarshal(dbe.Message, &msg); err != nil {
+		return deadletter.Entry{}, err
+	}
+
+	return deadletter.Entry{
+		ID:        dbe.ID,
+		Topic:     dbe.Topic,
+		Message:   &msg,
+		Error:     dbe.Error.String,
+		Attempts:  dbe.Attempts,
+		CreatedAt: dbe.CreatedAt,
+		UpdatedAt: dbe.UpdatedAt,
+	}, nil
+}
+
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
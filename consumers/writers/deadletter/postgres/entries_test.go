@@ -0,0 +1,162 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/consumers/writers/deadletter"
+	"github.com/absmach/magistrala/consumers/writers/deadletter/postgres"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEntry(t *testing.T, domain, channel string) deadletter.Entry {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	return deadletter.Entry{
+		ID:    testsutil.GenerateUUID(t),
+		Topic: fmt.Sprintf("m.%s.c.%s", domain, channel),
+		Message: &messaging.Message{
+			Domain:  domain,
+			Channel: channel,
+			Payload: []byte(`[{"n":"temperature","v":"invalid"}]`),
+		},
+		Error:     "invalid input syntax",
+		Attempts:  1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func newRepository() deadletter.Repository {
+	return postgres.New(database)
+}
+
+func TestSave(t *testing.T) {
+	repo := newRepository()
+	entry := newEntry(t, testsutil.GenerateUUID(t), testsutil.GenerateUUID(t))
+
+	cases := []struct {
+		desc  string
+		entry deadletter.Entry
+		err   error
+	}{
+		{
+			desc:  "save new entry",
+			entry: entry,
+		},
+		{
+			desc:  "save existing entry",
+			entry: entry,
+			err:   repoerr.ErrConflict,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.Save(context.Background(), tc.entry)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestRetrieveAll(t *testing.T) {
+	repo := newRepository()
+	domain := testsutil.GenerateUUID(t)
+	channel := testsutil.GenerateUUID(t)
+
+	var entries []deadletter.Entry
+	for i := 0; i < 5; i++ {
+		ch := channel
+		if i%2 == 1 {
+			ch = testsutil.GenerateUUID(t)
+		}
+		entry := newEntry(t, domain, ch)
+		entry.CreatedAt = entry.CreatedAt.Add(time.Duration(i) * time.Second)
+		require.Nil(t, repo.Save(context.Background(), entry), "unexpected error saving entry")
+		entries = append(entries, entry)
+	}
+
+	cases := []struct {
+		desc  string
+		pm    deadletter.PageMetadata
+		total uint64
+		size  int
+	}{
+		{
+			desc:  "retrieve entries by domain",
+			pm:    deadletter.PageMetadata{Limit: 10, Domain: domain},
+			total: 5,
+			size:  5,
+		},
+		{
+			desc:  "retrieve entries by domain and channel",
+			pm:    deadletter.PageMetadata{Limit: 10, Domain: domain, Channel: channel},
+			total: 3,
+			size:  3,
+		},
+		{
+			desc:  "retrieve entries with offset and limit",
+			pm:    deadletter.PageMetadata{Offset: 1, Limit: 2, Domain: domain},
+			total: 5,
+			size:  2,
+		},
+		{
+			desc:  "retrieve entries for unknown domain",
+			pm:    deadletter.PageMetadata{Limit: 10, Domain: testsutil.GenerateUUID(t)},
+			total: 0,
+			size:  0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := repo.RetrieveAll(context.Background(), tc.pm)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.total, page.Total)
+			assert.Len(t, page.Entries, tc.size)
+		})
+	}
+
+	page, err := repo.RetrieveAll(context.Background(), deadletter.PageMetadata{Limit: 1, Domain: domain})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, entries[0].ID, page.Entries[0].ID, "entries must be ordered by creation time")
+}
+
+func TestUpdateAndRemove(t *testing.T) {
+	repo := newRepository()
+	entry := newEntry(t, testsutil.GenerateUUID(t), testsutil.GenerateUUID(t))
+	require.Nil(t, repo.Save(context.Background(), entry), "unexpected error saving entry")
+
+	entry.Message.Payload = []byte(`[{"n":"temperature","v":21.5}]`)
+	entry.Attempts++
+	entry.Error = "replay failed"
+	entry.UpdatedAt = entry.UpdatedAt.Add(time.Minute)
+	err := repo.Update(context.Background(), entry)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error updating entry: %s", err))
+
+	saved, err := repo.Retrieve(context.Background(), entry.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error retrieving entry: %s", err))
+	assert.Equal(t, entry.Message.Payload, saved.Message.Payload)
+	assert.Equal(t, entry.Attempts, saved.Attempts)
+	assert.Equal(t, entry.Error, saved.Error)
+
+	unknown := newEntry(t, entry.Message.Domain, entry.Message.Channel)
+	err = repo.Update(context.Background(), unknown)
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("expected %s got %s", repoerr.ErrNotFound, err))
+
+	err = repo.Remove(context.Background(), entry.ID)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error removing entry: %s", err))
+	_, err = repo.Retrieve(context.Background(), entry.ID)
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("expected %s got %s", repoerr.ErrNotFound, err))
+	err = repo.Remove(context.Background(), entry.ID)
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("expected %s got %s", repoerr.ErrNotFound, err))
+}
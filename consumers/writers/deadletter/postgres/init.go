@@ -0,0 +1,34 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import migrate "github.com/rubenv/sql-migrate"
+
+// Migration of dead-letter entries.
+func Migration() *migrate.MemoryMigrationSource {
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "dead_letters_1",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS dead_letters (
+                        id          VARCHAR(36) PRIMARY KEY,
+                        topic       TEXT NOT NULL,
+                        domain      VARCHAR(36),
+                        channel     VARCHAR(36),
+                        message     BYTEA NOT NULL,
+                        error       TEXT,
+                        attempts    BIGINT NOT NULL DEFAULT 1,
+                        created_at  TIMESTAMP NOT NULL,
+                        updated_at  TIMESTAMP NOT NULL
+                    )`,
+					`CREATE INDEX IF NOT EXISTS dead_letters_channel_idx ON dead_letters (domain, channel)`,
+				},
+				Down: []string{
+					"DROP TABLE IF EXISTS dead_letters",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package deadletter
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrReplay indicates failure to replay a dead-lettered message.
+var ErrReplay = errors.NewServiceError("failed to replay dead-lettered message")
+
+var _ Service = (*service)(nil)
+
+type service struct {
+	repo     Repository
+	replayer Replayer
+}
+
+// New instantiates the dead-letter service implementation.
+func New(repo Repository, replayer Replayer) Service {
+	return &service{
+		repo:     repo,
+		replayer: replayer,
+	}
+}
+
+func (svc *service) ListEntries(ctx context.Context, session authn.Session, pm PageMetadata) (Page, error) {
+	if err := checkSuperAdmin(session); err != nil {
+		return Page{}, err
+	}
+
+	page, err := svc.repo.RetrieveAll(ctx, pm)
+	if err != nil {
+		return Page{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return page, nil
+}
+
+func (svc *service) ViewEntry(ctx context.Context, session authn.Session, id string) (Entry, error) {
+	if err := checkSuperAdmin(session); err != nil {
+		return Entry{}, err
+	}
+
+	entry, err := svc.repo.Retrieve(ctx, id)
+	if err != nil {
+		return Entry{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return entry, nil
+}
+
+func (svc *service) UpdateEntry(ctx context.Context, session authn.Session, id string, upd EntryUpdate) (Entry, error) {
+	if err := checkSuperAdmin(session); err != nil {
+		return Entry{}, err
+	}
+
+	entry, err := svc.repo.Retrieve(ctx, id)
+	if err != nil {
+		return Entry{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	msg := proto.Clone(entry.Message).(*messaging.Message)
+	if upd.Subtopic != nil {
+		msg.Subtopic = *upd.Subtopic
+	}
+	if upd.Payload != nil {
+		msg.Payload = upd.Payload
+	}
+	entry.Message = msg
+	entry.UpdatedAt = time.Now().UTC()
+
+	if err := svc.repo.Update(ctx, entry); err != nil {
+		return Entry{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return entry, nil
+}
+
+func (svc *service) ReplayEntry(ctx context.Context, session authn.Session, id string) error {
+	if err := checkSuperAdmin(session); err != nil {
+		return err
+	}
+
+	entry, err := svc.repo.Retrieve(ctx, id)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	if err := svc.replayer.Replay(ctx, entry.Topic, entry.Message); err != nil {
+		entry.Attempts++
+		entry.Error = err.Error()
+		entry.UpdatedAt = time.Now().UTC()
+		if uerr := svc.repo.Update(ctx, entry); uerr != nil {
+			return errors.Wrap(ErrReplay, errors.Wrap(err, errors.Wrap(svcerr.ErrUpdateEntity, uerr)))
+		}
+		return errors.Wrap(ErrReplay, err)
+	}
+
+	if err := svc.repo.Remove(ctx, id); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (svc *service) RemoveEntry(ctx context.Context, session authn.Session, id string) error {
+	if err := checkSuperAdmin(session); err != nil {
+		return err
+	}
+
+	if err := svc.repo.Remove(ctx, id); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func checkSuperAdmin(session authn.Session) error {
+	if session.Role != authn.SuperAdminRole {
+		return svcerr.ErrSuperAdminAction
+	}
+	return nil
+}
@@ -0,0 +1,324 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package deadletter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/consumers/writers/deadletter"
+	"github.com/absmach/magistrala/consumers/writers/deadletter/mocks"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	adminSession = authn.Session{UserID: "admin", Role: authn.SuperAdminRole}
+	userSession  = authn.Session{UserID: "user", Role: authn.UserRole}
+	errReplay    = errors.New("replay failed")
+)
+
+func newService() (deadletter.Service, *mocks.Repository, *mocks.Replayer) {
+	repo := new(mocks.Repository)
+	replayer := new(mocks.Replayer)
+
+	return deadletter.New(repo, replayer), repo, replayer
+}
+
+func newEntry(t *testing.T) deadletter.Entry {
+	return deadletter.Entry{
+		ID:    testsutil.GenerateUUID(t),
+		Topic: "m.domain.c.channel",
+		Message: &messaging.Message{
+			Domain:   "domain",
+			Channel:  "channel",
+			Subtopic: "temperature",
+			Payload:  []byte(`[{"n":"temperature","v":"invalid"}]`),
+		},
+		Error:    "invalid input syntax",
+		Attempts: 1,
+	}
+}
+
+func TestListEntries(t *testing.T) {
+	svc, repo, _ := newService()
+	page := deadletter.Page{
+		PageMetadata: deadletter.PageMetadata{Limit: 10},
+		Total:        1,
+		Entries:      []deadletter.Entry{newEntry(t)},
+	}
+
+	cases := []struct {
+		desc    string
+		session authn.Session
+		pm      deadletter.PageMetadata
+		page    deadletter.Page
+		repoErr error
+		err     error
+	}{
+		{
+			desc:    "list entries successfully",
+			session: adminSession,
+			pm:      page.PageMetadata,
+			page:    page,
+		},
+		{
+			desc:    "list entries as non super admin",
+			session: userSession,
+			pm:      page.PageMetadata,
+			err:     svcerr.ErrSuperAdminAction,
+		},
+		{
+			desc:    "list entries with repository error",
+			session: adminSession,
+			pm:      page.PageMetadata,
+			repoErr: repoerr.ErrViewEntity,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("RetrieveAll", context.Background(), tc.pm).Return(tc.page, tc.repoErr)
+			res, err := svc.ListEntries(context.Background(), tc.session, tc.pm)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.page, res)
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestViewEntry(t *testing.T) {
+	svc, repo, _ := newService()
+	entry := newEntry(t)
+
+	cases := []struct {
+		desc    string
+		session authn.Session
+		id      string
+		entry   deadletter.Entry
+		repoErr error
+		err     error
+	}{
+		{
+			desc:    "view entry successfully",
+			session: adminSession,
+			id:      entry.ID,
+			entry:   entry,
+		},
+		{
+			desc:    "view entry as non super admin",
+			session: userSession,
+			id:      entry.ID,
+			err:     svcerr.ErrSuperAdminAction,
+		},
+		{
+			desc:    "view non-existing entry",
+			session: adminSession,
+			id:      testsutil.GenerateUUID(t),
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("Retrieve", context.Background(), tc.id).Return(tc.entry, tc.repoErr)
+			res, err := svc.ViewEntry(context.Background(), tc.session, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.entry, res)
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestUpdateEntry(t *testing.T) {
+	svc, repo, _ := newService()
+	entry := newEntry(t)
+	subtopic := "humidity"
+	payload := []byte(`[{"n":"temperature","v":21.5}]`)
+
+	cases := []struct {
+		desc        string
+		session     authn.Session
+		upd         deadletter.EntryUpdate
+		retrieveErr error
+		updateErr   error
+		subtopic    string
+		payload     []byte
+		err         error
+	}{
+		{
+			desc:     "update entry payload",
+			session:  adminSession,
+			upd:      deadletter.EntryUpdate{Payload: payload},
+			subtopic: entry.Message.Subtopic,
+			payload:  payload,
+		},
+		{
+			desc:     "update entry subtopic",
+			session:  adminSession,
+			upd:      deadletter.EntryUpdate{Subtopic: &subtopic},
+			subtopic: subtopic,
+			payload:  entry.Message.Payload,
+		},
+		{
+			desc:    "update entry as non super admin",
+			session: userSession,
+			upd:     deadletter.EntryUpdate{Payload: payload},
+			err:     svcerr.ErrSuperAdminAction,
+		},
+		{
+			desc:        "update non-existing entry",
+			session:     adminSession,
+			upd:         deadletter.EntryUpdate{Payload: payload},
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrViewEntity,
+		},
+		{
+			desc:      "update entry with repository error",
+			session:   adminSession,
+			upd:       deadletter.EntryUpdate{Payload: payload},
+			updateErr: repoerr.ErrUpdateEntity,
+			err:       svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("Retrieve", context.Background(), entry.ID).Return(entry, tc.retrieveErr)
+			repoCall1 := repo.On("Update", context.Background(), mock.Anything).Return(tc.updateErr)
+			res, err := svc.UpdateEntry(context.Background(), tc.session, entry.ID, tc.upd)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, tc.subtopic, res.Message.Subtopic)
+				assert.Equal(t, tc.payload, res.Message.Payload)
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+		})
+	}
+	assert.Equal(t, "temperature", entry.Message.Subtopic, "stored message must not be modified in place")
+}
+
+func TestReplayEntry(t *testing.T) {
+	svc, repo, replayer := newService()
+	entry := newEntry(t)
+
+	cases := []struct {
+		desc        string
+		session     authn.Session
+		retrieveErr error
+		replayErr   error
+		updateErr   error
+		removeErr   error
+		err         error
+	}{
+		{
+			desc:    "replay entry successfully",
+			session: adminSession,
+		},
+		{
+			desc:    "replay entry as non super admin",
+			session: userSession,
+			err:     svcerr.ErrSuperAdminAction,
+		},
+		{
+			desc:        "replay non-existing entry",
+			session:     adminSession,
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrViewEntity,
+		},
+		{
+			desc:      "replay entry with handling error",
+			session:   adminSession,
+			replayErr: errReplay,
+			err:       deadletter.ErrReplay,
+		},
+		{
+			desc:      "replay entry with handling and update error",
+			session:   adminSession,
+			replayErr: errReplay,
+			updateErr: repoerr.ErrUpdateEntity,
+			err:       svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:      "replay entry with remove error",
+			session:   adminSession,
+			removeErr: repoerr.ErrRemoveEntity,
+			err:       svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("Retrieve", context.Background(), entry.ID).Return(entry, tc.retrieveErr)
+			replayCall := replayer.On("Replay", context.Background(), entry.Topic, entry.Message).Return(tc.replayErr)
+			var updated deadletter.Entry
+			repoCall1 := repo.On("Update", context.Background(), mock.Anything).Run(func(args mock.Arguments) {
+				updated = args.Get(1).(deadletter.Entry)
+			}).Return(tc.updateErr)
+			repoCall2 := repo.On("Remove", context.Background(), entry.ID).Return(tc.removeErr)
+			err := svc.ReplayEntry(context.Background(), tc.session, entry.ID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			switch {
+			case tc.err == nil:
+				repo.AssertCalled(t, "Remove", context.Background(), entry.ID)
+			case tc.replayErr != nil:
+				repo.AssertNotCalled(t, "Remove", context.Background(), entry.ID)
+				assert.Equal(t, entry.Attempts+1, updated.Attempts)
+				assert.Equal(t, tc.replayErr.Error(), updated.Error)
+			}
+			repoCall.Unset()
+			replayCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
+			repo.Calls = nil
+		})
+	}
+}
+
+func TestRemoveEntry(t *testing.T) {
+	svc, repo, _ := newService()
+	id := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc    string
+		session authn.Session
+		repoErr error
+		err     error
+	}{
+		{
+			desc:    "remove entry successfully",
+			session: adminSession,
+		},
+		{
+			desc:    "remove entry as non super admin",
+			session: userSession,
+			err:     svcerr.ErrSuperAdminAction,
+		},
+		{
+			desc:    "remove non-existing entry",
+			session: adminSession,
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("Remove", context.Background(), id).Return(tc.repoErr)
+			err := svc.RemoveEntry(context.Background(), tc.session, id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			repoCall.Unset()
+		})
+	}
+}
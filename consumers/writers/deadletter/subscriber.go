@@ -0,0 +1,311 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package deadletter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/absmach/magistrala"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+const (
+	defMaxAttempts = 5
+
+	// attemptsTTL is the time after which the failed attempts of a message
+	// which was not redelivered are forgotten.
+	attemptsTTL = time.Hour
+)
+
+var (
+	errNoHandler  = errors.New("no handler subscribed to the topic")
+	errNotHandled = errors.New("message was not acknowledged")
+)
+
+// Subscriber is a messaging.Subscriber which stores the messages its
+// handlers fail to consume as dead-letter entries and replays them on demand.
+type Subscriber interface {
+	messaging.Subscriber
+	Replayer
+}
+
+var _ Subscriber = (*subscriber)(nil)
+
+type subscriber struct {
+	messaging.Subscriber
+	repo        Repository
+	idp         magistrala.IDProvider
+	maxAttempts uint64
+	logger      *slog.Logger
+	mu          sync.RWMutex
+	handlers    map[string]messaging.MessageHandler
+	attempts    *attempts
+}
+
+// NewSubscriber wraps the subscriber so that messages which handlers fail
+// to consume are dead-lettered and acknowledged instead of being lost or
+// redelivered indefinitely. Messages which handlers terminate are
+// dead-lettered at once, while the other failures are returned to the broker
+// for redelivery until the message fails maxAttempts times. If the entry can
+// not be saved, the original handling error is returned to the broker.
+func NewSubscriber(sub messaging.Subscriber, repo Repository, idp magistrala.IDProvider, maxAttempts uint64, logger *slog.Logger) Subscriber {
+	if maxAttempts == 0 {
+		maxAttempts = defMaxAttempts
+	}
+
+	return &subscriber{
+		Subscriber:  sub,
+		repo:        repo,
+		idp:         idp,
+		maxAttempts: maxAttempts,
+		logger:      logger,
+		handlers:    make(map[string]messaging.MessageHandler),
+		attempts:    newAttempts(),
+	}
+}
+
+func (s *subscriber) Subscribe(ctx context.Context, cfg messaging.SubscriberConfig) error {
+	h := &handler{
+		sub:     s,
+		topic:   cfg.Topic,
+		handler: cfg.Handler,
+	}
+	cfg.Handler = h
+	if dh, ok := h.handler.(messaging.DeferredAckHandler); ok {
+		cfg.Handler = &deferredHandler{handler: h, deferred: dh}
+	}
+
+	if err := s.Subscriber.Subscribe(ctx, cfg); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.handlers[cfg.Topic] = h.handler
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *subscriber) Unsubscribe(ctx context.Context, id, topic string) error {
+	if err := s.Subscriber.Unsubscribe(ctx, id, topic); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	delete(s.handlers, topic)
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *subscriber) Replay(ctx context.Context, topic string, msg *messaging.Message) error {
+	s.mu.RLock()
+	h, ok := s.handlers[topic]
+	s.mu.RUnlock()
+	if !ok {
+		return errNoHandler
+	}
+
+	dh, ok := h.(messaging.DeferredAckHandler)
+	if !ok {
+		return h.Handle(msg)
+	}
+
+	type result struct {
+		at  messaging.AckType
+		err error
+	}
+	done := make(chan result, 1)
+	dh.HandleDeferred(msg, func(at messaging.AckType, err error) {
+		done <- result{at: at, err: err}
+	})
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case res := <-done:
+		switch {
+		case res.err != nil:
+			return res.err
+		case res.at != messaging.Ack && res.at != messaging.DoubleAck:
+			return errNotHandled
+		default:
+			return nil
+		}
+	}
+}
+
+// fail records the failed attempt to consume the message and dead-letters it
+// if the handling error is final. It reports whether the message is
+// dead-lettered.
+func (s *subscriber) fail(topic string, msg *messaging.Message, at messaging.AckType, herr error) bool {
+	if herr == nil || errors.Contains(herr, repoerr.ErrConflict) {
+		// Conflicting messages are already persisted.
+		s.attempts.reset(topic, msg)
+		return false
+	}
+
+	n := s.attempts.inc(topic, msg)
+	if at != messaging.Term && n < s.maxAttempts {
+		return false
+	}
+	if err := s.store(topic, msg, herr, n); err != nil {
+		return false
+	}
+	s.attempts.reset(topic, msg)
+
+	return true
+}
+
+// store saves the message which failed to be consumed as a new entry.
+func (s *subscriber) store(topic string, msg *messaging.Message, herr error, n uint64) error {
+	id, err := s.idp.ID()
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	entry := Entry{
+		ID:        id,
+		Topic:     topic,
+		Message:   msg,
+		Error:     herr.Error(),
+		Attempts:  n,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.Save(context.Background(), entry); err != nil {
+		s.logger.Warn(fmt.Sprintf("Failed to save dead-letter entry: %s", err), slog.String("topic", topic), slog.String("channel", msg.GetChannel()))
+		return err
+	}
+	s.logger.Info("Message dead-lettered", slog.String("id", id), slog.String("channel", msg.GetChannel()), slog.Uint64("attempts", n), slog.String("error", herr.Error()))
+
+	return nil
+}
+
+type handler struct {
+	sub     *subscriber
+	topic   string
+	handler messaging.MessageHandler
+}
+
+func (h *handler) Handle(msg *messaging.Message) error {
+	err := h.handler.Handle(msg)
+	if err == nil {
+		h.sub.attempts.reset(h.topic, msg)
+		return nil
+	}
+	at := messaging.NoAck
+	if e, ok := err.(messaging.Error); ok {
+		at = e.Ack()
+	}
+	if !h.sub.fail(h.topic, msg, at, err) {
+		return err
+	}
+
+	return nil
+}
+
+func (h *handler) Cancel() error {
+	return h.handler.Cancel()
+}
+
+// deferredHandler preserves deferred acknowledgement of the wrapped handler.
+type deferredHandler struct {
+	*handler
+	deferred messaging.DeferredAckHandler
+}
+
+func (h *deferredHandler) HandleDeferred(msg *messaging.Message, ack messaging.AckFunc) {
+	h.deferred.HandleDeferred(msg, func(at messaging.AckType, err error) {
+		if err == nil {
+			h.sub.attempts.reset(h.topic, msg)
+			ack(at, nil)
+			return
+		}
+		if h.sub.fail(h.topic, msg, at, err) {
+			ack(messaging.Ack, nil)
+			return
+		}
+		ack(at, err)
+	})
+}
+
+// attempts counts the failed attempts to consume the messages which are
+// redelivered by the broker. The messages are identified by their content,
+// since the broker delivery metadata is not passed to the handlers.
+type attempts struct {
+	mu        sync.Mutex
+	counts    map[[sha256.Size]byte]attempt
+	lastPrune time.Time
+}
+
+type attempt struct {
+	n        uint64
+	failedAt time.Time
+}
+
+func newAttempts() *attempts {
+	return &attempts{
+		counts:    make(map[[sha256.Size]byte]attempt),
+		lastPrune: time.Now(),
+	}
+}
+
+// inc records the failed attempt and returns the number of the failed
+// attempts of the message.
+func (a *attempts) inc(topic string, msg *messaging.Message) uint64 {
+	now := time.Now()
+	key := attemptKey(topic, msg)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if now.Sub(a.lastPrune) > attemptsTTL {
+		for k, at := range a.counts {
+			if now.Sub(at.failedAt) > attemptsTTL {
+				delete(a.counts, k)
+			}
+		}
+		a.lastPrune = now
+	}
+
+	at := a.counts[key]
+	at.n++
+	at.failedAt = now
+	a.counts[key] = at
+
+	return at.n
+}
+
+func (a *attempts) reset(topic string, msg *messaging.Message) {
+	key := attemptKey(topic, msg)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.counts, key)
+}
+
+func attemptKey(topic string, msg *messaging.Message) [sha256.Size]byte {
+	h := sha256.New()
+	for _, s := range []string{topic, msg.GetDomain(), msg.GetChannel(), msg.GetSubtopic(), msg.GetPublisher()} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(msg.GetCreated())))
+	h.Write(msg.GetPayload())
+
+	var key [sha256.Size]byte
+	copy(key[:], h.Sum(nil))
+
+	return key
+}
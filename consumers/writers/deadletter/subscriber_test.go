@@ -0,0 +1,122 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package deadletter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/consumers/writers/deadletter"
+	"github.com/absmach/magistrala/consumers/writers/deadletter/mocks"
+	mglog "github.com/absmach/magistrala/logger"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	topic       = "m.domain.c.channel"
+	maxAttempts = 3
+)
+
+var errUnavailable = errors.New("database unavailable")
+
+type subscriber struct {
+	messaging.Subscriber
+	handler messaging.MessageHandler
+}
+
+func (s *subscriber) Subscribe(_ context.Context, cfg messaging.SubscriberConfig) error {
+	s.handler = cfg.Handler
+	return nil
+}
+
+type handler struct {
+	err error
+}
+
+func (h *handler) Handle(_ *messaging.Message) error {
+	return h.err
+}
+
+func (h *handler) Cancel() error {
+	return nil
+}
+
+func TestHandle(t *testing.T) {
+	cases := []struct {
+		desc       string
+		err        error
+		deliveries int
+		errs       []bool
+		attempts   uint64
+	}{
+		{
+			desc:       "handle message successfully",
+			deliveries: 1,
+			errs:       []bool{false},
+		},
+		{
+			desc:       "handle terminated message",
+			err:        messaging.NewError(errUnavailable, messaging.Term),
+			deliveries: 1,
+			errs:       []bool{false},
+			attempts:   1,
+		},
+		{
+			desc:       "handle message with temporary error",
+			err:        errUnavailable,
+			deliveries: maxAttempts,
+			errs:       []bool{true, true, false},
+			attempts:   maxAttempts,
+		},
+		{
+			desc:       "handle redelivered message with temporary error",
+			err:        messaging.NewError(errUnavailable, messaging.Nack),
+			deliveries: maxAttempts - 1,
+			errs:       []bool{true, true},
+		},
+		{
+			desc:       "handle conflicting message",
+			err:        messaging.NewError(repoerr.ErrConflict, messaging.Term),
+			deliveries: 1,
+			errs:       []bool{true},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repo := new(mocks.Repository)
+			var saved []deadletter.Entry
+			repo.On("Save", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				saved = append(saved, args.Get(1).(deadletter.Entry))
+			}).Return(nil)
+
+			sub := &subscriber{}
+			dl := deadletter.NewSubscriber(sub, repo, uuid.NewMock(), maxAttempts, mglog.NewMock())
+			err := dl.Subscribe(context.Background(), messaging.SubscriberConfig{Topic: topic, Handler: &handler{err: tc.err}})
+			require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+			msg := &messaging.Message{Channel: "channel", Publisher: "publisher", Payload: []byte(`[{"n":"temperature","v":21}]`)}
+			for i := range tc.deliveries {
+				err := sub.handler.Handle(msg)
+				assert.Equal(t, tc.errs[i], err != nil, fmt.Sprintf("delivery %d: unexpected error: %v", i+1, err))
+			}
+
+			switch tc.attempts {
+			case 0:
+				assert.Empty(t, saved)
+			default:
+				require.Len(t, saved, 1)
+				assert.Equal(t, tc.attempts, saved[0].Attempts)
+				assert.Equal(t, topic, saved[0].Topic)
+			}
+		})
+	}
+}
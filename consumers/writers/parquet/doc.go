@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package parquet contains a writer which archives messages as Parquet files
+// in an S3-compatible object storage, partitioned by domain, channel and day.
+package parquet
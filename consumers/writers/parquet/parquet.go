@@ -0,0 +1,232 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"time"
+
+	"github.com/absmach/magistrala/consumers/writers/batch"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/transformers"
+	smqjson "github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+	parquetgo "github.com/parquet-go/parquet-go"
+)
+
+const (
+	senmlFormat = "senml"
+	dayFormat   = "2006-01-02"
+)
+
+var (
+	errSaveMessage    = errors.New("failed to archive messages")
+	errInvalidMessage = errors.New("invalid message representation")
+	errEncode         = errors.New("failed to encode parquet file")
+)
+
+var _ batch.Repository = (*archiver)(nil)
+
+type archiver struct {
+	store  ObjectStore
+	prefix string
+}
+
+// New returns a batch repository which writes each batch as Parquet files,
+// one per message format, domain, channel and day, to the object store.
+// Objects are stored under the prefix using Hive-style partition keys, e.g.
+// <prefix>/senml/domain=<domain>/channel=<channel>/day=2006-01-02/<id>.parquet.
+// The object id is derived from the records of the file, so the files of a
+// redelivered batch overwrite the already uploaded ones.
+func New(store ObjectStore, prefix string) batch.Repository {
+	return &archiver{
+		store:  store,
+		prefix: prefix,
+	}
+}
+
+type partition struct {
+	format  string
+	domain  string
+	channel string
+	day     string
+}
+
+func (a *archiver) SaveBatch(ctx context.Context, msgs []any) error {
+	senmlRecords := make(map[partition][]senmlRecord)
+	jsonRecords := make(map[partition][]jsonRecord)
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case []senml.Message:
+			for _, sm := range m {
+				rec := toSenMLRecord(sm)
+				p := partition{senmlFormat, sm.Domain, sm.Channel, day(rec.Time)}
+				senmlRecords[p] = append(senmlRecords[p], rec)
+			}
+		case smqjson.Messages:
+			for _, jm := range m.Data {
+				rec, err := toJSONRecord(jm)
+				if err != nil {
+					return messaging.NewError(errors.Wrap(errSaveMessage, err), messaging.Term)
+				}
+				p := partition{m.Format, jm.Domain, jm.Channel, day(rec.Created)}
+				jsonRecords[p] = append(jsonRecords[p], rec)
+			}
+		default:
+			return messaging.NewError(errors.Wrap(errSaveMessage, errInvalidMessage), messaging.Term)
+		}
+	}
+
+	for p, recs := range senmlRecords {
+		if err := write(ctx, a, p, recs); err != nil {
+			return err
+		}
+	}
+	for p, recs := range jsonRecords {
+		if err := write(ctx, a, p, recs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func write[T any](ctx context.Context, a *archiver, p partition, recs []T) error {
+	var buf bytes.Buffer
+	w := parquetgo.NewGenericWriter[T](&buf, parquetgo.Compression(&parquetgo.Zstd))
+	if _, err := w.Write(recs); err != nil {
+		return errors.Wrap(errSaveMessage, errors.Wrap(errEncode, err))
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(errSaveMessage, errors.Wrap(errEncode, err))
+	}
+
+	id, err := contentID(recs)
+	if err != nil {
+		return errors.Wrap(errSaveMessage, err)
+	}
+	if err := a.store.Put(ctx, a.key(p, id), buf.Bytes()); err != nil {
+		return errors.Wrap(errSaveMessage, err)
+	}
+
+	return nil
+}
+
+func (a *archiver) key(p partition, id string) string {
+	return path.Join(
+		a.prefix,
+		p.format,
+		"domain="+p.domain,
+		"channel="+p.channel,
+		"day="+p.day,
+		fmt.Sprintf("%s.parquet", id),
+	)
+}
+
+// contentID returns the id of the file which does not depend on the order
+// of the records, since the redelivered messages may arrive in a different
+// order.
+func contentID[T any](recs []T) (string, error) {
+	sums := make([][sha256.Size]byte, len(recs))
+	for i, rec := range recs {
+		b, err := json.Marshal(rec)
+		if err != nil {
+			return "", err
+		}
+		sums[i] = sha256.Sum256(b)
+	}
+	slices.SortFunc(sums, func(a, b [sha256.Size]byte) int {
+		return bytes.Compare(a[:], b[:])
+	})
+
+	h := sha256.New()
+	for _, sum := range sums {
+		h.Write(sum[:])
+	}
+
+	return hex.EncodeToString(h.Sum(nil)[:16]), nil
+}
+
+func day(ts int64) string {
+	return time.Unix(0, ts).UTC().Format(dayFormat)
+}
+
+type senmlRecord struct {
+	Domain      string            `parquet:"domain,dict"`
+	Channel     string            `parquet:"channel,dict"`
+	Subtopic    string            `parquet:"subtopic,dict"`
+	Publisher   string            `parquet:"publisher,dict"`
+	Protocol    string            `parquet:"protocol,dict"`
+	Name        string            `parquet:"name,dict"`
+	Unit        string            `parquet:"unit,dict"`
+	Time        int64             `parquet:"time,timestamp(nanosecond)"`
+	UpdateTime  float64           `parquet:"update_time"`
+	Value       *float64          `parquet:"value,optional"`
+	StringValue *string           `parquet:"string_value,optional"`
+	DataValue   *string           `parquet:"data_value,optional"`
+	BoolValue   *bool             `parquet:"bool_value,optional"`
+	Sum         *float64          `parquet:"sum,optional"`
+	Headers     map[string]string `parquet:"headers,optional"`
+}
+
+type jsonRecord struct {
+	Domain    string            `parquet:"domain,dict"`
+	Channel   string            `parquet:"channel,dict"`
+	Created   int64             `parquet:"created,timestamp(nanosecond)"`
+	Subtopic  string            `parquet:"subtopic,dict"`
+	Publisher string            `parquet:"publisher,dict"`
+	Protocol  string            `parquet:"protocol,dict"`
+	Payload   []byte            `parquet:"payload,json"`
+	Headers   map[string]string `parquet:"headers,optional"`
+}
+
+func toSenMLRecord(msg senml.Message) senmlRecord {
+	return senmlRecord{
+		Domain:      msg.Domain,
+		Channel:     msg.Channel,
+		Subtopic:    msg.Subtopic,
+		Publisher:   msg.Publisher,
+		Protocol:    msg.Protocol,
+		Name:        msg.Name,
+		Unit:        msg.Unit,
+		Time:        int64(transformers.ToUnixNano(msg.Time)),
+		UpdateTime:  msg.UpdateTime,
+		Value:       msg.Value,
+		StringValue: msg.StringValue,
+		DataValue:   msg.DataValue,
+		BoolValue:   msg.BoolValue,
+		Sum:         msg.Sum,
+		Headers:     msg.Headers,
+	}
+}
+
+func toJSONRecord(msg smqjson.Message) (jsonRecord, error) {
+	payload := []byte("{}")
+	if msg.Payload != nil {
+		b, err := json.Marshal(msg.Payload)
+		if err != nil {
+			return jsonRecord{}, err
+		}
+		payload = b
+	}
+
+	return jsonRecord{
+		Domain:    msg.Domain,
+		Channel:   msg.Channel,
+		Created:   transformers.ToUnixNano(msg.Created),
+		Subtopic:  msg.Subtopic,
+		Publisher: msg.Publisher,
+		Protocol:  msg.Protocol,
+		Payload:   payload,
+		Headers:   msg.Headers,
+	}, nil
+}
@@ -0,0 +1,229 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package parquet_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/consumers/writers/parquet"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	smqjson "github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+	parquetgo "github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	prefix  = "archive"
+	domain  = "domain"
+	channel = "channel"
+)
+
+var errPut = errors.New("put failed")
+
+type store struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	puts    int
+	failAt  int
+	err     error
+}
+
+func newStore() *store {
+	return &store{objects: make(map[string][]byte)}
+}
+
+func (s *store) Put(_ context.Context, key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.puts++
+	if s.err != nil && s.puts >= s.failAt {
+		return s.err
+	}
+	s.objects[key] = data
+
+	return nil
+}
+
+func (s *store) keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var keys []string
+	for k := range s.objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+type senmlRow struct {
+	Domain  string   `parquet:"domain"`
+	Channel string   `parquet:"channel"`
+	Name    string   `parquet:"name"`
+	Time    int64    `parquet:"time"`
+	Value   *float64 `parquet:"value,optional"`
+}
+
+type jsonRow struct {
+	Channel string            `parquet:"channel"`
+	Created int64             `parquet:"created"`
+	Payload string            `parquet:"payload"`
+	Headers map[string]string `parquet:"headers,optional"`
+}
+
+func read[T any](t *testing.T, data []byte) []T {
+	rows, err := parquetgo.Read[T](bytes.NewReader(data), int64(len(data)))
+	require.Nil(t, err, fmt.Sprintf("unexpected error reading parquet file: %s", err))
+
+	return rows
+}
+
+func TestSaveBatchSenML(t *testing.T) {
+	st := newStore()
+	repo := parquet.New(st, prefix)
+
+	day1 := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	value := 21.5
+	msgs := []any{
+		[]senml.Message{
+			{Domain: domain, Channel: channel, Name: "temperature", Time: float64(day1.UnixNano()), Value: &value},
+			{Domain: domain, Channel: channel, Name: "humidity", Time: float64(day1.Unix()), Value: &value},
+		},
+		[]senml.Message{
+			{Domain: domain, Channel: channel, Name: "temperature", Time: float64(day2.UnixNano()), Value: &value},
+			{Domain: domain, Channel: "other", Name: "temperature", Time: float64(day2.UnixNano()), Value: &value},
+		},
+	}
+
+	err := repo.SaveBatch(context.Background(), msgs)
+	require.Nil(t, err, fmt.Sprintf("unexpected error saving batch: %s", err))
+
+	keys := st.keys()
+	require.Len(t, keys, 3)
+	partitions := make(map[string][]senmlRow)
+	for _, key := range keys {
+		partitions[path.Dir(key)] = read[senmlRow](t, st.objects[key])
+	}
+
+	first := partitions["archive/senml/domain=domain/channel=channel/day=2024-03-01"]
+	require.Len(t, first, 2)
+	assert.Equal(t, "temperature", first[0].Name)
+	assert.Equal(t, day1.UnixNano(), first[0].Time)
+	assert.Equal(t, day1.UnixNano(), first[1].Time, "time in seconds must be normalized to nanoseconds")
+	assert.Equal(t, value, *first[0].Value)
+	assert.Len(t, partitions["archive/senml/domain=domain/channel=channel/day=2024-03-02"], 1)
+	assert.Len(t, partitions["archive/senml/domain=domain/channel=other/day=2024-03-02"], 1)
+}
+
+func TestSaveBatchJSON(t *testing.T) {
+	st := newStore()
+	repo := parquet.New(st, prefix)
+
+	created := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC).UnixNano()
+	msgs := []any{
+		smqjson.Messages{
+			Format: "some_json",
+			Data: []smqjson.Message{
+				{Domain: domain, Channel: channel, Created: created, Payload: map[string]any{"temperature": 21.5}, Headers: map[string]string{"correlation-id": "corr-1"}},
+				{Domain: domain, Channel: channel, Created: created},
+			},
+		},
+	}
+
+	err := repo.SaveBatch(context.Background(), msgs)
+	require.Nil(t, err, fmt.Sprintf("unexpected error saving batch: %s", err))
+
+	keys := st.keys()
+	require.Len(t, keys, 1)
+	assert.Regexp(t, regexp.MustCompile(`^archive/some_json/domain=domain/channel=channel/day=2024-03-01/[0-9a-f]{32}\.parquet$`), keys[0])
+	rows := read[jsonRow](t, st.objects[keys[0]])
+	require.Len(t, rows, 2)
+	assert.Equal(t, created, rows[0].Created)
+	assert.JSONEq(t, `{"temperature":21.5}`, rows[0].Payload)
+	assert.JSONEq(t, `{}`, rows[1].Payload)
+	assert.Equal(t, map[string]string{"correlation-id": "corr-1"}, rows[0].Headers)
+	assert.Empty(t, rows[1].Headers)
+}
+
+func TestSaveBatchRedelivery(t *testing.T) {
+	st := newStore()
+	repo := parquet.New(st, prefix)
+
+	ts := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	value := 21.5
+	first := []senml.Message{{Domain: domain, Channel: channel, Name: "temperature", Time: float64(ts.UnixNano()), Value: &value}}
+	second := []senml.Message{{Domain: domain, Channel: "other", Name: "temperature", Time: float64(ts.UnixNano()), Value: &value}}
+
+	// The upload of the second partition fails, so the batch is redelivered.
+	st.err, st.failAt = errPut, 2
+	err := repo.SaveBatch(context.Background(), []any{first, second})
+	require.NotNil(t, err, "expected error saving batch")
+	require.Len(t, st.keys(), 1)
+
+	st.err = nil
+	err = repo.SaveBatch(context.Background(), []any{second, first})
+	require.Nil(t, err, fmt.Sprintf("unexpected error saving batch: %s", err))
+	assert.Len(t, st.keys(), 2, "expected redelivered partition to overwrite the uploaded file")
+}
+
+func TestSaveBatchErrors(t *testing.T) {
+	cases := []struct {
+		desc     string
+		msgs     []any
+		storeErr error
+		err      error
+		ack      messaging.AckType
+	}{
+		{
+			desc: "save invalid message",
+			msgs: []any{"invalid"},
+			ack:  messaging.Term,
+		},
+		{
+			desc: "save message with invalid JSON payload",
+			msgs: []any{smqjson.Messages{
+				Format: "some_json",
+				Data:   []smqjson.Message{{Channel: channel, Payload: map[string]any{"invalid": make(chan int)}}},
+			}},
+			ack: messaging.Term,
+		},
+		{
+			desc:     "save message with object storage error",
+			msgs:     []any{[]senml.Message{{Channel: channel, Name: "temperature"}}},
+			storeErr: errPut,
+			err:      errPut,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			st := newStore()
+			st.err = tc.storeErr
+			repo := parquet.New(st, prefix)
+
+			err := repo.SaveBatch(context.Background(), tc.msgs)
+			require.NotNil(t, err, "expected error saving batch")
+			if tc.err != nil {
+				assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+				return
+			}
+			e, ok := err.(messaging.Error)
+			require.True(t, ok, fmt.Sprintf("%s: expected messaging error got %s", tc.desc, err))
+			assert.Equal(t, tc.ack, e.Ack())
+			assert.Empty(t, st.keys())
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package parquet
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const contentType = "application/vnd.apache.parquet"
+
+var (
+	errConnect      = errors.New("failed to connect to object storage")
+	errCreateBucket = errors.New("failed to create object storage bucket")
+)
+
+// ObjectStore specifies an API for storing archive objects.
+type ObjectStore interface {
+	// Put stores the data as the object with the given key.
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+// S3Config defines the S3-compatible object storage options.
+type S3Config struct {
+	Endpoint  string `env:"ENDPOINT"    envDefault:"localhost:9000"`
+	Region    string `env:"REGION"      envDefault:""`
+	Bucket    string `env:"BUCKET"      envDefault:"messages"`
+	AccessKey string `env:"ACCESS_KEY"  envDefault:""`
+	SecretKey string `env:"SECRET_KEY"  envDefault:""`
+	UseSSL    bool   `env:"USE_SSL"     envDefault:"false"`
+}
+
+var _ ObjectStore = (*s3Store)(nil)
+
+type s3Store struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewS3Store connects to the S3-compatible object storage and creates the
+// configured bucket if it does not exist.
+func NewS3Store(ctx context.Context, cfg S3Config) (ObjectStore, error) {
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, errors.Wrap(errConnect, err)
+	}
+
+	exists, err := client.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return nil, errors.Wrap(errConnect, err)
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, cfg.Bucket, minio.MakeBucketOptions{Region: cfg.Region}); err != nil {
+			return nil, errors.Wrap(errCreateBucket, err)
+		}
+	}
+
+	return &s3Store{client: client, bucket: cfg.Bucket}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
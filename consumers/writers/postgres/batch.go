@@ -0,0 +1,131 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/absmach/magistrala/consumers/writers/batch"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	smqjson "github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+// maxBatchRows limits the number of rows inserted by a single statement
+// to keep the number of bind parameters under the Postgres limit.
+const maxBatchRows = 1000
+
+var _ batch.Repository = (*postgresRepo)(nil)
+
+// NewBatch returns new PostgreSQL batch writer repository.
+//
+// Unlike the blocking writer, which rejects a message conflicting with an
+// already stored one, the batch writer silently skips the conflicting
+// records, so that a redelivered batch is not rejected as a whole.
+func NewBatch(db *sqlx.DB) batch.Repository {
+	return &postgresRepo{db: db}
+}
+
+func (pr postgresRepo) SaveBatch(ctx context.Context, msgs []any) (err error) {
+	var senmlMsgs []senmlMessage
+	var channels []string
+	jsonMsgs := make(map[string][]jsonMessage)
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case []senml.Message:
+			for _, sm := range m {
+				dbmsg, err := toSenMLMessage(sm)
+				if err != nil {
+					return errors.Wrap(errSaveMessage, err)
+				}
+				senmlMsgs = append(senmlMsgs, dbmsg)
+				channels = append(channels, sm.Channel)
+			}
+		case smqjson.Messages:
+			for _, jm := range m.Data {
+				dbmsg, err := toJSONMessage(jm)
+				if err != nil {
+					return messaging.NewError(errors.Wrap(errSaveMessage, err), messaging.Term)
+				}
+				jsonMsgs[m.Format] = append(jsonMsgs[m.Format], dbmsg)
+				channels = append(channels, jm.Channel)
+			}
+		default:
+			return messaging.NewError(errSaveMessage, messaging.Term)
+		}
+	}
+
+	for format := range jsonMsgs {
+		if err := pr.createTable(format); err != nil {
+			return errors.Wrap(errSaveMessage, err)
+		}
+	}
+
+	tx, err := pr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(errSaveMessage, err)
+	}
+	defer func() {
+		if err != nil {
+			if txErr := tx.Rollback(); txErr != nil {
+				err = errors.Wrap(err, errors.Wrap(errTransRollback, txErr))
+			}
+			return
+		}
+
+		if err = tx.Commit(); err != nil {
+			err = errors.Wrap(errSaveMessage, err)
+		}
+	}()
+
+	// Duplicates are skipped instead of failing the whole batch.
+	q := `INSERT INTO messages (id, channel, subtopic, publisher, protocol,
+          name, unit, value, string_value, bool_value, data_value, sum,
+          time, update_time, headers)
+          VALUES (:id, :channel, :subtopic, :publisher, :protocol, :name, :unit,
+          :value, :string_value, :bool_value, :data_value, :sum,
+          :time, :update_time, :headers)
+          ON CONFLICT DO NOTHING`
+	for start := 0; start < len(senmlMsgs); start += maxBatchRows {
+		end := min(start+maxBatchRows, len(senmlMsgs))
+		if _, err = tx.NamedExecContext(ctx, q, senmlMsgs[start:end]); err != nil {
+			return batchError(err)
+		}
+	}
+
+	for format, rows := range jsonMsgs {
+		q := fmt.Sprintf(`INSERT INTO %s (id, channel, created, subtopic, publisher, protocol, payload, headers)
+          VALUES (:id, :channel, :created, :subtopic, :publisher, :protocol, :payload, :headers)`, format)
+		for start := 0; start < len(rows); start += maxBatchRows {
+			end := min(start+maxBatchRows, len(rows))
+			if _, err = tx.NamedExecContext(ctx, q, rows[start:end]); err != nil {
+				return batchError(err)
+			}
+		}
+	}
+
+	if err = saveChannels(ctx, tx, channels); err != nil {
+		return errors.Wrap(errSaveMessage, err)
+	}
+
+	return nil
+}
+
+// batchError terminates messages which can never be saved so that
+// the batch is split and only the invalid messages are dropped.
+func batchError(err error) error {
+	if preErr, ok := err.(*pgconn.PrepareError); ok {
+		err = preErr.Unwrap()
+	}
+	if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == pgerrcode.InvalidTextRepresentation {
+		return messaging.NewError(errors.Wrap(errSaveMessage, errInvalidMessage), messaging.Term)
+	}
+
+	return errors.Wrap(errSaveMessage, err)
+}
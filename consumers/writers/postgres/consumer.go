@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/absmach/magistrala/consumers"
 	"github.com/absmach/magistrala/pkg/errors"
@@ -23,6 +24,7 @@ var (
 	errSaveMessage    = errors.New("failed to save message to postgres database")
 	errTransRollback  = errors.New("failed to rollback transaction")
 	errNoTable        = errors.New("relation does not exist")
+	errNoColumn       = errors.New("column does not exist")
 )
 
 var _ consumers.BlockingConsumer = (*postgresRepo)(nil)
@@ -52,10 +54,10 @@ func (pr postgresRepo) saveSenml(ctx context.Context, messages any) (err error)
 	}
 	q := `INSERT INTO messages (id, channel, subtopic, publisher, protocol,
           name, unit, value, string_value, bool_value, data_value, sum,
-          time, update_time)
+          time, update_time, headers)
           VALUES (:id, :channel, :subtopic, :publisher, :protocol, :name, :unit,
           :value, :string_value, :bool_value, :data_value, :sum,
-          :time, :update_time);`
+          :time, :update_time, :headers);`
 
 	tx, err := pr.db.BeginTxx(ctx, nil)
 	if err != nil {
@@ -75,11 +77,10 @@ func (pr postgresRepo) saveSenml(ctx context.Context, messages any) (err error)
 	}()
 
 	for _, msg := range msgs {
-		id, err := uuid.NewV4()
+		m, err := toSenMLMessage(msg)
 		if err != nil {
-			return err
+			return errors.Wrap(errSaveMessage, err)
 		}
-		m := senmlMessage{Message: msg, ID: id.String()}
 		if _, err := tx.NamedExec(q, m); err != nil {
 			pgErr, ok := err.(*pgconn.PgError)
 			if ok {
@@ -91,12 +92,20 @@ func (pr postgresRepo) saveSenml(ctx context.Context, messages any) (err error)
 			return errors.Wrap(errSaveMessage, err)
 		}
 	}
+
+	channels := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		channels = append(channels, msg.Channel)
+	}
+	if err := saveChannels(ctx, tx, channels); err != nil {
+		return errors.Wrap(errSaveMessage, err)
+	}
 	return err
 }
 
 func (pr postgresRepo) saveJSON(ctx context.Context, msgs smqjson.Messages) error {
 	if err := pr.insertJSON(ctx, msgs); err != nil {
-		if err == errNoTable {
+		if err == errNoTable || err == errNoColumn {
 			if err := pr.createTable(msgs.Format); err != nil {
 				return err
 			}
@@ -125,8 +134,8 @@ func (pr postgresRepo) insertJSON(ctx context.Context, msgs smqjson.Messages) er
 		}
 	}()
 
-	q := `INSERT INTO %s (id, channel, created, subtopic, publisher, protocol, payload)
-          VALUES (:id, :channel, :created, :subtopic, :publisher, :protocol, :payload);`
+	q := `INSERT INTO %s (id, channel, created, subtopic, publisher, protocol, payload, headers)
+          VALUES (:id, :channel, :created, :subtopic, :publisher, :protocol, :payload, :headers);`
 	q = fmt.Sprintf(q, msgs.Format)
 
 	for _, m := range msgs.Data {
@@ -147,11 +156,21 @@ func (pr postgresRepo) insertJSON(ctx context.Context, msgs smqjson.Messages) er
 					return errors.Wrap(errSaveMessage, errInvalidMessage)
 				case pgerrcode.UndefinedTable:
 					return errNoTable
+				case pgerrcode.UndefinedColumn:
+					return errNoColumn
 				}
 			}
 			return err
 		}
 	}
+
+	channels := make([]string, 0, len(msgs.Data))
+	for _, m := range msgs.Data {
+		channels = append(channels, m.Channel)
+	}
+	if err = saveChannels(ctx, tx, channels); err != nil {
+		return errors.Wrap(errSaveMessage, err)
+	}
 	return nil
 }
 
@@ -164,17 +183,37 @@ func (pr postgresRepo) createTable(name string) error {
             publisher     VARCHAR(254),
             protocol      TEXT,
             payload       JSONB,
+            headers       JSONB,
             PRIMARY KEY (id)
         )`
 	q = fmt.Sprintf(q, name)
 
-	_, err := pr.db.Exec(q)
+	if _, err := pr.db.Exec(q); err != nil {
+		return err
+	}
+
+	// Tables created before headers were introduced are extended in place.
+	_, err := pr.db.Exec(fmt.Sprintf(`ALTER TABLE %s ADD COLUMN IF NOT EXISTS headers JSONB`, name))
 	return err
 }
 
 type senmlMessage struct {
 	senml.Message
-	ID string `db:"id"`
+	ID      string `db:"id"`
+	Headers []byte `db:"headers"`
+}
+
+func toSenMLMessage(msg senml.Message) (senmlMessage, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return senmlMessage{}, err
+	}
+	headers, err := toHeaders(msg.Headers)
+	if err != nil {
+		return senmlMessage{}, err
+	}
+
+	return senmlMessage{Message: msg, ID: id.String(), Headers: headers}, nil
 }
 
 type jsonMessage struct {
@@ -185,6 +224,7 @@ type jsonMessage struct {
 	Publisher string `db:"publisher"`
 	Protocol  string `db:"protocol"`
 	Payload   []byte `db:"payload"`
+	Headers   []byte `db:"headers"`
 }
 
 func toJSONMessage(msg smqjson.Message) (jsonMessage, error) {
@@ -202,6 +242,11 @@ func toJSONMessage(msg smqjson.Message) (jsonMessage, error) {
 		data = b
 	}
 
+	headers, err := toHeaders(msg.Headers)
+	if err != nil {
+		return jsonMessage{}, errors.Wrap(errSaveMessage, err)
+	}
+
 	m := jsonMessage{
 		ID:        id.String(),
 		Channel:   msg.Channel,
@@ -210,7 +255,35 @@ func toJSONMessage(msg smqjson.Message) (jsonMessage, error) {
 		Publisher: msg.Publisher,
 		Protocol:  msg.Protocol,
 		Payload:   data,
+		Headers:   headers,
 	}
 
 	return m, nil
 }
+
+// saveChannels records the channels of the saved messages, so the retention
+// store lists them without scanning the messages. The channels are inserted
+// in order to avoid deadlocks of the concurrent transactions.
+func saveChannels(ctx context.Context, tx *sqlx.Tx, channels []string) error {
+	channels = slices.Compact(slices.Sorted(slices.Values(channels)))
+	for _, ch := range channels {
+		if ch == "" {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO message_channels (channel) VALUES ($1) ON CONFLICT DO NOTHING`, ch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// toHeaders encodes message headers as JSON. Messages without
+// headers are stored with NULL headers.
+func toHeaders(headers map[string]string) ([]byte, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(headers)
+}
@@ -41,6 +41,43 @@ func Migration() *migrate.MemoryMigrationSource {
 					`ALTER TABLE messages ADD PRIMARY KEY (time, publisher, subtopic, name)`,
 				},
 			},
+			{
+				Id: "messages_3",
+				Up: []string{
+					`ALTER TABLE messages ADD COLUMN IF NOT EXISTS headers JSONB`,
+				},
+				Down: []string{
+					`ALTER TABLE messages DROP COLUMN IF EXISTS headers`,
+				},
+			},
+			{
+				Id: "messages_4",
+				// The channels of the stored messages are listed by the
+				// retention enforcer, so they are kept apart from the messages.
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS message_channels (
+                        channel VARCHAR(254) PRIMARY KEY
+                    )`,
+					`INSERT INTO message_channels (channel)
+                        SELECT DISTINCT channel::text FROM messages WHERE channel IS NOT NULL
+                        ON CONFLICT DO NOTHING`,
+					`DO $$
+                    DECLARE t TEXT;
+                    BEGIN
+                        FOR t IN SELECT table_name FROM information_schema.columns
+                            WHERE table_schema = current_schema() AND column_name IN ('created', 'payload')
+                            GROUP BY table_name HAVING COUNT(*) = 2
+                        LOOP
+                            EXECUTE format('INSERT INTO message_channels (channel)
+                                SELECT DISTINCT channel FROM %I WHERE channel IS NOT NULL
+                                ON CONFLICT DO NOTHING', t);
+                        END LOOP;
+                    END $$`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS message_channels`,
+				},
+			},
 		},
 	}
 }
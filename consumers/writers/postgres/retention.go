@@ -0,0 +1,95 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/retention"
+	"github.com/jackc/pgx/v5"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	errListChannels  = errors.New("failed to list channels of stored messages")
+	errDeleteMessage = errors.New("failed to delete expired messages")
+)
+
+var _ retention.Store = (*retentionStore)(nil)
+
+type retentionStore struct {
+	db *sqlx.DB
+}
+
+// NewRetentionStore returns the store whose expired messages are removed by
+// the retention enforcer.
+func NewRetentionStore(db *sqlx.DB) retention.Store {
+	return &retentionStore{db: db}
+}
+
+// Channels lists the channels the writer recorded while saving the messages,
+// which spares the scan of all the stored messages. A channel whose messages
+// are all deleted stays listed.
+func (rs *retentionStore) Channels(ctx context.Context) ([]string, error) {
+	var chs []string
+	if err := rs.db.SelectContext(ctx, &chs, `SELECT channel FROM message_channels`); err != nil {
+		return nil, errors.Wrap(errListChannels, err)
+	}
+
+	return chs, nil
+}
+
+func (rs *retentionStore) Delete(ctx context.Context, channel string, before time.Time) (int64, error) {
+	tables, err := rs.jsonTables(ctx)
+	if err != nil {
+		return 0, errors.Wrap(errDeleteMessage, err)
+	}
+
+	cutoff := before.UnixNano()
+	res, err := rs.db.ExecContext(ctx, `DELETE FROM messages WHERE channel = $1 AND time < $2`, channel, float64(cutoff))
+	if err != nil {
+		return 0, errors.Wrap(errDeleteMessage, err)
+	}
+	total, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(errDeleteMessage, err)
+	}
+
+	for _, t := range tables {
+		res, err := rs.db.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s WHERE channel = $1 AND created < $2`, t), channel, cutoff)
+		if err != nil {
+			return total, errors.Wrap(errDeleteMessage, err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return total, errors.Wrap(errDeleteMessage, err)
+		}
+		total += n
+	}
+
+	return total, nil
+}
+
+// jsonTables returns the quoted names of the tables of the JSON messages,
+// which are created on demand for each message format.
+func (rs *retentionStore) jsonTables(ctx context.Context) ([]string, error) {
+	q := `SELECT table_name FROM information_schema.columns
+          WHERE table_schema = current_schema() AND column_name IN ('created', 'payload')
+          GROUP BY table_name HAVING COUNT(*) = 2;`
+
+	var names []string
+	if err := rs.db.SelectContext(ctx, &names, q); err != nil {
+		return nil, err
+	}
+
+	tables := make([]string, 0, len(names))
+	for _, name := range names {
+		tables = append(tables, pgx.Identifier{name}.Sanitize())
+	}
+
+	return tables, nil
+}
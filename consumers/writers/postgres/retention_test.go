@@ -0,0 +1,113 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/consumers/writers/postgres"
+	"github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionDelete(t *testing.T) {
+	repo := postgres.New(db)
+	store := postgres.NewRetentionStore(db)
+
+	chid, err := uuid.NewV4()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	pubid, err := uuid.NewV4()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	now := time.Now()
+	expired := now.Add(-48 * time.Hour).UnixNano()
+	fresh := now.UnixNano()
+
+	var senmlMsgs []senml.Message
+	jsonMsgs := json.Messages{Format: "retention_json"}
+	for i := 0; i < msgsNum; i++ {
+		created := fresh + int64(i)
+		if i%2 == 0 {
+			created = expired + int64(i)
+		}
+		senmlMsgs = append(senmlMsgs, senml.Message{
+			Channel:   chid.String(),
+			Publisher: pubid.String(),
+			Subtopic:  subtopic,
+			Name:      "temperature",
+			Value:     &v,
+			Time:      float64(created),
+		})
+		jsonMsgs.Data = append(jsonMsgs.Data, json.Message{
+			Channel:   chid.String(),
+			Publisher: pubid.String(),
+			Created:   created,
+			Subtopic:  subtopic,
+			Protocol:  "mqtt",
+			Payload:   map[string]any{"field_1": 123},
+		})
+	}
+	err = repo.ConsumeBlocking(context.Background(), senmlMsgs)
+	require.Nil(t, err, fmt.Sprintf("save SenML messages unexpected error: %s", err))
+	err = repo.ConsumeBlocking(context.Background(), jsonMsgs)
+	require.Nil(t, err, fmt.Sprintf("save JSON messages unexpected error: %s", err))
+
+	// The channels of the batch writer are listed as well.
+	batchChID := uuid.Must(uuid.NewV4()).String()
+	err = postgres.NewBatch(db).SaveBatch(context.Background(), []any{[]senml.Message{{
+		Channel:   batchChID,
+		Publisher: pubid.String(),
+		Subtopic:  subtopic,
+		Name:      "temperature",
+		Value:     &v,
+		Time:      float64(fresh),
+	}}})
+	require.Nil(t, err, fmt.Sprintf("save SenML batch unexpected error: %s", err))
+
+	chs, err := store.Channels(context.Background())
+	assert.Nil(t, err, fmt.Sprintf("list channels unexpected error: %s", err))
+	assert.Contains(t, chs, chid.String())
+	assert.Contains(t, chs, batchChID)
+
+	cases := []struct {
+		desc    string
+		channel string
+		before  time.Time
+		count   int64
+	}{
+		{
+			desc:    "delete expired messages",
+			channel: chid.String(),
+			before:  now.Add(-24 * time.Hour),
+			// Half of the SenML and half of the JSON messages are expired.
+			count: msgsNum,
+		},
+		{
+			desc:    "delete already deleted messages",
+			channel: chid.String(),
+			before:  now.Add(-24 * time.Hour),
+			count:   0,
+		},
+		{
+			desc:    "delete messages of channel without messages",
+			channel: uuid.Must(uuid.NewV4()).String(),
+			before:  now.Add(time.Hour),
+			count:   0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			count, err := store.Delete(context.Background(), tc.channel, tc.before)
+			assert.Nil(t, err, fmt.Sprintf("delete messages unexpected error: %s", err))
+			assert.Equal(t, tc.count, count)
+		})
+	}
+}
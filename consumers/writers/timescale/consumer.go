@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/absmach/magistrala/consumers"
 	"github.com/absmach/magistrala/pkg/errors"
@@ -25,6 +26,7 @@ var (
 	errSaveMessage    = errors.New("failed to save message to timescale database")
 	errTransRollback  = errors.New("failed to rollback transaction")
 	errNoTable        = errors.New("relation does not exist")
+	errNoColumn       = errors.New("column does not exist")
 )
 
 var _ consumers.BlockingConsumer = (*timescaleRepo)(nil)
@@ -58,10 +60,10 @@ func (tr timescaleRepo) saveSenml(ctx context.Context, messages any) (err error)
 	}
 	q := `INSERT INTO messages (channel, subtopic, publisher, protocol,
           name, unit, value, string_value, bool_value, data_value, sum,
-          time, update_time)
+          time, update_time, headers)
           VALUES (:channel, :subtopic, :publisher, :protocol, :name, :unit,
           :value, :string_value, :bool_value, :data_value, :sum,
-          :time, :update_time);`
+          :time, :update_time, :headers);`
 
 	tx, err := tr.db.BeginTxx(ctx, nil)
 	if err != nil {
@@ -81,7 +83,10 @@ func (tr timescaleRepo) saveSenml(ctx context.Context, messages any) (err error)
 	}()
 
 	for _, msg := range msgs {
-		m := senmlMessage{Message: msg}
+		m, err := toSenMLMessage(msg)
+		if err != nil {
+			return errors.Wrap(errSaveMessage, err)
+		}
 		if _, err := tx.NamedExec(q, m); err != nil {
 			pgErr, ok := err.(*pgconn.PgError)
 			if ok {
@@ -94,12 +99,20 @@ func (tr timescaleRepo) saveSenml(ctx context.Context, messages any) (err error)
 			return errors.Wrap(errSaveMessage, err)
 		}
 	}
+
+	channels := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		channels = append(channels, msg.Channel)
+	}
+	if err := saveChannels(ctx, tx, channels); err != nil {
+		return errors.Wrap(errSaveMessage, err)
+	}
 	return err
 }
 
 func (tr timescaleRepo) saveJSON(ctx context.Context, msgs smqjson.Messages) error {
 	if err := tr.insertJSON(ctx, msgs); err != nil {
-		if err == errNoTable {
+		if err == errNoTable || err == errNoColumn {
 			if err := tr.createTable(msgs.Format); err != nil {
 				return err
 			}
@@ -128,8 +141,8 @@ func (tr timescaleRepo) insertJSON(ctx context.Context, msgs smqjson.Messages) e
 		}
 	}()
 
-	q := `INSERT INTO %s (channel, created, subtopic, publisher, protocol, payload)
-          VALUES (:channel, :created, :subtopic, :publisher, :protocol, :payload);`
+	q := `INSERT INTO %s (channel, created, subtopic, publisher, protocol, payload, headers)
+          VALUES (:channel, :created, :subtopic, :publisher, :protocol, :payload, :headers);`
 	q = fmt.Sprintf(q, msgs.Format)
 
 	for _, m := range msgs.Data {
@@ -149,11 +162,21 @@ func (tr timescaleRepo) insertJSON(ctx context.Context, msgs smqjson.Messages) e
 					return errors.Wrap(errSaveMessage, errInvalidMessage)
 				case pgerrcode.UndefinedTable:
 					return errNoTable
+				case pgerrcode.UndefinedColumn:
+					return errNoColumn
 				}
 			}
 			return err
 		}
 	}
+
+	channels := make([]string, 0, len(msgs.Data))
+	for _, m := range msgs.Data {
+		channels = append(channels, m.Channel)
+	}
+	if err = saveChannels(ctx, tx, channels); err != nil {
+		return errors.Wrap(errSaveMessage, err)
+	}
 	return nil
 }
 
@@ -165,16 +188,32 @@ func (tr timescaleRepo) createTable(name string) error {
             publisher     VARCHAR(254),
             protocol      TEXT,
             payload       JSONB,
+            headers       JSONB,
             PRIMARY KEY (created, publisher, subtopic)
         );`
 	q = fmt.Sprintf(q, name)
 
-	_, err := tr.db.Exec(q)
+	if _, err := tr.db.Exec(q); err != nil {
+		return err
+	}
+
+	// Tables created before headers were introduced are extended in place.
+	_, err := tr.db.Exec(fmt.Sprintf(`ALTER TABLE %s ADD COLUMN IF NOT EXISTS headers JSONB`, name))
 	return err
 }
 
 type senmlMessage struct {
 	senml.Message
+	Headers []byte `db:"headers"`
+}
+
+func toSenMLMessage(msg senml.Message) (senmlMessage, error) {
+	headers, err := toHeaders(msg.Headers)
+	if err != nil {
+		return senmlMessage{}, err
+	}
+
+	return senmlMessage{Message: msg, Headers: headers}, nil
 }
 
 type jsonMessage struct {
@@ -184,6 +223,7 @@ type jsonMessage struct {
 	Publisher string `db:"publisher"`
 	Protocol  string `db:"protocol"`
 	Payload   []byte `db:"payload"`
+	Headers   []byte `db:"headers"`
 }
 
 func toJSONMessage(msg smqjson.Message) (jsonMessage, error) {
@@ -196,6 +236,11 @@ func toJSONMessage(msg smqjson.Message) (jsonMessage, error) {
 		data = b
 	}
 
+	headers, err := toHeaders(msg.Headers)
+	if err != nil {
+		return jsonMessage{}, errors.Wrap(errSaveMessage, err)
+	}
+
 	m := jsonMessage{
 		Channel:   msg.Channel,
 		Created:   msg.Created,
@@ -203,7 +248,35 @@ func toJSONMessage(msg smqjson.Message) (jsonMessage, error) {
 		Publisher: msg.Publisher,
 		Protocol:  msg.Protocol,
 		Payload:   data,
+		Headers:   headers,
 	}
 
 	return m, nil
 }
+
+// saveChannels records the channels of the saved messages, so the retention
+// store lists them without scanning the messages. The channels are inserted
+// in order to avoid deadlocks of the concurrent transactions.
+func saveChannels(ctx context.Context, tx *sqlx.Tx, channels []string) error {
+	channels = slices.Compact(slices.Sorted(slices.Values(channels)))
+	for _, ch := range channels {
+		if ch == "" {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO message_channels (channel) VALUES ($1) ON CONFLICT DO NOTHING`, ch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// toHeaders encodes message headers as JSON. Messages without
+// headers are stored with NULL headers.
+func toHeaders(headers map[string]string) ([]byte, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(headers)
+}
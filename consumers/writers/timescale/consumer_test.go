@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/absmach/magistrala/consumers/writers/timescale"
+	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/absmach/magistrala/pkg/transformers/json"
 	"github.com/absmach/magistrala/pkg/transformers/senml"
 	"github.com/gofrs/uuid/v5"
@@ -38,6 +39,7 @@ func TestSaveSenml(t *testing.T) {
 
 	msg := senml.Message{}
 	msg.Channel = chid.String()
+	msg.Headers = map[string]string{"correlation-id": "corr-1"}
 
 	pubid, err := uuid.NewV4()
 	assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
@@ -85,6 +87,7 @@ func TestSaveJSON(t *testing.T) {
 		Created:   time.Now().Unix(),
 		Subtopic:  "subtopic/format/some_json",
 		Protocol:  "mqtt",
+		Headers:   map[string]string{"correlation-id": "corr-1"},
 		Payload: map[string]any{
 			"field_1": 123,
 			"field_2": "value",
@@ -110,3 +113,105 @@ func TestSaveJSON(t *testing.T) {
 	err = repo.ConsumeBlocking(context.TODO(), msgs)
 	assert.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
 }
+
+func TestSaveBatch(t *testing.T) {
+	repo := timescale.NewBatch(db)
+
+	chid, err := uuid.NewV4()
+	assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	pubid, err := uuid.NewV4()
+	assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	now := time.Now().Unix()
+	var senmlMsgs []senml.Message
+	jsonMsgs := json.Messages{
+		Format: "batch_json",
+	}
+	for i := 0; i < msgsNum; i++ {
+		senmlMsgs = append(senmlMsgs, senml.Message{
+			Channel:   chid.String(),
+			Publisher: pubid.String(),
+			Subtopic:  subtopic,
+			Name:      "temperature",
+			Value:     &v,
+			Time:      float64(now + int64(i)),
+		})
+		jsonMsgs.Data = append(jsonMsgs.Data, json.Message{
+			Channel:   chid.String(),
+			Publisher: pubid.String(),
+			Created:   now + int64(i),
+			Subtopic:  subtopic,
+			Protocol:  "mqtt",
+			Payload: map[string]any{
+				"field_1": 123,
+				"field_2": "value",
+			},
+		})
+	}
+
+	cases := []struct {
+		desc  string
+		batch []any
+		err   error
+	}{
+		{
+			desc:  "save batch of SenML and JSON messages",
+			batch: []any{senmlMsgs, jsonMsgs},
+		},
+		{
+			desc:  "save empty batch",
+			batch: []any{},
+		},
+		{
+			desc:  "save batch with invalid message representation",
+			batch: []any{"invalid"},
+			err:   errors.New("failed to save message to timescale database"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.SaveBatch(context.Background(), tc.batch)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %s got %s\n", tc.err, err))
+		})
+	}
+}
+
+func TestSaveBatchConflicts(t *testing.T) {
+	repo := timescale.New(db)
+	batchRepo := timescale.NewBatch(db)
+
+	chid, err := uuid.NewV4()
+	assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	pubid, err := uuid.NewV4()
+	assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	now := time.Now().UnixNano()
+	var msgs []senml.Message
+	for i := 0; i < msgsNum; i++ {
+		msgs = append(msgs, senml.Message{
+			Channel:   chid.String(),
+			Publisher: pubid.String(),
+			Subtopic:  subtopic,
+			Name:      "temperature",
+			Value:     &v,
+			Time:      float64(now + int64(i)),
+		})
+	}
+
+	err = batchRepo.SaveBatch(context.Background(), []any{msgs})
+	assert.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+
+	// Conflicting single messages are rejected.
+	err = repo.ConsumeBlocking(context.Background(), msgs[:1])
+	assert.NotNil(t, err, "expected conflict error")
+
+	// Conflicting batch records are skipped.
+	err = batchRepo.SaveBatch(context.Background(), []any{msgs, msgs})
+	assert.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+
+	var count int
+	err = db.Get(&count, `SELECT COUNT(*) FROM messages WHERE channel = $1`, chid)
+	assert.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+	assert.Equal(t, msgsNum, count)
+}
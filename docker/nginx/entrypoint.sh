@@ -21,6 +21,8 @@ envsubst '
     ${MG_NGINX_MQTTS_PORT}
     ${MG_RE_HTTP_PORT}
     ${MG_ALARMS_HTTP_PORT}
+    ${MG_COMMANDS_HTTP_PORT}
+    ${MG_TWINS_HTTP_PORT}
     ${MG_REPORTS_HTTP_PORT}
     ${MG_NGINX_AMQP_PORT}' < /etc/nginx/nginx.conf.template > /etc/nginx/nginx.conf
 
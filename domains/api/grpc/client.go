@@ -23,6 +23,7 @@ type domainsGrpcClient struct {
 	deleteUserFromDomains endpoint.Endpoint
 	retrieveStatus        endpoint.Endpoint
 	retrieveIDByRoute     endpoint.Endpoint
+	retrieveEntity        endpoint.Endpoint
 	timeout               time.Duration
 }
 
@@ -53,6 +54,14 @@ func NewDomainsClient(conn *grpc.ClientConn, timeout time.Duration) grpcDomainsV
 			decodeRetrieveIDByRouteResponse,
 			grpcCommonV1.RetrieveEntityRes{},
 		).Endpoint(),
+		retrieveEntity: kitgrpc.NewClient(
+			conn,
+			domainsSvcName,
+			"RetrieveEntity",
+			encodeRetrieveEntityRequest,
+			decodeRetrieveEntityResponse,
+			grpcCommonV1.RetrieveEntityRes{},
+		).Endpoint(),
 		timeout: timeout,
 	}
 }
@@ -145,3 +154,28 @@ func encodeRetrieveIDByRouteRequest(_ context.Context, grpcReq any) (any, error)
 		Route: req.Route,
 	}, nil
 }
+
+func (client domainsGrpcClient) RetrieveEntity(ctx context.Context, in *grpcCommonV1.RetrieveEntityReq, opts ...grpc.CallOption) (*grpcCommonV1.RetrieveEntityRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.retrieveEntity(ctx, retrieveEntityReq{
+		ID: in.GetId(),
+	})
+	if err != nil {
+		return &grpcCommonV1.RetrieveEntityRes{}, grpcapi.DecodeError(err)
+	}
+
+	return res.(*grpcCommonV1.RetrieveEntityRes), nil
+}
+
+func decodeRetrieveEntityResponse(_ context.Context, grpcRes any) (any, error) {
+	return grpcRes.(*grpcCommonV1.RetrieveEntityRes), nil
+}
+
+func encodeRetrieveEntityRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(retrieveEntityReq)
+	return &grpcCommonV1.RetrieveEntityReq{
+		Id: req.ID,
+	}, nil
+}
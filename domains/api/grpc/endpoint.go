@@ -60,3 +60,23 @@ func retrieveIDByRouteEndpoint(svc domains.Service) endpoint.Endpoint {
 		}, nil
 	}
 }
+
+func retrieveEntityEndpoint(svc domains.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(retrieveEntityReq)
+		if err := req.validate(); err != nil {
+			return retrieveEntityRes{}, err
+		}
+
+		dom, err := svc.RetrieveEntity(ctx, req.ID)
+		if err != nil {
+			return retrieveEntityRes{}, err
+		}
+
+		return retrieveEntityRes{
+			id:       dom.ID,
+			status:   uint8(dom.Status),
+			metadata: dom.Metadata,
+		}, nil
+	}
+}
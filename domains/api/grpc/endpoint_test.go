@@ -218,3 +218,78 @@ func TestRetrieveIDByRoute(t *testing.T) {
 		svcCall.Unset()
 	}
 }
+
+func TestRetrieveEntity(t *testing.T) {
+	conn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error creating client connection %s", err))
+	grpcClient := grpcapi.NewDomainsClient(conn, time.Second)
+
+	dom := domains.Domain{
+		ID:       id,
+		Status:   domains.EnabledStatus,
+		Metadata: domains.Metadata{"retention": map[string]any{"period": "30d"}},
+	}
+
+	cases := []struct {
+		desc        string
+		retrieveReq *grpcCommonV1.RetrieveEntityReq
+		svcRes      domains.Domain
+		svcErr      error
+		retrieveRes *grpcCommonV1.RetrieveEntityRes
+		err         error
+	}{
+		{
+			desc: "retrieve entity with valid id",
+			retrieveReq: &grpcCommonV1.RetrieveEntityReq{
+				Id: id,
+			},
+			svcRes: dom,
+			retrieveRes: &grpcCommonV1.RetrieveEntityRes{
+				Entity: &grpcCommonV1.EntityBasic{
+					Id:       id,
+					Status:   uint32(domains.EnabledStatus),
+					Metadata: []byte(`{"retention":{"period":"30d"}}`),
+				},
+			},
+			err: nil,
+		},
+		{
+			desc: "retrieve entity without metadata",
+			retrieveReq: &grpcCommonV1.RetrieveEntityReq{
+				Id: id,
+			},
+			svcRes: domains.Domain{ID: id, Status: domains.DisabledStatus},
+			retrieveRes: &grpcCommonV1.RetrieveEntityRes{
+				Entity: &grpcCommonV1.EntityBasic{
+					Id:     id,
+					Status: uint32(domains.DisabledStatus),
+				},
+			},
+			err: nil,
+		},
+		{
+			desc: "retrieve entity with empty id",
+			retrieveReq: &grpcCommonV1.RetrieveEntityReq{
+				Id: "",
+			},
+			retrieveRes: &grpcCommonV1.RetrieveEntityRes{},
+			err:         apiutil.ErrMissingID,
+		},
+		{
+			desc: "retrieve non-existing entity",
+			retrieveReq: &grpcCommonV1.RetrieveEntityReq{
+				Id: "invalid",
+			},
+			svcErr:      svcerr.ErrNotFound,
+			retrieveRes: &grpcCommonV1.RetrieveEntityRes{},
+			err:         svcerr.ErrNotFound,
+		},
+	}
+	for _, tc := range cases {
+		svcCall := svc.On("RetrieveEntity", mock.Anything, tc.retrieveReq.Id).Return(tc.svcRes, tc.svcErr)
+		dpr, err := grpcClient.RetrieveEntity(context.Background(), tc.retrieveReq)
+		assert.Equal(t, tc.retrieveRes.Entity, dpr.Entity, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.retrieveRes.Entity, dpr.Entity))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		svcCall.Unset()
+	}
+}
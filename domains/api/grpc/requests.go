@@ -42,3 +42,15 @@ func (req retrieveIDByRouteReq) validate() error {
 
 	return nil
 }
+
+type retrieveEntityReq struct {
+	ID string
+}
+
+func (req retrieveEntityReq) validate() error {
+	if req.ID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
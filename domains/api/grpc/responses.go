@@ -14,3 +14,9 @@ type retrieveIDByRouteRes struct {
 type retrieveStatusRes struct {
 	status uint8
 }
+
+type retrieveEntityRes struct {
+	id       string
+	status   uint8
+	metadata map[string]any
+}
@@ -5,6 +5,7 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
 
 	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
 	grpcDomainsV1 "github.com/absmach/magistrala/api/grpc/domains/v1"
@@ -20,6 +21,7 @@ type domainsGrpcServer struct {
 	deleteUserFromDomains kitgrpc.Handler
 	retrieveStatus        kitgrpc.Handler
 	retrieveIDByRoute     kitgrpc.Handler
+	retrieveEntity        kitgrpc.Handler
 }
 
 func NewDomainsServer(svc domains.Service) grpcDomainsV1.DomainsServiceServer {
@@ -39,6 +41,11 @@ func NewDomainsServer(svc domains.Service) grpcDomainsV1.DomainsServiceServer {
 			decodeRetrieveIDByRouteRequest,
 			encodeRetrieveIDByRouteResponse,
 		),
+		retrieveEntity: kitgrpc.NewServer(
+			retrieveEntityEndpoint(svc),
+			decodeRetrieveEntityRequest,
+			encodeRetrieveEntityResponse,
+		),
 	}
 }
 
@@ -115,3 +122,40 @@ func (s *domainsGrpcServer) RetrieveIDByRoute(ctx context.Context, req *grpcComm
 
 	return res.(*grpcCommonV1.RetrieveEntityRes), nil
 }
+
+func decodeRetrieveEntityRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcCommonV1.RetrieveEntityReq)
+
+	return retrieveEntityReq{
+		ID: req.GetId(),
+	}, nil
+}
+
+func encodeRetrieveEntityResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(retrieveEntityRes)
+
+	var metadata []byte
+	if len(res.metadata) > 0 {
+		var err error
+		if metadata, err = json.Marshal(res.metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	return &grpcCommonV1.RetrieveEntityRes{
+		Entity: &grpcCommonV1.EntityBasic{
+			Id:       res.id,
+			Status:   uint32(res.status),
+			Metadata: metadata,
+		},
+	}, nil
+}
+
+func (s *domainsGrpcServer) RetrieveEntity(ctx context.Context, req *grpcCommonV1.RetrieveEntityReq) (*grpcCommonV1.RetrieveEntityRes, error) {
+	_, res, err := s.retrieveEntity.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcapi.EncodeError(err)
+	}
+
+	return res.(*grpcCommonV1.RetrieveEntityRes), nil
+}
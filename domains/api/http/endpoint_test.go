@@ -25,6 +25,7 @@ import (
 	authnmock "github.com/absmach/magistrala/pkg/authn/mocks"
 	"github.com/absmach/magistrala/pkg/errors"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/retention"
 	"github.com/absmach/magistrala/pkg/roles"
 	"github.com/absmach/magistrala/pkg/uuid"
 	"github.com/go-chi/chi/v5"
@@ -181,6 +182,19 @@ func TestCreateDomain(t *testing.T) {
 			status:      http.StatusBadRequest,
 			err:         apiutil.ErrMissingRoute,
 		},
+		{
+			desc: "register a new domain with invalid retention policy",
+			domain: domains.Domain{
+				Name:     "test",
+				Metadata: domains.Metadata{retention.MetadataKey: map[string]any{"period": "invalid"}},
+				Tags:     []string{"tag1", "tag2"},
+				Route:    "test",
+			},
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         retention.ErrInvalidPolicy,
+		},
 		{
 			desc: "register a  new domain with invalid content type",
 			domain: domains.Domain{
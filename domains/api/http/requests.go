@@ -7,6 +7,7 @@ import (
 	api "github.com/absmach/magistrala/api/http"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	"github.com/absmach/magistrala/domains"
+	"github.com/absmach/magistrala/pkg/retention"
 )
 
 const maxLimitSize = 100
@@ -20,6 +21,9 @@ type createDomainReq struct {
 }
 
 func (req createDomainReq) validate() error {
+	if err := retention.Validate(req.Metadata); err != nil {
+		return err
+	}
 	if req.ID != "" {
 		return api.ValidateUUID(req.ID)
 	}
@@ -60,6 +64,11 @@ func (req updateDomainReq) validate() error {
 	if req.domainID == "" {
 		return apiutil.ErrMissingID
 	}
+	if req.Metadata != nil {
+		if err := retention.Validate(*req.Metadata); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,154 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/domains"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/pkg/roles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSchemas(t *testing.T) {
+	now := time.Now().UTC()
+	session := authn.Session{
+		Type:     authn.AccessToken,
+		UserID:   testsutil.GenerateUUID(t),
+		DomainID: testsutil.GenerateUUID(t),
+	}
+	domain := domains.Domain{
+		ID:        session.DomainID,
+		Name:      "domain",
+		Metadata:  domains.Metadata{"key": "value"},
+		Tags:      []string{"tag"},
+		Route:     "route",
+		Status:    domains.EnabledStatus,
+		CreatedBy: session.UserID,
+		CreatedAt: now,
+		UpdatedBy: session.UserID,
+		UpdatedAt: now,
+	}
+	page := domains.Page{
+		Limit:    10,
+		Name:     "domain",
+		Order:    "name",
+		Dir:      "asc",
+		Metadata: domains.Metadata{"key": "value"},
+		Tags:     domains.TagsQuery{Elements: []string{"tag"}},
+		RoleID:   testsutil.GenerateUUID(t),
+		RoleName: "admin",
+		Actions:  []string{"read"},
+		Status:   domains.EnabledStatus,
+		IDs:      []string{domain.ID},
+	}
+	invitation := domains.Invitation{
+		InvitedBy:     session.UserID,
+		InviteeUserID: testsutil.GenerateUUID(t),
+		DomainID:      domain.ID,
+		DomainName:    domain.Name,
+		RoleID:        testsutil.GenerateUUID(t),
+		RoleName:      "member",
+		CreatedAt:     now,
+	}
+	invitationPage := domains.InvitationPageMeta{
+		Limit:         10,
+		InvitedBy:     session.UserID,
+		InviteeUserID: invitation.InviteeUserID,
+		DomainID:      domain.ID,
+		RoleID:        invitation.RoleID,
+		State:         domains.Pending,
+	}
+	requestID := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc  string
+		event events.Event
+	}{
+		{
+			desc: "create domain",
+			event: createDomainEvent{
+				Domain:           domain,
+				rolesProvisioned: []roles.RoleProvision{{Role: roles.Role{ID: testsutil.GenerateUUID(t), Name: "admin"}, OptionalActions: []string{"read"}}},
+				Session:          session,
+				requestID:        requestID,
+			},
+		},
+		{
+			desc:  "retrieve domain",
+			event: retrieveDomainEvent{Domain: domain, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update domain",
+			event: updateDomainEvent{domain: domain, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "enable domain",
+			event: enableDomainEvent{domainID: domain.ID, updatedAt: now, updatedBy: session.UserID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "disable domain",
+			event: disableDomainEvent{domainID: domain.ID, updatedAt: now, updatedBy: session.UserID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "freeze domain",
+			event: freezeDomainEvent{domainID: domain.ID, updatedAt: now, updatedBy: session.UserID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "list domains",
+			event: listDomainsEvent{Page: page, total: 1, userID: session.UserID, tokenType: session.Type.String(), requestID: requestID},
+		},
+		{
+			desc:  "send invitation",
+			event: sendInvitationEvent{invitation: invitation, session: session, requestID: requestID},
+		},
+		{
+			desc:  "accept invitation",
+			event: acceptInvitationEvent{invitation: invitation, session: session, requestID: requestID},
+		},
+		{
+			desc:  "reject invitation",
+			event: rejectInvitationEvent{invitation: invitation, session: session, requestID: requestID},
+		},
+		{
+			desc:  "list invitations",
+			event: listInvitationsEvent{InvitationPageMeta: invitationPage, session: session, requestID: requestID},
+		},
+		{
+			desc:  "list domain invitations",
+			event: listDomainInvitationsEvent{InvitationPageMeta: invitationPage, session: session, requestID: requestID},
+		},
+		{
+			desc:  "delete invitation",
+			event: deleteInvitationEvent{inviteeUserID: invitation.InviteeUserID, domainID: domain.ID, session: session, requestID: requestID},
+		},
+	}
+
+	covered := make(map[string]bool)
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, s, err := schema.ValidateEvent(tc.event)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			covered[s.Operation] = true
+		})
+	}
+
+	// Role events are published by the roles manager.
+	for _, s := range schema.Schemas() {
+		op := s.Operation
+		if !strings.HasPrefix(op, domainPrefix) && !strings.HasPrefix(op, invitationPrefix) ||
+			strings.HasPrefix(op, domainPrefix+"role") || strings.HasPrefix(op, domainPrefix+"members") {
+			continue
+		}
+		assert.True(t, covered[op], fmt.Sprintf("expected emitted event for schema %s", op))
+	}
+}
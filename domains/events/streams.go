@@ -9,7 +9,6 @@ import (
 	"github.com/absmach/magistrala/domains"
 	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/events"
-	"github.com/absmach/magistrala/pkg/events/store"
 	"github.com/absmach/magistrala/pkg/roles"
 	rmEvents "github.com/absmach/magistrala/pkg/roles/rolemanager/events"
 	"github.com/go-chi/chi/v5/middleware"
@@ -40,41 +39,38 @@ type eventStore struct {
 	rmEvents.RoleManagerEventStore
 }
 
-// NewEventStoreMiddleware returns wrapper around auth service that sends
-// events to event store.
-func NewEventStoreMiddleware(ctx context.Context, svc domains.Service, url string) (domains.Service, error) {
-	publisher, err := store.NewPublisher(ctx, url, "domains-es-pub")
-	if err != nil {
-		return nil, err
-	}
-
+// NewEventStoreMiddleware returns wrapper around domains service that sends
+// events to the publisher. If the publisher is transactional, such as the
+// PostgreSQL outbox, the events of each change are stored in the same
+// transaction as the change itself.
+func NewEventStoreMiddleware(svc domains.Service, publisher events.Publisher) domains.Service {
 	res := rmEvents.NewRoleManagerEventStore("domains", domainPrefix, svc, publisher)
 
 	return &eventStore{
 		svc:                   svc,
 		Publisher:             publisher,
 		RoleManagerEventStore: res,
-	}, nil
+	}
 }
 
-func (es *eventStore) CreateDomain(ctx context.Context, session authn.Session, domain domains.Domain) (domains.Domain, []roles.RoleProvision, error) {
-	domain, rps, err := es.svc.CreateDomain(ctx, session, domain)
-	if err != nil {
-		return domain, rps, err
-	}
+func (es *eventStore) CreateDomain(ctx context.Context, session authn.Session, d domains.Domain) (domain domains.Domain, rps []roles.RoleProvision, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		domain, rps, err = es.svc.CreateDomain(ctx, session, d)
+		if err != nil {
+			return err
+		}
 
-	event := createDomainEvent{
-		Domain:           domain,
-		rolesProvisioned: rps,
-		Session:          session,
-		requestID:        middleware.GetReqID(ctx),
-	}
+		event := createDomainEvent{
+			Domain:           domain,
+			rolesProvisioned: rps,
+			Session:          session,
+			requestID:        middleware.GetReqID(ctx),
+		}
 
-	if err := es.Publish(ctx, createStream, event); err != nil {
-		return domain, rps, err
-	}
+		return es.Publish(ctx, createStream, event)
+	})
 
-	return domain, rps, nil
+	return domain, rps, err
 }
 
 func (es *eventStore) RetrieveDomain(ctx context.Context, session authn.Session, id string, withRoles bool) (domains.Domain, error) {
@@ -96,86 +92,86 @@ func (es *eventStore) RetrieveDomain(ctx context.Context, session authn.Session,
 	return domain, nil
 }
 
-func (es *eventStore) UpdateDomain(ctx context.Context, session authn.Session, id string, d domains.DomainReq) (domains.Domain, error) {
-	domain, err := es.svc.UpdateDomain(ctx, session, id, d)
-	if err != nil {
-		return domain, err
-	}
+func (es *eventStore) UpdateDomain(ctx context.Context, session authn.Session, id string, d domains.DomainReq) (domain domains.Domain, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		domain, err = es.svc.UpdateDomain(ctx, session, id, d)
+		if err != nil {
+			return err
+		}
 
-	event := updateDomainEvent{
-		domain:    domain,
-		Session:   session,
-		requestID: middleware.GetReqID(ctx),
-	}
+		event := updateDomainEvent{
+			domain:    domain,
+			Session:   session,
+			requestID: middleware.GetReqID(ctx),
+		}
 
-	if err := es.Publish(ctx, updateStream, event); err != nil {
-		return domain, err
-	}
+		return es.Publish(ctx, updateStream, event)
+	})
 
-	return domain, nil
+	return domain, err
 }
 
-func (es *eventStore) EnableDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
-	domain, err := es.svc.EnableDomain(ctx, session, id)
-	if err != nil {
-		return domain, err
-	}
-
-	event := enableDomainEvent{
-		domainID:  id,
-		updatedAt: domain.UpdatedAt,
-		updatedBy: domain.UpdatedBy,
-		Session:   session,
-		requestID: middleware.GetReqID(ctx),
-	}
-
-	if err := es.Publish(ctx, enableStream, event); err != nil {
-		return domain, err
-	}
-
-	return domain, nil
+func (es *eventStore) EnableDomain(ctx context.Context, session authn.Session, id string) (domain domains.Domain, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		domain, err = es.svc.EnableDomain(ctx, session, id)
+		if err != nil {
+			return err
+		}
+
+		event := enableDomainEvent{
+			domainID:  id,
+			updatedAt: domain.UpdatedAt,
+			updatedBy: domain.UpdatedBy,
+			Session:   session,
+			requestID: middleware.GetReqID(ctx),
+		}
+
+		return es.Publish(ctx, enableStream, event)
+	})
+
+	return domain, err
 }
 
-func (es *eventStore) DisableDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
-	domain, err := es.svc.DisableDomain(ctx, session, id)
-	if err != nil {
-		return domain, err
-	}
-
-	event := disableDomainEvent{
-		domainID:  id,
-		updatedAt: domain.UpdatedAt,
-		updatedBy: domain.UpdatedBy,
-		Session:   session,
-		requestID: middleware.GetReqID(ctx),
-	}
-
-	if err := es.Publish(ctx, disableStream, event); err != nil {
-		return domain, err
-	}
-
-	return domain, nil
+func (es *eventStore) DisableDomain(ctx context.Context, session authn.Session, id string) (domain domains.Domain, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		domain, err = es.svc.DisableDomain(ctx, session, id)
+		if err != nil {
+			return err
+		}
+
+		event := disableDomainEvent{
+			domainID:  id,
+			updatedAt: domain.UpdatedAt,
+			updatedBy: domain.UpdatedBy,
+			Session:   session,
+			requestID: middleware.GetReqID(ctx),
+		}
+
+		return es.Publish(ctx, disableStream, event)
+	})
+
+	return domain, err
 }
 
-func (es *eventStore) FreezeDomain(ctx context.Context, session authn.Session, id string) (domains.Domain, error) {
-	domain, err := es.svc.FreezeDomain(ctx, session, id)
-	if err != nil {
-		return domain, err
-	}
-
-	event := freezeDomainEvent{
-		domainID:  id,
-		updatedAt: domain.UpdatedAt,
-		updatedBy: domain.UpdatedBy,
-		Session:   session,
-		requestID: middleware.GetReqID(ctx),
-	}
-
-	if err := es.Publish(ctx, freezeStream, event); err != nil {
-		return domain, err
-	}
-
-	return domain, nil
+func (es *eventStore) FreezeDomain(ctx context.Context, session authn.Session, id string) (domain domains.Domain, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		domain, err = es.svc.FreezeDomain(ctx, session, id)
+		if err != nil {
+			return err
+		}
+
+		event := freezeDomainEvent{
+			domainID:  id,
+			updatedAt: domain.UpdatedAt,
+			updatedBy: domain.UpdatedBy,
+			Session:   session,
+			requestID: middleware.GetReqID(ctx),
+		}
+
+		return es.Publish(ctx, freezeStream, event)
+	})
+
+	return domain, err
 }
 
 func (es *eventStore) ListDomains(ctx context.Context, session authn.Session, p domains.Page) (domains.DomainsPage, error) {
@@ -200,23 +196,23 @@ func (es *eventStore) ListDomains(ctx context.Context, session authn.Session, p
 	return dp, nil
 }
 
-func (es *eventStore) SendInvitation(ctx context.Context, session authn.Session, invitation domains.Invitation) (domains.Invitation, error) {
-	inv, err := es.svc.SendInvitation(ctx, session, invitation)
-	if err != nil {
-		return domains.Invitation{}, err
-	}
+func (es *eventStore) SendInvitation(ctx context.Context, session authn.Session, invitation domains.Invitation) (inv domains.Invitation, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if inv, err = es.svc.SendInvitation(ctx, session, invitation); err != nil {
+			inv = domains.Invitation{}
+			return err
+		}
 
-	event := sendInvitationEvent{
-		invitation: inv,
-		session:    session,
-		requestID:  middleware.GetReqID(ctx),
-	}
+		event := sendInvitationEvent{
+			invitation: inv,
+			session:    session,
+			requestID:  middleware.GetReqID(ctx),
+		}
 
-	if err := es.Publish(ctx, sendInvitationStream, event); err != nil {
-		return inv, err
-	}
+		return es.Publish(ctx, sendInvitationStream, event)
+	})
 
-	return inv, nil
+	return inv, err
 }
 
 func (es *eventStore) ListInvitations(ctx context.Context, session authn.Session, pm domains.InvitationPageMeta) (domains.InvitationPage, error) {
@@ -257,58 +253,60 @@ func (es *eventStore) ListDomainInvitations(ctx context.Context, session authn.S
 	return ip, nil
 }
 
-func (es *eventStore) AcceptInvitation(ctx context.Context, session authn.Session, domainID string) (domains.Invitation, error) {
-	inv, err := es.svc.AcceptInvitation(ctx, session, domainID)
-	if err != nil {
-		return inv, err
-	}
+func (es *eventStore) AcceptInvitation(ctx context.Context, session authn.Session, domainID string) (inv domains.Invitation, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if inv, err = es.svc.AcceptInvitation(ctx, session, domainID); err != nil {
+			return err
+		}
 
-	if err := es.RoleManagerEventStore.RoleAddMembersEventPublisher(ctx, inv.DomainID, inv.RoleID, []string{inv.InviteeUserID}); err != nil {
-		return inv, err
-	}
+		if err := es.RoleManagerEventStore.RoleAddMembersEventPublisher(ctx, inv.DomainID, inv.RoleID, []string{inv.InviteeUserID}); err != nil {
+			return err
+		}
 
-	event := acceptInvitationEvent{
-		invitation: inv,
-		session:    session,
-		requestID:  middleware.GetReqID(ctx),
-	}
+		event := acceptInvitationEvent{
+			invitation: inv,
+			session:    session,
+			requestID:  middleware.GetReqID(ctx),
+		}
 
-	if err := es.Publish(ctx, acceptInvitationStream, event); err != nil {
-		return inv, err
-	}
-	return inv, nil
+		return es.Publish(ctx, acceptInvitationStream, event)
+	})
+
+	return inv, err
 }
 
-func (es *eventStore) RejectInvitation(ctx context.Context, session authn.Session, domainID string) (domains.Invitation, error) {
-	inv, err := es.svc.RejectInvitation(ctx, session, domainID)
-	if err != nil {
-		return domains.Invitation{}, err
-	}
+func (es *eventStore) RejectInvitation(ctx context.Context, session authn.Session, domainID string) (inv domains.Invitation, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if inv, err = es.svc.RejectInvitation(ctx, session, domainID); err != nil {
+			inv = domains.Invitation{}
+			return err
+		}
 
-	event := rejectInvitationEvent{
-		invitation: inv,
-		session:    session,
-		requestID:  middleware.GetReqID(ctx),
-	}
+		event := rejectInvitationEvent{
+			invitation: inv,
+			session:    session,
+			requestID:  middleware.GetReqID(ctx),
+		}
 
-	if err := es.Publish(ctx, rejectInvitationStream, event); err != nil {
-		return inv, err
-	}
+		return es.Publish(ctx, rejectInvitationStream, event)
+	})
 
-	return inv, nil
+	return inv, err
 }
 
 func (es *eventStore) DeleteInvitation(ctx context.Context, session authn.Session, inviteeUserID, domainID string) error {
-	if err := es.svc.DeleteInvitation(ctx, session, inviteeUserID, domainID); err != nil {
-		return err
-	}
-
-	event := deleteInvitationEvent{
-		inviteeUserID: inviteeUserID,
-		domainID:      domainID,
-		session:       session,
-		requestID:     middleware.GetReqID(ctx),
-	}
-
-	return es.Publish(ctx, deleteInvitationStream, event)
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.DeleteInvitation(ctx, session, inviteeUserID, domainID); err != nil {
+			return err
+		}
+
+		event := deleteInvitationEvent{
+			inviteeUserID: inviteeUserID,
+			domainID:      domainID,
+			session:       session,
+			requestID:     middleware.GetReqID(ctx),
+		}
+
+		return es.Publish(ctx, deleteInvitationStream, event)
+	})
 }
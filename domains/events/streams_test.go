@@ -17,6 +17,7 @@ import (
 	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/errors"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/events/store"
 	"github.com/absmach/magistrala/pkg/roles"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/redis/go-redis/v9"
@@ -49,8 +50,9 @@ var (
 
 func newEventStoreMiddleware(t *testing.T) (*mocks.Service, domains.Service) {
 	svc := new(mocks.Service)
-	nsvc, err := events.NewEventStoreMiddleware(context.Background(), svc, storeURL)
-	require.Nil(t, err, fmt.Sprintf("create events store middleware failed with unexpected error: %s", err))
+	publisher, err := store.NewPublisher(context.Background(), storeURL, "domains-es-pub")
+	require.Nil(t, err, fmt.Sprintf("create events store publisher failed with unexpected error: %s", err))
+	nsvc := events.NewEventStoreMiddleware(svc, publisher)
 
 	return svc, nsvc
 }
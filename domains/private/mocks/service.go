@@ -99,6 +99,72 @@ func (_c *Service_DeleteUserFromDomains_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// RetrieveEntity provides a mock function for the type Service
+func (_mock *Service) RetrieveEntity(ctx context.Context, id string) (domains.Domain, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveEntity")
+	}
+
+	var r0 domains.Domain
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domains.Domain, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domains.Domain); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domains.Domain)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RetrieveEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveEntity'
+type Service_RetrieveEntity_Call struct {
+	*mock.Call
+}
+
+// RetrieveEntity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Service_Expecter) RetrieveEntity(ctx interface{}, id interface{}) *Service_RetrieveEntity_Call {
+	return &Service_RetrieveEntity_Call{Call: _e.mock.On("RetrieveEntity", ctx, id)}
+}
+
+func (_c *Service_RetrieveEntity_Call) Run(run func(ctx context.Context, id string)) *Service_RetrieveEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RetrieveEntity_Call) Return(domain domains.Domain, err error) *Service_RetrieveEntity_Call {
+	_c.Call.Return(domain, err)
+	return _c
+}
+
+func (_c *Service_RetrieveEntity_Call) RunAndReturn(run func(ctx context.Context, id string) (domains.Domain, error)) *Service_RetrieveEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveIDByRoute provides a mock function for the type Service
 func (_mock *Service) RetrieveIDByRoute(ctx context.Context, route string) (string, error) {
 	ret := _mock.Called(ctx, route)
@@ -17,6 +17,7 @@ type Service interface {
 	RetrieveStatus(ctx context.Context, id string) (domains.Status, error)
 	DeleteUserFromDomains(ctx context.Context, id string) error
 	RetrieveIDByRoute(ctx context.Context, route string) (string, error)
+	RetrieveEntity(ctx context.Context, id string) (domains.Domain, error)
 }
 
 var _ Service = (*service)(nil)
@@ -85,3 +86,12 @@ func (svc service) RetrieveIDByRoute(ctx context.Context, route string) (string,
 
 	return dom.ID, nil
 }
+
+func (svc service) RetrieveEntity(ctx context.Context, id string) (domains.Domain, error) {
+	dom, err := svc.repo.RetrieveDomainByID(ctx, id)
+	if err != nil {
+		return domains.Domain{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return dom, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/absmach/magistrala/pkg/errors"
 	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/events"
 	"github.com/absmach/magistrala/pkg/policies"
 	"github.com/absmach/magistrala/pkg/roles"
 )
@@ -139,7 +140,7 @@ func (svc service) EnableDomain(ctx context.Context, session authn.Session, id s
 	if err != nil {
 		return Domain{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
 	}
-	if err := svc.cache.RemoveStatus(ctx, id); err != nil {
+	if err := svc.removeStatusCache(ctx, id); err != nil {
 		return dom, errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 
@@ -153,7 +154,7 @@ func (svc service) DisableDomain(ctx context.Context, session authn.Session, id
 	if err != nil {
 		return Domain{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
 	}
-	if err := svc.cache.RemoveStatus(ctx, id); err != nil {
+	if err := svc.removeStatusCache(ctx, id); err != nil {
 		return dom, errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 
@@ -168,7 +169,7 @@ func (svc service) FreezeDomain(ctx context.Context, session authn.Session, id s
 	if err != nil {
 		return Domain{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
 	}
-	if err := svc.cache.RemoveStatus(ctx, id); err != nil {
+	if err := svc.removeStatusCache(ctx, id); err != nil {
 		return dom, errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
 
@@ -422,3 +423,16 @@ func (svc *service) RoleRemoveAllMembers(ctx context.Context, session authn.Sess
 
 	return svc.ProvisionManageService.RoleRemoveAllMembers(ctx, session, entityID, roleID)
 }
+
+// removeStatusCache removes the domain status from the cache, and again once
+// the change made with ctx is committed, so that a concurrent authorization
+// can not keep the status cached as it was before the change.
+func (svc service) removeStatusCache(ctx context.Context, id string) error {
+	if err := svc.cache.RemoveStatus(ctx, id); err != nil {
+		return err
+	}
+
+	return events.AfterCommit(ctx, func(ctx context.Context) error {
+		return svc.cache.RemoveStatus(ctx, id)
+	})
+}
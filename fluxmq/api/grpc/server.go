@@ -5,15 +5,20 @@ package grpc
 
 import (
 	"context"
+	"strconv"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	authv1 "github.com/absmach/fluxmq/pkg/proto/auth/v1"
 	"github.com/absmach/fluxmq/pkg/proto/auth/v1/authv1connect"
+	grpcCertsV1 "github.com/absmach/magistrala/api/grpc/certs/v1"
 	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
 	grpcClientsV1 "github.com/absmach/magistrala/api/grpc/clients/v1"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	smqauth "github.com/absmach/magistrala/auth"
+	"github.com/absmach/magistrala/fluxmq/cache"
+	"github.com/absmach/magistrala/fluxmq/limiter"
 	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/connections"
 	"github.com/absmach/magistrala/pkg/errors"
@@ -22,30 +27,63 @@ import (
 	"github.com/absmach/magistrala/pkg/policies"
 )
 
+const (
+	// PayloadSizeHeader is the auth callout request header which carries
+	// the publish payload size in bytes. Without it, only the message rate
+	// limits are enforced. FluxMQ v0.30.0 does not set it.
+	PayloadSizeHeader = "X-Payload-Size"
+
+	// rateLimitReasonCode is the MQTT v5 "Message rate too high" reason code.
+	rateLimitReasonCode = 0x96
+	rateLimitReason     = "rate limit exceeded"
+)
+
 var _ authv1connect.AuthServiceHandler = (*connectServer)(nil)
 
 type connectServer struct {
 	authv1connect.UnimplementedAuthServiceHandler
 	clients  grpcClientsV1.ClientsServiceClient
 	channels grpcChannelsV1.ChannelsServiceClient
+	certs    grpcCertsV1.CertsServiceClient
 	parser   messaging.TopicParser
+	cache    *cache.Cache
+	limiter  *limiter.Limiter
+	x509     X509Config
 }
 
 // NewServer creates a FluxMQ AuthService Connect handler that bridges to
-// Magistrala's Clients (authn) and Channels (authz) services.
+// Magistrala's Clients (authn) and Channels (authz) services. Authorization
+// decisions are cached in authzCache, unless it is nil. If certificate
+// authentication is enabled, the client certificates are mapped to the
+// clients using the Certs service. The authorized publishes are rate limited
+// by publishLimiter, unless it is nil.
 func NewServer(
 	clients grpcClientsV1.ClientsServiceClient,
 	channels grpcChannelsV1.ChannelsServiceClient,
+	certs grpcCertsV1.CertsServiceClient,
 	parser messaging.TopicParser,
+	authzCache *cache.Cache,
+	publishLimiter *limiter.Limiter,
+	x509Cfg X509Config,
 ) authv1connect.AuthServiceHandler {
 	return &connectServer{
 		clients:  clients,
 		channels: channels,
+		certs:    certs,
 		parser:   parser,
+		cache:    authzCache,
+		limiter:  publishLimiter,
+		x509:     x509Cfg,
 	}
 }
 
 func (s *connectServer) Authenticate(ctx context.Context, req *connect.Request[authv1.AuthnReq]) (*connect.Response[authv1.AuthnRes], error) {
+	if s.x509.Enabled {
+		if cert := req.Header().Get(ClientCertHeader); cert != "" {
+			return s.authenticateCert(ctx, cert)
+		}
+	}
+
 	username := req.Msg.GetUsername()
 	password := req.Msg.GetPassword()
 
@@ -63,6 +101,16 @@ func (s *connectServer) Authenticate(ctx context.Context, req *connect.Request[a
 		}
 	}
 
+	if s.x509.Enabled && res.GetAuthenticated() {
+		allowed, err := s.secretAllowed(ctx, res.GetId())
+		if err != nil {
+			return nil, encodeError(err)
+		}
+		if !allowed {
+			return connect.NewResponse(&authv1.AuthnRes{Authenticated: false}), nil
+		}
+	}
+
 	return connect.NewResponse(&authv1.AuthnRes{
 		Authenticated: res.GetAuthenticated(),
 		Id:            res.GetId(),
@@ -75,15 +123,15 @@ func (s *connectServer) Authorize(ctx context.Context, req *connect.Request[auth
 		return nil, encodeError(err)
 	}
 
-	var domainID, channelID string
+	var domainID, channelID, subtopic string
 	var topicType messaging.TopicType
 	var err error
 
 	switch connType {
 	case connections.Publish:
-		domainID, channelID, _, topicType, err = s.parser.ParsePublishTopic(ctx, req.Msg.GetTopic(), true)
+		domainID, channelID, subtopic, topicType, err = s.parser.ParsePublishTopic(ctx, req.Msg.GetTopic(), true)
 	case connections.Subscribe:
-		domainID, channelID, _, topicType, err = s.parser.ParseSubscribeTopic(ctx, req.Msg.GetTopic(), true)
+		domainID, channelID, subtopic, topicType, err = s.parser.ParseSubscribeTopic(ctx, req.Msg.GetTopic(), true)
 	}
 	if err != nil {
 		if shouldDenyAuthorize(err) {
@@ -96,12 +144,26 @@ func (s *connectServer) Authorize(ctx context.Context, req *connect.Request[auth
 		return connect.NewResponse(&authv1.AuthzRes{Authorized: true}), nil
 	}
 
+	key := cache.Key{
+		ClientID:  req.Msg.GetExternalId(),
+		ChannelID: channelID,
+		ConnType:  connType,
+		Subtopic:  subtopic,
+	}
+	if s.cache != nil {
+		if authorized, ok := s.cache.Get(key); ok {
+			return s.limit(ctx, req, domainID, channelID, authorized), nil
+		}
+	}
+
+	checkedAt := time.Now()
 	ar := &grpcChannelsV1.AuthzReq{
 		Type:       uint32(connType),
 		ClientId:   req.Msg.GetExternalId(),
 		ClientType: policies.ClientType,
 		ChannelId:  channelID,
 		DomainId:   domainID,
+		Subtopic:   subtopic,
 	}
 	res, err := s.channels.Authorize(ctx, ar)
 	if err != nil {
@@ -110,10 +172,31 @@ func (s *connectServer) Authorize(ctx context.Context, req *connect.Request[auth
 		}
 		return nil, encodeError(err)
 	}
+	if s.cache != nil {
+		s.cache.Set(key, res.GetAuthorized(), checkedAt)
+	}
 
-	return connect.NewResponse(&authv1.AuthzRes{
-		Authorized: res.GetAuthorized(),
-	}), nil
+	return s.limit(ctx, req, domainID, channelID, res.GetAuthorized()), nil
+}
+
+// limit denies the authorized publishes over the client or channel rate
+// limits.
+func (s *connectServer) limit(ctx context.Context, req *connect.Request[authv1.AuthzReq], domainID, channelID string, authorized bool) *connect.Response[authv1.AuthzRes] {
+	if !authorized || s.limiter == nil || connections.ConnType(req.Msg.GetAction()) != connections.Publish {
+		return connect.NewResponse(&authv1.AuthzRes{Authorized: authorized})
+	}
+
+	// An invalid size is ignored, so that the message rate limits still apply.
+	size, _ := strconv.Atoi(req.Header().Get(PayloadSizeHeader))
+	if !s.limiter.Allow(ctx, domainID, req.Msg.GetExternalId(), channelID, max(size, 0)) {
+		return connect.NewResponse(&authv1.AuthzRes{
+			Authorized: false,
+			ReasonCode: rateLimitReasonCode,
+			Reason:     rateLimitReason,
+		})
+	}
+
+	return connect.NewResponse(&authv1.AuthzRes{Authorized: true})
 }
 
 func shouldTryDomainAuth(msg *authv1.AuthnReq, username, password string) bool {
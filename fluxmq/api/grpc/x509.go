@@ -0,0 +1,263 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	authv1 "github.com/absmach/fluxmq/pkg/proto/auth/v1"
+	grpcCertsV1 "github.com/absmach/magistrala/api/grpc/certs/v1"
+	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
+	"github.com/absmach/magistrala/clients"
+	"github.com/absmach/magistrala/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ClientCertHeader is the auth callout request header which carries the
+	// URL-escaped PEM client certificate chain presented to the broker.
+	// FluxMQ v0.30.0 and earlier do not set this header, so certificate
+	// authentication is accepted only with a later BrokerVersion.
+	ClientCertHeader = "X-Client-Cert"
+
+	// noCertHeaderVersion is the latest FluxMQ version known not to forward
+	// the client certificates in the ClientCertHeader.
+	noCertHeaderVersion = "v0.30.0"
+
+	// SecretFallbackKey is the client metadata key which allows or forbids
+	// the client to authenticate with its secret when certificate
+	// authentication is enabled.
+	SecretFallbackKey = "secret_fallback"
+)
+
+var (
+	errInvalidCert    = errors.New("invalid client certificate")
+	errRevokedCert    = errors.New("client certificate is revoked")
+	errUnverifiedCert = errors.New("client certificate is not signed by a trusted CA or is not valid at this time")
+	errMissingCACerts = errors.New("missing CA certificates for client certificate verification")
+	errBrokerVersion  = errors.New("broker version does not forward client certificates")
+)
+
+// X509Config represents the client certificate authentication configuration.
+type X509Config struct {
+	// Enabled enables authentication with the client certificates forwarded
+	// by the broker in the ClientCertHeader.
+	Enabled bool `env:"ENABLED" envDefault:"false"`
+	// BrokerVersion is the version of the FluxMQ broker which sends the
+	// auth callouts. Certificate authentication requires a version later
+	// than v0.30.0, which is the latest one known not to forward the
+	// client certificates.
+	BrokerVersion string `env:"BROKER_VERSION" envDefault:""`
+	// CACerts is the path to the PEM bundle of the CA certificates the
+	// client certificate chains are verified against.
+	CACerts string `env:"CA_CERTS" envDefault:""`
+	// CommonNames lists the client IDs which may authenticate with verified
+	// certificates unknown to the certs service, using the certificate
+	// common name as the client ID.
+	CommonNames []string `env:"COMMON_NAMES" envDefault:"" envSeparator:","`
+	// SecretFallback allows the clients without SecretFallbackKey in their
+	// metadata to authenticate with their secrets.
+	SecretFallback bool `env:"SECRET_FALLBACK" envDefault:"true"`
+
+	roots *x509.CertPool
+}
+
+// Load checks the broker version and loads the CA certificates used to
+// verify the client certificates. Certificates are never trusted without a
+// verified chain, so the configuration is rejected if certificate
+// authentication is enabled without CA certificates.
+func (c *X509Config) Load() error {
+	if c.Enabled && !forwardsCerts(c.BrokerVersion) {
+		return errBrokerVersion
+	}
+	if c.CACerts == "" {
+		if c.Enabled {
+			return errMissingCACerts
+		}
+		return nil
+	}
+	data, err := os.ReadFile(c.CACerts)
+	if err != nil {
+		return errors.Wrap(errMissingCACerts, err)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(data) {
+		return errMissingCACerts
+	}
+	c.roots = roots
+
+	return nil
+}
+
+func (s *connectServer) authenticateCert(ctx context.Context, escapedPEM string) (*connect.Response[authv1.AuthnRes], error) {
+	cert, intermediates, err := parseClientCert(escapedPEM)
+	if err != nil {
+		return nil, encodeError(errors.Wrap(errors.ErrMalformedEntity, err))
+	}
+	if err := s.verifyCert(cert, intermediates); err != nil {
+		return connect.NewResponse(&authv1.AuthnRes{Authenticated: false}), nil
+	}
+
+	id, err := s.certClientID(ctx, cert)
+	if err != nil {
+		if errors.Contains(err, errRevokedCert) || status.Code(err) == codes.NotFound {
+			return connect.NewResponse(&authv1.AuthnRes{Authenticated: false}), nil
+		}
+		return nil, encodeError(err)
+	}
+
+	res, err := s.clients.RetrieveEntity(ctx, &grpcCommonV1.RetrieveEntityReq{Id: id})
+	if err != nil {
+		if shouldDenyAuthorize(err) {
+			return connect.NewResponse(&authv1.AuthnRes{Authenticated: false}), nil
+		}
+		return nil, encodeError(err)
+	}
+	if clients.Status(res.GetEntity().GetStatus()) != clients.EnabledStatus {
+		return connect.NewResponse(&authv1.AuthnRes{Authenticated: false}), nil
+	}
+
+	return connect.NewResponse(&authv1.AuthnRes{
+		Authenticated: true,
+		Id:            id,
+	}), nil
+}
+
+// verifyCert verifies the certificate chain against the configured CA
+// certificates and checks that every certificate in the chain is within its
+// validity period.
+func (s *connectServer) verifyCert(cert *x509.Certificate, intermediates *x509.CertPool) error {
+	if s.x509.roots == nil {
+		return errMissingCACerts
+	}
+	opts := x509.VerifyOptions{
+		Roots:         s.x509.roots,
+		Intermediates: intermediates,
+		CurrentTime:   time.Now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if _, err := cert.Verify(opts); err != nil {
+		return errors.Wrap(errUnverifiedCert, err)
+	}
+
+	return nil
+}
+
+// certClientID maps the certificate to the client using the certificate
+// serial number, and checks that the certificate is not revoked. Only the
+// common names listed in the configuration are mapped to clients without
+// the certs service.
+func (s *connectServer) certClientID(ctx context.Context, cert *x509.Certificate) (string, error) {
+	res, err := s.certs.RetrieveCert(ctx, &grpcCertsV1.EntityReq{SerialNumber: cert.SerialNumber.Text(16)})
+	switch {
+	case err == nil && res.GetRevoked():
+		return "", errRevokedCert
+	case err == nil:
+		return res.GetEntityId(), nil
+	case status.Code(err) == codes.NotFound && cert.Subject.CommonName != "" && slices.Contains(s.x509.CommonNames, cert.Subject.CommonName):
+		return cert.Subject.CommonName, nil
+	default:
+		return "", err
+	}
+}
+
+// forwardsCerts reports whether the broker version is later than the latest
+// version known not to forward the client certificates.
+func forwardsCerts(version string) bool {
+	v, ok := parseVersion(version)
+	if !ok {
+		return false
+	}
+	last, _ := parseVersion(noCertHeaderVersion)
+
+	return slices.Compare(v, last) > 0
+}
+
+// parseVersion parses the major, minor and patch numbers of the version.
+func parseVersion(version string) ([]int, bool) {
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if len(parts) != 3 {
+		return nil, false
+	}
+	v := make([]int, len(parts))
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+		v[i] = n
+	}
+
+	return v, true
+}
+
+// secretAllowed reports whether the client may authenticate with its secret.
+func (s *connectServer) secretAllowed(ctx context.Context, id string) (bool, error) {
+	res, err := s.clients.RetrieveEntity(ctx, &grpcCommonV1.RetrieveEntityReq{Id: id})
+	if err != nil {
+		return false, err
+	}
+
+	metadata := res.GetEntity().GetMetadata()
+	if len(metadata) == 0 {
+		return s.x509.SecretFallback, nil
+	}
+	var md map[string]any
+	if err := json.Unmarshal(metadata, &md); err != nil {
+		return s.x509.SecretFallback, nil
+	}
+	if allowed, ok := md[SecretFallbackKey].(bool); ok {
+		return allowed, nil
+	}
+
+	return s.x509.SecretFallback, nil
+}
+
+// parseClientCert parses the client certificate followed by the optional
+// intermediate CA certificates.
+func parseClientCert(escapedPEM string) (*x509.Certificate, *x509.CertPool, error) {
+	data, err := url.QueryUnescape(escapedPEM)
+	if err != nil {
+		return nil, nil, errors.Wrap(errInvalidCert, err)
+	}
+
+	var cert *x509.Certificate
+	intermediates := x509.NewCertPool()
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, nil, errInvalidCert
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, nil, errors.Wrap(errInvalidCert, err)
+		}
+		if cert == nil {
+			cert = c
+			continue
+		}
+		intermediates.AddCert(c)
+	}
+	if cert == nil {
+		return nil, nil, errInvalidCert
+	}
+
+	return cert, intermediates, nil
+}
@@ -0,0 +1,369 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	authv1 "github.com/absmach/fluxmq/pkg/proto/auth/v1"
+	grpcCertsV1 "github.com/absmach/magistrala/api/grpc/certs/v1"
+	grpcClientsV1 "github.com/absmach/magistrala/api/grpc/clients/v1"
+	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
+	certsmocks "github.com/absmach/magistrala/certs/mocks"
+	chmocks "github.com/absmach/magistrala/channels/mocks"
+	"github.com/absmach/magistrala/clients"
+	clmocks "github.com/absmach/magistrala/clients/mocks"
+	fluxmqgrpc "github.com/absmach/magistrala/fluxmq/api/grpc"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	clientID      = "client-id"
+	commonName    = "cn-client-id"
+	serialNumber  = "12ab34"
+	brokerVersion = "v0.31.0"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	path string
+}
+
+func newCA(t *testing.T) testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err, "unexpected error generating key")
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.Nil(t, err, "unexpected error creating CA certificate")
+	cert, err := x509.ParseCertificate(der)
+	require.Nil(t, err, "unexpected error parsing CA certificate")
+
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	err = os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600)
+	require.Nil(t, err, "unexpected error writing CA certificate")
+
+	return testCA{cert: cert, key: key, path: path}
+}
+
+// clientCert issues the client certificate valid between notBefore and
+// notAfter, signed by the CA or self-signed if the CA is nil.
+func clientCert(t *testing.T, ca *testCA, notBefore, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err, "unexpected error generating key")
+
+	serial, _ := new(big.Int).SetString(serialNumber, 16)
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	parent, signer := tmpl, key
+	if ca != nil {
+		parent, signer = ca.cert, ca.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, signer)
+	require.Nil(t, err, "unexpected error creating certificate")
+
+	return url.QueryEscape(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+}
+
+func entity(status clients.Status, metadata string) *grpcCommonV1.RetrieveEntityRes {
+	e := &grpcCommonV1.EntityBasic{Id: clientID, Status: uint32(status)}
+	if metadata != "" {
+		e.Metadata = []byte(metadata)
+	}
+	return &grpcCommonV1.RetrieveEntityRes{Entity: e}
+}
+
+func TestAuthenticateCert(t *testing.T) {
+	ca := newCA(t)
+	now := time.Now()
+	cert := clientCert(t, &ca, now.Add(-time.Hour), now.Add(time.Hour))
+	selfSigned := clientCert(t, nil, now.Add(-time.Hour), now.Add(time.Hour))
+	expired := clientCert(t, &ca, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	notYetValid := clientCert(t, &ca, now.Add(time.Hour), now.Add(2*time.Hour))
+	notFound := status.Error(codes.NotFound, "entity not found")
+
+	cases := []struct {
+		desc          string
+		cfg           fluxmqgrpc.X509Config
+		cert          string
+		certRes       *grpcCertsV1.CertRes
+		certErr       error
+		entityID      string
+		entityRes     *grpcCommonV1.RetrieveEntityRes
+		entityErr     error
+		authenticated bool
+		id            string
+		code          connect.Code
+	}{
+		{
+			desc:          "authenticate with certificate",
+			cfg:           fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path},
+			cert:          cert,
+			certRes:       &grpcCertsV1.CertRes{EntityId: clientID},
+			entityID:      clientID,
+			entityRes:     entity(clients.EnabledStatus, ""),
+			authenticated: true,
+			id:            clientID,
+		},
+		{
+			desc:    "authenticate with self-signed certificate with known serial number",
+			cfg:     fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path},
+			cert:    selfSigned,
+			certRes: &grpcCertsV1.CertRes{EntityId: clientID},
+		},
+		{
+			desc:    "authenticate with self-signed certificate mapped by common name",
+			cfg:     fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path, CommonNames: []string{commonName}},
+			cert:    selfSigned,
+			certErr: notFound,
+		},
+		{
+			desc:    "authenticate with expired certificate",
+			cfg:     fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path},
+			cert:    expired,
+			certRes: &grpcCertsV1.CertRes{EntityId: clientID},
+		},
+		{
+			desc:    "authenticate with not yet valid certificate",
+			cfg:     fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path},
+			cert:    notYetValid,
+			certRes: &grpcCertsV1.CertRes{EntityId: clientID},
+		},
+		{
+			desc:    "authenticate with revoked certificate",
+			cfg:     fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path},
+			cert:    cert,
+			certRes: &grpcCertsV1.CertRes{EntityId: clientID, Revoked: true},
+		},
+		{
+			desc:    "authenticate with unknown certificate",
+			cfg:     fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path},
+			cert:    cert,
+			certErr: notFound,
+		},
+		{
+			desc:    "authenticate with unknown certificate with common name not allowed",
+			cfg:     fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path, CommonNames: []string{clientID}},
+			cert:    cert,
+			certErr: notFound,
+		},
+		{
+			desc:          "authenticate with unknown certificate mapped by common name",
+			cfg:           fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path, CommonNames: []string{commonName}},
+			cert:          cert,
+			certErr:       notFound,
+			entityID:      commonName,
+			entityRes:     entity(clients.EnabledStatus, ""),
+			authenticated: true,
+			id:            commonName,
+		},
+		{
+			desc:      "authenticate with certificate of disabled client",
+			cfg:       fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path},
+			cert:      cert,
+			certRes:   &grpcCertsV1.CertRes{EntityId: clientID},
+			entityID:  clientID,
+			entityRes: entity(clients.DisabledStatus, ""),
+		},
+		{
+			desc:      "authenticate with certificate of removed client",
+			cfg:       fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path},
+			cert:      cert,
+			certRes:   &grpcCertsV1.CertRes{EntityId: clientID},
+			entityID:  clientID,
+			entityErr: svcerr.ErrNotFound,
+		},
+		{
+			desc:    "authenticate with certs service failure",
+			cfg:     fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path},
+			cert:    cert,
+			certErr: status.Error(codes.Unavailable, "unavailable"),
+			code:    connect.CodeInternal,
+		},
+		{
+			desc: "authenticate with malformed certificate",
+			cfg:  fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path},
+			cert: "invalid",
+			code: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.cfg.Load()
+			require.Nil(t, err, "unexpected error loading CA certificates")
+			clientsClient := new(clmocks.ClientsServiceClient)
+			certsClient := new(certsmocks.CertsServiceClient)
+			server := fluxmqgrpc.NewServer(clientsClient, new(chmocks.ChannelsServiceClient), certsClient, nil, nil, nil, tc.cfg)
+
+			certCall := certsClient.On("RetrieveCert", mock.Anything, &grpcCertsV1.EntityReq{SerialNumber: serialNumber}).Return(tc.certRes, tc.certErr)
+			entityCall := clientsClient.On("RetrieveEntity", mock.Anything, &grpcCommonV1.RetrieveEntityReq{Id: tc.entityID}).Return(tc.entityRes, tc.entityErr)
+			defer func() {
+				certCall.Unset()
+				entityCall.Unset()
+			}()
+
+			req := connect.NewRequest(&authv1.AuthnReq{ClientId: "mqtt-client"})
+			req.Header().Set(fluxmqgrpc.ClientCertHeader, tc.cert)
+			res, err := server.Authenticate(context.Background(), req)
+			if tc.code != 0 {
+				assert.Equal(t, tc.code, connect.CodeOf(err))
+				return
+			}
+			require.Nil(t, err, "unexpected error")
+			assert.Equal(t, tc.authenticated, res.Msg.GetAuthenticated())
+			assert.Equal(t, tc.id, res.Msg.GetId())
+			if !tc.authenticated && tc.cert != cert {
+				certsClient.AssertNotCalled(t, "RetrieveCert", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	ca := newCA(t)
+	invalid := filepath.Join(t.TempDir(), "invalid.pem")
+	require.Nil(t, os.WriteFile(invalid, []byte("invalid"), 0o600), "unexpected error writing file")
+
+	cases := []struct {
+		desc string
+		cfg  fluxmqgrpc.X509Config
+		err  bool
+	}{
+		{
+			desc: "load CA certificates with certificates disabled",
+			cfg:  fluxmqgrpc.X509Config{},
+		},
+		{
+			desc: "load CA certificates",
+			cfg:  fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: ca.path},
+		},
+		{
+			desc: "load CA certificates without broker version",
+			cfg:  fluxmqgrpc.X509Config{Enabled: true, CACerts: ca.path},
+			err:  true,
+		},
+		{
+			desc: "load CA certificates with broker version which does not forward certificates",
+			cfg:  fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: "v0.30.0", CACerts: ca.path},
+			err:  true,
+		},
+		{
+			desc: "load CA certificates with invalid broker version",
+			cfg:  fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: "latest", CACerts: ca.path},
+			err:  true,
+		},
+		{
+			desc: "load CA certificates with later broker version",
+			cfg:  fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: "1.0.0", CACerts: ca.path},
+		},
+		{
+			desc: "load CA certificates without CA certificates",
+			cfg:  fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion},
+			err:  true,
+		},
+		{
+			desc: "load CA certificates with missing file",
+			cfg:  fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: filepath.Join(t.TempDir(), "missing.pem")},
+			err:  true,
+		},
+		{
+			desc: "load CA certificates with invalid file",
+			cfg:  fluxmqgrpc.X509Config{Enabled: true, BrokerVersion: brokerVersion, CACerts: invalid},
+			err:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.cfg.Load()
+			assert.Equal(t, tc.err, err != nil, fmt.Sprintf("%s: unexpected error: %v", tc.desc, err))
+		})
+	}
+}
+
+func TestAuthenticateSecret(t *testing.T) {
+	cases := []struct {
+		desc          string
+		cfg           fluxmqgrpc.X509Config
+		entityRes     *grpcCommonV1.RetrieveEntityRes
+		authenticated bool
+	}{
+		{
+			desc:          "authenticate with secret with certificates disabled",
+			cfg:           fluxmqgrpc.X509Config{},
+			authenticated: true,
+		},
+		{
+			desc:          "authenticate with secret with fallback allowed by default",
+			cfg:           fluxmqgrpc.X509Config{Enabled: true, SecretFallback: true},
+			entityRes:     entity(clients.EnabledStatus, ""),
+			authenticated: true,
+		},
+		{
+			desc:      "authenticate with secret with fallback forbidden by default",
+			cfg:       fluxmqgrpc.X509Config{Enabled: true},
+			entityRes: entity(clients.EnabledStatus, ""),
+		},
+		{
+			desc:          "authenticate with secret with fallback allowed for client",
+			cfg:           fluxmqgrpc.X509Config{Enabled: true},
+			entityRes:     entity(clients.EnabledStatus, `{"secret_fallback":true}`),
+			authenticated: true,
+		},
+		{
+			desc:      "authenticate with secret with fallback forbidden for client",
+			cfg:       fluxmqgrpc.X509Config{Enabled: true, SecretFallback: true},
+			entityRes: entity(clients.EnabledStatus, `{"secret_fallback":false}`),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			clientsClient := new(clmocks.ClientsServiceClient)
+			server := fluxmqgrpc.NewServer(clientsClient, new(chmocks.ChannelsServiceClient), new(certsmocks.CertsServiceClient), nil, nil, nil, tc.cfg)
+
+			clientsClient.On("Authenticate", mock.Anything, mock.Anything).Return(&grpcClientsV1.AuthnRes{Authenticated: true, Id: clientID}, nil)
+			clientsClient.On("RetrieveEntity", mock.Anything, &grpcCommonV1.RetrieveEntityReq{Id: clientID}).Return(tc.entityRes, nil)
+
+			req := connect.NewRequest(&authv1.AuthnReq{ClientId: "mqtt-client", Username: clientID, Password: "secret"})
+			res, err := server.Authenticate(context.Background(), req)
+			require.Nil(t, err, "unexpected error")
+			assert.Equal(t, tc.authenticated, res.Msg.GetAuthenticated())
+		})
+	}
+}
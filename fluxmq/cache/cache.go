@@ -0,0 +1,185 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/dgraph-io/ristretto/v2"
+	"github.com/go-kit/kit/metrics"
+)
+
+// Config represents the authorization decision cache configuration. The cache
+// is disabled if TTL is zero.
+//
+// The cached decisions are invalidated on the client, channel, domain status
+// and role events. Changes without such events, like moving a group under
+// another parent, apply to the cached decisions of the users only once they
+// expire after TTL.
+//
+// Decisions are cached per subtopic, so a client which publishes to many
+// subtopics of a channel takes one entry for each of them. MaxCost should be
+// sized for the number of active client, channel and subtopic combinations
+// rather than for the number of connections.
+type Config struct {
+	TTL         time.Duration `env:"TTL"          envDefault:"30s"`     // time a decision is cached for.
+	NumCounters int64         `env:"NUM_COUNTERS" envDefault:"1000000"` // number of keys to track frequency of.
+	MaxCost     int64         `env:"MAX_COST"     envDefault:"100000"`  // maximum number of cached decisions, one per subtopic.
+	BufferItems int64         `env:"BUFFER_ITEMS" envDefault:"64"`      // number of keys per Get buffer.
+}
+
+// Key identifies an authorization decision. The subtopic is a part of the key
+// because the connection topics may allow only some subtopics of the channel.
+type Key struct {
+	ClientID  string
+	ChannelID string
+	ConnType  connections.ConnType
+	Subtopic  string
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%s:%s:%d:%s", k.ClientID, k.ChannelID, k.ConnType, k.Subtopic)
+}
+
+type decision struct {
+	authorized bool
+	checkedAt  time.Time
+}
+
+// Cache is a bounded cache of authorization decisions. Each decision expires
+// after the configured TTL.
+//
+// Invalidating a client, a channel or all the decisions records the time of
+// invalidation, and decisions made before that time are not used anymore.
+// The invalidation times are kept only as long as the decisions they apply
+// to.
+type Cache struct {
+	ttl     time.Duration
+	cache   *ristretto.Cache[string, decision]
+	lookups metrics.Counter
+
+	mu          sync.RWMutex
+	clients     map[string]time.Time
+	channels    map[string]time.Time
+	all         time.Time
+	lastCleanup time.Time
+}
+
+// New returns an authorization decision cache. The cache lookups are counted
+// using the lookups counter with the result label set to hit or miss.
+func New(cfg Config, lookups metrics.Counter) (*Cache, error) {
+	cache, err := ristretto.NewCache(&ristretto.Config[string, decision]{
+		NumCounters: cfg.NumCounters,
+		MaxCost:     cfg.MaxCost,
+		BufferItems: cfg.BufferItems,
+		// Each decision costs 1, so MaxCost bounds the number of decisions.
+		IgnoreInternalCost: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cache{
+		ttl:         cfg.TTL,
+		cache:       cache,
+		lookups:     lookups,
+		clients:     make(map[string]time.Time),
+		channels:    make(map[string]time.Time),
+		lastCleanup: time.Now(),
+	}, nil
+}
+
+// Get returns the cached decision for the given key. The second return value
+// reports whether a valid decision was found.
+func (c *Cache) Get(key Key) (bool, bool) {
+	d, ok := c.cache.Get(key.String())
+	if ok && c.invalidated(key, d.checkedAt) {
+		ok = false
+	}
+
+	result := "miss"
+	if ok {
+		result = "hit"
+	}
+	c.lookups.With("result", result).Add(1)
+
+	return d.authorized, ok
+}
+
+// Set caches the decision for the given key. The checkedAt time must be taken
+// before the decision is requested, so that a decision which races with an
+// invalidation is not cached as valid.
+func (c *Cache) Set(key Key, authorized bool, checkedAt time.Time) {
+	if c.invalidated(key, checkedAt) {
+		return
+	}
+	c.cache.SetWithTTL(key.String(), decision{authorized: authorized, checkedAt: checkedAt}, 1, c.ttl)
+}
+
+// InvalidateClient invalidates the cached decisions of the client.
+func (c *Cache) InvalidateClient(id string) {
+	c.invalidate(c.clients, id)
+}
+
+// InvalidateChannel invalidates the cached decisions of the channel.
+func (c *Cache) InvalidateChannel(id string) {
+	c.invalidate(c.channels, id)
+}
+
+// InvalidateAll invalidates all the cached decisions.
+func (c *Cache) InvalidateAll() {
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.all = now
+}
+
+// Close stops the cache.
+func (c *Cache) Close() {
+	c.cache.Close()
+}
+
+func (c *Cache) invalidated(key Key, checkedAt time.Time) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if !checkedAt.After(c.all) {
+		return true
+	}
+	if t, ok := c.clients[key.ClientID]; ok && !checkedAt.After(t) {
+		return true
+	}
+	if t, ok := c.channels[key.ChannelID]; ok && !checkedAt.After(t) {
+		return true
+	}
+
+	return false
+}
+
+func (c *Cache) invalidate(times map[string]time.Time, id string) {
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	times[id] = now
+
+	// Decisions older than TTL are expired anyway, so are the invalidations.
+	if now.Sub(c.lastCleanup) < c.ttl {
+		return
+	}
+	for _, m := range []map[string]time.Time{c.clients, c.channels} {
+		for id, t := range m {
+			if now.Sub(t) > c.ttl {
+				delete(m, id)
+			}
+		}
+	}
+	c.lastCleanup = now
+}
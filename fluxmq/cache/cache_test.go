@@ -0,0 +1,200 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/fluxmq/cache"
+	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	clientID  = "client"
+	channelID = "channel"
+)
+
+var key = cache.Key{
+	ClientID:  clientID,
+	ChannelID: channelID,
+	ConnType:  connections.Publish,
+}
+
+// counter counts the lookups per result.
+type counter struct {
+	result  string
+	results map[string]float64
+}
+
+func (c *counter) With(labelValues ...string) metrics.Counter {
+	return &counter{result: labelValues[1], results: c.results}
+}
+
+func (c *counter) Add(delta float64) {
+	c.results[c.result] += delta
+}
+
+func newCache(t *testing.T, ttl time.Duration) (*cache.Cache, *counter) {
+	lookups := &counter{results: make(map[string]float64)}
+	c, err := cache.New(cache.Config{
+		TTL:         ttl,
+		NumCounters: 1000,
+		MaxCost:     100,
+		BufferItems: 64,
+	}, lookups)
+	require.Nil(t, err, "unexpected error creating cache")
+	t.Cleanup(c.Close)
+
+	return c, lookups
+}
+
+// set caches the decision and waits for ristretto to apply the write.
+func set(c *cache.Cache, key cache.Key, authorized bool, checkedAt time.Time) {
+	c.Set(key, authorized, checkedAt)
+	time.Sleep(10 * time.Millisecond)
+}
+
+func TestGet(t *testing.T) {
+	subscribe := key
+	subscribe.ConnType = connections.Subscribe
+
+	cases := []struct {
+		desc       string
+		set        []cache.Key
+		authorized bool
+		get        cache.Key
+		ok         bool
+	}{
+		{
+			desc:       "get cached allowed decision",
+			set:        []cache.Key{key},
+			authorized: true,
+			get:        key,
+			ok:         true,
+		},
+		{
+			desc:       "get cached denied decision",
+			set:        []cache.Key{key},
+			authorized: false,
+			get:        key,
+			ok:         true,
+		},
+		{
+			desc: "get decision which is not cached",
+			get:  key,
+			ok:   false,
+		},
+		{
+			desc:       "get decision of other connection type",
+			set:        []cache.Key{key},
+			authorized: true,
+			get:        subscribe,
+			ok:         false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, _ := newCache(t, time.Minute)
+			for _, k := range tc.set {
+				set(c, k, tc.authorized, time.Now())
+			}
+			authorized, ok := c.Get(tc.get)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.authorized && tc.ok, authorized)
+		})
+	}
+}
+
+func TestExpiration(t *testing.T) {
+	c, _ := newCache(t, 50*time.Millisecond)
+	set(c, key, true, time.Now())
+
+	_, ok := c.Get(key)
+	assert.True(t, ok, "expected cached decision")
+
+	time.Sleep(100 * time.Millisecond)
+	_, ok = c.Get(key)
+	assert.False(t, ok, "expected expired decision")
+}
+
+func TestInvalidate(t *testing.T) {
+	otherClient := key
+	otherClient.ClientID = "other-client"
+	otherChannel := key
+	otherChannel.ChannelID = "other-channel"
+
+	cases := []struct {
+		desc       string
+		invalidate func(c *cache.Cache)
+		invalid    []cache.Key
+		valid      []cache.Key
+	}{
+		{
+			desc:       "invalidate client",
+			invalidate: func(c *cache.Cache) { c.InvalidateClient(clientID) },
+			invalid:    []cache.Key{key, otherChannel},
+			valid:      []cache.Key{otherClient},
+		},
+		{
+			desc:       "invalidate channel",
+			invalidate: func(c *cache.Cache) { c.InvalidateChannel(channelID) },
+			invalid:    []cache.Key{key, otherClient},
+			valid:      []cache.Key{otherChannel},
+		},
+		{
+			desc:       "invalidate unknown client",
+			invalidate: func(c *cache.Cache) { c.InvalidateClient("unknown") },
+			valid:      []cache.Key{key, otherClient, otherChannel},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, _ := newCache(t, time.Minute)
+			for _, k := range []cache.Key{key, otherClient, otherChannel} {
+				set(c, k, true, time.Now())
+			}
+			tc.invalidate(c)
+			for _, k := range tc.invalid {
+				_, ok := c.Get(k)
+				assert.False(t, ok, "expected invalidated decision for %s", k)
+			}
+			for _, k := range tc.valid {
+				_, ok := c.Get(k)
+				assert.True(t, ok, "expected cached decision for %s", k)
+			}
+		})
+	}
+}
+
+func TestSetAfterInvalidate(t *testing.T) {
+	c, _ := newCache(t, time.Minute)
+
+	checkedAt := time.Now()
+	c.InvalidateChannel(channelID)
+	set(c, key, true, checkedAt)
+
+	_, ok := c.Get(key)
+	assert.False(t, ok, "expected decision checked before invalidation not to be cached")
+
+	set(c, key, true, time.Now())
+	_, ok = c.Get(key)
+	assert.True(t, ok, "expected decision checked after invalidation to be cached")
+}
+
+func TestLookupMetrics(t *testing.T) {
+	c, lookups := newCache(t, time.Minute)
+
+	c.Get(key)
+	set(c, key, true, time.Now())
+	c.Get(key)
+	c.Get(key)
+
+	assert.Equal(t, map[string]float64{"hit": 2, "miss": 1}, lookups.results)
+}
@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cache contains the FluxMQ authorization decision cache. The
+// decisions of the Channels service are cached per client, channel,
+// connection type and subtopic, and invalidated on client, channel, group and
+// domain events.
+package cache
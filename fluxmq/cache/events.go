@@ -0,0 +1,156 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/store"
+)
+
+const (
+	clientsStream  = "events.magistrala.client.*"
+	channelsStream = "events.magistrala.channel.*"
+	domainsStream  = "events.magistrala.domain.*"
+	groupsStream   = "events.magistrala.group.*"
+)
+
+// Operations which change the connections, the status or the permissions of
+// the clients and the channels.
+var (
+	clientOperations = map[string]bool{
+		"client.enable":        true,
+		"client.disable":       true,
+		"client.remove":        true,
+		"client.set_parent":    true,
+		"client.remove_parent": true,
+	}
+	channelOperations = map[string]bool{
+		"channel.enable":        true,
+		"channel.disable":       true,
+		"channel.remove":        true,
+		"channel.set_parent":    true,
+		"channel.remove_parent": true,
+	}
+	connectionOperations = map[string]bool{
+		"channel.connect":    true,
+		"channel.disconnect": true,
+	}
+	// The decisions do not record their domain, so the domain status
+	// changes invalidate all of them.
+	domainOperations = map[string]bool{
+		"domain.enable":  true,
+		"domain.disable": true,
+		"domain.freeze":  true,
+	}
+	// The user permissions on the channels come from the roles of the
+	// channels, their parent groups and their domains. A role change may
+	// apply to any channel below the entity, so it invalidates all the
+	// decisions.
+	roleEntities   = []string{"channel.", "group.", "domain."}
+	roleOperations = map[string]bool{
+		"role.add":                           true,
+		"role.remove":                        true,
+		"role.actions.add":                   true,
+		"role.actions.remove":                true,
+		"role.actions.remove_all":            true,
+		"role.members.add":                   true,
+		"role.members.remove":                true,
+		"role.members.remove_all":            true,
+		"role.members.remove_from_all_roles": true,
+		"members.remove":                     true,
+	}
+)
+
+type eventHandler struct {
+	cache *Cache
+}
+
+// Subscribe invalidates the cached decisions on client, channel, group and
+// domain events.
+// Each instance has its own cache and needs all the events, so the consumer
+// name must be unique per instance.
+func Subscribe(ctx context.Context, cache *Cache, esURL, esConsumerName string, logger *slog.Logger) error {
+	subscriber, err := store.NewSubscriber(ctx, esURL, "fluxmq-authz-es-sub", logger)
+	if err != nil {
+		return err
+	}
+
+	for _, stream := range []string{clientsStream, channelsStream, domainsStream, groupsStream} {
+		subConfig := events.SubscriberConfig{
+			Stream:   stream,
+			Consumer: esConsumerName,
+			Handler:  NewEventHandler(cache),
+		}
+		if err := subscriber.Subscribe(ctx, subConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewEventHandler returns an event handler which invalidates the cached
+// decisions of the connected, disconnected, enabled, disabled and removed
+// clients and channels, and all the decisions on domain status and role
+// changes.
+func NewEventHandler(cache *Cache) events.EventHandler {
+	return &eventHandler{cache: cache}
+}
+
+func (eh *eventHandler) Handle(_ context.Context, event events.Event) error {
+	msg, err := event.Encode()
+	if err != nil {
+		return err
+	}
+
+	op, _ := msg["operation"].(string)
+	id, _ := msg["id"].(string)
+	switch {
+	case clientOperations[op] && id != "":
+		eh.cache.InvalidateClient(id)
+	case channelOperations[op] && id != "":
+		eh.cache.InvalidateChannel(id)
+	case connectionOperations[op]:
+		// Connections change the decisions of the connected pairs only, but
+		// invalidating the channels is enough to drop all of them.
+		for _, id := range toStrings(msg["channel_ids"]) {
+			eh.cache.InvalidateChannel(id)
+		}
+	case domainOperations[op] || roleOperation(op):
+		eh.cache.InvalidateAll()
+	}
+
+	return nil
+}
+
+func roleOperation(op string) bool {
+	for _, prefix := range roleEntities {
+		if suffix, ok := strings.CutPrefix(op, prefix); ok {
+			return roleOperations[suffix]
+		}
+	}
+
+	return false
+}
+
+func toStrings(val any) []string {
+	switch val := val.(type) {
+	case []string:
+		return val
+	case []any:
+		var strs []string
+		for _, v := range val {
+			if s, ok := v.(string); ok {
+				strs = append(strs, s)
+			}
+		}
+		return strs
+	default:
+		return nil
+	}
+}
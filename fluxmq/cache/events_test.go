@@ -0,0 +1,124 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/fluxmq/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+type event map[string]any
+
+func (e event) Encode() (map[string]any, error) {
+	return e, nil
+}
+
+func TestHandle(t *testing.T) {
+	otherClient := key
+	otherClient.ClientID = "other-client"
+	otherChannel := key
+	otherChannel.ChannelID = "other-channel"
+
+	cases := []struct {
+		desc    string
+		event   event
+		invalid []cache.Key
+	}{
+		{
+			desc:    "handle client disable event",
+			event:   event{"operation": "client.disable", "id": clientID},
+			invalid: []cache.Key{key, otherChannel},
+		},
+		{
+			desc:    "handle client remove event",
+			event:   event{"operation": "client.remove", "id": clientID},
+			invalid: []cache.Key{key, otherChannel},
+		},
+		{
+			desc:    "handle channel disable event",
+			event:   event{"operation": "channel.disable", "id": channelID},
+			invalid: []cache.Key{key, otherClient},
+		},
+		{
+			desc:    "handle channel set parent event",
+			event:   event{"operation": "channel.set_parent", "id": channelID},
+			invalid: []cache.Key{key, otherClient},
+		},
+		{
+			desc: "handle connect event",
+			event: event{
+				"operation":   "channel.connect",
+				"client_ids":  []any{clientID},
+				"channel_ids": []any{channelID},
+			},
+			invalid: []cache.Key{key, otherClient},
+		},
+		{
+			desc: "handle disconnect event",
+			event: event{
+				"operation":   "channel.disconnect",
+				"client_ids":  []string{clientID},
+				"channel_ids": []string{channelID, "other-channel"},
+			},
+			invalid: []cache.Key{key, otherClient, otherChannel},
+		},
+		{
+			desc:    "handle domain disable event",
+			event:   event{"operation": "domain.disable", "id": "domain"},
+			invalid: []cache.Key{key, otherClient, otherChannel},
+		},
+		{
+			desc:    "handle channel role members remove event",
+			event:   event{"operation": "channel.role.members.remove", "entity_id": channelID},
+			invalid: []cache.Key{key, otherClient, otherChannel},
+		},
+		{
+			desc:    "handle group role actions remove event",
+			event:   event{"operation": "group.role.actions.remove", "entity_id": "group"},
+			invalid: []cache.Key{key, otherClient, otherChannel},
+		},
+		{
+			desc:  "handle domain update event",
+			event: event{"operation": "domain.update", "id": "domain"},
+		},
+		{
+			desc:  "handle client role members add event",
+			event: event{"operation": "client.role.members.add", "entity_id": clientID},
+		},
+		{
+			desc:  "handle client update event",
+			event: event{"operation": "client.update", "id": clientID},
+		},
+		{
+			desc:  "handle channel view event",
+			event: event{"operation": "channel.view", "id": channelID},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, _ := newCache(t, time.Minute)
+			keys := []cache.Key{key, otherClient, otherChannel}
+			for _, k := range keys {
+				set(c, k, true, time.Now())
+			}
+
+			err := cache.NewEventHandler(c).Handle(context.Background(), tc.event)
+			assert.Nil(t, err, "unexpected error handling event")
+
+			invalid := make(map[cache.Key]bool)
+			for _, k := range tc.invalid {
+				invalid[k] = true
+			}
+			for _, k := range keys {
+				_, ok := c.Get(k)
+				assert.Equal(t, !invalid[k], ok, "unexpected cached decision for %s", k)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package limiter contains the FluxMQ publish rate limiter. The publishes are
+// limited per client and per channel, in messages and bytes per second, using
+// the limits from the client and channel metadata or the default limits. The
+// bytes limits are enforced only if the broker sends the payload size of the
+// publishes with the auth callout, which FluxMQ v0.30.0 does not do.
+package limiter
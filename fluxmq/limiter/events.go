@@ -0,0 +1,110 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package limiter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/store"
+)
+
+const (
+	clientsStream  = "events.magistrala.client.*"
+	channelsStream = "events.magistrala.channel.*"
+
+	rateLimit       = "messaging.rate_limit"
+	violationStream = "magistrala." + rateLimit
+)
+
+// Operations which change the metadata of the clients and the channels.
+var (
+	clientOperations = map[string]bool{
+		"client.update": true,
+		"client.remove": true,
+	}
+	channelOperations = map[string]bool{
+		"channel.update": true,
+		"channel.remove": true,
+	}
+)
+
+var _ events.Event = (*violationEvent)(nil)
+
+type violationEvent struct {
+	violation
+	dropped uint64
+	limits  Limits
+}
+
+func (ve violationEvent) Encode() (map[string]any, error) {
+	val := map[string]any{
+		"operation":   rateLimit,
+		"domain":      ve.domain,
+		"scope":       ve.scope,
+		"client_ids":  []string{ve.client},
+		"channel_ids": []string{ve.channel},
+		"dropped":     ve.dropped,
+	}
+	if ve.limits.MessagesPerSec > 0 {
+		val["messages_per_sec"] = ve.limits.MessagesPerSec
+	}
+	if ve.limits.BytesPerSec > 0 {
+		val["bytes_per_sec"] = ve.limits.BytesPerSec
+	}
+
+	return val, nil
+}
+
+type eventHandler struct {
+	limiter *Limiter
+}
+
+// Subscribe reloads the limits of the updated and removed clients and
+// channels. Each instance has its own limits and needs all the events, so
+// the consumer name must be unique per instance.
+func Subscribe(ctx context.Context, limiter *Limiter, esURL, esConsumerName string, logger *slog.Logger) error {
+	subscriber, err := store.NewSubscriber(ctx, esURL, "fluxmq-limiter-es-sub", logger)
+	if err != nil {
+		return err
+	}
+
+	for _, stream := range []string{clientsStream, channelsStream} {
+		subConfig := events.SubscriberConfig{
+			Stream:   stream,
+			Consumer: esConsumerName,
+			Handler:  NewEventHandler(limiter),
+		}
+		if err := subscriber.Subscribe(ctx, subConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewEventHandler returns an event handler which drops the limits of the
+// updated and removed clients and channels.
+func NewEventHandler(limiter *Limiter) events.EventHandler {
+	return &eventHandler{limiter: limiter}
+}
+
+func (eh *eventHandler) Handle(_ context.Context, event events.Event) error {
+	msg, err := event.Encode()
+	if err != nil {
+		return err
+	}
+
+	op, _ := msg["operation"].(string)
+	id, _ := msg["id"].(string)
+	switch {
+	case clientOperations[op] && id != "":
+		eh.limiter.InvalidateClient(id)
+	case channelOperations[op] && id != "":
+		eh.limiter.InvalidateChannel(id)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package limiter_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/fluxmq/limiter"
+	"github.com/stretchr/testify/assert"
+)
+
+type event map[string]any
+
+func (e event) Encode() (map[string]any, error) {
+	return e, nil
+}
+
+func TestHandle(t *testing.T) {
+	cases := []struct {
+		desc           string
+		event          event
+		clientReloads  int
+		channelReloads int
+	}{
+		{
+			desc:          "handle client update event",
+			event:         event{"operation": "client.update", "id": clientID},
+			clientReloads: 1,
+		},
+		{
+			desc:          "handle client remove event",
+			event:         event{"operation": "client.remove", "id": clientID},
+			clientReloads: 1,
+		},
+		{
+			desc:           "handle channel update event",
+			event:          event{"operation": "channel.update", "id": channelID},
+			channelReloads: 1,
+		},
+		{
+			desc:           "handle channel remove event",
+			event:          event{"operation": "channel.remove", "id": channelID},
+			channelReloads: 1,
+		},
+		{
+			desc:  "handle client update event without id",
+			event: event{"operation": "client.update"},
+		},
+		{
+			desc:  "handle client view event",
+			event: event{"operation": "client.view", "id": clientID},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			l, clients, channels, _ := newLimiter(limiter.Config{SettingsTTL: time.Minute}, "", "", nil)
+			l.Allow(context.Background(), domainID, clientID, channelID, 10)
+
+			err := limiter.NewEventHandler(l).Handle(context.Background(), tc.event)
+			assert.Nil(t, err, "unexpected error handling event")
+
+			l.Allow(context.Background(), domainID, clientID, channelID, 10)
+			clients.AssertNumberOfCalls(t, "RetrieveEntity", 1+tc.clientReloads)
+			channels.AssertNumberOfCalls(t, "RetrieveEntity", 1+tc.channelReloads)
+		})
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package limiter
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
+	grpcClientsV1 "github.com/absmach/magistrala/api/grpc/clients/v1"
+	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/go-kit/kit/metrics"
+	"golang.org/x/time/rate"
+)
+
+// Scopes of the rate limits.
+const (
+	ScopeClient  = "client"
+	ScopeChannel = "channel"
+)
+
+const (
+	loadTimeout    = 5 * time.Second
+	publishTimeout = 5 * time.Second
+	// retryInterval is the time the default limits are used for an entity
+	// whose metadata could not be read.
+	retryInterval = 10 * time.Second
+)
+
+// Config represents the rate limiter configuration. The default limits apply
+// to the clients and the channels which have no limits in their metadata.
+// The bytes limits need the broker to send the publish payload size with the
+// auth callout. FluxMQ v0.30.0 does not send it, so with that broker only the
+// messages limits are enforced.
+type Config struct {
+	Enabled         bool          `env:"ENABLED"          envDefault:"false"` // whether the publishes are rate limited.
+	ClientMessages  float64       `env:"CLIENT_MESSAGES"  envDefault:"0"`     // default messages per second of a client.
+	ClientBytes     float64       `env:"CLIENT_BYTES"     envDefault:"0"`     // default bytes per second of a client.
+	ChannelMessages float64       `env:"CHANNEL_MESSAGES" envDefault:"0"`     // default messages per second of a channel.
+	ChannelBytes    float64       `env:"CHANNEL_BYTES"    envDefault:"0"`     // default bytes per second of a channel.
+	SettingsTTL     time.Duration `env:"SETTINGS_TTL"     envDefault:"5m"`    // time the limits of an entity are used before they are read again.
+	ReportInterval  time.Duration `env:"REPORT_INTERVAL"  envDefault:"10s"`   // interval of the rate limit violation events.
+}
+
+type entry struct {
+	buckets
+	expiresAt time.Time
+}
+
+type violation struct {
+	scope   string
+	domain  string
+	client  string
+	channel string
+}
+
+type violations struct {
+	dropped uint64
+	limits  Limits
+}
+
+// Limiter limits the publish rate of the clients and the channels. The
+// publishes over the limits are counted and reported periodically to the
+// event store, so that they are recorded in the journal.
+type Limiter struct {
+	cfg       Config
+	clients   grpcClientsV1.ClientsServiceClient
+	channels  grpcChannelsV1.ChannelsServiceClient
+	publisher events.Publisher
+	limited   metrics.Counter
+	logger    *slog.Logger
+
+	mu         sync.Mutex
+	entries    map[string]map[string]*entry
+	violations map[violation]violations
+}
+
+// New returns a rate limiter which reads the limits from the client and
+// channel metadata. The limited publishes are counted using the limited
+// counter with the scope label, and reported using publisher, unless it
+// is nil.
+func New(cfg Config, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, publisher events.Publisher, limited metrics.Counter, logger *slog.Logger) *Limiter {
+	return &Limiter{
+		cfg:       cfg,
+		clients:   clients,
+		channels:  channels,
+		publisher: publisher,
+		limited:   limited,
+		logger:    logger,
+		entries: map[string]map[string]*entry{
+			ScopeClient:  make(map[string]*entry),
+			ScopeChannel: make(map[string]*entry),
+		},
+		violations: make(map[violation]violations),
+	}
+}
+
+// Start reports the violations every report interval until ctx is done.
+func (l *Limiter) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(l.cfg.ReportInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				l.Report(context.Background()) //nolint:contextcheck // The remaining violations are reported after ctx is done.
+				return
+			case <-ticker.C:
+				l.Report(ctx)
+			}
+		}
+	}()
+}
+
+// Allow reports whether the client may publish a message of the given size
+// to the channel. A publish over any of the client or channel limits does
+// not consume the other limits.
+func (l *Limiter) Allow(ctx context.Context, domainID, clientID, channelID string, size int) bool {
+	scopes := []struct {
+		scope   string
+		buckets buckets
+	}{
+		{ScopeClient, l.buckets(ctx, ScopeClient, clientID)},
+		{ScopeChannel, l.buckets(ctx, ScopeChannel, channelID)},
+	}
+
+	now := time.Now()
+	var taken []*rate.Reservation
+	for _, s := range scopes {
+		for _, b := range []struct {
+			bucket *rate.Limiter
+			n      int
+		}{
+			{s.buckets.messages, 1},
+			{s.buckets.bytes, size},
+		} {
+			r, ok := reserve(b.bucket, now, b.n)
+			if !ok {
+				for _, r := range taken {
+					r.CancelAt(now)
+				}
+				l.violate(violation{
+					scope:   s.scope,
+					domain:  domainID,
+					client:  clientID,
+					channel: channelID,
+				}, s.buckets.limits)
+				return false
+			}
+			if r != nil {
+				taken = append(taken, r)
+			}
+		}
+	}
+
+	return true
+}
+
+// InvalidateClient expires the client limits, so that they are read again
+// on the next publish.
+func (l *Limiter) InvalidateClient(id string) {
+	l.invalidate(ScopeClient, id)
+}
+
+// InvalidateChannel expires the channel limits, so that they are read again
+// on the next publish.
+func (l *Limiter) InvalidateChannel(id string) {
+	l.invalidate(ScopeChannel, id)
+}
+
+// Report publishes the violations since the last report, one event per
+// scope, client and channel, and removes the expired limits.
+func (l *Limiter) Report(ctx context.Context) {
+	now := time.Now()
+	l.mu.Lock()
+	vs := l.violations
+	l.violations = make(map[violation]violations)
+	for _, entries := range l.entries {
+		for id, e := range entries {
+			if now.After(e.expiresAt) {
+				delete(entries, id)
+			}
+		}
+	}
+	l.mu.Unlock()
+
+	if l.publisher == nil {
+		return
+	}
+	for v, c := range vs {
+		pctx, cancel := context.WithTimeout(ctx, publishTimeout)
+		event := violationEvent{
+			violation: v,
+			dropped:   c.dropped,
+			limits:    c.limits,
+		}
+		if err := l.publisher.Publish(pctx, violationStream, event); err != nil {
+			l.logger.Warn(fmt.Sprintf("failed to publish rate limit violation of %s %s: %s", v.scope, v.id(), err))
+		}
+		cancel()
+	}
+}
+
+func (l *Limiter) buckets(ctx context.Context, scope, id string) buckets {
+	now := time.Now()
+	l.mu.Lock()
+	if e, ok := l.entries[scope][id]; ok && now.Before(e.expiresAt) {
+		l.mu.Unlock()
+		return e.buckets
+	}
+	l.mu.Unlock()
+
+	limits, ttl := l.load(ctx, scope, id)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// Keep the buckets state if the limits did not change.
+	if cur, ok := l.entries[scope][id]; ok && cur.limits == limits {
+		cur.expiresAt = now.Add(ttl)
+		return cur.buckets
+	}
+	e := &entry{
+		buckets:   newBuckets(limits),
+		expiresAt: now.Add(ttl),
+	}
+	l.entries[scope][id] = e
+
+	return e.buckets
+}
+
+func (l *Limiter) load(ctx context.Context, scope, id string) (Limits, time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, loadTimeout)
+	defer cancel()
+
+	defaults := Limits{MessagesPerSec: l.cfg.ClientMessages, BytesPerSec: l.cfg.ClientBytes}
+	retrieve := l.clients.RetrieveEntity
+	if scope == ScopeChannel {
+		defaults = Limits{MessagesPerSec: l.cfg.ChannelMessages, BytesPerSec: l.cfg.ChannelBytes}
+		retrieve = l.channels.RetrieveEntity
+	}
+
+	res, err := retrieve(ctx, &grpcCommonV1.RetrieveEntityReq{Id: id})
+	if err != nil {
+		l.logger.Warn(fmt.Sprintf("Using default rate limits for %s %s: %s", scope, id, err))
+		return defaults, retryInterval
+	}
+	limits, err := ParseLimits(res.GetEntity().GetMetadata(), defaults)
+	if err != nil {
+		l.logger.Warn(fmt.Sprintf("Using default rate limits for %s %s: %s", scope, id, err))
+	}
+
+	return limits, l.cfg.SettingsTTL
+}
+
+// invalidate expires the entry instead of removing it, so that the buckets
+// state is kept if the limits did not change.
+func (l *Limiter) invalidate(scope, id string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e, ok := l.entries[scope][id]; ok {
+		e.expiresAt = time.Time{}
+	}
+}
+
+func (l *Limiter) violate(v violation, limits Limits) {
+	l.limited.With("scope", v.scope).Add(1)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	c := l.violations[v]
+	c.dropped++
+	c.limits = limits
+	l.violations[v] = c
+}
+
+func (v violation) id() string {
+	if v.scope == ScopeChannel {
+		return v.channel
+	}
+
+	return v.client
+}
+
+// reserve takes n tokens from the bucket if they are available now.
+func reserve(bucket *rate.Limiter, now time.Time, n int) (*rate.Reservation, bool) {
+	if bucket == nil || n == 0 {
+		return nil, true
+	}
+	r := bucket.ReserveN(now, n)
+	if !r.OK() {
+		return nil, false
+	}
+	if r.DelayFrom(now) > 0 {
+		r.CancelAt(now)
+		return nil, false
+	}
+
+	return r, true
+}
@@ -0,0 +1,211 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package limiter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
+	chmocks "github.com/absmach/magistrala/channels/mocks"
+	clmocks "github.com/absmach/magistrala/clients/mocks"
+	"github.com/absmach/magistrala/fluxmq/limiter"
+	mglog "github.com/absmach/magistrala/logger"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/mocks"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	domainID  = "domain"
+	clientID  = "client"
+	channelID = "channel"
+)
+
+// counter counts the limited messages per scope.
+type counter struct {
+	scope  string
+	scopes map[string]float64
+}
+
+func (c *counter) With(labelValues ...string) metrics.Counter {
+	return &counter{scope: labelValues[1], scopes: c.scopes}
+}
+
+func (c *counter) Add(delta float64) {
+	c.scopes[c.scope] += delta
+}
+
+func entityRes(id, metadata string) *grpcCommonV1.RetrieveEntityRes {
+	return &grpcCommonV1.RetrieveEntityRes{
+		Entity: &grpcCommonV1.EntityBasic{Id: id, Metadata: []byte(metadata)},
+	}
+}
+
+func newLimiter(cfg limiter.Config, clientMetadata, channelMetadata string, publisher events.Publisher) (*limiter.Limiter, *clmocks.ClientsServiceClient, *chmocks.ChannelsServiceClient, *counter) {
+	clients := new(clmocks.ClientsServiceClient)
+	channels := new(chmocks.ChannelsServiceClient)
+	clients.On("RetrieveEntity", mock.Anything, &grpcCommonV1.RetrieveEntityReq{Id: clientID}).Return(entityRes(clientID, clientMetadata), nil)
+	channels.On("RetrieveEntity", mock.Anything, &grpcCommonV1.RetrieveEntityReq{Id: channelID}).Return(entityRes(channelID, channelMetadata), nil)
+
+	limited := &counter{scopes: make(map[string]float64)}
+	l := limiter.New(cfg, clients, channels, publisher, limited, mglog.NewMock())
+
+	return l, clients, channels, limited
+}
+
+type publish struct {
+	size    int
+	allowed bool
+}
+
+func TestAllow(t *testing.T) {
+	cfg := limiter.Config{SettingsTTL: time.Minute}
+
+	cases := []struct {
+		desc            string
+		cfg             limiter.Config
+		clientMetadata  string
+		channelMetadata string
+		publishes       []publish
+		limited         map[string]float64
+	}{
+		{
+			desc:      "allow publishes without limits",
+			cfg:       cfg,
+			publishes: []publish{{10, true}, {10, true}, {10, true}},
+			limited:   map[string]float64{},
+		},
+		{
+			desc:           "limit client messages",
+			cfg:            cfg,
+			clientMetadata: `{"rate_limit":{"messages_per_sec":2}}`,
+			publishes:      []publish{{10, true}, {10, true}, {10, false}},
+			limited:        map[string]float64{limiter.ScopeClient: 1},
+		},
+		{
+			desc:            "limit channel bytes",
+			cfg:             cfg,
+			channelMetadata: `{"rate_limit":{"bytes_per_sec":100}}`,
+			publishes:       []publish{{60, true}, {60, false}, {40, true}},
+			limited:         map[string]float64{limiter.ScopeChannel: 1},
+		},
+		{
+			desc:      "limit client messages using defaults",
+			cfg:       limiter.Config{ClientMessages: 1, SettingsTTL: time.Minute},
+			publishes: []publish{{10, true}, {10, false}},
+			limited:   map[string]float64{limiter.ScopeClient: 1},
+		},
+		{
+			desc:            "limit channel messages overriding defaults",
+			cfg:             limiter.Config{ChannelMessages: 1, SettingsTTL: time.Minute},
+			channelMetadata: `{"rate_limit":{"messages_per_sec":3}}`,
+			publishes:       []publish{{10, true}, {10, true}, {10, true}, {10, false}},
+			limited:         map[string]float64{limiter.ScopeChannel: 1},
+		},
+		{
+			desc:            "limit channel without consuming client limit",
+			cfg:             cfg,
+			clientMetadata:  `{"rate_limit":{"messages_per_sec":1}}`,
+			channelMetadata: `{"rate_limit":{"bytes_per_sec":10}}`,
+			publishes:       []publish{{20, false}, {5, true}, {5, false}},
+			limited:         map[string]float64{limiter.ScopeChannel: 1, limiter.ScopeClient: 1},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			l, _, _, limited := newLimiter(tc.cfg, tc.clientMetadata, tc.channelMetadata, nil)
+			for i, p := range tc.publishes {
+				allowed := l.Allow(context.Background(), domainID, clientID, channelID, p.size)
+				assert.Equal(t, p.allowed, allowed, fmt.Sprintf("%s: publish %d: expected allowed %t got %t", tc.desc, i, p.allowed, allowed))
+			}
+			assert.Equal(t, tc.limited, limited.scopes)
+		})
+	}
+}
+
+func TestAllowCancelsClientLimit(t *testing.T) {
+	otherChannelID := "other-channel-id"
+	l, _, channels, _ := newLimiter(limiter.Config{SettingsTTL: time.Minute}, `{"rate_limit":{"messages_per_sec":2}}`, `{"rate_limit":{"messages_per_sec":1}}`, nil)
+	channels.On("RetrieveEntity", mock.Anything, &grpcCommonV1.RetrieveEntityReq{Id: otherChannelID}).Return(entityRes(otherChannelID, ""), nil)
+
+	assert.True(t, l.Allow(context.Background(), domainID, clientID, channelID, 10))
+	// The publish over the channel limit does not consume the client limit.
+	assert.False(t, l.Allow(context.Background(), domainID, clientID, channelID, 10))
+	assert.True(t, l.Allow(context.Background(), domainID, clientID, otherChannelID, 10))
+	assert.False(t, l.Allow(context.Background(), domainID, clientID, otherChannelID, 10))
+}
+
+func TestAllowRetrieveError(t *testing.T) {
+	clients := new(clmocks.ClientsServiceClient)
+	channels := new(chmocks.ChannelsServiceClient)
+	clients.On("RetrieveEntity", mock.Anything, mock.Anything).Return(nil, status.Error(codes.Unavailable, "unavailable"))
+	channels.On("RetrieveEntity", mock.Anything, mock.Anything).Return(entityRes(channelID, ""), nil)
+	cfg := limiter.Config{ClientMessages: 1, SettingsTTL: time.Minute}
+	l := limiter.New(cfg, clients, channels, nil, &counter{scopes: make(map[string]float64)}, mglog.NewMock())
+
+	assert.True(t, l.Allow(context.Background(), domainID, clientID, channelID, 10))
+	assert.False(t, l.Allow(context.Background(), domainID, clientID, channelID, 10))
+	clients.AssertNumberOfCalls(t, "RetrieveEntity", 1)
+}
+
+func TestInvalidate(t *testing.T) {
+	l, clients, _, _ := newLimiter(limiter.Config{SettingsTTL: time.Minute}, `{"rate_limit":{"messages_per_sec":1}}`, "", nil)
+
+	assert.True(t, l.Allow(context.Background(), domainID, clientID, channelID, 10))
+	assert.False(t, l.Allow(context.Background(), domainID, clientID, channelID, 10))
+	clients.AssertNumberOfCalls(t, "RetrieveEntity", 1)
+
+	// The buckets are kept if the reloaded limits are the same.
+	l.InvalidateClient(clientID)
+	assert.False(t, l.Allow(context.Background(), domainID, clientID, channelID, 10))
+	clients.AssertNumberOfCalls(t, "RetrieveEntity", 2)
+
+	clients.ExpectedCalls = nil
+	clients.On("RetrieveEntity", mock.Anything, mock.Anything).Return(entityRes(clientID, `{"rate_limit":{"messages_per_sec":5}}`), nil)
+	l.InvalidateClient(clientID)
+	assert.True(t, l.Allow(context.Background(), domainID, clientID, channelID, 10))
+}
+
+func TestReport(t *testing.T) {
+	publisher := new(mocks.Publisher)
+	var published []map[string]any
+	publisher.On("Publish", mock.Anything, "magistrala.messaging.rate_limit", mock.Anything).Run(func(args mock.Arguments) {
+		event, _, err := schema.ValidateEvent(args.Get(2).(events.Event))
+		require.Nil(t, err, fmt.Sprintf("unexpected error validating event: %s", err))
+		published = append(published, event)
+	}).Return(nil)
+
+	cfg := limiter.Config{SettingsTTL: time.Minute}
+	l, _, _, _ := newLimiter(cfg, `{"rate_limit":{"messages_per_sec":1,"bytes_per_sec":1000}}`, "", publisher)
+	for range 3 {
+		l.Allow(context.Background(), domainID, clientID, channelID, 10)
+	}
+
+	l.Report(context.Background())
+	expected := map[string]any{
+		"operation":        "messaging.rate_limit",
+		"domain":           domainID,
+		"scope":            limiter.ScopeClient,
+		"client_ids":       []string{clientID},
+		"channel_ids":      []string{channelID},
+		"dropped":          uint64(2),
+		"messages_per_sec": float64(1),
+		"bytes_per_sec":    float64(1000),
+	}
+	assert.Equal(t, []map[string]any{expected}, published)
+
+	// The violations are reported once.
+	l.Report(context.Background())
+	assert.Len(t, published, 1)
+}
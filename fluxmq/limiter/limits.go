@@ -0,0 +1,88 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package limiter
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+// MetadataKey is the client and channel metadata key of the rate limits.
+const MetadataKey = "rate_limit"
+
+// ErrInvalidLimits indicates invalid rate limits in the entity metadata.
+var ErrInvalidLimits = errors.New("invalid rate limits")
+
+// Limits are the publish rate limits of a client or a channel. A zero limit
+// means that the publishes are not limited.
+type Limits struct {
+	MessagesPerSec float64 `json:"messages_per_sec,omitempty"`
+	BytesPerSec    float64 `json:"bytes_per_sec,omitempty"`
+}
+
+// ParseLimits returns the rate limits from the entity metadata. The limits
+// which are not set in the metadata are taken from the defaults.
+func ParseLimits(metadata []byte, defaults Limits) (Limits, error) {
+	if len(metadata) == 0 {
+		return defaults, nil
+	}
+
+	var md map[string]json.RawMessage
+	if err := json.Unmarshal(metadata, &md); err != nil {
+		return defaults, errors.Wrap(ErrInvalidLimits, err)
+	}
+	raw, ok := md[MetadataKey]
+	if !ok {
+		return defaults, nil
+	}
+
+	var l struct {
+		MessagesPerSec *float64 `json:"messages_per_sec"`
+		BytesPerSec    *float64 `json:"bytes_per_sec"`
+	}
+	if err := json.Unmarshal(raw, &l); err != nil {
+		return defaults, errors.Wrap(ErrInvalidLimits, err)
+	}
+
+	limits := defaults
+	if l.MessagesPerSec != nil {
+		limits.MessagesPerSec = *l.MessagesPerSec
+	}
+	if l.BytesPerSec != nil {
+		limits.BytesPerSec = *l.BytesPerSec
+	}
+	if limits.MessagesPerSec < 0 || limits.BytesPerSec < 0 {
+		return defaults, errors.Wrap(ErrInvalidLimits, errors.New("negative limit"))
+	}
+
+	return limits, nil
+}
+
+// buckets hold the token buckets of a client or a channel. The bucket sizes
+// allow a burst of one second worth of publishes, so a message larger than
+// the bytes per second limit is never allowed.
+type buckets struct {
+	limits   Limits
+	messages *rate.Limiter
+	bytes    *rate.Limiter
+}
+
+func newBuckets(l Limits) buckets {
+	return buckets{
+		limits:   l,
+		messages: newBucket(l.MessagesPerSec),
+		bytes:    newBucket(l.BytesPerSec),
+	}
+}
+
+func newBucket(perSec float64) *rate.Limiter {
+	if perSec <= 0 {
+		return nil
+	}
+
+	return rate.NewLimiter(rate.Limit(perSec), int(math.Max(1, math.Ceil(perSec))))
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package limiter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/fluxmq/limiter"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLimits(t *testing.T) {
+	defaults := limiter.Limits{MessagesPerSec: 10, BytesPerSec: 1024}
+
+	cases := []struct {
+		desc     string
+		metadata string
+		limits   limiter.Limits
+		err      error
+	}{
+		{
+			desc:   "parse empty metadata",
+			limits: defaults,
+		},
+		{
+			desc:     "parse metadata without limits",
+			metadata: `{"location":"lab"}`,
+			limits:   defaults,
+		},
+		{
+			desc:     "parse metadata with all limits",
+			metadata: `{"rate_limit":{"messages_per_sec":2.5,"bytes_per_sec":100}}`,
+			limits:   limiter.Limits{MessagesPerSec: 2.5, BytesPerSec: 100},
+		},
+		{
+			desc:     "parse metadata with messages limit",
+			metadata: `{"rate_limit":{"messages_per_sec":5}}`,
+			limits:   limiter.Limits{MessagesPerSec: 5, BytesPerSec: 1024},
+		},
+		{
+			desc:     "parse metadata with disabled bytes limit",
+			metadata: `{"rate_limit":{"bytes_per_sec":0}}`,
+			limits:   limiter.Limits{MessagesPerSec: 10},
+		},
+		{
+			desc:     "parse metadata with negative limit",
+			metadata: `{"rate_limit":{"messages_per_sec":-1}}`,
+			limits:   defaults,
+			err:      limiter.ErrInvalidLimits,
+		},
+		{
+			desc:     "parse metadata with invalid limits",
+			metadata: `{"rate_limit":"fast"}`,
+			limits:   defaults,
+			err:      limiter.ErrInvalidLimits,
+		},
+		{
+			desc:     "parse invalid metadata",
+			metadata: `{`,
+			limits:   defaults,
+			err:      limiter.ErrInvalidLimits,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			limits, err := limiter.ParseLimits([]byte(tc.metadata), defaults)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.limits, limits)
+		})
+	}
+}
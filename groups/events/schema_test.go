@@ -0,0 +1,152 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/groups"
+	"github.com/absmach/magistrala/internal/nullable"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/pkg/roles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSchemas(t *testing.T) {
+	now := time.Now().UTC()
+	session := authn.Session{
+		Type:     authn.AccessToken,
+		UserID:   testsutil.GenerateUUID(t),
+		DomainID: testsutil.GenerateUUID(t),
+	}
+	group := groups.Group{
+		ID:          testsutil.GenerateUUID(t),
+		Domain:      session.DomainID,
+		Parent:      testsutil.GenerateUUID(t),
+		Name:        "group",
+		Description: nullable.New("description"),
+		Tags:        []string{"tag"},
+		Metadata:    groups.Metadata{"key": "value"},
+		Path:        "path",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		UpdatedBy:   session.UserID,
+		Status:      groups.EnabledStatus,
+	}
+	page := groups.PageMeta{
+		Total:    1,
+		Limit:    10,
+		Name:     "group",
+		Metadata: groups.Metadata{"key": "value"},
+		Tags:     groups.TagsQuery{Elements: []string{"tag"}},
+	}
+	childrenIDs := []string{testsutil.GenerateUUID(t)}
+	tokenType := session.Type.String()
+	requestID := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc  string
+		event events.Event
+	}{
+		{
+			desc: "create group",
+			event: createGroupEvent{
+				Group:            group,
+				rolesProvisioned: []roles.RoleProvision{{Role: roles.Role{ID: testsutil.GenerateUUID(t), Name: "admin"}, OptionalActions: []string{"read"}}},
+				Session:          session,
+				requestID:        requestID,
+			},
+		},
+		{
+			desc:  "create group with minimal fields",
+			event: createGroupEvent{Group: groups.Group{ID: group.ID, CreatedAt: now}, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update group",
+			event: updateGroupEvent{Group: group, operation: groupUpdate, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update group tags",
+			event: updateGroupEvent{Group: group, operation: groupUpdateTags, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "enable group",
+			event: changeGroupStatusEvent{id: group.ID, operation: groupEnable, status: groups.EnabledStatus.String(), updatedAt: now, updatedBy: session.UserID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "disable group",
+			event: changeGroupStatusEvent{id: group.ID, operation: groupDisable, status: groups.DisabledStatus.String(), updatedAt: now, updatedBy: session.UserID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "view group",
+			event: viewGroupEvent{Group: group, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "list groups",
+			event: listGroupEvent{PageMeta: page, domainID: session.DomainID, userID: session.UserID, tokenType: tokenType, requestID: requestID},
+		},
+		{
+			desc:  "list user groups",
+			event: listUserGroupEvent{userID: session.UserID, domainID: session.DomainID, PageMeta: page, tokenType: tokenType, requestID: requestID},
+		},
+		{
+			desc:  "remove group",
+			event: deleteGroupEvent{id: group.ID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "retrieve group hierarchy",
+			event: retrieveGroupHierarchyEvent{id: group.ID, HierarchyPageMeta: groups.HierarchyPageMeta{Level: 1, Direction: -1, Tree: true}, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "add parent group",
+			event: addParentGroupEvent{id: group.ID, parentID: group.Parent, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "remove parent group",
+			event: removeParentGroupEvent{id: group.ID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "add children groups",
+			event: addChildrenGroupsEvent{id: group.ID, childrenIDs: childrenIDs, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "remove children groups",
+			event: removeChildrenGroupsEvent{id: group.ID, childrenIDs: childrenIDs, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "remove all children groups",
+			event: removeAllChildrenGroupsEvent{id: group.ID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "list children groups",
+			event: listChildrenGroupsEvent{id: group.ID, startLevel: 1, endLevel: -1, PageMeta: page, domainID: session.DomainID, userID: session.UserID, tokenType: tokenType, requestID: requestID},
+		},
+	}
+
+	covered := make(map[string]bool)
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, s, err := schema.ValidateEvent(tc.event)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			covered[s.Operation] = true
+		})
+	}
+
+	// Role events are published by the roles manager.
+	for _, s := range schema.Schemas() {
+		op := s.Operation
+		if !strings.HasPrefix(op, groupPrefix) ||
+			strings.HasPrefix(op, groupPrefix+"role") || strings.HasPrefix(op, groupPrefix+"members") {
+			continue
+		}
+		assert.True(t, covered[op], fmt.Sprintf("expected emitted event for schema %s", op))
+	}
+}
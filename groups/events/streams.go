@@ -9,7 +9,6 @@ import (
 	"github.com/absmach/magistrala/groups"
 	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/events"
-	"github.com/absmach/magistrala/pkg/events/store"
 	"github.com/absmach/magistrala/pkg/roles"
 	rmEvents "github.com/absmach/magistrala/pkg/roles/rolemanager/events"
 	"github.com/go-chi/chi/v5/middleware"
@@ -43,79 +42,78 @@ type eventStore struct {
 	rmEvents.RoleManagerEventStore
 }
 
-// NewEventStoreMiddleware returns wrapper around clients service that sends
-// events to event store.
-func New(ctx context.Context, svc groups.Service, url string) (groups.Service, error) {
-	publisher, err := store.NewPublisher(ctx, url, "groups-es-pub")
-	if err != nil {
-		return nil, err
-	}
+// New returns wrapper around groups service that sends events to the
+// publisher. If the publisher is transactional, such as the PostgreSQL
+// outbox, the events of each change are stored in the same transaction as
+// the change itself.
+func New(svc groups.Service, publisher events.Publisher) groups.Service {
 	rmes := rmEvents.NewRoleManagerEventStore("groups", groupPrefix, svc, publisher)
 
 	return &eventStore{
 		svc:                   svc,
 		Publisher:             publisher,
 		RoleManagerEventStore: rmes,
-	}, nil
+	}
 }
 
-func (es eventStore) CreateGroup(ctx context.Context, session authn.Session, group groups.Group) (groups.Group, []roles.RoleProvision, error) {
-	group, rps, err := es.svc.CreateGroup(ctx, session, group)
-	if err != nil {
-		return group, rps, err
-	}
+func (es eventStore) CreateGroup(ctx context.Context, session authn.Session, group groups.Group) (g groups.Group, rps []roles.RoleProvision, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		g, rps, err = es.svc.CreateGroup(ctx, session, group)
+		if err != nil {
+			return err
+		}
 
-	event := createGroupEvent{
-		Group:            group,
-		rolesProvisioned: rps,
-		Session:          session,
-		requestID:        middleware.GetReqID(ctx),
-	}
+		event := createGroupEvent{
+			Group:            g,
+			rolesProvisioned: rps,
+			Session:          session,
+			requestID:        middleware.GetReqID(ctx),
+		}
 
-	if err := es.Publish(ctx, createStream, event); err != nil {
-		return group, rps, err
-	}
+		return es.Publish(ctx, createStream, event)
+	})
 
-	return group, rps, nil
+	return g, rps, err
 }
 
-func (es eventStore) UpdateGroup(ctx context.Context, session authn.Session, group groups.Group) (groups.Group, error) {
-	group, err := es.svc.UpdateGroup(ctx, session, group)
-	if err != nil {
-		return group, err
-	}
+func (es eventStore) UpdateGroup(ctx context.Context, session authn.Session, group groups.Group) (g groups.Group, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		g, err = es.svc.UpdateGroup(ctx, session, group)
+		if err != nil {
+			return err
+		}
 
-	event := updateGroupEvent{
-		Group:     group,
-		Session:   session,
-		operation: groupUpdate,
-		requestID: middleware.GetReqID(ctx),
-	}
+		event := updateGroupEvent{
+			Group:     g,
+			Session:   session,
+			operation: groupUpdate,
+			requestID: middleware.GetReqID(ctx),
+		}
 
-	if err := es.Publish(ctx, updateStream, event); err != nil {
-		return group, err
-	}
+		return es.Publish(ctx, updateStream, event)
+	})
 
-	return group, nil
+	return g, err
 }
 
-func (es *eventStore) UpdateGroupTags(ctx context.Context, session authn.Session, g groups.Group) (groups.Group, error) {
-	g, err := es.svc.UpdateGroupTags(ctx, session, g)
-	if err != nil {
-		return g, err
-	}
+func (es *eventStore) UpdateGroupTags(ctx context.Context, session authn.Session, group groups.Group) (g groups.Group, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		g, err = es.svc.UpdateGroupTags(ctx, session, group)
+		if err != nil {
+			return err
+		}
 
-	event := updateGroupEvent{
-		Group:     g,
-		Session:   session,
-		operation: groupUpdateTags,
-		requestID: middleware.GetReqID(ctx),
-	}
-	if err := es.Publish(ctx, updateTagsStream, event); err != nil {
-		return g, err
-	}
+		event := updateGroupEvent{
+			Group:     g,
+			Session:   session,
+			operation: groupUpdateTags,
+			requestID: middleware.GetReqID(ctx),
+		}
 
-	return g, nil
+		return es.Publish(ctx, updateTagsStream, event)
+	})
+
+	return g, err
 }
 
 func (es eventStore) ViewGroup(ctx context.Context, session authn.Session, id string, withRoles bool) (groups.Group, error) {
@@ -178,25 +176,31 @@ func (es eventStore) ListUserGroups(ctx context.Context, session authn.Session,
 	return gp, nil
 }
 
-func (es eventStore) EnableGroup(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
-	group, err := es.svc.EnableGroup(ctx, session, id)
-	if err != nil {
-		return group, err
-	}
+func (es eventStore) EnableGroup(ctx context.Context, session authn.Session, id string) (group groups.Group, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if group, err = es.svc.EnableGroup(ctx, session, id); err != nil {
+			return err
+		}
 
-	return es.changeStatus(ctx, session, groupEnable, enableStream, group)
+		return es.changeStatus(ctx, session, groupEnable, enableStream, group)
+	})
+
+	return group, err
 }
 
-func (es eventStore) DisableGroup(ctx context.Context, session authn.Session, id string) (groups.Group, error) {
-	group, err := es.svc.DisableGroup(ctx, session, id)
-	if err != nil {
-		return group, err
-	}
+func (es eventStore) DisableGroup(ctx context.Context, session authn.Session, id string) (group groups.Group, err error) {
+	err = events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if group, err = es.svc.DisableGroup(ctx, session, id); err != nil {
+			return err
+		}
 
-	return es.changeStatus(ctx, session, groupDisable, disableStream, group)
+		return es.changeStatus(ctx, session, groupDisable, disableStream, group)
+	})
+
+	return group, err
 }
 
-func (es eventStore) changeStatus(ctx context.Context, session authn.Session, operation, stream string, group groups.Group) (groups.Group, error) {
+func (es eventStore) changeStatus(ctx context.Context, session authn.Session, operation, stream string, group groups.Group) error {
 	event := changeGroupStatusEvent{
 		id:        group.ID,
 		operation: operation,
@@ -207,25 +211,21 @@ func (es eventStore) changeStatus(ctx context.Context, session authn.Session, op
 		requestID: middleware.GetReqID(ctx),
 	}
 
-	if err := es.Publish(ctx, stream, event); err != nil {
-		return group, err
-	}
-
-	return group, nil
+	return es.Publish(ctx, stream, event)
 }
 
 func (es eventStore) DeleteGroup(ctx context.Context, session authn.Session, id string) error {
-	if err := es.svc.DeleteGroup(ctx, session, id); err != nil {
-		return err
-	}
-	if err := es.Publish(ctx, removeStream, deleteGroupEvent{
-		id:        id,
-		Session:   session,
-		requestID: middleware.GetReqID(ctx),
-	}); err != nil {
-		return err
-	}
-	return nil
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.DeleteGroup(ctx, session, id); err != nil {
+			return err
+		}
+
+		return es.Publish(ctx, removeStream, deleteGroupEvent{
+			id:        id,
+			Session:   session,
+			requestID: middleware.GetReqID(ctx),
+		})
+	})
 }
 
 func (es eventStore) RetrieveGroupHierarchy(ctx context.Context, session authn.Session, id string, hm groups.HierarchyPageMeta) (groups.HierarchyPage, error) {
@@ -240,54 +240,53 @@ func (es eventStore) RetrieveGroupHierarchy(ctx context.Context, session authn.S
 }
 
 func (es eventStore) AddParentGroup(ctx context.Context, session authn.Session, id, parentID string) error {
-	if err := es.svc.AddParentGroup(ctx, session, id, parentID); err != nil {
-		return err
-	}
-	if err := es.Publish(ctx, addParentStream, addParentGroupEvent{id: id, parentID: parentID, Session: session, requestID: middleware.GetReqID(ctx)}); err != nil {
-		return err
-	}
-	return nil
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.AddParentGroup(ctx, session, id, parentID); err != nil {
+			return err
+		}
+
+		return es.Publish(ctx, addParentStream, addParentGroupEvent{id: id, parentID: parentID, Session: session, requestID: middleware.GetReqID(ctx)})
+	})
 }
 
 func (es eventStore) RemoveParentGroup(ctx context.Context, session authn.Session, id string) error {
-	if err := es.svc.RemoveParentGroup(ctx, session, id); err != nil {
-		return err
-	}
-	if err := es.Publish(ctx, removeParentStream, removeParentGroupEvent{id: id, Session: session, requestID: middleware.GetReqID(ctx)}); err != nil {
-		return err
-	}
-	return nil
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.RemoveParentGroup(ctx, session, id); err != nil {
+			return err
+		}
+
+		return es.Publish(ctx, removeParentStream, removeParentGroupEvent{id: id, Session: session, requestID: middleware.GetReqID(ctx)})
+	})
 }
 
 func (es eventStore) AddChildrenGroups(ctx context.Context, session authn.Session, id string, childrenGroupIDs []string) error {
-	if err := es.svc.AddChildrenGroups(ctx, session, id, childrenGroupIDs); err != nil {
-		return err
-	}
-	if err := es.Publish(ctx, addChildrenStream, addChildrenGroupsEvent{id: id, Session: session, childrenIDs: childrenGroupIDs, requestID: middleware.GetReqID(ctx)}); err != nil {
-		return err
-	}
-	return nil
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.AddChildrenGroups(ctx, session, id, childrenGroupIDs); err != nil {
+			return err
+		}
+
+		return es.Publish(ctx, addChildrenStream, addChildrenGroupsEvent{id: id, Session: session, childrenIDs: childrenGroupIDs, requestID: middleware.GetReqID(ctx)})
+	})
 }
 
 func (es eventStore) RemoveChildrenGroups(ctx context.Context, session authn.Session, id string, childrenGroupIDs []string) error {
-	if err := es.svc.RemoveChildrenGroups(ctx, session, id, childrenGroupIDs); err != nil {
-		return err
-	}
-	if err := es.Publish(ctx, removeChildrenStream, removeChildrenGroupsEvent{id: id, Session: session, childrenIDs: childrenGroupIDs, requestID: middleware.GetReqID(ctx)}); err != nil {
-		return err
-	}
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.RemoveChildrenGroups(ctx, session, id, childrenGroupIDs); err != nil {
+			return err
+		}
 
-	return nil
+		return es.Publish(ctx, removeChildrenStream, removeChildrenGroupsEvent{id: id, Session: session, childrenIDs: childrenGroupIDs, requestID: middleware.GetReqID(ctx)})
+	})
 }
 
 func (es eventStore) RemoveAllChildrenGroups(ctx context.Context, session authn.Session, id string) error {
-	if err := es.svc.RemoveAllChildrenGroups(ctx, session, id); err != nil {
-		return err
-	}
-	if err := es.Publish(ctx, removeAllChildrenStream, removeAllChildrenGroupsEvent{id: id, Session: session, requestID: middleware.GetReqID(ctx)}); err != nil {
-		return err
-	}
-	return nil
+	return events.Atomic(ctx, es.Publisher, func(ctx context.Context) error {
+		if err := es.svc.RemoveAllChildrenGroups(ctx, session, id); err != nil {
+			return err
+		}
+
+		return es.Publish(ctx, removeAllChildrenStream, removeAllChildrenGroupsEvent{id: id, Session: session, requestID: middleware.GetReqID(ctx)})
+	})
 }
 
 func (es eventStore) ListChildrenGroups(ctx context.Context, session authn.Session, id string, startLevel, endLevel int64, pm groups.PageMeta) (groups.Page, error) {
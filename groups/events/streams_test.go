@@ -17,6 +17,7 @@ import (
 	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/errors"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/events/store"
 	"github.com/absmach/magistrala/pkg/roles"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/redis/go-redis/v9"
@@ -52,8 +53,9 @@ var (
 
 func newEventStoreMiddleware(t *testing.T) (*mocks.Service, groups.Service) {
 	svc := new(mocks.Service)
-	nsvc, err := events.New(context.Background(), svc, storeURL)
-	require.Nil(t, err, fmt.Sprintf("create events store middleware failed with unexpected error: %s", err))
+	publisher, err := store.NewPublisher(context.Background(), storeURL, "groups-es-pub")
+	require.Nil(t, err, fmt.Sprintf("create events store publisher failed with unexpected error: %s", err))
+	nsvc := events.New(svc, publisher)
 
 	return svc, nsvc
 }
@@ -7,16 +7,14 @@ import (
 	"context"
 	"errors"
 	"log/slog"
-	"time"
 
 	"github.com/absmach/magistrala/journal"
 	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
 	"github.com/absmach/magistrala/pkg/events/store"
 )
 
 var (
-	ErrMissingOccurredAt = errors.New("missing occurred_at")
-	errMissingOperation  = errors.New("missing operation")
 	errMissingAttributes = errors.New("missing attributes")
 	errMsg               = "failed to save journal"
 )
@@ -39,46 +37,29 @@ func Handle(service journal.Service) handleFunc {
 			return err
 		}
 
-		operation, ok := data["operation"].(string)
-		if !ok {
+		record, err := schema.Decode(data)
+		if err != nil {
 			// Error is logged instead of being returned to avoid redelivering of the event.
-			slog.Error(errMsg, "error", errMissingOperation)
-			return nil
-		}
-		delete(data, "operation")
-
-		if operation == "" {
-			slog.Error(errMsg, "error", errMissingOperation)
-			return nil
-		}
-
-		occurredAt, ok := data["occurred_at"].(float64)
-		if !ok {
-			slog.Error(errMsg, "error", ErrMissingOccurredAt)
-			return nil
-		}
-		delete(data, "occurred_at")
-
-		if occurredAt == 0 {
-			slog.Error(errMsg, "error", ErrMissingOccurredAt)
+			slog.Error(errMsg, "error", err)
 			return nil
 		}
 
-		metadata, ok := data["metadata"].(map[string]any)
-		if !ok {
+		metadata := record.Object("metadata")
+		if metadata == nil {
 			metadata = make(map[string]any)
 		}
-		delete(data, "metadata")
+		attributes := record.Attributes
+		delete(attributes, "metadata")
 
-		if len(data) == 0 {
+		if len(attributes) == 0 {
 			slog.Error(errMsg, "error", errMissingAttributes)
 			return nil
 		}
 
 		j := journal.Journal{
-			Operation:  operation,
-			OccurredAt: time.Unix(0, int64(occurredAt)),
-			Attributes: data,
+			Operation:  record.Operation,
+			OccurredAt: record.OccurredAt,
+			Attributes: attributes,
 			Metadata:   metadata,
 		}
 		if err := service.Save(ctx, j); err != nil {
@@ -23,7 +23,7 @@ import (
 )
 
 var (
-	operation = "users.create"
+	operation = "user.identify"
 	payload   = map[string]any{
 		"temperature": rand.Float64(),
 		"humidity":    float64(rand.Intn(1000)),
@@ -66,6 +66,7 @@ func TestHandle(t *testing.T) {
 				"operation":   operation,
 				"occurred_at": float64(time.Now().UnixNano()),
 				"id":          testsutil.GenerateUUID(t),
+				"request_id":  testsutil.GenerateUUID(t),
 				"tags":        []any{testsutil.GenerateUUID(t), testsutil.GenerateUUID(t)},
 				"number":      float64(rand.Intn(1000)),
 				"metadata":    payload,
@@ -120,6 +121,28 @@ func TestHandle(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			desc: "with unknown operation",
+			event: map[string]any{
+				"operation":   "unknown.create",
+				"occurred_at": float64(time.Now().UnixNano()),
+				"id":          testsutil.GenerateUUID(t),
+				"request_id":  testsutil.GenerateUUID(t),
+				"metadata":    payload,
+			},
+			err: nil,
+		},
+		{
+			desc: "with event not matching schema",
+			event: map[string]any{
+				"operation":   operation,
+				"occurred_at": float64(time.Now().UnixNano()),
+				"id":          float64(rand.Intn(1000)),
+				"request_id":  testsutil.GenerateUUID(t),
+				"metadata":    payload,
+			},
+			err: nil,
+		},
 		{
 			desc: "with missing occurred_at",
 			event: map[string]any{
@@ -244,6 +267,7 @@ func TestHandle(t *testing.T) {
 				"operation":   operation,
 				"occurred_at": float64(time.Now().UnixNano()),
 				"id":          testsutil.GenerateUUID(t),
+				"request_id":  testsutil.GenerateUUID(t),
 				"tags":        []any{testsutil.GenerateUUID(t), testsutil.GenerateUUID(t)},
 				"number":      float64(rand.Intn(1000)),
 				"metadata":    payload,
@@ -125,13 +125,16 @@ func (page JournalsPage) MarshalJSON() ([]byte, error) {
 }
 
 type ClientTelemetry struct {
-	ClientID         string    `json:"client_id"`
-	DomainID         string    `json:"domain_id"`
-	Subscriptions    uint64    `json:"subscriptions"`
-	InboundMessages  uint64    `json:"inbound_messages"`
-	OutboundMessages uint64    `json:"outbound_messages"`
-	FirstSeen        time.Time `json:"first_seen"`
-	LastSeen         time.Time `json:"last_seen"`
+	ClientID         string `json:"client_id"`
+	DomainID         string `json:"domain_id"`
+	Subscriptions    uint64 `json:"subscriptions"`
+	InboundMessages  uint64 `json:"inbound_messages"`
+	OutboundMessages uint64 `json:"outbound_messages"`
+	// RateLimitedMessages is the number of client messages dropped by the
+	// publish rate limits.
+	RateLimitedMessages uint64    `json:"rate_limited_messages"`
+	FirstSeen           time.Time `json:"first_seen"`
+	LastSeen            time.Time `json:"last_seen"`
 }
 
 type ClientSubscription struct {
@@ -185,4 +188,8 @@ type Repository interface {
 
 	// IncrementOutboundMessages increments the outbound messages count for a client.
 	IncrementOutboundMessages(ctx context.Context, channelID, subtopic string) error
+
+	// IncrementRateLimitedMessages increments the rate limited messages count
+	// for a client by the rate limited messages count of ct.
+	IncrementRateLimitedMessages(ctx context.Context, ct ClientTelemetry) error
 }
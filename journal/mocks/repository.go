@@ -348,6 +348,63 @@ func (_c *Repository_IncrementOutboundMessages_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// IncrementRateLimitedMessages provides a mock function for the type Repository
+func (_mock *Repository) IncrementRateLimitedMessages(ctx context.Context, ct journal.ClientTelemetry) error {
+	ret := _mock.Called(ctx, ct)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementRateLimitedMessages")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, journal.ClientTelemetry) error); ok {
+		r0 = returnFunc(ctx, ct)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_IncrementRateLimitedMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementRateLimitedMessages'
+type Repository_IncrementRateLimitedMessages_Call struct {
+	*mock.Call
+}
+
+// IncrementRateLimitedMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ct journal.ClientTelemetry
+func (_e *Repository_Expecter) IncrementRateLimitedMessages(ctx interface{}, ct interface{}) *Repository_IncrementRateLimitedMessages_Call {
+	return &Repository_IncrementRateLimitedMessages_Call{Call: _e.mock.On("IncrementRateLimitedMessages", ctx, ct)}
+}
+
+func (_c *Repository_IncrementRateLimitedMessages_Call) Run(run func(ctx context.Context, ct journal.ClientTelemetry)) *Repository_IncrementRateLimitedMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 journal.ClientTelemetry
+		if args[1] != nil {
+			arg1 = args[1].(journal.ClientTelemetry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_IncrementRateLimitedMessages_Call) Return(err error) *Repository_IncrementRateLimitedMessages_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_IncrementRateLimitedMessages_Call) RunAndReturn(run func(ctx context.Context, ct journal.ClientTelemetry) error) *Repository_IncrementRateLimitedMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveSubscription provides a mock function for the type Repository
 func (_mock *Repository) RemoveSubscription(ctx context.Context, subscriberID string) error {
 	ret := _mock.Called(ctx, subscriberID)
@@ -88,6 +88,15 @@ func Migration() *migrate.MemoryMigrationSource {
 					`DROP INDEX IF EXISTS idx_journal_domain;`,
 				},
 			},
+			{
+				Id: "journal_04",
+				Up: []string{
+					`ALTER TABLE clients_telemetry ADD COLUMN IF NOT EXISTS rate_limited_messages BIGINT DEFAULT 0`,
+				},
+				Down: []string{
+					`ALTER TABLE clients_telemetry DROP COLUMN IF EXISTS rate_limited_messages`,
+				},
+			},
 		},
 	}
 }
@@ -1247,6 +1247,63 @@ func TestIncrementInboundMessages(t *testing.T) {
 	}
 }
 
+func TestIncrementRateLimitedMessages(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM clients_telemetry")
+		require.Nil(t, err)
+	})
+	repo := postgres.NewRepository(database)
+
+	clientID := testsutil.GenerateUUID(t)
+	domainID := testsutil.GenerateUUID(t)
+	firstSeen := time.Now().UTC().Truncate(time.Millisecond)
+
+	cases := []struct {
+		desc            string
+		telemetry       journal.ClientTelemetry
+		expectedLimited uint64
+		err             error
+	}{
+		{
+			desc: "increment rate limited messages for new client",
+			telemetry: journal.ClientTelemetry{
+				ClientID:            clientID,
+				DomainID:            domainID,
+				RateLimitedMessages: 3,
+				FirstSeen:           firstSeen,
+				LastSeen:            firstSeen,
+			},
+			expectedLimited: 3,
+			err:             nil,
+		},
+		{
+			desc: "increment rate limited messages for existing client",
+			telemetry: journal.ClientTelemetry{
+				ClientID:            clientID,
+				DomainID:            domainID,
+				RateLimitedMessages: 5,
+				FirstSeen:           firstSeen,
+				LastSeen:            firstSeen.Add(time.Hour),
+			},
+			expectedLimited: 8,
+			err:             nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.IncrementRateLimitedMessages(context.Background(), tc.telemetry)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+
+			if err == nil {
+				result, err := repo.RetrieveClientTelemetry(context.Background(), tc.telemetry.ClientID, tc.telemetry.DomainID)
+				require.Nil(t, err)
+				assert.Equal(t, tc.expectedLimited, result.RateLimitedMessages)
+			}
+		})
+	}
+}
+
 func TestIncrementOutboundMessages(t *testing.T) {
 	t.Cleanup(func() {
 		_, err := db.Exec("DELETE FROM subscriptions")
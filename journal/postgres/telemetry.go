@@ -207,13 +207,40 @@ func (repo *repository) IncrementOutboundMessages(ctx context.Context, channelID
 	return nil
 }
 
+func (repo *repository) IncrementRateLimitedMessages(ctx context.Context, ct journal.ClientTelemetry) error {
+	q := `INSERT INTO clients_telemetry (client_id, domain_id, rate_limited_messages, first_seen, last_seen)
+		VALUES (:client_id, :domain_id, :rate_limited_messages, :first_seen, :last_seen)
+		ON CONFLICT (client_id)
+		DO UPDATE SET
+			rate_limited_messages = clients_telemetry.rate_limited_messages + EXCLUDED.rate_limited_messages,
+			last_seen = EXCLUDED.last_seen;
+	`
+
+	dbct, err := toDBClientsTelemetry(ct)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	result, err := repo.db.NamedExecContext(ctx, q, dbct)
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
 type dbClientTelemetry struct {
-	ClientID         string       `db:"client_id"`
-	DomainID         string       `db:"domain_id"`
-	InboundMessages  uint64       `db:"inbound_messages"`
-	OutboundMessages uint64       `db:"outbound_messages"`
-	FirstSeen        time.Time    `db:"first_seen"`
-	LastSeen         sql.NullTime `db:"last_seen"`
+	ClientID            string       `db:"client_id"`
+	DomainID            string       `db:"domain_id"`
+	InboundMessages     uint64       `db:"inbound_messages"`
+	OutboundMessages    uint64       `db:"outbound_messages"`
+	RateLimitedMessages uint64       `db:"rate_limited_messages"`
+	FirstSeen           time.Time    `db:"first_seen"`
+	LastSeen            sql.NullTime `db:"last_seen"`
 }
 
 func toDBClientsTelemetry(ct journal.ClientTelemetry) (dbClientTelemetry, error) {
@@ -228,12 +255,13 @@ func toDBClientsTelemetry(ct journal.ClientTelemetry) (dbClientTelemetry, error)
 	}
 
 	return dbClientTelemetry{
-		ClientID:         ct.ClientID,
-		DomainID:         ct.DomainID,
-		InboundMessages:  ct.InboundMessages,
-		OutboundMessages: ct.OutboundMessages,
-		FirstSeen:        ct.FirstSeen,
-		LastSeen:         lastSeen,
+		ClientID:            ct.ClientID,
+		DomainID:            ct.DomainID,
+		InboundMessages:     ct.InboundMessages,
+		OutboundMessages:    ct.OutboundMessages,
+		RateLimitedMessages: ct.RateLimitedMessages,
+		FirstSeen:           ct.FirstSeen,
+		LastSeen:            lastSeen,
 	}, nil
 }
 
@@ -244,11 +272,12 @@ func toClientsTelemetry(dbct dbClientTelemetry) (journal.ClientTelemetry, error)
 	}
 
 	return journal.ClientTelemetry{
-		ClientID:         dbct.ClientID,
-		DomainID:         dbct.DomainID,
-		InboundMessages:  dbct.InboundMessages,
-		OutboundMessages: dbct.OutboundMessages,
-		FirstSeen:        dbct.FirstSeen,
-		LastSeen:         lastSeen,
+		ClientID:            dbct.ClientID,
+		DomainID:            dbct.DomainID,
+		InboundMessages:     dbct.InboundMessages,
+		OutboundMessages:    dbct.OutboundMessages,
+		RateLimitedMessages: dbct.RateLimitedMessages,
+		FirstSeen:           dbct.FirstSeen,
+		LastSeen:            lastSeen,
 	}, nil
 }
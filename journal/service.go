@@ -23,6 +23,7 @@ const (
 	messagingPublish     = "messaging.client_publish"
 	messagingSubscribe   = "messaging.client_subscribe"
 	messagingUnsubscribe = "messaging.client_unsubscribe"
+	messagingRateLimit   = "messaging.rate_limit"
 )
 
 var (
@@ -108,6 +109,9 @@ func (svc *service) handleTelemetry(ctx context.Context, journal Journal) error
 	case mqttDisconnect:
 		return svc.removeMqttSubscription(ctx, journal)
 
+	case messagingRateLimit:
+		return svc.updateRateLimitedCount(ctx, journal)
+
 	default:
 		return nil
 	}
@@ -233,6 +237,22 @@ func (svc *service) updateMessageCount(ctx context.Context, journal Journal) err
 	return nil
 }
 
+func (svc *service) updateRateLimitedCount(ctx context.Context, journal Journal) error {
+	re, err := toRateLimitEvent(journal)
+	if err != nil {
+		return err
+	}
+	ct := ClientTelemetry{
+		ClientID:            re.clientID,
+		DomainID:            re.domainID,
+		RateLimitedMessages: re.dropped,
+		FirstSeen:           journal.OccurredAt,
+		LastSeen:            journal.OccurredAt,
+	}
+
+	return svc.repository.IncrementRateLimitedMessages(ctx, ct)
+}
+
 type clientEvent struct {
 	id        string
 	domain    string
@@ -383,6 +403,37 @@ func toMqttDisconnectEvent(journal Journal) (adapterEvent, error) {
 	}, nil
 }
 
+type rateLimitEvent struct {
+	clientID string
+	domainID string
+	dropped  uint64
+}
+
+func toRateLimitEvent(journal Journal) (rateLimitEvent, error) {
+	domainID, err := getStringAttribute(journal, "domain")
+	if err != nil {
+		return rateLimitEvent{}, err
+	}
+	clientIDs, _ := journal.Attributes["client_ids"].([]any)
+	if len(clientIDs) != 1 {
+		return rateLimitEvent{}, fmt.Errorf("missing or invalid client_ids attribute")
+	}
+	clientID, ok := clientIDs[0].(string)
+	if !ok {
+		return rateLimitEvent{}, fmt.Errorf("missing or invalid client_ids attribute")
+	}
+	dropped, ok := journal.Attributes["dropped"].(float64)
+	if !ok || dropped < 0 {
+		return rateLimitEvent{}, fmt.Errorf("missing or invalid dropped attribute")
+	}
+
+	return rateLimitEvent{
+		clientID: clientID,
+		domainID: domainID,
+		dropped:  uint64(dropped),
+	}, nil
+}
+
 func getStringAttribute(journal Journal, key string) (string, error) {
 	value, ok := journal.Attributes[key].(string)
 	if !ok {
@@ -69,6 +69,83 @@ func TestSave(t *testing.T) {
 	}
 }
 
+func TestSaveRateLimit(t *testing.T) {
+	repo := new(mocks.Repository)
+	svc := journal.NewService(idProvider, repo)
+
+	clientID := testsutil.GenerateUUID(t)
+	domainID := testsutil.GenerateUUID(t)
+	occurredAt := time.Now().UTC()
+	attributes := func(clientIDs []any, dropped any) map[string]any {
+		return map[string]any{
+			"domain":      domainID,
+			"scope":       "channel",
+			"client_ids":  clientIDs,
+			"channel_ids": []any{testsutil.GenerateUUID(t)},
+			"dropped":     dropped,
+		}
+	}
+
+	cases := []struct {
+		desc       string
+		attributes map[string]any
+		telemetry  journal.ClientTelemetry
+		repoErr    error
+		err        error
+	}{
+		{
+			desc:       "save rate limit journal successfully",
+			attributes: attributes([]any{clientID}, float64(5)),
+			telemetry: journal.ClientTelemetry{
+				ClientID:            clientID,
+				DomainID:            domainID,
+				RateLimitedMessages: 5,
+				FirstSeen:           occurredAt,
+				LastSeen:            occurredAt,
+			},
+		},
+		{
+			desc:       "save rate limit journal with repo error",
+			attributes: attributes([]any{clientID}, float64(5)),
+			telemetry: journal.ClientTelemetry{
+				ClientID:            clientID,
+				DomainID:            domainID,
+				RateLimitedMessages: 5,
+				FirstSeen:           occurredAt,
+				LastSeen:            occurredAt,
+			},
+			repoErr: repoerr.ErrUpdateEntity,
+			err:     repoerr.ErrUpdateEntity,
+		},
+		{
+			desc:       "save rate limit journal without client",
+			attributes: attributes([]any{}, float64(5)),
+			err:        errors.New("failed to handle client telemetry"),
+		},
+		{
+			desc:       "save rate limit journal with invalid dropped count",
+			attributes: attributes([]any{clientID}, "5"),
+			err:        errors.New("failed to handle client telemetry"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			j := journal.Journal{
+				Operation:  "messaging.rate_limit",
+				OccurredAt: occurredAt,
+				Attributes: tc.attributes,
+			}
+			saveCall := repo.On("Save", context.Background(), mock.Anything).Return(nil)
+			incCall := repo.On("IncrementRateLimitedMessages", context.Background(), tc.telemetry).Return(tc.repoErr)
+			err := svc.Save(context.Background(), j)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			saveCall.Unset()
+			incCall.Unset()
+		})
+	}
+}
+
 func TestReadAll(t *testing.T) {
 	repo := new(mocks.Repository)
 	svc := journal.NewService(idProvider, repo)
@@ -0,0 +1,102 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package connections
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+// ClientIDPlaceholder is replaced with the connected client ID in the topic
+// patterns, so that a single connect request can restrict each client to its
+// own subtopics, e.g. "commands/{client_id}/#".
+const ClientIDPlaceholder = "{client_id}"
+
+const (
+	topicSep       = "/"
+	singleWildcard = "+"
+	multiWildcard  = "#"
+)
+
+// ErrInvalidTopic indicates an invalid connection topic pattern.
+var ErrInvalidTopic = errors.New("invalid connection topic")
+
+// ValidateTopics checks the connection topic patterns. The patterns are
+// /-separated subtopics which may contain the MQTT single level (+) and
+// multi level (#) wildcards.
+func ValidateTopics(topics []string) error {
+	for _, topic := range topics {
+		if err := validateTopic(topic); err != nil {
+			return errors.Wrap(ErrInvalidTopic, fmt.Errorf("%q: %w", topic, err))
+		}
+	}
+
+	return nil
+}
+
+func validateTopic(topic string) error {
+	if topic == "" {
+		return errors.New("empty topic")
+	}
+	if strings.ContainsAny(topic, " *>") {
+		return errors.New("invalid characters")
+	}
+	levels := strings.Split(topic, topicSep)
+	for i, level := range levels {
+		switch {
+		case level == "":
+			return errors.New("empty topic level")
+		case level == multiWildcard && i != len(levels)-1:
+			return errors.New("multi level wildcard must be the last level")
+		case len(level) > 1 && strings.ContainsAny(level, singleWildcard+multiWildcard):
+			return errors.New("wildcards must occupy whole levels")
+		}
+	}
+
+	return nil
+}
+
+// AllowsTopic reports whether the connection topic patterns allow the client
+// to use the subtopic. Connections without topic patterns allow all the
+// subtopics. A published subtopic must match one of the patterns, and a
+// subscribed subtopic, which may contain wildcards, must not match anything
+// that none of the patterns match. An empty subtopic is the channel itself.
+func AllowsTopic(topics []string, clientID, subtopic string) bool {
+	if len(topics) == 0 {
+		return true
+	}
+	for _, topic := range topics {
+		topic = strings.ReplaceAll(topic, ClientIDPlaceholder, clientID)
+		if covers(topic, subtopic) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// covers reports whether every topic matched by the filter is matched by the
+// pattern.
+func covers(pattern, filter string) bool {
+	plevels := strings.Split(pattern, topicSep)
+	flevels := strings.Split(filter, topicSep)
+	for i, p := range plevels {
+		switch {
+		case p == multiWildcard:
+			return true
+		case i >= len(flevels):
+			return false
+		case flevels[i] == multiWildcard:
+			return false
+		case p == singleWildcard:
+			continue
+		case p != flevels[i]:
+			return false
+		}
+	}
+
+	return len(plevels) == len(flevels)
+}
@@ -0,0 +1,178 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package connections_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTopics(t *testing.T) {
+	cases := []struct {
+		desc   string
+		topics []string
+		err    error
+	}{
+		{
+			desc:   "validate no topics",
+			topics: nil,
+			err:    nil,
+		},
+		{
+			desc:   "validate topics with wildcards",
+			topics: []string{"sensors/+/temp", "commands/{client_id}/#", "#"},
+			err:    nil,
+		},
+		{
+			desc:   "validate empty topic",
+			topics: []string{""},
+			err:    connections.ErrInvalidTopic,
+		},
+		{
+			desc:   "validate topic with empty level",
+			topics: []string{"sensors//temp"},
+			err:    connections.ErrInvalidTopic,
+		},
+		{
+			desc:   "validate topic with multi level wildcard before the last level",
+			topics: []string{"sensors/#/temp"},
+			err:    connections.ErrInvalidTopic,
+		},
+		{
+			desc:   "validate topic with partial level wildcard",
+			topics: []string{"sensors/room+/temp"},
+			err:    connections.ErrInvalidTopic,
+		},
+		{
+			desc:   "validate topic with NATS wildcard",
+			topics: []string{"sensors.*"},
+			err:    connections.ErrInvalidTopic,
+		},
+		{
+			desc:   "validate topic with space",
+			topics: []string{"sensors/room 1"},
+			err:    connections.ErrInvalidTopic,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := connections.ValidateTopics(tc.topics)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestAllowsTopic(t *testing.T) {
+	clientID := "client"
+
+	cases := []struct {
+		desc     string
+		topics   []string
+		subtopic string
+		allowed  bool
+	}{
+		{
+			desc:     "no topics allow all subtopics",
+			topics:   nil,
+			subtopic: "sensors/room1/temp",
+			allowed:  true,
+		},
+		{
+			desc:     "no topics allow the channel",
+			topics:   nil,
+			subtopic: "",
+			allowed:  true,
+		},
+		{
+			desc:     "single level wildcard matches subtopic",
+			topics:   []string{"sensors/+/temp"},
+			subtopic: "sensors/room1/temp",
+			allowed:  true,
+		},
+		{
+			desc:     "single level wildcard does not match deeper subtopic",
+			topics:   []string{"sensors/+/temp"},
+			subtopic: "sensors/room1/desk/temp",
+			allowed:  false,
+		},
+		{
+			desc:     "single level wildcard covers single level wildcard filter",
+			topics:   []string{"sensors/+/temp"},
+			subtopic: "sensors/+/temp",
+			allowed:  true,
+		},
+		{
+			desc:     "single level wildcard does not cover multi level wildcard filter",
+			topics:   []string{"sensors/+/temp"},
+			subtopic: "sensors/#",
+			allowed:  false,
+		},
+		{
+			desc:     "multi level wildcard matches subtopic",
+			topics:   []string{"commands/#"},
+			subtopic: "commands/reboot/now",
+			allowed:  true,
+		},
+		{
+			desc:     "multi level wildcard covers multi level wildcard filter",
+			topics:   []string{"commands/#"},
+			subtopic: "commands/#",
+			allowed:  true,
+		},
+		{
+			desc:     "multi level wildcard covers all subtopics",
+			topics:   []string{"#"},
+			subtopic: "#",
+			allowed:  true,
+		},
+		{
+			desc:     "multi level wildcard covers the channel",
+			topics:   []string{"#"},
+			subtopic: "",
+			allowed:  true,
+		},
+		{
+			desc:     "topics do not allow the channel",
+			topics:   []string{"sensors/+/temp"},
+			subtopic: "",
+			allowed:  false,
+		},
+		{
+			desc:     "client ID placeholder matches client subtopic",
+			topics:   []string{"commands/{client_id}/#"},
+			subtopic: "commands/client/reboot",
+			allowed:  true,
+		},
+		{
+			desc:     "client ID placeholder does not match other client subtopic",
+			topics:   []string{"commands/{client_id}/#"},
+			subtopic: "commands/other/reboot",
+			allowed:  false,
+		},
+		{
+			desc:     "any of the topics matches subtopic",
+			topics:   []string{"sensors/+/temp", "status"},
+			subtopic: "status",
+			allowed:  true,
+		},
+		{
+			desc:     "exact topic does not match other subtopic",
+			topics:   []string{"status"},
+			subtopic: "status/online",
+			allowed:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			allowed := connections.AllowsTopic(tc.topics, clientID, tc.subtopic)
+			assert.Equal(t, tc.allowed, allowed, fmt.Sprintf("%s: expected %t got %t\n", tc.desc, tc.allowed, allowed))
+		})
+	}
+}
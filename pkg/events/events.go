@@ -5,6 +5,7 @@ package events
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/absmach/magistrala/pkg/messaging"
@@ -32,6 +33,75 @@ type Publisher interface {
 	Close() error
 }
 
+// TxPublisher specifies a Publisher which stores events in a transaction
+// together with the state changes they describe.
+type TxPublisher interface {
+	Publisher
+
+	// Atomic runs fn in a transaction. Events published with the context
+	// passed to fn are delivered only if fn succeeds.
+	Atomic(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type afterCommitKey struct{}
+
+type afterCommit struct {
+	mu  sync.Mutex
+	fns []func(ctx context.Context) error
+}
+
+// Atomic runs fn in a transaction of the publisher if it is a TxPublisher,
+// otherwise it calls fn directly. Functions passed to AfterCommit with the
+// context passed to fn are called once fn succeeds and the transaction is
+// committed.
+func Atomic(ctx context.Context, publisher Publisher, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(afterCommitKey{}).(*afterCommit); ok {
+		// Nested calls are committed with the outermost one.
+		return atomic(ctx, publisher, fn)
+	}
+
+	ac := &afterCommit{}
+	ctx = context.WithValue(ctx, afterCommitKey{}, ac)
+	if err := atomic(ctx, publisher, fn); err != nil {
+		return err
+	}
+
+	ac.mu.Lock()
+	fns := ac.fns
+	ac.mu.Unlock()
+	for _, fn := range fns {
+		if err := fn(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func atomic(ctx context.Context, publisher Publisher, fn func(ctx context.Context) error) error {
+	if tp, ok := publisher.(TxPublisher); ok {
+		return tp.Atomic(ctx, fn)
+	}
+
+	return fn(ctx)
+}
+
+// AfterCommit calls fn once the transaction of the Atomic call the context
+// belongs to is committed, and not at all if it is rolled back. The error of
+// fn is returned by Atomic. Outside of Atomic, fn is called at once.
+func AfterCommit(ctx context.Context, fn func(ctx context.Context) error) error {
+	ac, ok := ctx.Value(afterCommitKey{}).(*afterCommit)
+	if !ok {
+		return fn(ctx)
+	}
+
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	ac.fns = append(ac.fns, fn)
+
+	return nil
+}
+
 // EventHandler represents event handler for Subscriber.
 type EventHandler interface {
 	// Handle handles events passed by underlying implementation.
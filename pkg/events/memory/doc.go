@@ -0,0 +1,8 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package memory contains the domain events store implementation backed by
+// the in-process message broker, for single binary deployments and tests.
+// Event streams are dot-separated, the same as with NATS, and subscriptions
+// support the * and > wildcards.
+package memory
@@ -0,0 +1,54 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/messaging"
+	broker "github.com/absmach/magistrala/pkg/messaging/memory"
+)
+
+var _ events.Publisher = (*pubEventStore)(nil)
+
+type pubEventStore struct {
+	publisher messaging.Publisher
+}
+
+// NewPublisher returns an events publisher backed by the in-process broker
+// at the url.
+func NewPublisher(ctx context.Context, url string) (events.Publisher, error) {
+	publisher, err := broker.NewPublisher(ctx, url, broker.Prefix(eventsPrefix), broker.MaxMessages(maxEvents))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pubEventStore{publisher: publisher}, nil
+}
+
+func (es *pubEventStore) Publish(ctx context.Context, stream string, event events.Event) error {
+	values, err := event.Encode()
+	if err != nil {
+		return err
+	}
+	values["occurred_at"] = time.Now().UnixNano()
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	record := &messaging.Message{
+		Payload: data,
+	}
+
+	return es.publisher.Publish(ctx, toTopic(stream), record)
+}
+
+func (es *pubEventStore) Close() error {
+	return es.publisher.Close()
+}
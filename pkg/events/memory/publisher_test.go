@@ -0,0 +1,196 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package memory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	mglog "github.com/absmach/magistrala/logger"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/memory"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	url      = "memory://events-tests"
+	consumer = "tests-consumer"
+	timeout  = 5 * time.Second
+)
+
+var logger = mglog.NewMock()
+
+type testEvent struct {
+	Data map[string]any
+}
+
+func (te testEvent) Encode() (map[string]any, error) {
+	data := make(map[string]any)
+	for k, v := range te.Data {
+		data[k] = v
+	}
+
+	return data, nil
+}
+
+type handler struct {
+	events chan map[string]any
+}
+
+func (h handler) Handle(_ context.Context, event events.Event) error {
+	data, err := event.Encode()
+	if err != nil {
+		return err
+	}
+	h.events <- data
+
+	return nil
+}
+
+func TestPublish(t *testing.T) {
+	publisher, err := memory.NewPublisher(context.Background(), url)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error on creating event store: %s", err))
+	defer publisher.Close()
+
+	subscriber, err := memory.NewSubscriber(context.Background(), url, logger)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error on creating event store: %s", err))
+	defer subscriber.Close()
+
+	all := handler{events: make(chan map[string]any, 10)}
+	err = subscriber.Subscribe(context.Background(), events.SubscriberConfig{
+		Stream:   ">",
+		Consumer: consumer + "-all",
+		Handler:  all,
+	})
+	require.Nil(t, err, fmt.Sprintf("got unexpected error on subscribing to event store: %s", err))
+
+	clients := handler{events: make(chan map[string]any, 10)}
+	err = subscriber.Subscribe(context.Background(), events.SubscriberConfig{
+		Stream:   "events.magistrala.client.*",
+		Consumer: consumer + "-clients",
+		Handler:  clients,
+		Ordered:  true,
+	})
+	require.Nil(t, err, fmt.Sprintf("got unexpected error on subscribing to event store: %s", err))
+
+	cases := []struct {
+		desc    string
+		stream  string
+		event   map[string]any
+		clients bool
+		err     error
+	}{
+		{
+			desc:    "publish client event",
+			stream:  "magistrala.client.create",
+			event:   map[string]any{"operation": "client.create", "id": "client"},
+			clients: true,
+		},
+		{
+			desc:   "publish channel event",
+			stream: "magistrala.channel.create",
+			event:  map[string]any{"operation": "channel.create", "id": "channel"},
+		},
+		{
+			desc:   "publish event with invalid value",
+			stream: "magistrala.client.create",
+			event:  map[string]any{"location": make(chan int)},
+			err:    fmt.Errorf("json: unsupported type: chan int"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := publisher.Publish(context.Background(), tc.stream, testEvent{Data: tc.event})
+			if tc.err != nil {
+				assert.ErrorContains(t, err, tc.err.Error())
+				return
+			}
+			require.Nil(t, err, fmt.Sprintf("%s: got unexpected error: %s", tc.desc, err))
+
+			event := receive(t, all.events)
+			assert.Equal(t, tc.event["operation"], event["operation"])
+			assert.NotNil(t, event["occurred_at"])
+			if tc.clients {
+				assert.Equal(t, tc.event["id"], receive(t, clients.events)["id"])
+				return
+			}
+			select {
+			case event := <-clients.events:
+				assert.Failf(t, "unexpected event", "received %v", event)
+			case <-time.After(200 * time.Millisecond):
+			}
+		})
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	publisher, err := memory.NewPublisher(context.Background(), url)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error on creating event store: %s", err))
+	defer publisher.Close()
+
+	subscriber, err := memory.NewSubscriber(context.Background(), url, logger)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error on creating event store: %s", err))
+	defer subscriber.Close()
+
+	err = publisher.Publish(context.Background(), "magistrala.domain.create", testEvent{Data: map[string]any{"id": "domain"}})
+	require.Nil(t, err, fmt.Sprintf("got unexpected error on publishing event: %s", err))
+
+	h := handler{events: make(chan map[string]any, 10)}
+	cases := []struct {
+		desc   string
+		config events.SubscriberConfig
+		err    error
+	}{
+		{
+			desc: "subscribe with an empty stream",
+			config: events.SubscriberConfig{
+				Consumer: consumer,
+				Handler:  h,
+			},
+			err: memory.ErrEmptyStream,
+		},
+		{
+			desc: "subscribe with an empty consumer",
+			config: events.SubscriberConfig{
+				Stream:  "events.magistrala.domain.*",
+				Handler: h,
+			},
+			err: memory.ErrEmptyConsumer,
+		},
+		{
+			desc: "subscribe delivering all events",
+			config: events.SubscriberConfig{
+				Stream:         "events.magistrala.domain.*",
+				Consumer:       consumer + "-domains",
+				Handler:        h,
+				DeliveryPolicy: messaging.DeliverAllPolicy,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := subscriber.Subscribe(context.Background(), tc.config)
+			assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, "domain", receive(t, h.events)["id"], "existing events must be delivered")
+			}
+		})
+	}
+}
+
+func receive(t *testing.T, events chan map[string]any) map[string]any {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(timeout):
+		require.FailNow(t, "timed out waiting for event")
+		return nil
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/messaging"
+	broker "github.com/absmach/magistrala/pkg/messaging/memory"
+)
+
+var _ events.Subscriber = (*subEventStore)(nil)
+
+const (
+	eventsPrefix = "events"
+
+	maxEvents = int(events.MaxEventStreamLen)
+)
+
+var (
+	// ErrEmptyStream is returned when stream name is empty.
+	ErrEmptyStream = errors.New("stream name cannot be empty")
+
+	// ErrEmptyConsumer is returned when consumer name is empty.
+	ErrEmptyConsumer = errors.New("consumer name cannot be empty")
+
+	streamReplacer = strings.NewReplacer(".", "/", "*", "+", ">", "#")
+)
+
+type subEventStore struct {
+	pubsub messaging.PubSub
+}
+
+// NewSubscriber returns an events subscriber backed by the in-process broker
+// at the url.
+func NewSubscriber(ctx context.Context, url string, logger *slog.Logger) (events.Subscriber, error) {
+	pubsub, err := broker.NewPubSub(ctx, url, logger, broker.Prefix(eventsPrefix), broker.MaxMessages(maxEvents))
+	if err != nil {
+		return nil, err
+	}
+
+	return &subEventStore{
+		pubsub: pubsub,
+	}, nil
+}
+
+func (es *subEventStore) Subscribe(ctx context.Context, cfg events.SubscriberConfig) error {
+	if cfg.Stream == "" {
+		return ErrEmptyStream
+	}
+	if cfg.Consumer == "" {
+		return ErrEmptyConsumer
+	}
+
+	subCfg := messaging.SubscriberConfig{
+		ID:    cfg.Consumer,
+		Topic: eventsPrefix + "/" + toTopic(strings.TrimPrefix(cfg.Stream, eventsPrefix+".")),
+		Handler: &eventHandler{
+			handler: cfg.Handler,
+			ctx:     ctx,
+		},
+		DeliveryPolicy: cfg.DeliveryPolicy,
+		Ordered:        cfg.Ordered,
+	}
+
+	return es.pubsub.Subscribe(ctx, subCfg)
+}
+
+func (es *subEventStore) Close() error {
+	return es.pubsub.Close()
+}
+
+// toTopic converts the dot-separated stream with NATS wildcards to a
+// /-separated topic with MQTT wildcards.
+func toTopic(stream string) string {
+	return streamReplacer.Replace(stream)
+}
+
+type event struct {
+	Data map[string]any
+}
+
+func (re event) Encode() (map[string]any, error) {
+	return re.Data, nil
+}
+
+type eventHandler struct {
+	handler events.EventHandler
+	ctx     context.Context
+}
+
+func (eh *eventHandler) Handle(msg *messaging.Message) error {
+	event := event{
+		Data: make(map[string]any),
+	}
+
+	if err := json.Unmarshal(msg.GetPayload(), &event.Data); err != nil {
+		return messaging.NewError(err, messaging.Term)
+	}
+
+	if err := eh.handler.Handle(eh.ctx, event); err != nil {
+		return fmt.Errorf("failed to handle event: %w", err)
+	}
+
+	return nil
+}
+
+func (eh *eventHandler) Cancel() error {
+	return nil
+}
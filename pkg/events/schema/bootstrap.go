@@ -0,0 +1,75 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+const (
+	configPrefix   = "bootstrap.config."
+	profilePrefix  = "bootstrap.profile."
+	bindingsPrefix = "bootstrap.bindings."
+)
+
+var config = Fields{
+	"config_id":   Optional(String),
+	"content":     Optional(String),
+	"domain_id":   Optional(String),
+	"name":        Optional(String),
+	"external_id": Optional(String),
+	"client_cert": Optional(String),
+	"client_key":  Optional(String),
+	"ca_cert":     Optional(String),
+}
+
+var configID = Fields{
+	"config_id": Required(String),
+}
+
+var profile = Fields{
+	"profile_id": Optional(String),
+	"domain_id":  Optional(String),
+	"name":       Optional(String),
+}
+
+var bootstrapSchemas = []Schema{
+	New(configPrefix+"create", 1, config, Fields{
+		"status": Required(String),
+	}),
+	New(configPrefix+"view", 1, config, Fields{
+		"status": Required(String),
+	}),
+	New(configPrefix+"update", 1, config, Fields{
+		"status": Required(String),
+	}),
+	New(configPrefix+"remove", 1, configID),
+	New(configPrefix+"list", 1, Fields{
+		"offset":     Required(Integer),
+		"limit":      Required(Integer),
+		"full_match": Optional(Object),
+	}),
+	New(configPrefix+"enable", 1, configID),
+	New(configPrefix+"disable", 1, configID),
+	New("bootstrap.client.bootstrap", 1, config, Fields{
+		"external_id": Required(String),
+		"success":     Required(Boolean),
+	}),
+	New("bootstrap.cert.update", 1, configID, Fields{
+		"client_cert": Required(String),
+		"client_key":  Required(String),
+		"ca_cert":     Required(String),
+	}),
+	New(profilePrefix+"create", 1, profile),
+	New(profilePrefix+"view", 1, profile),
+	New(profilePrefix+"update", 1, profile),
+	New(profilePrefix+"list", 1, profile),
+	New(profilePrefix+"delete", 1, Fields{
+		"profile_id": Required(String),
+	}),
+	New(profilePrefix+"assign", 1, configID, Fields{
+		"profile_id": Required(String),
+	}),
+	New(bindingsPrefix+"bind", 1, configID, Fields{
+		"slots": Required(Strings),
+	}),
+	New(bindingsPrefix+"list", 1, configID),
+	New(bindingsPrefix+"refresh", 1, configID),
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+const channelPrefix = "channel."
+
+var channel = Fields{
+	"id":         Optional(String),
+	"name":       Optional(String),
+	"route":      Optional(String),
+	"tags":       Optional(Strings),
+	"metadata":   Optional(Object),
+	"status":     Optional(String),
+	"created_at": Optional(Time),
+	"updated_at": Optional(Time),
+	"updated_by": Optional(String),
+}
+
+var listChannels = Fields{
+	"tag": Optional(Strings),
+	"ids": Optional(Strings),
+}
+
+var connections = Fields{
+	"client_ids":  Required(Strings),
+	"channel_ids": Required(Strings),
+	"types":       Required(Strings),
+}
+
+var channelSchemas = []Schema{
+	New(channelPrefix+"create", 1, channel, session, Fields{
+		"id":                Required(String),
+		"route":             Required(String),
+		"status":            Required(String),
+		"created_at":        Required(Time),
+		"roles_provisioned": Required(Array),
+	}),
+	New(channelPrefix+"update", 1, channel, session, updateEntity),
+	New(channelPrefix+"update_tags", 1, channel, session, updateEntity),
+	New(channelPrefix+"enable", 1, session, changeStatus),
+	New(channelPrefix+"disable", 1, session, changeStatus),
+	New(channelPrefix+"view", 1, channel, session, Fields{
+		"id": Required(String),
+	}),
+	New(channelPrefix+"list", 1, page, session, listChannels),
+	New(channelPrefix+"list_by_user", 1, page, session, listChannels, Fields{
+		"req_user_id": Required(String),
+	}),
+	New(channelPrefix+"remove", 1, session, Fields{
+		"id": Required(String),
+	}),
+	New(channelPrefix+"connect", 1, session, connections, Fields{
+		"topics": Optional(Strings),
+	}),
+	New(channelPrefix+"disconnect", 1, session, connections),
+	New(channelPrefix+"set_parent", 1, session, setParent),
+	New(channelPrefix+"remove_parent", 1, session, Fields{
+		"id": Required(String),
+	}),
+	// Published periodically by the writers with the number of messages of
+	// a channel publisher which did not match the channel payload schema,
+	// and the last validation error.
+	New(channelPrefix+"payload_reject", 1, Fields{
+		"id":        Required(String),
+		"domain":    Required(String),
+		"publisher": Required(String),
+		"protocol":  Required(String),
+		"subtopic":  Optional(String),
+		"rejected":  Required(Integer),
+		"error":     Required(String),
+	}),
+}
@@ -0,0 +1,74 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+const clientPrefix = "client."
+
+var client = Fields{
+	"id":               Optional(String),
+	"name":             Optional(String),
+	"tags":             Optional(Strings),
+	"identity":         Optional(String),
+	"metadata":         Optional(Object),
+	"private_metadata": Optional(Object),
+	"status":           Optional(String),
+	"created_at":       Optional(Time),
+	"updated_at":       Optional(Time),
+	"updated_by":       Optional(String),
+}
+
+var listClients = Fields{
+	"tag":      Optional(Strings),
+	"ids":      Optional(Strings),
+	"identity": Optional(String),
+}
+
+var clientSchemas = []Schema{
+	New(clientPrefix+"create", 1, client, session, Fields{
+		"id":                Required(String),
+		"status":            Required(String),
+		"created_at":        Required(Time),
+		"roles_provisioned": Required(Array),
+	}),
+	New(clientPrefix+"update", 1, client, session, updateEntity),
+	New(clientPrefix+"update_tags", 1, client, session, updateEntity),
+	New(clientPrefix+"update_secret", 1, client, session, updateEntity),
+	New(clientPrefix+"rotate_secret", 1, client, session, updateEntity, Fields{
+		"previous_secret_expires_at": Required(Time),
+	}),
+	// Previous secrets are dropped by the service, so the event has no session.
+	New(clientPrefix+"drop_secret", 1, Fields{
+		"id":                         Required(String),
+		"domain":                     Required(String),
+		"previous_secret_expires_at": Required(Time),
+	}),
+	// Offline clients are reported from their MQTT sessions, so the event has
+	// no request session.
+	New(clientPrefix+"offline", 1, Fields{
+		"id":                Required(String),
+		"domain":            Required(String),
+		"connected_at":      Required(Time),
+		"disconnected_at":   Required(Time),
+		"disconnect_reason": Optional(String),
+	}),
+	New(clientPrefix+"enable", 1, session, changeStatus),
+	New(clientPrefix+"disable", 1, session, changeStatus),
+	New(clientPrefix+"view", 1, client, session, Fields{
+		"id": Required(String),
+	}),
+	// Listing clients of a user is published with the list operation.
+	New(clientPrefix+"list", 1, page, session, listClients, Fields{
+		"req_user_id": Optional(String),
+	}),
+	New(clientPrefix+"list_by_user", 1, page, session, listClients, Fields{
+		"req_user_id": Required(String),
+	}),
+	New(clientPrefix+"remove", 1, session, Fields{
+		"id": Required(String),
+	}),
+	New(clientPrefix+"set_parent", 1, session, setParent),
+	New(clientPrefix+"remove_parent", 1, session, Fields{
+		"id": Required(String),
+	}),
+}
@@ -0,0 +1,12 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package schema contains the typed and versioned schemas of the events
+// published by Magistrala services. Events are validated against the
+// schema of their operation at publish time and stamped with the schema
+// version, so consumers can decode them without hand-rolled parsing.
+// Schemas can be exported as JSON Schema documents for external consumers.
+//
+// Every service tests its events with ValidateEvent, so a schema mismatch
+// fails the build instead of the request publishing the event.
+package schema
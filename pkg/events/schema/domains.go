@@ -0,0 +1,103 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+const (
+	domainPrefix     = "domain."
+	invitationPrefix = "invitation."
+)
+
+// domainSession holds the session fields of the domain events, which are
+// published with the domain ID instead of the session domain.
+var domainSession = Fields{
+	"user_id":     Required(String),
+	"token_type":  Required(String),
+	"super_admin": Required(Boolean),
+	"request_id":  Required(String),
+}
+
+var domain = Fields{
+	"id":         Required(String),
+	"name":       Optional(String),
+	"route":      Required(String),
+	"tags":       Optional(Strings),
+	"metadata":   Optional(Object),
+	"status":     Required(String),
+	"created_at": Required(Time),
+	"created_by": Optional(String),
+	"updated_at": Optional(Time),
+	"updated_by": Optional(String),
+}
+
+var changeDomainStatus = Fields{
+	"id":         Required(String),
+	"updated_at": Required(Time),
+	"updated_by": Required(String),
+}
+
+var invitation = Fields{
+	"domain_id":       Required(String),
+	"invitee_user_id": Required(String),
+	"invited_by":      Required(String),
+	"role_id":         Required(String),
+	"domain_name":     Optional(String),
+	"role_name":       Optional(String),
+	"token_type":      Required(String),
+	"super_admin":     Required(Boolean),
+	"request_id":      Required(String),
+}
+
+var listInvitations = Fields{
+	"offset":          Required(Integer),
+	"limit":           Required(Integer),
+	"invited_by":      Optional(String),
+	"invitee_user_id": Optional(String),
+	"domain_id":       Optional(String),
+	"role_id":         Optional(String),
+	"state":           Optional(String),
+	"token_type":      Required(String),
+	"request_id":      Required(String),
+}
+
+var domainSchemas = []Schema{
+	New(domainPrefix+"create", 1, domain, domainSession, Fields{
+		"created_by":        Required(String),
+		"roles_provisioned": Required(Array),
+	}),
+	New(domainPrefix+"retrieve", 1, domain, domainSession),
+	New(domainPrefix+"update", 1, domain, domainSession, Fields{
+		"created_by": Required(String),
+		"updated_at": Required(Time),
+		"updated_by": Required(String),
+	}),
+	New(domainPrefix+"enable", 1, domainSession, changeDomainStatus),
+	New(domainPrefix+"disable", 1, domainSession, changeDomainStatus),
+	New(domainPrefix+"freeze", 1, domainSession, changeDomainStatus),
+	New(domainPrefix+"list", 1, page, domainSession, Fields{
+		"id":        Optional(String),
+		"tag":       Optional(Strings),
+		"role_id":   Optional(String),
+		"role_name": Optional(String),
+		"actions":   Optional(Strings),
+		"ids":       Optional(Strings),
+		"identity":  Optional(String),
+	}),
+	New(invitationPrefix+"send", 1, invitation),
+	New(invitationPrefix+"accept", 1, invitation),
+	New(invitationPrefix+"reject", 1, invitation),
+	New(invitationPrefix+"list", 1, listInvitations, Fields{
+		"user_id": Required(String),
+	}),
+	New(invitationPrefix+"list_domain", 1, listInvitations, Fields{
+		"domain_id":   Required(String),
+		"super_admin": Required(Boolean),
+	}),
+	New(invitationPrefix+"delete", 1, Fields{
+		"domain_id":       Required(String),
+		"invitee_user_id": Required(String),
+		"token_type":      Required(String),
+		"super_admin":     Required(Boolean),
+		"request_id":      Required(String),
+	}),
+}
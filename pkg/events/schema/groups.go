@@ -0,0 +1,76 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+const groupPrefix = "group."
+
+var group = Fields{
+	"id":          Optional(String),
+	"parent":      Optional(String),
+	"name":        Optional(String),
+	"description": Optional(String),
+	"tags":        Optional(Strings),
+	"metadata":    Optional(Object),
+	"status":      Optional(String),
+	"created_at":  Optional(Time),
+	"updated_at":  Optional(Time),
+	"updated_by":  Optional(String),
+}
+
+var listGroups = Fields{
+	"tag": Optional(Strings),
+}
+
+var groupChildren = Fields{
+	"id":           Required(String),
+	"children_ids": Required(Strings),
+}
+
+var groupSchemas = []Schema{
+	New(groupPrefix+"create", 1, group, session, Fields{
+		"id":                Required(String),
+		"status":            Required(String),
+		"created_at":        Required(Time),
+		"roles_provisioned": Required(Array),
+	}),
+	New(groupPrefix+"update", 1, group, session, updateEntity, Fields{
+		"tags": Required(Strings),
+	}),
+	New(groupPrefix+"update_tags", 1, group, session, updateEntity, Fields{
+		"tags": Required(Strings),
+	}),
+	New(groupPrefix+"enable", 1, session, changeStatus),
+	New(groupPrefix+"disable", 1, session, changeStatus),
+	New(groupPrefix+"view", 1, group, session, Fields{
+		"id": Required(String),
+	}),
+	New(groupPrefix+"list", 1, page, session, listGroups),
+	New(groupPrefix+"list_user_groups", 1, page, session, listGroups),
+	New(groupPrefix+"remove", 1, session, Fields{
+		"id": Required(String),
+	}),
+	New(groupPrefix+"retrieve_group_hierarchy", 1, session, Fields{
+		"id":        Required(String),
+		"level":     Required(Integer),
+		"direction": Required(Integer),
+		"tree":      Required(Boolean),
+	}),
+	New(groupPrefix+"add_parent_group", 1, session, Fields{
+		"id":        Required(String),
+		"parent_id": Required(String),
+	}),
+	New(groupPrefix+"remove_parent_group", 1, session, Fields{
+		"id": Required(String),
+	}),
+	New(groupPrefix+"add_children_groups", 1, session, groupChildren),
+	New(groupPrefix+"remove_children_groups", 1, session, groupChildren),
+	New(groupPrefix+"remove_all_children_groups", 1, session, Fields{
+		"id": Required(String),
+	}),
+	New(groupPrefix+"list_children_groups", 1, page, session, listGroups, Fields{
+		"id":          Required(String),
+		"start_level": Required(Integer),
+		"end_level":   Required(Integer),
+	}),
+}
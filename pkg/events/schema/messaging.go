@@ -0,0 +1,54 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+const (
+	messagingPrefix = "messaging."
+	mqttPrefix      = "mqtt."
+)
+
+var subscription = Fields{
+	"subscriber_id": Required(String),
+	"client_id":     Required(String),
+	"topic":         Required(String),
+}
+
+// messagingSchemas describe the events of the messaging adapters, of the
+// MQTT broker, which publishes client sessions events, and of the FluxMQ
+// rate limiter.
+var messagingSchemas = []Schema{
+	New(messagingPrefix+"client_publish", 1, Fields{
+		"domain_id":  Required(String),
+		"channel_id": Required(String),
+		"client_id":  Required(String),
+		"subtopic":   Required(String),
+	}),
+	New(messagingPrefix+"client_subscribe", 1, subscription),
+	New(messagingPrefix+"rate_limit", 1, Fields{
+		"domain":           Required(String),
+		"scope":            Required(String),
+		"client_ids":       Required(Strings),
+		"channel_ids":      Required(Strings),
+		"dropped":          Required(Integer),
+		"messages_per_sec": Optional(Number),
+		"bytes_per_sec":    Optional(Number),
+	}),
+	New(messagingPrefix+"client_unsubscribe", 1, subscription),
+	New(mqttPrefix+"client_subscribe", 1, Fields{
+		"subscriber_id": Required(String),
+		"client_id":     Required(String),
+		"channel_id":    Required(String),
+		"subtopic":      Required(String),
+	}),
+	New(mqttPrefix+"client_connect", 1, Fields{
+		"subscriber_id": Required(String),
+		"client_id":     Required(String),
+		"domain_id":     Required(String),
+	}),
+	New(mqttPrefix+"client_disconnect", 1, Fields{
+		"subscriber_id": Required(String),
+		"client_id":     Required(String),
+		"reason":        Optional(String),
+	}),
+}
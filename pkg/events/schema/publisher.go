@@ -0,0 +1,57 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"context"
+
+	"github.com/absmach/magistrala/pkg/events"
+)
+
+var _ events.TxPublisher = (*publisherMiddleware)(nil)
+
+type publisherMiddleware struct {
+	registry  *Registry
+	publisher events.Publisher
+}
+
+// NewPublisher returns a publisher which validates events against the
+// latest schema of their operation in the default registry and stamps
+// them with the schema version. Events which do not match their schema
+// are not published.
+func NewPublisher(publisher events.Publisher) events.TxPublisher {
+	return NewRegistryPublisher(registry, publisher)
+}
+
+// NewRegistryPublisher returns a validating publisher using the registry.
+func NewRegistryPublisher(registry *Registry, publisher events.Publisher) events.TxPublisher {
+	return &publisherMiddleware{
+		registry:  registry,
+		publisher: publisher,
+	}
+}
+
+func (pm *publisherMiddleware) Publish(ctx context.Context, stream string, event events.Event) error {
+	values, s, err := pm.registry.ValidateEvent(event)
+	if err != nil {
+		return err
+	}
+	values[VersionKey] = s.Version
+
+	return pm.publisher.Publish(ctx, stream, versionedEvent(values))
+}
+
+func (pm *publisherMiddleware) Atomic(ctx context.Context, fn func(ctx context.Context) error) error {
+	return events.Atomic(ctx, pm.publisher, fn)
+}
+
+func (pm *publisherMiddleware) Close() error {
+	return pm.publisher.Close()
+}
+
+type versionedEvent map[string]any
+
+func (ve versionedEvent) Encode() (map[string]any, error) {
+	return ve, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/mocks"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const stream = "magistrala.thing.create"
+
+type testEvent struct {
+	data map[string]any
+	err  error
+}
+
+func (te testEvent) Encode() (map[string]any, error) {
+	return te.data, te.err
+}
+
+func TestPublish(t *testing.T) {
+	errEncode := errors.New("encode error")
+
+	cases := []struct {
+		desc   string
+		event  testEvent
+		pubErr error
+		err    error
+	}{
+		{
+			desc:  "publish valid event",
+			event: testEvent{data: map[string]any{"operation": "thing.create", "id": "id", "count": 1, "name": "name"}},
+		},
+		{
+			desc:   "publish valid event with publisher error",
+			event:  testEvent{data: map[string]any{"operation": "thing.create", "id": "id", "count": 1, "name": "name"}},
+			pubErr: errors.New("publish error"),
+			err:    errors.New("publish error"),
+		},
+		{
+			desc:  "publish event not matching latest schema",
+			event: testEvent{data: map[string]any{"operation": "thing.create", "id": "id", "count": 1}},
+			err:   schema.ErrInvalidEvent,
+		},
+		{
+			desc:  "publish event with unknown operation",
+			event: testEvent{data: map[string]any{"operation": "thing.update", "id": "id"}},
+			err:   schema.ErrUnknownOperation,
+		},
+		{
+			desc:  "publish event without operation",
+			event: testEvent{data: map[string]any{"id": "id"}},
+			err:   schema.ErrMissingOperation,
+		},
+		{
+			desc:  "publish event with encode error",
+			event: testEvent{err: errEncode},
+			err:   errEncode,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			pub := new(mocks.Publisher)
+			var published map[string]any
+			pubCall := pub.On("Publish", mock.Anything, stream, mock.Anything).Run(func(args mock.Arguments) {
+				published, _ = args.Get(2).(events.Event).Encode()
+			}).Return(tc.pubErr)
+
+			p := schema.NewRegistryPublisher(newRegistry(t), pub)
+			err := p.Publish(context.Background(), stream, tc.event)
+			switch tc.err {
+			case nil:
+				assert.Nil(t, err, fmt.Sprintf("publish expected to succeed: %s", err))
+				assert.Equal(t, uint32(2), published[schema.VersionKey])
+				assert.Equal(t, "thing.create", published[schema.OperationKey])
+			default:
+				assert.ErrorContains(t, err, tc.err.Error())
+			}
+			if tc.err == nil || tc.pubErr != nil {
+				pub.AssertCalled(t, "Publish", mock.Anything, stream, mock.Anything)
+			} else {
+				pub.AssertNotCalled(t, "Publish", mock.Anything, stream, mock.Anything)
+			}
+			pubCall.Unset()
+		})
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/events"
+)
+
+// defVersion is the version of events published without schema version,
+// i.e. before the events were versioned.
+const defVersion uint32 = 1
+
+var (
+	// ErrUnknownOperation indicates an event operation without schema.
+	ErrUnknownOperation = errors.New("unknown event operation")
+
+	// ErrUnknownVersion indicates an event schema version which is not registered.
+	ErrUnknownVersion = errors.New("unknown event schema version")
+
+	// ErrDuplicateSchema indicates that the schema version is already registered.
+	ErrDuplicateSchema = errors.New("event schema version already registered")
+
+	// ErrMissingOccurredAt indicates an event without publish time.
+	ErrMissingOccurredAt = errors.New("missing or invalid event occurred_at")
+)
+
+// registry holds the schemas of the events published by Magistrala services.
+var registry = NewRegistry()
+
+// Register adds the schemas to the default registry.
+func Register(schemas ...Schema) error {
+	return registry.Register(schemas...)
+}
+
+// Lookup returns the schema of the operation at the given version from
+// the default registry.
+func Lookup(operation string, version uint32) (Schema, error) {
+	return registry.Lookup(operation, version)
+}
+
+// Latest returns the latest schema version of the operation from the
+// default registry.
+func Latest(operation string) (Schema, error) {
+	return registry.Latest(operation)
+}
+
+// Schemas returns all the schemas of the default registry.
+func Schemas() []Schema {
+	return registry.Schemas()
+}
+
+// Validate checks JSON decoded event values against the default registry.
+func Validate(values map[string]any) (Schema, error) {
+	return registry.Validate(values)
+}
+
+// ValidateEvent checks the encoded event against the latest schema of its
+// operation in the default registry.
+func ValidateEvent(event events.Event) (map[string]any, Schema, error) {
+	return registry.ValidateEvent(event)
+}
+
+// Decode decodes JSON decoded event values using the default registry.
+func Decode(values map[string]any) (Record, error) {
+	return registry.Decode(values)
+}
+
+// Registry holds the event schemas by operation and version.
+type Registry struct {
+	mu      sync.RWMutex
+	schemas map[string]map[uint32]Schema
+}
+
+// NewRegistry returns an empty schema registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		schemas: make(map[string]map[uint32]Schema),
+	}
+}
+
+// Register adds the schemas to the registry.
+func (r *Registry) Register(schemas ...Schema) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, s := range schemas {
+		if s.Operation == "" {
+			return ErrMissingOperation
+		}
+		if s.Version == 0 {
+			return errors.Wrap(ErrUnknownVersion, fmt.Errorf("%s: version must be positive", s.Operation))
+		}
+		versions, ok := r.schemas[s.Operation]
+		if !ok {
+			versions = make(map[uint32]Schema)
+			r.schemas[s.Operation] = versions
+		}
+		if _, ok := versions[s.Version]; ok {
+			return errors.Wrap(ErrDuplicateSchema, fmt.Errorf("%s v%d", s.Operation, s.Version))
+		}
+		versions[s.Version] = s
+	}
+
+	return nil
+}
+
+// ValidateEvent encodes the event and checks the values, as consumers will
+// see them once JSON decoded, against the latest schema of the event
+// operation. It returns the encoded values and the schema.
+func (r *Registry) ValidateEvent(event events.Event) (map[string]any, Schema, error) {
+	values, err := event.Encode()
+	if err != nil {
+		return nil, Schema{}, err
+	}
+	operation, ok := values[OperationKey].(string)
+	if !ok || operation == "" {
+		return nil, Schema{}, ErrMissingOperation
+	}
+	s, err := r.Latest(operation)
+	if err != nil {
+		return nil, Schema{}, err
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, Schema{}, err
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, Schema{}, err
+	}
+	if err := s.Validate(decoded); err != nil {
+		return nil, Schema{}, err
+	}
+
+	return values, s, nil
+}
+
+// Lookup returns the schema of the operation at the given version.
+func (r *Registry) Lookup(operation string, version uint32) (Schema, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	versions, ok := r.schemas[operation]
+	if !ok {
+		return Schema{}, errors.Wrap(ErrUnknownOperation, fmt.Errorf("%q", operation))
+	}
+	s, ok := versions[version]
+	if !ok {
+		return Schema{}, errors.Wrap(ErrUnknownVersion, fmt.Errorf("%s v%d", operation, version))
+	}
+
+	return s, nil
+}
+
+// Latest returns the latest schema version of the operation.
+func (r *Registry) Latest(operation string) (Schema, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	versions, ok := r.schemas[operation]
+	if !ok {
+		return Schema{}, errors.Wrap(ErrUnknownOperation, fmt.Errorf("%q", operation))
+	}
+
+	return versions[slices.Max(slices.Collect(maps.Keys(versions)))], nil
+}
+
+// Schemas returns all the registered schemas ordered by operation and version.
+func (r *Registry) Schemas() []Schema {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var schemas []Schema
+	for _, versions := range r.schemas {
+		for _, s := range versions {
+			schemas = append(schemas, s)
+		}
+	}
+	slices.SortFunc(schemas, func(a, b Schema) int {
+		if c := strings.Compare(a.Operation, b.Operation); c != 0 {
+			return c
+		}
+		return int(a.Version) - int(b.Version)
+	})
+
+	return schemas
+}
+
+// Validate checks JSON decoded event values against the schema of the
+// event operation and version, and returns the schema. Events without
+// schema version were published before the events were versioned, and are
+// validated against the first schema version.
+func (r *Registry) Validate(values map[string]any) (Schema, error) {
+	operation, ok := values[OperationKey].(string)
+	if !ok || operation == "" {
+		return Schema{}, ErrMissingOperation
+	}
+
+	version := defVersion
+	if v, ok := values[VersionKey]; ok {
+		vf, ok := v.(float64)
+		if !ok || vf < 1 || !Integer.matches(v) {
+			return Schema{}, errors.Wrap(ErrUnknownVersion, fmt.Errorf("%s: invalid version %v", operation, v))
+		}
+		version = uint32(vf)
+	}
+	s, err := r.Lookup(operation, version)
+	if err != nil {
+		return Schema{}, err
+	}
+	if err := s.Validate(values); err != nil {
+		return Schema{}, err
+	}
+
+	return s, nil
+}
+
+// Decode validates the JSON decoded event consumed from the events store
+// and returns it as a record.
+func (r *Registry) Decode(values map[string]any) (Record, error) {
+	s, err := r.Validate(values)
+	if err != nil {
+		return Record{}, err
+	}
+	occurredAt, ok := values[OccurredAtKey].(float64)
+	if !ok || occurredAt <= 0 {
+		return Record{}, ErrMissingOccurredAt
+	}
+
+	attrs := maps.Clone(values)
+	delete(attrs, OperationKey)
+	delete(attrs, OccurredAtKey)
+	delete(attrs, VersionKey)
+
+	return Record{
+		Operation:  s.Operation,
+		Version:    s.Version,
+		OccurredAt: time.Unix(0, int64(occurredAt)),
+		Attributes: attrs,
+		schema:     s,
+	}, nil
+}
+
+// Record is a decoded event. Attributes hold the JSON decoded event fields
+// other than operation, publish time and schema version, and can be read
+// as their schema types using the Record getters.
+type Record struct {
+	Operation  string
+	Version    uint32
+	OccurredAt time.Time
+	Attributes map[string]any
+	schema     Schema
+}
+
+// Schema returns the schema the record was decoded with.
+func (r Record) Schema() Schema {
+	return r.schema
+}
+
+// String returns the string field, or empty string if the field is not set.
+func (r Record) String(key string) string {
+	val, _ := r.Attributes[key].(string)
+	return val
+}
+
+// Integer returns the integer field, or zero if the field is not set.
+func (r Record) Integer(key string) int64 {
+	val, _ := r.Attributes[key].(float64)
+	return int64(val)
+}
+
+// Number returns the number field, or zero if the field is not set.
+func (r Record) Number(key string) float64 {
+	val, _ := r.Attributes[key].(float64)
+	return val
+}
+
+// Boolean returns the boolean field, or false if the field is not set.
+func (r Record) Boolean(key string) bool {
+	val, _ := r.Attributes[key].(bool)
+	return val
+}
+
+// Object returns the object field, or nil if the field is not set.
+func (r Record) Object(key string) map[string]any {
+	val, _ := r.Attributes[key].(map[string]any)
+	return val
+}
+
+// Strings returns the string array field, or nil if the field is not set.
+func (r Record) Strings(key string) []string {
+	vals, _ := r.Attributes[key].([]any)
+	var res []string
+	for _, v := range vals {
+		if s, ok := v.(string); ok {
+			res = append(res, s)
+		}
+	}
+
+	return res
+}
+
+// Time returns the time field, or zero time if the field is not set.
+func (r Record) Time(key string) time.Time {
+	val, _ := r.Attributes[key].(string)
+	t, err := time.Parse(time.RFC3339Nano, val)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
@@ -0,0 +1,182 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRegistry(t *testing.T) *schema.Registry {
+	r := schema.NewRegistry()
+	err := r.Register(
+		testSchema,
+		schema.New("thing.create", 2, testSchema.Fields, schema.Fields{
+			"name": schema.Required(schema.String),
+		}),
+	)
+	require.Nil(t, err, fmt.Sprintf("register expected to succeed: %s", err))
+
+	return r
+}
+
+func TestRegister(t *testing.T) {
+	r := newRegistry(t)
+
+	err := r.Register(testSchema)
+	assert.True(t, errors.Contains(err, schema.ErrDuplicateSchema), fmt.Sprintf("expected %s, got %s", schema.ErrDuplicateSchema, err))
+	err = r.Register(schema.New("", 1))
+	assert.True(t, errors.Contains(err, schema.ErrMissingOperation), fmt.Sprintf("expected %s, got %s", schema.ErrMissingOperation, err))
+	err = r.Register(schema.New("thing.remove", 0))
+	assert.True(t, errors.Contains(err, schema.ErrUnknownVersion), fmt.Sprintf("expected %s, got %s", schema.ErrUnknownVersion, err))
+
+	s, err := r.Lookup("thing.create", 1)
+	assert.Nil(t, err, fmt.Sprintf("lookup expected to succeed: %s", err))
+	assert.Equal(t, testSchema, s)
+	_, err = r.Lookup("thing.create", 3)
+	assert.True(t, errors.Contains(err, schema.ErrUnknownVersion), fmt.Sprintf("expected %s, got %s", schema.ErrUnknownVersion, err))
+	_, err = r.Lookup("thing.update", 1)
+	assert.True(t, errors.Contains(err, schema.ErrUnknownOperation), fmt.Sprintf("expected %s, got %s", schema.ErrUnknownOperation, err))
+
+	s, err = r.Latest("thing.create")
+	assert.Nil(t, err, fmt.Sprintf("latest expected to succeed: %s", err))
+	assert.Equal(t, uint32(2), s.Version)
+
+	assert.Len(t, r.Schemas(), 2)
+}
+
+func TestDecode(t *testing.T) {
+	r := newRegistry(t)
+	now := time.Now().UTC()
+
+	cases := []struct {
+		desc    string
+		values  map[string]any
+		version uint32
+		err     error
+	}{
+		{
+			desc: "decode versioned event",
+			values: map[string]any{
+				"operation":      "thing.create",
+				"occurred_at":    now.UnixNano(),
+				"schema_version": 2,
+				"id":             "id",
+				"name":           "name",
+				"count":          3,
+				"tags":           []string{"a", "b"},
+				"created_at":     now,
+			},
+			version: 2,
+		},
+		{
+			desc: "decode event without version",
+			values: map[string]any{
+				"operation":   "thing.create",
+				"occurred_at": now.UnixNano(),
+				"id":          "id",
+				"count":       3,
+				"tags":        []string{"a", "b"},
+				"created_at":  now,
+			},
+			version: 1,
+		},
+		{
+			desc: "decode event not matching version schema",
+			values: map[string]any{
+				"operation":      "thing.create",
+				"occurred_at":    now.UnixNano(),
+				"schema_version": 2,
+				"id":             "id",
+				"count":          3,
+			},
+			err: schema.ErrInvalidEvent,
+		},
+		{
+			desc: "decode event with unknown version",
+			values: map[string]any{
+				"operation":      "thing.create",
+				"occurred_at":    now.UnixNano(),
+				"schema_version": 5,
+				"id":             "id",
+				"count":          3,
+			},
+			err: schema.ErrUnknownVersion,
+		},
+		{
+			desc: "decode event with invalid version",
+			values: map[string]any{
+				"operation":      "thing.create",
+				"occurred_at":    now.UnixNano(),
+				"schema_version": "2",
+			},
+			err: schema.ErrUnknownVersion,
+		},
+		{
+			desc: "decode event with unknown operation",
+			values: map[string]any{
+				"operation":   "thing.update",
+				"occurred_at": now.UnixNano(),
+			},
+			err: schema.ErrUnknownOperation,
+		},
+		{
+			desc: "decode event without operation",
+			values: map[string]any{
+				"occurred_at": now.UnixNano(),
+			},
+			err: schema.ErrMissingOperation,
+		},
+		{
+			desc: "decode event without occurred_at",
+			values: map[string]any{
+				"operation": "thing.create",
+				"id":        "id",
+				"count":     3,
+			},
+			err: schema.ErrMissingOccurredAt,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			rec, err := r.Decode(decode(t, tc.values))
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v, got %v", tc.err, err))
+			if tc.err != nil {
+				return
+			}
+			assert.Equal(t, "thing.create", rec.Operation)
+			assert.Equal(t, tc.version, rec.Version)
+			assert.Equal(t, tc.version, rec.Schema().Version)
+			assert.WithinDuration(t, now, rec.OccurredAt, time.Microsecond)
+			assert.NotContains(t, rec.Attributes, schema.OperationKey)
+			assert.NotContains(t, rec.Attributes, schema.OccurredAtKey)
+			assert.NotContains(t, rec.Attributes, schema.VersionKey)
+			assert.Equal(t, "id", rec.String("id"))
+			assert.Equal(t, int64(3), rec.Integer("count"))
+			assert.Equal(t, []string{"a", "b"}, rec.Strings("tags"))
+			assert.True(t, now.Equal(rec.Time("created_at")), "expected created_at %s, got %s", now, rec.Time("created_at"))
+			assert.True(t, rec.Time("updated_at").IsZero())
+		})
+	}
+}
+
+func TestBuiltinSchemas(t *testing.T) {
+	schemas := schema.Schemas()
+	require.NotEmpty(t, schemas)
+
+	for _, op := range []string{"user.create", "client.create", "channel.connect", "group.create", "domain.create", "invitation.send", "rule.replay", "report.create", "bootstrap.config.create", "messaging.client_publish", "client.role.add", "rule.members.list"} {
+		_, err := schema.Latest(op)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected registered schema: %s", op, err))
+	}
+	for _, s := range schemas {
+		assert.NotEmpty(t, s.Fields, fmt.Sprintf("%s: expected schema fields", s.Operation))
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+// roleEntities lists the operation prefixes of the entities with roles.
+// Role events are published with the operation of the entity prefix
+// followed by the role operation, e.g. "client.role.add".
+var roleEntities = []string{clientPrefix, channelPrefix, groupPrefix, domainPrefix, rulePrefix, reportPrefix}
+
+var role = Fields{
+	"id":         Required(String),
+	"name":       Required(String),
+	"entity_id":  Required(String),
+	"created_by": Required(String),
+	"created_at": Required(Time),
+	"updated_by": Required(String),
+	"updated_at": Required(Time),
+	"request_id": Required(String),
+}
+
+var roleRef = Fields{
+	"entity_id":  Required(String),
+	"role_id":    Required(String),
+	"request_id": Required(String),
+}
+
+var roleActions = Fields{
+	"actions": Required(Strings),
+}
+
+var roleMembers = Fields{
+	"members": Required(Strings),
+}
+
+var rolePage = Fields{
+	"entity_id":  Required(String),
+	"limit":      Required(Integer),
+	"offset":     Required(Integer),
+	"request_id": Required(String),
+}
+
+func roleSchemas(prefix string) []Schema {
+	return []Schema{
+		New(prefix+"role.add", 1, role, Fields{
+			"optional_actions": Required(Strings),
+			"optional_members": Required(Strings),
+		}),
+		New(prefix+"role.remove", 1, roleRef),
+		New(prefix+"role.update", 1, role),
+		New(prefix+"role.view", 1, role),
+		New(prefix+"role.view_all", 1, rolePage),
+		New(prefix+"role.list_available_actions", 1, Fields{
+			"request_id": Required(String),
+		}),
+		New(prefix+"role.actions.add", 1, roleRef, roleActions),
+		// The operation name is kept as published by the roles manager.
+		New(prefix+"role.actions.ist", 1, roleRef),
+		New(prefix+"role.actions.check", 1, roleRef, roleActions, Fields{
+			"is_all_exists": Required(Boolean),
+		}),
+		New(prefix+"role.actions.remove", 1, roleRef, roleActions),
+		New(prefix+"role.actions.remove_all", 1, roleRef),
+		New(prefix+"role.members.add", 1, roleRef, roleMembers),
+		New(prefix+"role.members.list", 1, roleRef, Fields{
+			"limit":  Required(Integer),
+			"offset": Required(Integer),
+		}),
+		New(prefix+"role.members.check", 1, roleRef, roleMembers),
+		New(prefix+"role.members.remove", 1, roleRef, roleMembers),
+		New(prefix+"role.members.remove_all", 1, roleRef),
+		New(prefix+"members.list", 1, rolePage),
+		New(prefix+"members.remove", 1, roleMembers, Fields{
+			"entity_id":  Required(String),
+			"request_id": Required(String),
+		}),
+		New(prefix+"role.members.remove_from_all_roles", 1, Fields{
+			"member_id":  Required(String),
+			"request_id": Required(String),
+		}),
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+const (
+	rulePrefix   = "rule."
+	reportPrefix = "report."
+)
+
+var rule = Fields{
+	"id":            Required(String),
+	"name":          Required(String),
+	"domain":        Optional(String),
+	"tags":          Optional(Strings),
+	"metadata":      Optional(Object),
+	"input_channel": Optional(String),
+	"input_topic":   Optional(String),
+	"logic":         Optional(Object),
+	"schedule":      Required(Object),
+	"status":        Required(String),
+	"created_at":    Required(Time),
+	"created_by":    Required(String),
+	"updated_at":    Optional(Time),
+	"updated_by":    Optional(String),
+}
+
+var ruleSchemas = []Schema{
+	New(rulePrefix+"create", 1, rule, session, Fields{
+		"roles_provisioned": Required(Array),
+	}),
+	New(rulePrefix+"list", 1, page, session, Fields{
+		"status":           Required(String),
+		"domain_id":        Required(String),
+		"input_channel":    Optional(String),
+		"input_topic":      Optional(String),
+		"scheduled":        Optional(Boolean),
+		"output_channel":   Optional(String),
+		"tag":              Optional(String),
+		"scheduled_before": Optional(Time),
+		"scheduled_after":  Optional(Time),
+		"recurring":        Optional(String),
+	}),
+	New(rulePrefix+"view", 1, rule, session),
+	New(rulePrefix+"update", 1, rule, session),
+	New(rulePrefix+"update_tags", 1, rule, session),
+	New(rulePrefix+"update_schedule", 1, rule, session),
+	New(rulePrefix+"enable", 1, rule, session),
+	New(rulePrefix+"disable", 1, rule, session),
+	New(rulePrefix+"remove", 1, session, Fields{
+		"id": Required(String),
+	}),
+	New(rulePrefix+"replay", 1, session, Fields{
+		"id":             Required(String),
+		"replay_id":      Required(String),
+		"start_time":     Optional(Time),
+		"start_sequence": Optional(Integer),
+		"end_time":       Required(Time),
+	}),
+}
+
+var reportSchemas = []Schema{
+	New(reportPrefix+"create", 1, session, Fields{
+		"id":   Required(String),
+		"name": Required(String),
+	}),
+	New(reportPrefix+"remove", 1, session, Fields{
+		"id": Required(String),
+	}),
+}
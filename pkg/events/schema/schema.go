@@ -0,0 +1,209 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+const (
+	// OperationKey is the event key holding the event operation.
+	OperationKey = "operation"
+	// OccurredAtKey is the event key holding the Unix time in nanoseconds
+	// at which the event was published.
+	OccurredAtKey = "occurred_at"
+	// VersionKey is the event key holding the schema version of the event.
+	VersionKey = "schema_version"
+)
+
+var (
+	// ErrInvalidEvent indicates an event which does not match its schema.
+	ErrInvalidEvent = errors.New("event does not match schema")
+
+	// ErrMissingOperation indicates an event without operation.
+	ErrMissingOperation = errors.New("missing or invalid event operation")
+)
+
+// Type is the type of the event field value.
+type Type string
+
+const (
+	String  Type = "string"
+	Integer Type = "integer"
+	Number  Type = "number"
+	Boolean Type = "boolean"
+	Object  Type = "object"
+	Array   Type = "array"
+	// Strings is an array of strings.
+	Strings Type = "strings"
+	// Time is a RFC3339 formatted time string.
+	Time Type = "time"
+	// Any matches any value.
+	Any Type = "any"
+)
+
+// Field describes a single event field.
+type Field struct {
+	Type     Type `json:"type"`
+	Required bool `json:"required,omitempty"`
+}
+
+// Required returns a field which must be present in the event.
+func Required(t Type) Field {
+	return Field{Type: t, Required: true}
+}
+
+// Optional returns a field which may be omitted from the event.
+func Optional(t Type) Field {
+	return Field{Type: t}
+}
+
+// Fields maps event keys to their field descriptions.
+type Fields map[string]Field
+
+// Schema describes the events of a single operation. Fields which are not
+// described by the schema are allowed so that producers can add fields
+// without breaking consumers.
+type Schema struct {
+	Operation string `json:"operation"`
+	Version   uint32 `json:"version"`
+	Fields    Fields `json:"fields"`
+}
+
+// New returns a schema of the operation at the given version. Field sets
+// are merged in order, so later sets override the earlier ones.
+func New(operation string, version uint32, fields ...Fields) Schema {
+	fs := Fields{}
+	for _, f := range fields {
+		maps.Copy(fs, f)
+	}
+
+	return Schema{
+		Operation: operation,
+		Version:   version,
+		Fields:    fs,
+	}
+}
+
+// Validate checks JSON decoded event values against the schema.
+func (s Schema) Validate(values map[string]any) error {
+	for _, key := range slices.Sorted(maps.Keys(s.Fields)) {
+		f := s.Fields[key]
+		val, ok := values[key]
+		if !ok {
+			if f.Required {
+				return errors.Wrap(ErrInvalidEvent, fmt.Errorf("%s: missing required field %q", s.Operation, key))
+			}
+			continue
+		}
+		if !f.Type.matches(val) {
+			return errors.Wrap(ErrInvalidEvent, fmt.Errorf("%s: field %q must be of type %s", s.Operation, key, f.Type))
+		}
+	}
+
+	return nil
+}
+
+// JSONSchema returns the JSON Schema document describing the schema.
+func (s Schema) JSONSchema() map[string]any {
+	props := map[string]any{
+		OperationKey:  map[string]any{"const": s.Operation},
+		OccurredAtKey: map[string]any{"type": "integer"},
+		VersionKey:    map[string]any{"const": s.Version},
+	}
+	required := []string{OperationKey}
+	for key, f := range s.Fields {
+		props[key] = f.Type.jsonSchema()
+		if f.Required {
+			required = append(required, key)
+		}
+	}
+	slices.Sort(required)
+
+	return map[string]any{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"$id":                  fmt.Sprintf("magistrala:events:%s:v%d", s.Operation, s.Version),
+		"title":                s.Operation,
+		"type":                 "object",
+		"properties":           props,
+		"required":             required,
+		"additionalProperties": true,
+	}
+}
+
+func (t Type) matches(val any) bool {
+	switch t {
+	case Any:
+		return true
+	case Object:
+		if val == nil {
+			return true
+		}
+		_, ok := val.(map[string]any)
+		return ok
+	case Array:
+		if val == nil {
+			return true
+		}
+		_, ok := val.([]any)
+		return ok
+	case Strings:
+		if val == nil {
+			return true
+		}
+		vals, ok := val.([]any)
+		if !ok {
+			return false
+		}
+		for _, v := range vals {
+			if _, ok := v.(string); !ok {
+				return false
+			}
+		}
+		return true
+	case String:
+		_, ok := val.(string)
+		return ok
+	case Boolean:
+		_, ok := val.(bool)
+		return ok
+	case Number:
+		_, ok := val.(float64)
+		return ok
+	case Integer:
+		n, ok := val.(float64)
+		return ok && n == math.Trunc(n)
+	case Time:
+		s, ok := val.(string)
+		if !ok {
+			return false
+		}
+		_, err := time.Parse(time.RFC3339Nano, s)
+		return err == nil
+	default:
+		return false
+	}
+}
+
+func (t Type) jsonSchema() map[string]any {
+	switch t {
+	case Any:
+		return map[string]any{}
+	case Time:
+		return map[string]any{"type": "string", "format": "date-time"}
+	// Go encodes nil maps and slices as null.
+	case Strings:
+		return map[string]any{"type": []string{"array", "null"}, "items": map[string]any{"type": "string"}}
+	case Object, Array:
+		return map[string]any{"type": []string{string(t), "null"}}
+	default:
+		return map[string]any{"type": string(t)}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = schema.New("thing.create", 1, schema.Fields{
+	"id":         schema.Required(schema.String),
+	"count":      schema.Required(schema.Integer),
+	"ratio":      schema.Optional(schema.Number),
+	"enabled":    schema.Optional(schema.Boolean),
+	"metadata":   schema.Optional(schema.Object),
+	"items":      schema.Optional(schema.Array),
+	"tags":       schema.Optional(schema.Strings),
+	"created_at": schema.Optional(schema.Time),
+	"extra":      schema.Optional(schema.Any),
+})
+
+// decode returns the values as consumers see them.
+func decode(t *testing.T, values map[string]any) map[string]any {
+	data, err := json.Marshal(values)
+	require.Nil(t, err, fmt.Sprintf("marshal expected to succeed: %s", err))
+	var ret map[string]any
+	err = json.Unmarshal(data, &ret)
+	require.Nil(t, err, fmt.Sprintf("unmarshal expected to succeed: %s", err))
+
+	return ret
+}
+
+func TestSchemaValidate(t *testing.T) {
+	cases := []struct {
+		desc   string
+		values map[string]any
+		err    error
+	}{
+		{
+			desc: "valid event with all fields",
+			values: map[string]any{
+				"id":         "id",
+				"count":      uint64(10),
+				"ratio":      0.5,
+				"enabled":    true,
+				"metadata":   map[string]any{"key": "value"},
+				"items":      []any{1, "two"},
+				"tags":       []string{"tag"},
+				"created_at": time.Now(),
+				"extra":      struct{}{},
+				"unknown":    "unknown",
+			},
+		},
+		{
+			desc:   "valid event with required fields",
+			values: map[string]any{"id": "id", "count": 0},
+		},
+		{
+			desc: "valid event with null collections",
+			values: map[string]any{
+				"id":       "id",
+				"count":    1,
+				"metadata": map[string]any(nil),
+				"items":    []any(nil),
+				"tags":     []string(nil),
+			},
+		},
+		{
+			desc:   "event with missing required field",
+			values: map[string]any{"id": "id"},
+			err:    schema.ErrInvalidEvent,
+		},
+		{
+			desc:   "event with invalid string",
+			values: map[string]any{"id": 1, "count": 1},
+			err:    schema.ErrInvalidEvent,
+		},
+		{
+			desc:   "event with fractional integer",
+			values: map[string]any{"id": "id", "count": 1.5},
+			err:    schema.ErrInvalidEvent,
+		},
+		{
+			desc:   "event with invalid boolean",
+			values: map[string]any{"id": "id", "count": 1, "enabled": "true"},
+			err:    schema.ErrInvalidEvent,
+		},
+		{
+			desc:   "event with invalid object",
+			values: map[string]any{"id": "id", "count": 1, "metadata": []string{}},
+			err:    schema.ErrInvalidEvent,
+		},
+		{
+			desc:   "event with invalid strings",
+			values: map[string]any{"id": "id", "count": 1, "tags": []any{"tag", 1}},
+			err:    schema.ErrInvalidEvent,
+		},
+		{
+			desc:   "event with invalid time",
+			values: map[string]any{"id": "id", "count": 1, "created_at": "yesterday"},
+			err:    schema.ErrInvalidEvent,
+		},
+		{
+			desc:   "event with null string",
+			values: map[string]any{"id": nil, "count": 1},
+			err:    schema.ErrInvalidEvent,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := testSchema.Validate(decode(t, tc.values))
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected error %v, got %v", tc.err, err))
+		})
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	doc := testSchema.JSONSchema()
+
+	assert.Equal(t, "thing.create", doc["title"])
+	assert.Equal(t, []string{"count", "id", "operation"}, doc["required"])
+
+	props, ok := doc["properties"].(map[string]any)
+	require.True(t, ok, "properties expected to be an object")
+	assert.Equal(t, map[string]any{"const": "thing.create"}, props[schema.OperationKey])
+	assert.Equal(t, map[string]any{"type": "integer"}, props["count"])
+	assert.Equal(t, map[string]any{"type": "string", "format": "date-time"}, props["created_at"])
+	assert.Equal(t, map[string]any{"type": []string{"array", "null"}, "items": map[string]any{"type": "string"}}, props["tags"])
+
+	_, err := json.Marshal(doc)
+	assert.Nil(t, err, fmt.Sprintf("marshal expected to succeed: %s", err))
+}
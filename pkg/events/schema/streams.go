@@ -0,0 +1,64 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import "slices"
+
+// session holds the fields describing the session of the request which
+// produced the event.
+var session = Fields{
+	"domain":      Required(String),
+	"user_id":     Required(String),
+	"token_type":  Required(String),
+	"super_admin": Required(Boolean),
+	"request_id":  Required(String),
+}
+
+var page = Fields{
+	"total":    Required(Integer),
+	"offset":   Required(Integer),
+	"limit":    Required(Integer),
+	"name":     Optional(String),
+	"order":    Optional(String),
+	"dir":      Optional(String),
+	"metadata": Optional(Object),
+	"status":   Optional(String),
+}
+
+var updateEntity = Fields{
+	"updated_at": Required(Time),
+	"updated_by": Required(String),
+}
+
+var changeStatus = Fields{
+	"id":         Required(String),
+	"status":     Required(String),
+	"updated_at": Required(Time),
+	"updated_by": Required(String),
+}
+
+var setParent = Fields{
+	"id":              Required(String),
+	"parent_group_id": Required(String),
+}
+
+func init() {
+	schemas := slices.Concat(
+		userSchemas,
+		clientSchemas,
+		channelSchemas,
+		groupSchemas,
+		domainSchemas,
+		ruleSchemas,
+		reportSchemas,
+		bootstrapSchemas,
+		messagingSchemas,
+	)
+	for _, prefix := range roleEntities {
+		schemas = append(schemas, roleSchemas(prefix)...)
+	}
+	if err := registry.Register(schemas...); err != nil {
+		panic(err)
+	}
+}
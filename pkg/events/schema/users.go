@@ -0,0 +1,137 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+const userPrefix = "user."
+
+// userSession holds the session fields of the users service events, which
+// are not scoped to a domain.
+var userSession = Fields{
+	"token_type":  Required(String),
+	"super_admin": Required(Boolean),
+	"request_id":  Required(String),
+}
+
+var user = Fields{
+	"id":               Optional(String),
+	"first_name":       Optional(String),
+	"last_name":        Optional(String),
+	"tags":             Optional(Strings),
+	"metadata":         Optional(Object),
+	"private_metadata": Optional(Object),
+	"username":         Optional(String),
+	"email":            Optional(String),
+	"status":           Optional(String),
+	"created_at":       Optional(Time),
+	"updated_at":       Optional(Time),
+	"updated_by":       Optional(String),
+}
+
+var updateUser = Fields{
+	"updated_at": Required(Time),
+	"updated_by": Required(String),
+}
+
+var userSchemas = []Schema{
+	New(userPrefix+"create", 1, user, userSession, Fields{
+		"id":         Required(String),
+		"status":     Required(String),
+		"created_at": Required(Time),
+	}),
+	New(userPrefix+"send_verification", 1, Fields{
+		"user_id":    Required(String),
+		"token_type": Required(String),
+		"request_id": Required(String),
+	}),
+	New(userPrefix+"verify_email", 1, Fields{
+		"email":       Required(String),
+		"user_id":     Required(String),
+		"verified_at": Required(Time),
+		"request_id":  Required(String),
+	}),
+	New(userPrefix+"update", 1, user, userSession, updateUser),
+	New(userPrefix+"update_role", 1, user, userSession, updateUser),
+	New(userPrefix+"update_tags", 1, user, userSession, updateUser),
+	New(userPrefix+"update_secret", 1, user, userSession, updateUser),
+	New(userPrefix+"update_email", 1, user, userSession, updateUser),
+	New(userPrefix+"update_username", 1, userSession, updateUser, Fields{
+		"id":         Optional(String),
+		"first_name": Optional(String),
+		"last_name":  Optional(String),
+		"username":   Optional(String),
+	}),
+	New(userPrefix+"update_profile_picture", 1, userSession, updateUser, Fields{
+		"id":              Optional(String),
+		"profile_picture": Optional(String),
+	}),
+	New(userPrefix+"enable", 1, userSession, updateUser, Fields{
+		"id":     Required(String),
+		"status": Required(String),
+	}),
+	New(userPrefix+"disable", 1, userSession, updateUser, Fields{
+		"id":     Required(String),
+		"status": Required(String),
+	}),
+	New(userPrefix+"view", 1, user, userSession, Fields{
+		"id": Required(String),
+	}),
+	New(userPrefix+"view_profile", 1, user, userSession, Fields{
+		"id": Required(String),
+	}),
+	New(userPrefix+"list", 1, page, userSession, Fields{
+		"first_name": Optional(String),
+		"last_name":  Optional(String),
+		"domain":     Optional(String),
+		"tags":       Optional(Strings),
+		"permission": Optional(String),
+		"username":   Optional(String),
+		"email":      Optional(String),
+	}),
+	New(userPrefix+"search", 1, Fields{
+		"total":      Required(Integer),
+		"offset":     Required(Integer),
+		"limit":      Required(Integer),
+		"request_id": Required(String),
+		"id":         Optional(String),
+		"username":   Optional(String),
+		"first_name": Optional(String),
+		"last_name":  Optional(String),
+		"email":      Optional(String),
+	}),
+	New(userPrefix+"identify", 1, Fields{
+		"id":         Required(String),
+		"request_id": Required(String),
+	}),
+	New(userPrefix+"issue_token", 1, Fields{
+		"username":   Required(String),
+		"request_id": Required(String),
+	}),
+	New(userPrefix+"refresh_token", 1, Fields{
+		"request_id": Required(String),
+	}),
+	New(userPrefix+"revoke_refresh_token", 1, Fields{
+		"token_id":   Required(String),
+		"request_id": Required(String),
+	}),
+	New(userPrefix+"reset_secret", 1, Fields{
+		"request_id": Required(String),
+	}),
+	New(userPrefix+"send_password_reset", 1, Fields{
+		"host":       Required(String),
+		"email":      Required(String),
+		"user":       Required(String),
+		"request_id": Required(String),
+	}),
+	New(userPrefix+"oauth_callback", 1, Fields{
+		"user_id":    Required(String),
+		"request_id": Required(String),
+	}),
+	New(userPrefix+"delete", 1, userSession, Fields{
+		"id": Required(String),
+	}),
+	New(userPrefix+"add_policy", 1, userSession, Fields{
+		"id":   Required(String),
+		"role": Required(String),
+	}),
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/absmach/magistrala/pkg/events"
 	"github.com/absmach/magistrala/pkg/events/fluxmq"
+	"github.com/absmach/magistrala/pkg/events/schema"
 )
 
 // StreamAllEvents represents subject to subscribe for all the events.
@@ -28,7 +29,7 @@ func NewPublisher(ctx context.Context, url, connectionName string) (events.Publi
 		return nil, err
 	}
 
-	return pb, nil
+	return schema.NewPublisher(pb), nil
 }
 
 func NewSubscriber(ctx context.Context, url, connectionName string, logger *slog.Logger) (events.Subscriber, error) {
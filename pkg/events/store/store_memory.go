@@ -0,0 +1,42 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build es_memory
+// +build es_memory
+
+package store
+
+import (
+	"context"
+	"log"
+	"log/slog"
+
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/memory"
+	"github.com/absmach/magistrala/pkg/events/schema"
+)
+
+// StreamAllEvents represents subject to subscribe for all the events.
+const StreamAllEvents = ">"
+
+func init() {
+	log.Println("The binary was built using the in-process events store")
+}
+
+func NewPublisher(ctx context.Context, url, _ string) (events.Publisher, error) {
+	pb, err := memory.NewPublisher(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewPublisher(pb), nil
+}
+
+func NewSubscriber(ctx context.Context, url, _ string, logger *slog.Logger) (events.Subscriber, error) {
+	pb, err := memory.NewSubscriber(ctx, url, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
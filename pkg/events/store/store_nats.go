@@ -13,6 +13,7 @@ import (
 
 	"github.com/absmach/magistrala/pkg/events"
 	"github.com/absmach/magistrala/pkg/events/nats"
+	"github.com/absmach/magistrala/pkg/events/schema"
 )
 
 // StreamAllEvents represents subject to subscribe for all the events.
@@ -28,7 +29,7 @@ func NewPublisher(ctx context.Context, url, _ string) (events.Publisher, error)
 		return nil, err
 	}
 
-	return pb, nil
+	return schema.NewPublisher(pb), nil
 }
 
 func NewSubscriber(ctx context.Context, url, _ string, logger *slog.Logger) (events.Subscriber, error) {
@@ -1,8 +1,8 @@
 // Copyright (c) Abstract Machines
 // SPDX-License-Identifier: Apache-2.0
 
-//go:build !es_nats && !es_rabbitmq && !es_fluxmq
-// +build !es_nats,!es_rabbitmq,!es_fluxmq
+//go:build !es_nats && !es_rabbitmq && !es_fluxmq && !es_memory
+// +build !es_nats,!es_rabbitmq,!es_fluxmq,!es_memory
 
 package store
 
@@ -13,6 +13,7 @@ import (
 
 	"github.com/absmach/magistrala/pkg/events"
 	"github.com/absmach/magistrala/pkg/events/redis"
+	"github.com/absmach/magistrala/pkg/events/schema"
 )
 
 // StreamAllEvents represents subject to subscribe for all the events.
@@ -28,7 +29,7 @@ func NewPublisher(ctx context.Context, url, _ string) (events.Publisher, error)
 		return nil, err
 	}
 
-	return pb, nil
+	return schema.NewPublisher(pb), nil
 }
 
 func NewSubscriber(_ context.Context, url, _ string, logger *slog.Logger) (events.Subscriber, error) {
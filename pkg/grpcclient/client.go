@@ -6,6 +6,7 @@ package grpcclient
 import (
 	"context"
 
+	grpcCertsV1 "github.com/absmach/magistrala/api/grpc/certs/v1"
 	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
 	grpcClientsV1 "github.com/absmach/magistrala/api/grpc/clients/v1"
 	grpcDomainsV1 "github.com/absmach/magistrala/api/grpc/domains/v1"
@@ -13,6 +14,7 @@ import (
 	grpcTokenV1 "github.com/absmach/magistrala/api/grpc/token/v1"
 	grpcUsersV1 "github.com/absmach/magistrala/api/grpc/users/v1"
 	tokengrpc "github.com/absmach/magistrala/auth/api/grpc/token"
+	certsgrpc "github.com/absmach/magistrala/certs/api/grpc"
 	channelsgrpc "github.com/absmach/magistrala/channels/api/grpc"
 	clientsauth "github.com/absmach/magistrala/clients/api/grpc"
 	domainsgrpc "github.com/absmach/magistrala/domains/api/grpc"
@@ -86,6 +88,20 @@ func SetupChannelsClient(ctx context.Context, cfg Config) (grpcChannelsV1.Channe
 	return channelsgrpc.NewClient(client.Connection(), cfg.Timeout), client, nil
 }
 
+// SetupCertsClient loads certs gRPC configuration and creates new certs gRPC client.
+//
+// For example:
+//
+// certsClient, certsHandler, err := grpcclient.SetupCertsClient(ctx, grpcclient.Config{}).
+func SetupCertsClient(ctx context.Context, cfg Config) (grpcCertsV1.CertsServiceClient, Handler, error) {
+	client, err := NewHandler(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return certsgrpc.NewClient(client.Connection(), cfg.Timeout), client, nil
+}
+
 // SetupGroupsClient loads groups gRPC configuration and creates new groups gRPC client.
 //
 // For example:
@@ -1,8 +1,8 @@
 // Copyright (c) Abstract Machines
 // SPDX-License-Identifier: Apache-2.0
 
-//go:build !msg_nats
-// +build !msg_nats
+//go:build !msg_nats && !msg_kafka && !msg_memory
+// +build !msg_nats,!msg_kafka,!msg_memory
 
 package brokers
 
@@ -0,0 +1,107 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package compression
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// None disables payload compression.
+	None = "none"
+	// Zstd compresses payloads using Zstandard.
+	Zstd = "zstd"
+	// Snappy compresses payloads using Snappy block format.
+	Snappy = "snappy"
+
+	// MaxPayloadSize limits the size of a decompressed payload.
+	MaxPayloadSize = 64 << 20
+
+	// DefMinSize is the default size of the smallest payload to compress.
+	DefMinSize = 1024
+)
+
+var (
+	// ErrUnsupportedEncoding indicates an unknown content encoding.
+	ErrUnsupportedEncoding = errors.New("unsupported content encoding")
+
+	// ErrPayloadTooLarge indicates a payload which exceeds MaxPayloadSize once decompressed.
+	ErrPayloadTooLarge = errors.New("decompressed payload too large")
+)
+
+// Config configures payload compression of published messages.
+type Config struct {
+	// Encoding is one of None, Zstd or Snappy. Empty value is treated as None.
+	Encoding string
+	// MinSize is the size of the smallest payload to compress. Smaller
+	// payloads are published as they are.
+	MinSize int
+}
+
+var (
+	zstdEncoder = sync.OnceValues(func() (*zstd.Encoder, error) {
+		return zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	})
+	zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+		return zstd.NewReader(nil, zstd.WithDecoderMaxMemory(MaxPayloadSize), zstd.WithDecoderConcurrency(0))
+	})
+)
+
+// Validate checks whether the encoding is supported.
+func Validate(encoding string) error {
+	switch encoding {
+	case "", None, Zstd, Snappy:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedEncoding, encoding)
+	}
+}
+
+// Compress compresses data using the encoding.
+func Compress(encoding string, data []byte) ([]byte, error) {
+	switch encoding {
+	case Zstd:
+		enc, err := zstdEncoder()
+		if err != nil {
+			return nil, err
+		}
+		return enc.EncodeAll(data, make([]byte, 0, len(data)/2)), nil
+	case Snappy:
+		return snappy.Encode(nil, data), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedEncoding, encoding)
+	}
+}
+
+// Decompress decompresses data compressed using the encoding.
+func Decompress(encoding string, data []byte) ([]byte, error) {
+	switch encoding {
+	case Zstd:
+		dec, err := zstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		ret, err := dec.DecodeAll(data, nil)
+		if errors.Is(err, zstd.ErrDecoderSizeExceeded) || errors.Is(err, zstd.ErrWindowSizeExceeded) {
+			return nil, ErrPayloadTooLarge
+		}
+		return ret, err
+	case Snappy:
+		n, err := snappy.DecodedLen(data)
+		if err != nil {
+			return nil, err
+		}
+		if n > MaxPayloadSize {
+			return nil, ErrPayloadTooLarge
+		}
+		return snappy.Decode(nil, data)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedEncoding, encoding)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package compression_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/pkg/messaging/compression"
+	"github.com/klauspost/compress/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var payload = bytes.Repeat([]byte(`{"n":"temperature","u":"Cel","v":21.5},`), 100)
+
+func TestCompress(t *testing.T) {
+	for _, encoding := range []string{compression.Zstd, compression.Snappy} {
+		t.Run(encoding, func(t *testing.T) {
+			data, err := compression.Compress(encoding, payload)
+			require.Nil(t, err, fmt.Sprintf("compress expected to succeed: %s", err))
+			assert.Less(t, len(data), len(payload))
+
+			got, err := compression.Decompress(encoding, data)
+			require.Nil(t, err, fmt.Sprintf("decompress expected to succeed: %s", err))
+			assert.Equal(t, payload, got)
+
+			_, err = compression.Decompress(encoding, []byte("not compressed"))
+			assert.NotNil(t, err, "decompressing invalid data expected to fail")
+		})
+	}
+
+	_, err := compression.Compress("gzip", payload)
+	assert.ErrorIs(t, err, compression.ErrUnsupportedEncoding)
+	_, err = compression.Decompress("gzip", payload)
+	assert.ErrorIs(t, err, compression.ErrUnsupportedEncoding)
+}
+
+func TestDecompressTooLarge(t *testing.T) {
+	large := make([]byte, compression.MaxPayloadSize+1)
+
+	data, err := compression.Compress(compression.Zstd, large)
+	require.Nil(t, err, fmt.Sprintf("compress expected to succeed: %s", err))
+	_, err = compression.Decompress(compression.Zstd, data)
+	assert.ErrorIs(t, err, compression.ErrPayloadTooLarge)
+
+	_, err = compression.Decompress(compression.Snappy, snappy.Encode(nil, large))
+	assert.ErrorIs(t, err, compression.ErrPayloadTooLarge)
+}
+
+func TestValidate(t *testing.T) {
+	for _, encoding := range []string{"", compression.None, compression.Zstd, compression.Snappy} {
+		assert.Nil(t, compression.Validate(encoding), encoding)
+	}
+	assert.ErrorIs(t, compression.Validate("gzip"), compression.ErrUnsupportedEncoding)
+}
+
+func random(t *testing.T, n int) []byte {
+	data := make([]byte, n)
+	_, err := rand.Read(data)
+	require.Nil(t, err)
+
+	return data
+}
@@ -0,0 +1,10 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package compression provides publisher and pubsub middlewares which
+// transparently compress message payloads on the internal message bus.
+//
+// Compressed messages are marked using the message content encoding, so
+// subscribers decompress them regardless of their own configuration and
+// compressing and non-compressing producers can share a topic.
+package compression
@@ -0,0 +1,94 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package compression
+
+import (
+	"context"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	compressOp   = "compress"
+	decompressOp = "decompress"
+	originalSize = "original"
+	encodedSize  = "encoded"
+)
+
+var _ messaging.Publisher = (*publisherMiddleware)(nil)
+
+type publisherMiddleware struct {
+	publisher messaging.Publisher
+	encoding  string
+	minSize   int
+	bytes     metrics.Counter
+	ratio     metrics.Histogram
+}
+
+// NewPublisher returns a publisher which compresses message payloads using
+// the configured encoding. Payloads smaller than the configured minimum size,
+// already encoded payloads and payloads which do not shrink are published
+// as they are.
+func NewPublisher(cfg Config, publisher messaging.Publisher, bytes metrics.Counter, ratio metrics.Histogram) (messaging.Publisher, error) {
+	if err := Validate(cfg.Encoding); err != nil {
+		return nil, err
+	}
+
+	return &publisherMiddleware{
+		publisher: publisher,
+		encoding:  cfg.Encoding,
+		minSize:   cfg.MinSize,
+		bytes:     bytes,
+		ratio:     ratio,
+	}, nil
+}
+
+func (pm *publisherMiddleware) Publish(ctx context.Context, topic string, msg *messaging.Message) error {
+	if pm.encoding == "" || pm.encoding == None || msg.GetContentEncoding() != "" || len(msg.GetPayload()) < pm.minSize {
+		return pm.publisher.Publish(ctx, topic, msg)
+	}
+
+	data, err := Compress(pm.encoding, msg.GetPayload())
+	if err != nil {
+		return err
+	}
+	pm.observe(compressOp, len(msg.GetPayload()), len(data))
+	if len(data) >= len(msg.GetPayload()) {
+		return pm.publisher.Publish(ctx, topic, msg)
+	}
+
+	// Copy the message so the caller's payload is left untouched.
+	m := &messaging.Message{
+		Channel:         msg.GetChannel(),
+		Domain:          msg.GetDomain(),
+		Subtopic:        msg.GetSubtopic(),
+		Publisher:       msg.GetPublisher(),
+		Protocol:        msg.GetProtocol(),
+		Payload:         data,
+		Created:         msg.GetCreated(),
+		ClientId:        msg.GetClientId(),
+		Headers:         msg.GetHeaders(),
+		ContentType:     msg.GetContentType(),
+		ContentEncoding: pm.encoding,
+	}
+
+	return pm.publisher.Publish(ctx, topic, m)
+}
+
+func (pm *publisherMiddleware) Close() error {
+	return pm.publisher.Close()
+}
+
+func (pm *publisherMiddleware) observe(op string, original, encoded int) {
+	observe(pm.bytes, pm.ratio, pm.encoding, op, original, encoded)
+}
+
+func observe(bytes metrics.Counter, ratio metrics.Histogram, encoding, op string, original, encoded int) {
+	bytes.With("encoding", encoding, "operation", op, "size", originalSize).Add(float64(original))
+	bytes.With("encoding", encoding, "operation", op, "size", encodedSize).Add(float64(encoded))
+	if original > 0 {
+		ratio.With("encoding", encoding, "operation", op).Observe(float64(encoded) / float64(original))
+	}
+}
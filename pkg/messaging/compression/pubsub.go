@@ -0,0 +1,108 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package compression
+
+import (
+	"context"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/go-kit/kit/metrics"
+)
+
+var _ messaging.PubSub = (*pubsubMiddleware)(nil)
+
+type pubsubMiddleware struct {
+	publisherMiddleware
+	pubsub messaging.PubSub
+}
+
+// NewPubSub returns a pubsub which compresses published payloads like the
+// publisher returned by NewPublisher, and decompresses received payloads
+// marked with a supported content encoding. Messages which can not be
+// decompressed are terminated.
+func NewPubSub(cfg Config, pubsub messaging.PubSub, bytes metrics.Counter, ratio metrics.Histogram) (messaging.PubSub, error) {
+	if err := Validate(cfg.Encoding); err != nil {
+		return nil, err
+	}
+
+	return &pubsubMiddleware{
+		publisherMiddleware: publisherMiddleware{
+			publisher: pubsub,
+			encoding:  cfg.Encoding,
+			minSize:   cfg.MinSize,
+			bytes:     bytes,
+			ratio:     ratio,
+		},
+		pubsub: pubsub,
+	}, nil
+}
+
+func (pm *pubsubMiddleware) Subscribe(ctx context.Context, cfg messaging.SubscriberConfig) error {
+	h := &handler{
+		handler: cfg.Handler,
+		bytes:   pm.bytes,
+		ratio:   pm.ratio,
+	}
+	cfg.Handler = h
+	if dh, ok := h.handler.(messaging.DeferredAckHandler); ok {
+		cfg.Handler = &deferredHandler{handler: h, deferred: dh}
+	}
+
+	return pm.pubsub.Subscribe(ctx, cfg)
+}
+
+func (pm *pubsubMiddleware) Unsubscribe(ctx context.Context, id, topic string) error {
+	return pm.pubsub.Unsubscribe(ctx, id, topic)
+}
+
+type handler struct {
+	handler messaging.MessageHandler
+	bytes   metrics.Counter
+	ratio   metrics.Histogram
+}
+
+func (h *handler) Handle(msg *messaging.Message) error {
+	if err := h.decompress(msg); err != nil {
+		return err
+	}
+
+	return h.handler.Handle(msg)
+}
+
+func (h *handler) Cancel() error {
+	return h.handler.Cancel()
+}
+
+// decompress replaces the payload of a compressed message in place.
+func (h *handler) decompress(msg *messaging.Message) error {
+	encoding := msg.GetContentEncoding()
+	if encoding == "" {
+		return nil
+	}
+
+	data, err := Decompress(encoding, msg.GetPayload())
+	if err != nil {
+		return messaging.NewError(err, messaging.Term)
+	}
+	observe(h.bytes, h.ratio, encoding, decompressOp, len(data), len(msg.GetPayload()))
+	msg.Payload = data
+	msg.ContentEncoding = ""
+
+	return nil
+}
+
+// deferredHandler preserves deferred acknowledgement of the wrapped handler.
+type deferredHandler struct {
+	*handler
+	deferred messaging.DeferredAckHandler
+}
+
+func (h *deferredHandler) HandleDeferred(msg *messaging.Message, ack messaging.AckFunc) {
+	if err := h.decompress(msg); err != nil {
+		ack(messaging.Term, err)
+		return
+	}
+
+	h.deferred.HandleDeferred(msg, ack)
+}
@@ -0,0 +1,204 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package compression_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	mglog "github.com/absmach/magistrala/logger"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/messaging/compression"
+	"github.com/absmach/magistrala/pkg/messaging/memory"
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	topic     = "domain/c/channel/sensors"
+	subscribe = "m/" + topic
+	timeout   = 5 * time.Second
+)
+
+type recorder struct {
+	msgs []*messaging.Message
+}
+
+func (r *recorder) Publish(_ context.Context, _ string, msg *messaging.Message) error {
+	r.msgs = append(r.msgs, msg)
+	return nil
+}
+
+func (r *recorder) Close() error {
+	return nil
+}
+
+type handler struct {
+	msgs chan *messaging.Message
+}
+
+func (h *handler) Handle(msg *messaging.Message) error {
+	h.msgs <- msg
+	return nil
+}
+
+func (h *handler) Cancel() error {
+	return nil
+}
+
+type deferredHandler struct {
+	handler
+	acks chan messaging.AckType
+}
+
+func (h *deferredHandler) HandleDeferred(msg *messaging.Message, ack messaging.AckFunc) {
+	h.msgs <- msg
+	ack(messaging.Ack, nil)
+	h.acks <- messaging.Ack
+}
+
+func TestPublish(t *testing.T) {
+	cases := []struct {
+		desc     string
+		cfg      compression.Config
+		msg      *messaging.Message
+		encoding string
+	}{
+		{
+			desc:     "publish with zstd compression",
+			cfg:      compression.Config{Encoding: compression.Zstd, MinSize: 10},
+			msg:      &messaging.Message{Payload: payload, ContentType: "application/json"},
+			encoding: compression.Zstd,
+		},
+		{
+			desc:     "publish with snappy compression",
+			cfg:      compression.Config{Encoding: compression.Snappy},
+			msg:      &messaging.Message{Payload: payload},
+			encoding: compression.Snappy,
+		},
+		{
+			desc: "publish payload smaller than minimum size",
+			cfg:  compression.Config{Encoding: compression.Zstd, MinSize: len(payload) + 1},
+			msg:  &messaging.Message{Payload: payload},
+		},
+		{
+			desc: "publish without compression",
+			cfg:  compression.Config{Encoding: compression.None},
+			msg:  &messaging.Message{Payload: payload},
+		},
+		{
+			desc: "publish incompressible payload",
+			cfg:  compression.Config{Encoding: compression.Zstd},
+			msg:  &messaging.Message{Payload: random(t, 4096)},
+		},
+		{
+			desc:     "publish already encoded payload",
+			cfg:      compression.Config{Encoding: compression.Zstd},
+			msg:      &messaging.Message{Payload: payload, ContentEncoding: compression.Snappy},
+			encoding: compression.Snappy,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			rec := &recorder{}
+			pub, err := compression.NewPublisher(tc.cfg, rec, generic.NewCounter("bytes"), generic.NewHistogram("ratio", 10))
+			require.Nil(t, err, fmt.Sprintf("unexpected error creating publisher: %s", err))
+
+			original := append([]byte{}, tc.msg.Payload...)
+			err = pub.Publish(context.Background(), topic, tc.msg)
+			require.Nil(t, err, fmt.Sprintf("unexpected error publishing: %s", err))
+			require.Len(t, rec.msgs, 1)
+
+			got := rec.msgs[0]
+			assert.Equal(t, original, tc.msg.Payload, "published message must be left untouched")
+			assert.Equal(t, tc.encoding, got.ContentEncoding)
+			assert.Equal(t, tc.msg.ContentType, got.ContentType)
+			if tc.encoding == tc.cfg.Encoding {
+				assert.Less(t, len(got.Payload), len(original))
+				return
+			}
+			assert.Equal(t, original, got.Payload)
+		})
+	}
+}
+
+func TestInvalidEncoding(t *testing.T) {
+	cfg := compression.Config{Encoding: "gzip"}
+	_, err := compression.NewPublisher(cfg, &recorder{}, generic.NewCounter("bytes"), generic.NewHistogram("ratio", 10))
+	assert.ErrorIs(t, err, compression.ErrUnsupportedEncoding)
+	_, err = compression.NewPubSub(cfg, nil, generic.NewCounter("bytes"), generic.NewHistogram("ratio", 10))
+	assert.ErrorIs(t, err, compression.ErrUnsupportedEncoding)
+}
+
+func TestPubSub(t *testing.T) {
+	ctx := context.Background()
+	url := "memory://" + t.Name()
+
+	plain, err := memory.NewPubSub(ctx, url, mglog.NewMock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating pubsub: %s", err))
+	t.Cleanup(func() { plain.Close() })
+
+	inner, err := memory.NewPubSub(ctx, url, mglog.NewMock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating pubsub: %s", err))
+	ps, err := compression.NewPubSub(compression.Config{Encoding: compression.Zstd}, inner, generic.NewCounter("bytes"), generic.NewHistogram("ratio", 10))
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating pubsub: %s", err))
+	t.Cleanup(func() { ps.Close() })
+
+	h := &handler{msgs: make(chan *messaging.Message, 10)}
+	dh := &deferredHandler{handler: handler{msgs: make(chan *messaging.Message, 10)}, acks: make(chan messaging.AckType, 10)}
+	for id, hdl := range map[string]messaging.MessageHandler{"sync": h, "deferred": dh} {
+		err = ps.Subscribe(ctx, messaging.SubscriberConfig{ID: id, Topic: subscribe, Handler: hdl})
+		require.Nil(t, err, fmt.Sprintf("unexpected error subscribing: %s", err))
+	}
+
+	// Compressed and uncompressed producers share the topic.
+	for _, pub := range []messaging.Publisher{ps, plain} {
+		err = pub.Publish(ctx, topic, &messaging.Message{Domain: "domain", Channel: "channel", Subtopic: "sensors", Payload: payload})
+		require.Nil(t, err, fmt.Sprintf("unexpected error publishing: %s", err))
+	}
+
+	for _, msgs := range []chan *messaging.Message{h.msgs, dh.msgs} {
+		for range 2 {
+			select {
+			case msg := <-msgs:
+				assert.Equal(t, payload, msg.Payload)
+				assert.Empty(t, msg.ContentEncoding)
+			case <-time.After(timeout):
+				t.Fatal("timed out waiting for message")
+			}
+		}
+	}
+}
+
+func TestHandleInvalidPayload(t *testing.T) {
+	ctx := context.Background()
+	url := "memory://" + t.Name()
+
+	plain, err := memory.NewPubSub(ctx, url, mglog.NewMock())
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating pubsub: %s", err))
+	t.Cleanup(func() { plain.Close() })
+
+	ps, err := compression.NewPubSub(compression.Config{}, plain, generic.NewCounter("bytes"), generic.NewHistogram("ratio", 10))
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating pubsub: %s", err))
+
+	h := &handler{msgs: make(chan *messaging.Message, 10)}
+	err = ps.Subscribe(ctx, messaging.SubscriberConfig{ID: "invalid", Topic: subscribe, Handler: h})
+	require.Nil(t, err, fmt.Sprintf("unexpected error subscribing: %s", err))
+
+	err = plain.Publish(ctx, topic, &messaging.Message{Domain: "domain", Channel: "channel", Subtopic: "sensors", Payload: []byte("garbage"), ContentEncoding: compression.Zstd})
+	require.Nil(t, err, fmt.Sprintf("unexpected error publishing: %s", err))
+	err = plain.Publish(ctx, topic, &messaging.Message{Domain: "domain", Channel: "channel", Subtopic: "sensors", Payload: payload})
+	require.Nil(t, err, fmt.Sprintf("unexpected error publishing: %s", err))
+
+	select {
+	case msg := <-h.msgs:
+		assert.Equal(t, payload, msg.Payload, "message which can not be decompressed must not reach the handler")
+	case <-time.After(timeout):
+		t.Fatal("timed out waiting for message")
+	}
+}
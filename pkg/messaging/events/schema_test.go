@@ -0,0 +1,43 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSchemas(t *testing.T) {
+	clientID := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc  string
+		event events.Event
+	}{
+		{
+			desc:  "client publish",
+			event: publishEvent{domainID: testsutil.GenerateUUID(t), channelID: testsutil.GenerateUUID(t), clientID: clientID, subtopic: "temperature"},
+		},
+		{
+			desc:  "client subscribe",
+			event: subscribeEvent{operation: clientSubscribe, subscriberID: testsutil.GenerateUUID(t), clientID: clientID, topic: "m.domain.c.channel"},
+		},
+		{
+			desc:  "client unsubscribe",
+			event: subscribeEvent{operation: clientUnsubscribe, subscriberID: testsutil.GenerateUUID(t), clientID: clientID, topic: "m.domain.c.channel"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, _, err := schema.ValidateEvent(tc.event)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		})
+	}
+}
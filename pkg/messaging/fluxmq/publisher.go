@@ -67,15 +67,26 @@ func (pub *publisher) Publish(ctx context.Context, topic string, msg *messaging.
 		return ErrEmptyTopic
 	}
 
-	props := map[string]string{
-		"external_id": msg.GetPublisher(),
-		"protocol":    msg.GetProtocol(),
+	props := make(map[string]string, len(msg.GetHeaders())+6)
+	// User headers must not shadow the properties carrying message fields.
+	for key, value := range msg.GetHeaders() {
+		if !isReservedHeader(key) {
+			props[key] = value
+		}
 	}
+	props[propExternalID] = msg.GetPublisher()
+	props[propProtocol] = msg.GetProtocol()
 	if clientID := msg.ClientIdentity(); clientID != "" {
-		props["client_id"] = clientID
+		props[propClientID] = clientID
 	}
 	if msg.GetCreated() != 0 {
-		props["created"] = strconv.FormatInt(msg.GetCreated(), 10)
+		props[propCreated] = strconv.FormatInt(msg.GetCreated(), 10)
+	}
+	if ct := msg.GetContentType(); ct != "" {
+		props[propContentType] = ct
+	}
+	if ce := msg.GetContentEncoding(); ce != "" {
+		props[propEncoding] = ce
 	}
 
 	cleanTopic := strings.TrimPrefix(strings.TrimSpace(topic), "/")
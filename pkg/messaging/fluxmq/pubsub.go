@@ -11,11 +11,11 @@ import (
 	"strconv"
 	"strings"
 	"sync"
-	"time"
 
 	fluxamqp "github.com/absmach/fluxmq/client/amqp"
 	fluxtopics "github.com/absmach/fluxmq/topics"
 	"github.com/absmach/magistrala/pkg/messaging"
+	amqp091 "github.com/rabbitmq/amqp091-go"
 )
 
 // Publisher and Subscriber errors.
@@ -105,6 +105,10 @@ func (ps *pubsub) Subscribe(_ context.Context, cfg messaging.SubscriberConfig) e
 		opts.Offset = "last"
 	case messaging.DeliverAllPolicy:
 		opts.Offset = "first"
+	case messaging.DeliverByStartTimePolicy:
+		opts.Offset = fmt.Sprintf("timestamp=%d", cfg.StartTime.UnixMilli())
+	case messaging.DeliverByStartSequencePolicy:
+		opts.Offset = fmt.Sprintf("offset=%d", cfg.StartSequence)
 	}
 
 	if err := ps.client.SubscribeToStream(opts, func(msg *fluxamqp.QueueMessage) {
@@ -171,7 +175,7 @@ func (ps *pubsub) Unsubscribe(_ context.Context, id, topic string) error {
 
 func (ps *pubsub) handleTopicMessage(h messaging.MessageHandler, msg *fluxamqp.Message) error {
 	mqttTopic := fluxtopics.AMQPTopicToMQTT(msg.Topic)
-	m, err := messageFromDelivery(msg.Body, msg.Headers, msg.Timestamp, ps.prefix, mqttTopic)
+	m, err := messageFromDelivery(msg.Delivery, ps.prefix, mqttTopic)
 	if err != nil {
 		return fmt.Errorf("failed to parse MQTT topic %q: %w", msg.Topic, err)
 	}
@@ -185,7 +189,7 @@ func (ps *pubsub) handleTopicMessage(h messaging.MessageHandler, msg *fluxamqp.M
 
 func (ps *pubsub) handle(h messaging.MessageHandler, msg *fluxamqp.QueueMessage) error {
 	mqttTopic := strings.TrimPrefix(msg.RoutingKey, queuePrefix)
-	m, err := messageFromDelivery(msg.Body, msg.Headers, msg.Timestamp, ps.prefix, mqttTopic)
+	m, err := messageFromDelivery(msg.Delivery, ps.prefix, mqttTopic)
 	if err != nil {
 		if rejectErr := msg.Reject(); rejectErr != nil {
 			return errors.Join(err, rejectErr)
@@ -193,6 +197,24 @@ func (ps *pubsub) handle(h messaging.MessageHandler, msg *fluxamqp.QueueMessage)
 		return err
 	}
 
+	if dh, ok := h.(messaging.DeferredAckHandler); ok {
+		dh.HandleDeferred(m, func(at messaging.AckType, err error) {
+			if err != nil {
+				ps.logWarn("failed to handle message",
+					"channel", m.Channel,
+					"domain", m.Domain,
+					"subtopic", m.Subtopic,
+					"publisher", m.Publisher,
+					"error", err,
+				)
+			}
+			if ackErr := ps.handleAck(at, msg); ackErr != nil {
+				ps.logWarn(fmt.Sprintf("failed to %s message", at.String()), "error", ackErr)
+			}
+		})
+		return nil
+	}
+
 	handleErr := h.Handle(m)
 	ackType := ps.errAckType(handleErr)
 	if handleErr != nil {
@@ -212,36 +234,39 @@ func (ps *pubsub) handle(h messaging.MessageHandler, msg *fluxamqp.QueueMessage)
 	return nil
 }
 
-func messageFromDelivery(body []byte, headers map[string]any, ts time.Time, prefix, mqttTopic string) (*messaging.Message, error) {
+func messageFromDelivery(d amqp091.Delivery, prefix, mqttTopic string) (*messaging.Message, error) {
 	domain, channel, subtopic, err := parseMQTTTopic(prefix, mqttTopic)
 	if err != nil {
 		return nil, err
 	}
 
-	clientID := stringHeader(headers, "client_id")
-	publisher := stringHeader(headers, "external_id")
+	clientID := stringHeader(d.Headers, propClientID)
+	publisher := stringHeader(d.Headers, propExternalID)
 
-	protocol := stringHeader(headers, "protocol")
+	protocol := stringHeader(d.Headers, propProtocol)
 	if protocol == "" {
 		protocol = "mqtt"
 	}
 
-	created := ts.UnixNano()
-	if s := stringHeader(headers, "created"); s != "" {
+	created := d.Timestamp.UnixNano()
+	if s := stringHeader(d.Headers, propCreated); s != "" {
 		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
 			created = v
 		}
 	}
 
 	return &messaging.Message{
-		Domain:    domain,
-		Channel:   channel,
-		Subtopic:  subtopic,
-		Payload:   body,
-		Publisher: publisher,
-		ClientId:  clientID,
-		Protocol:  protocol,
-		Created:   created,
+		Domain:          domain,
+		Channel:         channel,
+		Subtopic:        subtopic,
+		Payload:         d.Body,
+		Publisher:       publisher,
+		ClientId:        clientID,
+		Protocol:        protocol,
+		Created:         created,
+		ContentType:     d.ContentType,
+		ContentEncoding: d.ContentEncoding,
+		Headers:         userHeaders(d),
 	}, nil
 }
 
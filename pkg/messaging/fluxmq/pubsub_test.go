@@ -4,6 +4,7 @@
 package fluxmq
 
 import (
+	"maps"
 	"testing"
 	"time"
 
@@ -112,6 +113,7 @@ func TestMessageFromDelivery(t *testing.T) {
 		name      string
 		body      []byte
 		headers   map[string]any
+		props     amqp091.Delivery
 		ts        time.Time
 		prefix    string
 		mqttTopic string
@@ -172,6 +174,36 @@ func TestMessageFromDelivery(t *testing.T) {
 				Created:   time.Unix(1710000000, 500).UnixNano(),
 			},
 		},
+		{
+			name: "content type and user properties",
+			body: []byte(`[{"n":"temp","v":21}]`),
+			headers: map[string]any{
+				"external_id":     "ext-1",
+				"trace-id":        "abc",
+				"x-stream-offset": "42",
+				"x-work-group":    "writers",
+				"priority":        int64(1),
+			},
+			props:     amqp091.Delivery{ContentType: "application/senml+json", ContentEncoding: "zstd", CorrelationId: "corr-1", ReplyTo: "m/dom/c/ch/replies"},
+			ts:        time.Unix(1710000000, 0),
+			prefix:    "m",
+			mqttTopic: "m/dom/c/ch",
+			want: &messaging.Message{
+				Domain:          "dom",
+				Channel:         "ch",
+				Payload:         []byte(`[{"n":"temp","v":21}]`),
+				Publisher:       "ext-1",
+				Protocol:        "mqtt",
+				Created:         time.Unix(1710000000, 0).UnixNano(),
+				ContentType:     "application/senml+json",
+				ContentEncoding: "zstd",
+				Headers: map[string]string{
+					"trace-id":       "abc",
+					"correlation-id": "corr-1",
+					"reply-to":       "m/dom/c/ch/replies",
+				},
+			},
+		},
 		{
 			name:      "invalid topic",
 			body:      []byte("x"),
@@ -183,7 +215,9 @@ func TestMessageFromDelivery(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got, err := messageFromDelivery(tc.body, tc.headers, tc.ts, tc.prefix, tc.mqttTopic)
+			d := tc.props
+			d.Body, d.Headers, d.Timestamp = tc.body, tc.headers, tc.ts
+			got, err := messageFromDelivery(d, tc.prefix, tc.mqttTopic)
 			if tc.wantErr {
 				if err == nil {
 					t.Fatal("expected error, got nil")
@@ -211,6 +245,15 @@ func TestMessageFromDelivery(t *testing.T) {
 			if got.Created != tc.want.Created {
 				t.Fatalf("created mismatch: got %d, want %d", got.Created, tc.want.Created)
 			}
+			if got.ContentEncoding != tc.want.ContentEncoding {
+				t.Fatalf("content encoding mismatch: got %q, want %q", got.ContentEncoding, tc.want.ContentEncoding)
+			}
+			if got.ContentType != tc.want.ContentType {
+				t.Fatalf("content type mismatch: got %q, want %q", got.ContentType, tc.want.ContentType)
+			}
+			if !maps.Equal(got.Headers, tc.want.Headers) {
+				t.Fatalf("headers mismatch: got %v, want %v", got.Headers, tc.want.Headers)
+			}
 		})
 	}
 }
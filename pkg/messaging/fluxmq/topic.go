@@ -9,10 +9,28 @@ import (
 
 	fluxamqp "github.com/absmach/fluxmq/client/amqp"
 	"github.com/absmach/magistrala/pkg/messaging"
+	amqp091 "github.com/rabbitmq/amqp091-go"
 )
 
 const queuePrefix = "$queue/"
 
+// Property keys used to carry messaging.Message fields which have no
+// dedicated AMQP property.
+const (
+	propExternalID    = "external_id"
+	propProtocol      = "protocol"
+	propClientID      = "client_id"
+	propCreated       = "created"
+	propContentType   = "content-type"
+	propEncoding      = "content-encoding"
+	propCorrelationID = "correlation-id"
+	propReplyTo       = "reply-to"
+)
+
+// brokerHeaderPrefix marks headers added by the broker itself (stream
+// offsets, work-queue state), which are not part of the message.
+const brokerHeaderPrefix = "x-"
+
 var nameReplacer = strings.NewReplacer(
 	" ", "_",
 	".", "_",
@@ -113,6 +131,42 @@ func stringHeader(headers map[string]any, key string) string {
 	}
 }
 
+// isReservedHeader reports whether the header key is used to transport
+// message fields or broker state rather than user properties.
+func isReservedHeader(key string) bool {
+	switch strings.ToLower(key) {
+	case propExternalID, propProtocol, propClientID, propCreated, propContentType, propEncoding:
+		return true
+	}
+	return strings.HasPrefix(key, brokerHeaderPrefix)
+}
+
+// userHeaders extracts user properties from the delivery. The AMQP
+// correlation ID and reply-to properties are restored as headers, mirroring
+// how the publisher maps them.
+func userHeaders(d amqp091.Delivery) map[string]string {
+	headers := map[string]string{}
+	for key := range d.Headers {
+		if isReservedHeader(key) {
+			continue
+		}
+		if v := stringHeader(d.Headers, key); v != "" {
+			headers[key] = v
+		}
+	}
+	if d.CorrelationId != "" {
+		headers[propCorrelationID] = d.CorrelationId
+	}
+	if d.ReplyTo != "" {
+		headers[propReplyTo] = d.ReplyTo
+	}
+	if len(headers) == 0 {
+		return nil
+	}
+
+	return headers
+}
+
 func declareStream(client *fluxamqp.Client, prefix string) error {
 	_, err := client.DeclareStreamQueue(&fluxamqp.StreamQueueOptions{
 		Name:    prefix,
@@ -255,6 +255,24 @@ func TestStringHeader(t *testing.T) {
 	}
 }
 
+func TestIsReservedHeader(t *testing.T) {
+	cases := map[string]bool{
+		"external_id":     true,
+		"protocol":        true,
+		"client_id":       true,
+		"created":         true,
+		"Content-Type":    true,
+		"x-stream-offset": true,
+		"correlation-id":  false,
+		"trace-id":        false,
+	}
+	for key, want := range cases {
+		if got := isReservedHeader(key); got != want {
+			t.Fatalf("isReservedHeader(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
 func TestFormatConsumerName(t *testing.T) {
 	got := formatConsumerName("m/domain/c/channel/#", "re/service 1")
 	want := "m_domain_c_channel__-re_service_1"
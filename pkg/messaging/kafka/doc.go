@@ -0,0 +1,11 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kafka holds the implementation of the Publisher and PubSub
+// interfaces for Apache Kafka. Every prefix is mapped to a single Kafka
+// topic which plays the role of a stream, while the Magistrala topic is
+// carried as the record key. Records with the same key land in the same
+// partition, so messages of a channel topic are delivered in order.
+// Subscriptions are durable consumer groups which filter records by the
+// subscribed topic, MQTT wildcards included.
+package kafka
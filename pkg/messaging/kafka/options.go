@@ -0,0 +1,105 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"errors"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+// ErrInvalidType is returned when the provided value is not of the expected type.
+var ErrInvalidType = errors.New("invalid type")
+
+const (
+	msgPrefix = "m"
+
+	// defaultAckWait mirrors the default JetStream acknowledgement wait, after
+	// which unacknowledged messages are redelivered.
+	defaultAckWait = 30 * time.Second
+)
+
+type options struct {
+	prefix            string
+	partitions        int32
+	replicationFactor int16
+	ackWait           time.Duration
+}
+
+func defaultOptions() options {
+	return options{
+		prefix: msgPrefix,
+		// -1 makes the broker use its configured defaults.
+		partitions:        -1,
+		replicationFactor: -1,
+		ackWait:           defaultAckWait,
+	}
+}
+
+// Prefix sets the prefix for the publisher or subscriber. The prefix is used
+// as the name of the Kafka topic.
+func Prefix(prefix string) messaging.Option {
+	return func(val any) error {
+		switch v := val.(type) {
+		case *publisher:
+			v.prefix = prefix
+		case *pubsub:
+			v.prefix = prefix
+		default:
+			return ErrInvalidType
+		}
+
+		return nil
+	}
+}
+
+// Partitions sets the number of partitions of the Kafka topic created by the
+// publisher or subscriber. It has no effect if the topic already exists.
+func Partitions(partitions int32) messaging.Option {
+	return func(val any) error {
+		switch v := val.(type) {
+		case *publisher:
+			v.partitions = partitions
+		case *pubsub:
+			v.partitions = partitions
+		default:
+			return ErrInvalidType
+		}
+
+		return nil
+	}
+}
+
+// ReplicationFactor sets the replication factor of the Kafka topic created by
+// the publisher or subscriber. It has no effect if the topic already exists.
+func ReplicationFactor(factor int16) messaging.Option {
+	return func(val any) error {
+		switch v := val.(type) {
+		case *publisher:
+			v.replicationFactor = factor
+		case *pubsub:
+			v.replicationFactor = factor
+		default:
+			return ErrInvalidType
+		}
+
+		return nil
+	}
+}
+
+// AckWait sets the duration after which a message which is neither
+// acknowledged nor terminated is redelivered to the subscriber.
+func AckWait(wait time.Duration) messaging.Option {
+	return func(val any) error {
+		switch v := val.(type) {
+		case *pubsub:
+			v.ackWait = wait
+		default:
+			return ErrInvalidType
+		}
+
+		return nil
+	}
+}
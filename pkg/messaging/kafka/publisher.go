@@ -0,0 +1,82 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ messaging.Publisher = (*publisher)(nil)
+
+type publisher struct {
+	client *kgo.Client
+	seeds  []string
+	options
+}
+
+// NewPublisher returns Kafka message Publisher. The url is a comma separated
+// list of seed broker addresses.
+func NewPublisher(ctx context.Context, url string, opts ...messaging.Option) (messaging.Publisher, error) {
+	pub := &publisher{
+		options: defaultOptions(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(pub); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := pub.connect(ctx, url); err != nil {
+		return nil, err
+	}
+
+	return pub, nil
+}
+
+func (pub *publisher) connect(ctx context.Context, url string) error {
+	pub.seeds = seedBrokers(url)
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(pub.seeds...),
+		kgo.DefaultProduceTopic(pub.prefix),
+		kgo.RequiredAcks(kgo.AllISRAcks()),
+	)
+	if err != nil {
+		return err
+	}
+	if err := createTopic(ctx, client, pub.options); err != nil {
+		client.Close()
+		return err
+	}
+	pub.client = client
+
+	return nil
+}
+
+func (pub *publisher) Publish(ctx context.Context, topic string, msg *messaging.Message) error {
+	if topic == "" {
+		return ErrEmptyTopic
+	}
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	record := &kgo.Record{
+		Key:   []byte(recordKey(topic)),
+		Value: data,
+	}
+
+	return pub.client.ProduceSync(ctx, record).FirstErr()
+}
+
+func (pub *publisher) Close() error {
+	pub.client.Close()
+	return nil
+}
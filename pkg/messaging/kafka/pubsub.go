@@ -0,0 +1,419 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/protobuf/proto"
+)
+
+// Publisher and Subscriber errors.
+var (
+	ErrNotSubscribed = errors.New("not subscribed")
+	ErrEmptyTopic    = errors.New("empty topic")
+	ErrEmptyID       = errors.New("empty id")
+)
+
+var _ messaging.PubSub = (*pubsub)(nil)
+
+type pubsub struct {
+	publisher
+	logger *slog.Logger
+
+	mu            sync.Mutex
+	subscriptions map[string]*subscription
+}
+
+// NewPubSub returns Kafka message publisher/subscriber. Every subscription
+// is a consumer group named after the subscribed topic and subscriber ID, so
+// subscribers sharing the ID share the load and resume from the last
+// committed offset after a restart.
+func NewPubSub(ctx context.Context, url string, logger *slog.Logger, opts ...messaging.Option) (messaging.PubSub, error) {
+	ps := &pubsub{
+		publisher: publisher{
+			options: defaultOptions(),
+		},
+		logger:        logger,
+		subscriptions: make(map[string]*subscription),
+	}
+
+	for _, opt := range opts {
+		if err := opt(ps); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ps.connect(ctx, url); err != nil {
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+func (ps *pubsub) Subscribe(_ context.Context, cfg messaging.SubscriberConfig) error {
+	if cfg.ID == "" {
+		return ErrEmptyID
+	}
+	if cfg.Topic == "" {
+		return ErrEmptyTopic
+	}
+
+	offset := kgo.NewOffset().AtEnd()
+	switch cfg.DeliveryPolicy {
+	case messaging.DeliverAllPolicy:
+		offset = kgo.NewOffset().AtStart()
+	case messaging.DeliverByStartTimePolicy:
+		offset = kgo.NewOffset().AfterMilli(cfg.StartTime.UnixMilli())
+	case messaging.DeliverByStartSequencePolicy:
+		// Kafka offsets are per partition, so the sequence applies to each partition.
+		offset = kgo.NewOffset().At(int64(cfg.StartSequence))
+	}
+
+	sub := &subscription{
+		ps:         ps,
+		group:      formatConsumerName(cfg.Topic, cfg.ID),
+		filter:     topicFilter(ps.prefix, cfg.Topic),
+		ordered:    cfg.Ordered,
+		handler:    cfg.Handler,
+		partitions: make(map[int32]*partition),
+		done:       make(chan struct{}),
+	}
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(ps.seeds...),
+		kgo.ConsumerGroup(sub.group),
+		kgo.ConsumeTopics(ps.prefix),
+		kgo.ConsumeResetOffset(offset),
+		kgo.AutoCommitMarks(),
+		kgo.OnPartitionsRevoked(sub.onRevoked),
+		kgo.OnPartitionsLost(sub.onLost),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+	sub.client = client
+
+	// The consumer lives until Unsubscribe or Close, not until the end of the request context.
+	ctx, cancel := context.WithCancel(context.Background())
+	sub.cancel = cancel
+	go sub.run(ctx)
+
+	key := subscriptionKey(cfg.ID, cfg.Topic)
+	ps.mu.Lock()
+	old, ok := ps.subscriptions[key]
+	ps.subscriptions[key] = sub
+	ps.mu.Unlock()
+	if ok {
+		old.close()
+	}
+
+	return nil
+}
+
+func (ps *pubsub) Unsubscribe(ctx context.Context, id, topic string) error {
+	if id == "" {
+		return ErrEmptyID
+	}
+	if topic == "" {
+		return ErrEmptyTopic
+	}
+
+	key := subscriptionKey(id, topic)
+	ps.mu.Lock()
+	sub, ok := ps.subscriptions[key]
+	delete(ps.subscriptions, key)
+	ps.mu.Unlock()
+	if !ok {
+		return ErrNotSubscribed
+	}
+	sub.close()
+
+	// Like deleting a durable consumer, deleting the group drops its offsets.
+	resp, err := kadm.NewClient(ps.client).DeleteGroup(ctx, sub.group)
+	if err == nil {
+		err = resp.Err
+	}
+	// Groups without committed offsets are removed by the broker once empty.
+	if errors.Is(err, kerr.GroupIDNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+func (ps *pubsub) Close() error {
+	ps.mu.Lock()
+	subs := ps.subscriptions
+	ps.subscriptions = make(map[string]*subscription)
+	ps.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.close()
+	}
+
+	return ps.publisher.Close()
+}
+
+type subscription struct {
+	ps      *pubsub
+	client  *kgo.Client
+	group   string
+	filter  string
+	ordered bool
+	handler messaging.MessageHandler
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	mu         sync.Mutex
+	partitions map[int32]*partition
+}
+
+// partition tracks the records of an assigned partition which are consumed
+// but not yet acknowledged. Kafka offsets are committed cumulatively, so the
+// committed offset never moves past the oldest pending record.
+type partition struct {
+	next    int64
+	epoch   int32
+	pending map[int64]struct{}
+	revoked bool
+}
+
+func (s *subscription) run(ctx context.Context) {
+	defer close(s.done)
+
+	for {
+		fetches := s.client.PollFetches(ctx)
+		if fetches.IsClientClosed() || ctx.Err() != nil {
+			return
+		}
+		fetches.EachError(func(topic string, partition int32, err error) {
+			s.ps.logger.Warn("failed to fetch records",
+				slog.String("topic", topic),
+				slog.Int("partition", int(partition)),
+				slog.String("consumer_group", s.group),
+				slog.String("error", err.Error()),
+			)
+		})
+		fetches.EachRecord(func(r *kgo.Record) {
+			s.consume(ctx, r)
+		})
+	}
+}
+
+func (s *subscription) consume(ctx context.Context, r *kgo.Record) {
+	p := s.track(r)
+	if !messaging.MatchTopic(s.filter, string(r.Key)) {
+		s.settle(ctx, p, r, false)
+		return
+	}
+
+	if !s.ordered {
+		s.deliver(ctx, p, r)
+		return
+	}
+
+	// Ordered subscriptions have at most one message in flight, so the next
+	// record is not handled before the current one is acknowledged.
+	for {
+		res := make(chan messaging.AckType, 1)
+		s.handle(r, func(at messaging.AckType) {
+			res <- at
+		})
+
+		var at messaging.AckType
+		select {
+		case at = <-res:
+		case <-ctx.Done():
+			return
+		}
+
+		delay, retry := s.ack(ctx, p, r, at)
+		if !retry {
+			return
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		if s.revoked(p) {
+			return
+		}
+	}
+}
+
+func (s *subscription) deliver(ctx context.Context, p *partition, r *kgo.Record) {
+	s.handle(r, func(at messaging.AckType) {
+		delay, retry := s.ack(ctx, p, r, at)
+		if !retry {
+			return
+		}
+		time.AfterFunc(delay, func() {
+			if ctx.Err() != nil || s.revoked(p) {
+				return
+			}
+			s.deliver(ctx, p, r)
+		})
+	})
+}
+
+// handle passes the record to the handler and reports the acknowledgement
+// type to done once the handler is done with the message.
+func (s *subscription) handle(r *kgo.Record, done func(messaging.AckType)) {
+	args := []any{
+		slog.String("topic", r.Topic),
+		slog.String("key", string(r.Key)),
+		slog.Int("partition", int(r.Partition)),
+		slog.Int64("offset", r.Offset),
+		slog.String("consumer_group", s.group),
+	}
+
+	var msg messaging.Message
+	if err := proto.Unmarshal(r.Value, &msg); err != nil {
+		ackType := messaging.Term
+		args = append(args, slog.String("ack_type", ackType.String()), slog.String("error", err.Error()))
+		s.ps.logger.Warn("failed to unmarshal message", args...)
+		done(ackType)
+		return
+	}
+
+	if dh, ok := s.handler.(messaging.DeferredAckHandler); ok {
+		var once sync.Once
+		dh.HandleDeferred(&msg, func(at messaging.AckType, err error) {
+			once.Do(func() {
+				if err != nil {
+					args = append(args, slog.String("ack_type", at.String()), slog.String("error", err.Error()))
+					s.ps.logger.Warn("failed to handle message", args...)
+				}
+				done(at)
+			})
+		})
+		return
+	}
+
+	err := s.handler.Handle(&msg)
+	ackType := errAckType(err)
+	if err != nil {
+		args = append(args, slog.String("ack_type", ackType.String()), slog.String("error", err.Error()))
+		s.ps.logger.Warn("failed to handle message", args...)
+	}
+	done(ackType)
+}
+
+// ack applies the acknowledgement type to the record and reports whether and
+// when the record has to be redelivered. Nack redelivers immediately, while
+// messages which are neither acknowledged nor terminated are redelivered
+// after the ack wait, the same as with JetStream.
+func (s *subscription) ack(ctx context.Context, p *partition, r *kgo.Record, at messaging.AckType) (time.Duration, bool) {
+	switch at {
+	case messaging.Ack, messaging.Term:
+		s.settle(ctx, p, r, false)
+		return 0, false
+	case messaging.DoubleAck:
+		s.settle(ctx, p, r, true)
+		return 0, false
+	case messaging.Nack:
+		return 0, true
+	default:
+		return s.ps.ackWait, true
+	}
+}
+
+func (s *subscription) track(r *kgo.Record) *partition {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.partitions[r.Partition]
+	if !ok {
+		p = &partition{pending: make(map[int64]struct{})}
+		s.partitions[r.Partition] = p
+	}
+	p.pending[r.Offset] = struct{}{}
+	p.next = r.Offset + 1
+	p.epoch = r.LeaderEpoch
+
+	return p
+}
+
+// settle marks the record as processed and commits the offset up to the
+// oldest record which is still pending.
+func (s *subscription) settle(ctx context.Context, p *partition, r *kgo.Record, commit bool) {
+	s.mu.Lock()
+	if p.revoked {
+		s.mu.Unlock()
+		return
+	}
+	delete(p.pending, r.Offset)
+	offset := p.next
+	for o := range p.pending {
+		offset = min(offset, o)
+	}
+	s.client.MarkCommitOffsets(map[string]map[int32]kgo.EpochOffset{
+		r.Topic: {r.Partition: {Epoch: p.epoch, Offset: offset}},
+	})
+	s.mu.Unlock()
+
+	if commit {
+		if err := s.client.CommitMarkedOffsets(ctx); err != nil {
+			s.ps.logger.Warn(fmt.Sprintf("failed to double ack message: %s", err))
+		}
+	}
+}
+
+func (s *subscription) revoked(p *partition) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return p.revoked
+}
+
+func (s *subscription) onRevoked(ctx context.Context, cl *kgo.Client, revoked map[string][]int32) {
+	if err := cl.CommitMarkedOffsets(ctx); err != nil {
+		s.ps.logger.Warn(fmt.Sprintf("failed to commit offsets of revoked partitions: %s", err))
+	}
+	s.onLost(ctx, cl, revoked)
+}
+
+// onLost drops the state of the partitions which are no longer assigned. Their
+// pending records are redelivered to the new owner of the partition.
+func (s *subscription) onLost(_ context.Context, _ *kgo.Client, lost map[string][]int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range lost[s.ps.prefix] {
+		if p, ok := s.partitions[id]; ok {
+			p.revoked = true
+			delete(s.partitions, id)
+		}
+	}
+}
+
+func (s *subscription) close() {
+	s.cancel()
+	<-s.done
+	s.client.Close()
+}
+
+func errAckType(err error) messaging.AckType {
+	if err == nil {
+		return messaging.Ack
+	}
+	if e, ok := err.(messaging.Error); ok && e != nil {
+		return e.Ack()
+	}
+	return messaging.NoAck
+}
+
+func subscriptionKey(id, topic string) string {
+	return fmt.Sprintf("%s|%s", id, topic)
+}
@@ -0,0 +1,468 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/messaging/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	domain   = "5e1a1f2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+	channel  = "9b7b1b3f-b1b0-46a8-a717-b8213f9eda3b"
+	subtopic = "engine"
+	clientID = "9b7b1b3f-b1b0-46a8-a717-b8213f9eda3b"
+	timeout  = 10 * time.Second
+)
+
+var (
+	errHandle = errors.New("failed to handle message")
+	prefixes  atomic.Uint32
+)
+
+type handler struct {
+	msgs chan *messaging.Message
+	mu   sync.Mutex
+	errs []error
+}
+
+func newHandler(errs ...error) *handler {
+	return &handler{msgs: make(chan *messaging.Message, 100), errs: errs}
+}
+
+func (h *handler) Handle(msg *messaging.Message) error {
+	h.msgs <- msg
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.errs) == 0 {
+		return nil
+	}
+	err := h.errs[0]
+	h.errs = h.errs[1:]
+
+	return err
+}
+
+func (h *handler) Cancel() error {
+	return nil
+}
+
+type deferredHandler struct {
+	*handler
+	acks chan messaging.AckFunc
+}
+
+func (h deferredHandler) HandleDeferred(msg *messaging.Message, ack messaging.AckFunc) {
+	h.msgs <- msg
+	h.acks <- ack
+}
+
+func newPubSub(t *testing.T, prefix string) messaging.PubSub {
+	ps, err := kafka.NewPubSub(context.Background(), address, logger, kafka.Prefix(prefix), kafka.AckWait(time.Second))
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating pubsub: %s", err))
+	t.Cleanup(func() {
+		assert.Nil(t, ps.Close())
+	})
+
+	return ps
+}
+
+// newPrefix returns a prefix, and so a Kafka topic, unique to the test run
+// since consumer groups keep their offsets in between the runs.
+func newPrefix(name string) string {
+	return fmt.Sprintf("%s%d", name, prefixes.Add(1))
+}
+
+func topic(prefix, subtopic string) string {
+	return fmt.Sprintf("%s/%s", prefix, messaging.EncodeTopicSuffix(domain, channel, subtopic))
+}
+
+func publish(t *testing.T, pub messaging.Publisher, subtopic string, payload string) {
+	msg := &messaging.Message{
+		Domain:   domain,
+		Channel:  channel,
+		Subtopic: subtopic,
+		Payload:  []byte(payload),
+		Created:  time.Now().UnixNano(),
+	}
+	err := pub.Publish(context.Background(), messaging.EncodeTopicSuffix(domain, channel, subtopic), msg)
+	require.Nil(t, err, fmt.Sprintf("unexpected error publishing message: %s", err))
+}
+
+func receive(t *testing.T, msgs chan *messaging.Message) *messaging.Message {
+	select {
+	case msg := <-msgs:
+		return msg
+	case <-time.After(timeout):
+		require.FailNow(t, "timed out waiting for message")
+		return nil
+	}
+}
+
+func assertNoMessage(t *testing.T, msgs chan *messaging.Message, wait time.Duration) {
+	select {
+	case msg := <-msgs:
+		assert.Failf(t, "unexpected message", "received %s", msg.GetPayload())
+	case <-time.After(wait):
+	}
+}
+
+func TestPublisher(t *testing.T) {
+	prefix := newPrefix("publisher")
+	pub, err := kafka.NewPublisher(context.Background(), address, kafka.Prefix(prefix))
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating publisher: %s", err))
+	defer pub.Close()
+
+	ps := newPubSub(t, prefix)
+	h := newHandler()
+	err = ps.Subscribe(context.Background(), messaging.SubscriberConfig{
+		ID:             clientID,
+		Topic:          prefix + "/#",
+		Handler:        h,
+		DeliveryPolicy: messaging.DeliverAllPolicy,
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error subscribing: %s", err))
+
+	cases := []struct {
+		desc    string
+		topic   string
+		message *messaging.Message
+		err     error
+	}{
+		{
+			desc:    "publish empty message",
+			topic:   channel,
+			message: &messaging.Message{},
+		},
+		{
+			desc:    "publish message",
+			topic:   messaging.EncodeTopicSuffix(domain, channel, subtopic),
+			message: &messaging.Message{Domain: domain, Channel: channel, Subtopic: subtopic, Payload: []byte("payload")},
+		},
+		{
+			desc:    "publish message with empty topic",
+			topic:   "",
+			message: &messaging.Message{Payload: []byte("payload")},
+			err:     kafka.ErrEmptyTopic,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := pub.Publish(context.Background(), tc.topic, tc.message)
+			assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err != nil {
+				return
+			}
+			msg := receive(t, h.msgs)
+			assert.Equal(t, tc.message.GetPayload(), msg.GetPayload())
+			assert.Equal(t, tc.message.GetSubtopic(), msg.GetSubtopic())
+		})
+	}
+}
+
+func TestPubsub(t *testing.T) {
+	ps := newPubSub(t, "pubsub")
+
+	cases := []struct {
+		desc      string
+		topic     string
+		clientID  string
+		subscribe bool
+		err       error
+		handler   messaging.MessageHandler
+	}{
+		{
+			desc:      "subscribe to a topic with an ID",
+			topic:     "pubsub/#",
+			clientID:  "client1",
+			subscribe: true,
+			handler:   newHandler(),
+		},
+		{
+			desc:      "subscribe to the same topic with a different ID",
+			topic:     "pubsub/#",
+			clientID:  "client2",
+			subscribe: true,
+			handler:   newHandler(),
+		},
+		{
+			desc:      "subscribe to an already subscribed topic with an ID",
+			topic:     "pubsub/#",
+			clientID:  "client1",
+			subscribe: true,
+			handler:   newHandler(),
+		},
+		{
+			desc:      "subscribe to an empty topic with an ID",
+			topic:     "",
+			clientID:  "client1",
+			subscribe: true,
+			err:       kafka.ErrEmptyTopic,
+			handler:   newHandler(),
+		},
+		{
+			desc:      "subscribe to a topic with empty id",
+			topic:     "pubsub/#",
+			clientID:  "",
+			subscribe: true,
+			err:       kafka.ErrEmptyID,
+			handler:   newHandler(),
+		},
+		{
+			desc:      "unsubscribe from a topic with an ID",
+			topic:     "pubsub/#",
+			clientID:  "client1",
+			subscribe: false,
+		},
+		{
+			desc:      "unsubscribe from a non-existent topic with an ID",
+			topic:     "pubsub/h",
+			clientID:  "client1",
+			subscribe: false,
+			err:       kafka.ErrNotSubscribed,
+		},
+		{
+			desc:      "unsubscribe from an already unsubscribed topic with an ID",
+			topic:     "pubsub/#",
+			clientID:  "client1",
+			subscribe: false,
+			err:       kafka.ErrNotSubscribed,
+		},
+		{
+			desc:      "unsubscribe from an empty topic with an ID",
+			topic:     "",
+			clientID:  "client1",
+			subscribe: false,
+			err:       kafka.ErrEmptyTopic,
+		},
+		{
+			desc:      "unsubscribe from a topic with empty ID",
+			topic:     "pubsub/#",
+			clientID:  "",
+			subscribe: false,
+			err:       kafka.ErrEmptyID,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var err error
+			switch tc.subscribe {
+			case true:
+				err = ps.Subscribe(context.Background(), messaging.SubscriberConfig{
+					ID:      tc.clientID,
+					Topic:   tc.topic,
+					Handler: tc.handler,
+				})
+			default:
+				err = ps.Unsubscribe(context.Background(), tc.clientID, tc.topic)
+			}
+			assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestTopicFilter(t *testing.T) {
+	prefix := newPrefix("filter")
+	ps := newPubSub(t, prefix)
+	publish(t, ps, "temperature", "temperature")
+	publish(t, ps, "humidity", "humidity")
+	publish(t, ps, "temperature/room", "room")
+
+	cases := []struct {
+		desc     string
+		topic    string
+		payloads []string
+	}{
+		{
+			desc:     "subscribe to all messages",
+			topic:    prefix + "/#",
+			payloads: []string{"temperature", "humidity", "room"},
+		},
+		{
+			desc:     "subscribe to a subtopic",
+			topic:    topic(prefix, "temperature"),
+			payloads: []string{"temperature"},
+		},
+		{
+			desc:     "subscribe to a single level wildcard",
+			topic:    topic(prefix, "+"),
+			payloads: []string{"temperature", "humidity"},
+		},
+		{
+			desc:     "subscribe to a multi level wildcard",
+			topic:    topic(prefix, "temperature/#"),
+			payloads: []string{"temperature", "room"},
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			h := newHandler()
+			err := ps.Subscribe(context.Background(), messaging.SubscriberConfig{
+				ID:             fmt.Sprintf("filter%d", i),
+				Topic:          tc.topic,
+				Handler:        h,
+				DeliveryPolicy: messaging.DeliverAllPolicy,
+				Ordered:        true,
+			})
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error subscribing: %s", tc.desc, err))
+
+			var payloads []string
+			for range tc.payloads {
+				payloads = append(payloads, string(receive(t, h.msgs).GetPayload()))
+			}
+			assert.ElementsMatch(t, tc.payloads, payloads)
+			assertNoMessage(t, h.msgs, 500*time.Millisecond)
+		})
+	}
+}
+
+func TestDeliveryPolicy(t *testing.T) {
+	prefix := newPrefix("policy")
+	ps := newPubSub(t, prefix)
+	publish(t, ps, subtopic, "old")
+
+	all := newHandler()
+	err := ps.Subscribe(context.Background(), messaging.SubscriberConfig{
+		ID:             "all",
+		Topic:          prefix + "/#",
+		Handler:        all,
+		DeliveryPolicy: messaging.DeliverAllPolicy,
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error subscribing: %s", err))
+	assert.Equal(t, "old", string(receive(t, all.msgs).GetPayload()), "deliver all policy must deliver existing messages")
+
+	latest := newHandler()
+	err = ps.Subscribe(context.Background(), messaging.SubscriberConfig{
+		ID:             "new",
+		Topic:          prefix + "/#",
+		Handler:        latest,
+		DeliveryPolicy: messaging.DeliverNewPolicy,
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error subscribing: %s", err))
+
+	// The consumer group starts from the end of the topic once it joins,
+	// so publish until the first new message is received.
+	var msg *messaging.Message
+	for i := 0; msg == nil && i < 20; i++ {
+		publish(t, ps, subtopic, "new")
+		select {
+		case msg = <-latest.msgs:
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+	require.NotNil(t, msg, "deliver new policy must deliver new messages")
+	assert.Equal(t, "new", string(msg.GetPayload()), "deliver new policy must not deliver existing messages")
+}
+
+func TestAck(t *testing.T) {
+	cases := []struct {
+		desc       string
+		err        error
+		redelivery bool
+	}{
+		{
+			desc: "handle message successfully",
+		},
+		{
+			desc:       "handle message with nack",
+			err:        messaging.NewError(errHandle, messaging.Nack),
+			redelivery: true,
+		},
+		{
+			desc:       "handle message with generic error",
+			err:        errHandle,
+			redelivery: true,
+		},
+		{
+			desc:       "handle message with in progress",
+			err:        messaging.NewError(errHandle, messaging.InProgress),
+			redelivery: true,
+		},
+		{
+			desc: "handle message with term",
+			err:  messaging.NewError(errHandle, messaging.Term),
+		},
+		{
+			desc: "handle message with double ack",
+			err:  messaging.NewError(errHandle, messaging.DoubleAck),
+		},
+	}
+
+	for i, tc := range cases {
+		for _, ordered := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s ordered %t", tc.desc, ordered), func(t *testing.T) {
+				prefix := newPrefix(fmt.Sprintf("ack%d%t", i, ordered))
+				ps := newPubSub(t, prefix)
+				publish(t, ps, subtopic, "payload")
+
+				h := newHandler(tc.err)
+				err := ps.Subscribe(context.Background(), messaging.SubscriberConfig{
+					ID:             clientID,
+					Topic:          prefix + "/#",
+					Handler:        h,
+					DeliveryPolicy: messaging.DeliverAllPolicy,
+					Ordered:        ordered,
+				})
+				require.Nil(t, err, fmt.Sprintf("%s: unexpected error subscribing: %s", tc.desc, err))
+
+				receive(t, h.msgs)
+				if tc.redelivery {
+					assert.Equal(t, "payload", string(receive(t, h.msgs).GetPayload()))
+				}
+				assertNoMessage(t, h.msgs, 2*time.Second)
+			})
+		}
+	}
+}
+
+func TestDeferredAck(t *testing.T) {
+	prefix := newPrefix("deferred")
+	ps := newPubSub(t, prefix)
+	publish(t, ps, subtopic, "first")
+	publish(t, ps, subtopic, "second")
+
+	h := deferredHandler{handler: newHandler(), acks: make(chan messaging.AckFunc, 100)}
+	err := ps.Subscribe(context.Background(), messaging.SubscriberConfig{
+		ID:             clientID,
+		Topic:          prefix + "/#",
+		Handler:        h,
+		DeliveryPolicy: messaging.DeliverAllPolicy,
+		Ordered:        true,
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error subscribing: %s", err))
+
+	assert.Equal(t, "first", string(receive(t, h.msgs).GetPayload()))
+	assertNoMessage(t, h.msgs, 500*time.Millisecond)
+	ack := <-h.acks
+	ack(messaging.Nack, errHandle)
+	assert.Equal(t, "first", string(receive(t, h.msgs).GetPayload()), "nacked message must be redelivered")
+	ack = <-h.acks
+	ack(messaging.Ack, nil)
+	assert.Equal(t, "second", string(receive(t, h.msgs).GetPayload()))
+	ack = <-h.acks
+	ack(messaging.Ack, nil)
+
+	// Resubscribing resumes the consumer group from the committed offset.
+	err = ps.Subscribe(context.Background(), messaging.SubscriberConfig{
+		ID:             clientID,
+		Topic:          prefix + "/#",
+		Handler:        h,
+		DeliveryPolicy: messaging.DeliverAllPolicy,
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error subscribing: %s", err))
+	assertNoMessage(t, h.msgs, 2*time.Second)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka_test
+
+import (
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	mglog "github.com/absmach/magistrala/logger"
+	"github.com/twmb/franz-go/pkg/kfake"
+)
+
+var (
+	address string
+	logger  = mglog.NewMock()
+)
+
+func TestMain(m *testing.M) {
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.DefaultNumPartitions(2))
+	if err != nil {
+		log.Fatalf("Could not start Kafka cluster: %s", err)
+	}
+	address = "kafka://" + strings.Join(cluster.ListenAddrs(), ",")
+
+	code := m.Run()
+	cluster.Close()
+
+	os.Exit(code)
+}
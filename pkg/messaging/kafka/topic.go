@@ -0,0 +1,85 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+const urlScheme = "kafka://"
+
+var nameReplacer = strings.NewReplacer(
+	" ", "_",
+	".", "_",
+	"*", "_",
+	">", "_",
+	"/", "_",
+	"\\", "_",
+	"+", "_",
+	"#", "_",
+)
+
+// seedBrokers parses a comma separated list of broker addresses, optionally
+// prefixed with the kafka:// scheme.
+func seedBrokers(url string) []string {
+	var seeds []string
+	for _, addr := range strings.Split(url, ",") {
+		addr = strings.TrimPrefix(strings.TrimSpace(addr), urlScheme)
+		if addr != "" {
+			seeds = append(seeds, addr)
+		}
+	}
+
+	return seeds
+}
+
+func createTopic(ctx context.Context, client *kgo.Client, opts options) error {
+	resp, err := kadm.NewClient(client).CreateTopic(ctx, opts.partitions, opts.replicationFactor, nil, opts.prefix)
+	if err == nil {
+		err = resp.Err
+	}
+	if err != nil && !errors.Is(err, kerr.TopicAlreadyExists) {
+		return fmt.Errorf("failed to create topic %s: %w", opts.prefix, err)
+	}
+
+	return nil
+}
+
+// recordKey returns the topic of the published message relative to the prefix.
+func recordKey(topic string) string {
+	return strings.Trim(strings.TrimSpace(topic), "/")
+}
+
+// topicFilter returns the subscription filter relative to the prefix.
+func topicFilter(prefix, topic string) string {
+	topic = strings.TrimSpace(topic)
+	switch {
+	case topic == prefix:
+		return "#"
+	case strings.HasPrefix(topic, prefix+"/"):
+		topic = strings.TrimPrefix(topic, prefix+"/")
+	}
+
+	topic = strings.Trim(topic, "/")
+	if topic == "" {
+		return "#"
+	}
+
+	return topic
+}
+
+func formatConsumerName(topic, id string) string {
+	// Group names are used in logs and metrics, keep them free of separators and wildcards.
+	topic = nameReplacer.Replace(topic)
+	id = nameReplacer.Replace(id)
+
+	return fmt.Sprintf("%s-%s", topic, id)
+}
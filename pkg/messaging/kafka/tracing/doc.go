@@ -0,0 +1,9 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracing provides tracing instrumentation for the Kafka message
+// publisher and pub/sub.
+//
+// For more details about tracing instrumentation for Magistrala messaging refer
+// to the documentation at https://magistrala.absmach.eu/docs/.
+package tracing
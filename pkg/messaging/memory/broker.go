@@ -0,0 +1,106 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	scheme = "memory"
+
+	openTimeout = 5 * time.Second
+)
+
+// ErrInvalidURL is returned when the URL does not use the memory scheme.
+var ErrInvalidURL = errors.New("invalid in-process broker URL")
+
+var (
+	registryMu sync.Mutex
+	registry   = make(map[string]*broker)
+)
+
+// broker holds the streams shared by the publishers and subscribers created
+// with the same URL.
+type broker struct {
+	key  string
+	db   *bolt.DB
+	refs int
+
+	mu      sync.Mutex
+	streams map[string]*stream
+}
+
+// acquire returns the broker for the URL, creating it on first use. In-memory
+// brokers live as long as the process, so durable consumers survive closing
+// and reopening the pub/sub, while persistent brokers are closed once the
+// last publisher or subscriber is closed.
+func acquire(rawURL string) (*broker, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != scheme {
+		return nil, ErrInvalidURL
+	}
+	key := u.Host + u.Path
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	b, ok := registry[key]
+	if !ok {
+		b = &broker{
+			key:     key,
+			streams: make(map[string]*stream),
+		}
+		if u.Path != "" {
+			db, err := bolt.Open(u.Path, 0o600, &bolt.Options{Timeout: openTimeout})
+			if err != nil {
+				return nil, err
+			}
+			b.db = db
+		}
+		registry[key] = b
+	}
+	b.refs++
+
+	return b, nil
+}
+
+func (b *broker) release() error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	b.refs--
+	if b.refs > 0 || b.db == nil {
+		return nil
+	}
+	delete(registry, b.key)
+
+	return b.db.Close()
+}
+
+func (b *broker) stream(name string, maxMessages int) (*stream, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if s, ok := b.streams[name]; ok {
+		return s, nil
+	}
+	s := &stream{
+		db:          b.db,
+		name:        name,
+		maxMessages: maxMessages,
+		consumers:   make(map[string]*consumer),
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	b.streams[name] = s
+
+	return s, nil
+}
@@ -0,0 +1,358 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"encoding/binary"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// consumer is a durable consumer of a stream. All the entries up to the
+// acknowledgement floor are acknowledged, which is the position the consumer
+// resumes from. Messages are dispatched to the attached subscriptions in
+// turns, so subscribers sharing the consumer share the load.
+type consumer struct {
+	stream *stream
+	name   string
+
+	mu            sync.Mutex
+	filter        string
+	ordered       bool
+	ackWait       time.Duration
+	maxAckPending int
+	floor         uint64
+	next          uint64
+	acked         map[uint64]struct{}
+	pending       map[uint64]struct{}
+	redeliveries  []uint64
+	subs          []*subscription
+	turn          int
+	removed       bool
+	cancel        context.CancelFunc
+	done          chan struct{}
+
+	wakeCh chan struct{}
+}
+
+type subscription struct {
+	consumer *consumer
+	handler  messaging.MessageHandler
+	logger   *slog.Logger
+}
+
+type delivery struct {
+	sub   *subscription
+	entry entry
+}
+
+func newConsumer(s *stream, name, filter string, floor uint64) *consumer {
+	return &consumer{
+		stream:        s,
+		name:          name,
+		filter:        filter,
+		ackWait:       defaultAckWait,
+		maxAckPending: defaultMaxAckPending,
+		floor:         floor,
+		next:          floor + 1,
+		acked:         make(map[uint64]struct{}),
+		pending:       make(map[uint64]struct{}),
+		wakeCh:        make(chan struct{}, 1),
+	}
+}
+
+func (c *consumer) setFilter(filter string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.filter = filter
+}
+
+func (c *consumer) configure(ordered bool, opts options) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ordered = ordered
+	c.ackWait = opts.ackWait
+	c.maxAckPending = opts.maxAckPending
+}
+
+// attach adds the subscription and starts the delivery if it is the first one.
+func (c *consumer) attach(sub *subscription) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.subs = append(c.subs, sub)
+	if len(c.subs) > 1 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.done = make(chan struct{})
+	go c.run(ctx, c.done)
+}
+
+// detach removes the subscription and stops the delivery if it is the last
+// one. It reports whether the consumer has no subscriptions left.
+func (c *consumer) detach(sub *subscription) bool {
+	c.mu.Lock()
+	c.subs = slices.DeleteFunc(c.subs, func(s *subscription) bool {
+		return s == sub
+	})
+	if len(c.subs) > 0 {
+		c.mu.Unlock()
+		return false
+	}
+	cancel, done := c.cancel, c.done
+	c.cancel, c.done = nil, nil
+	c.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+
+	return true
+}
+
+func (c *consumer) remove() error {
+	c.mu.Lock()
+	c.removed = true
+	c.mu.Unlock()
+
+	return c.stream.removeConsumer(c)
+}
+
+func (c *consumer) wake() {
+	select {
+	case c.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+func (c *consumer) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	for {
+		for {
+			d, ok := c.poll()
+			if !ok {
+				break
+			}
+			c.deliver(d)
+			if ctx.Err() != nil {
+				return
+			}
+		}
+		select {
+		case <-c.wakeCh:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// poll returns the next message to deliver. Redeliveries go first, while new
+// messages are delivered as long as the number of pending messages allows.
+func (c *consumer) poll() (delivery, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for len(c.subs) > 0 {
+		if len(c.redeliveries) > 0 {
+			seq := c.redeliveries[0]
+			c.redeliveries = c.redeliveries[1:]
+			if _, ok := c.pending[seq]; !ok {
+				continue
+			}
+			e, ok := c.stream.get(seq)
+			if !ok {
+				// The message was discarded from the stream in the meantime.
+				delete(c.pending, seq)
+				c.ackLocked(seq)
+				continue
+			}
+			return delivery{sub: c.nextSub(), entry: e}, true
+		}
+
+		if len(c.pending) >= c.maxAckPending || (c.ordered && len(c.pending) > 0) {
+			return delivery{}, false
+		}
+		e, ok := c.stream.next(c.next)
+		if !ok {
+			return delivery{}, false
+		}
+		c.next = e.seq + 1
+		if !messaging.MatchTopic(c.filter, e.topic) {
+			c.ackLocked(e.seq)
+			continue
+		}
+		c.pending[e.seq] = struct{}{}
+
+		return delivery{sub: c.nextSub(), entry: e}, true
+	}
+
+	return delivery{}, false
+}
+
+func (c *consumer) nextSub() *subscription {
+	c.turn = (c.turn + 1) % len(c.subs)
+	return c.subs[c.turn]
+}
+
+func (c *consumer) deliver(d delivery) {
+	seq := d.entry.seq
+	args := []any{
+		slog.String("stream", c.stream.name),
+		slog.String("consumer", c.name),
+		slog.String("topic", d.entry.topic),
+		slog.Uint64("stream_seq", seq),
+	}
+
+	var msg messaging.Message
+	if err := proto.Unmarshal(d.entry.data, &msg); err != nil {
+		ackType := messaging.Term
+		args = append(args, slog.String("ack_type", ackType.String()), slog.String("error", err.Error()))
+		d.sub.logger.Warn("failed to unmarshal message", args...)
+		c.settle(seq, ackType)
+		return
+	}
+
+	if dh, ok := d.sub.handler.(messaging.DeferredAckHandler); ok {
+		var once sync.Once
+		dh.HandleDeferred(&msg, func(at messaging.AckType, err error) {
+			once.Do(func() {
+				if err != nil {
+					args = append(args, slog.String("ack_type", at.String()), slog.String("error", err.Error()))
+					d.sub.logger.Warn("failed to handle message", args...)
+				}
+				c.settle(seq, at)
+			})
+		})
+		return
+	}
+
+	err := d.sub.handler.Handle(&msg)
+	ackType := errAckType(err)
+	if err != nil {
+		args = append(args, slog.String("ack_type", ackType.String()), slog.String("error", err.Error()))
+		d.sub.logger.Warn("failed to handle message", args...)
+	}
+	c.settle(seq, ackType)
+}
+
+// settle applies the acknowledgement type to the delivered message. Nack
+// redelivers immediately, while messages which are neither acknowledged nor
+// terminated are redelivered after the ack wait, the same as with JetStream.
+func (c *consumer) settle(seq uint64, at messaging.AckType) {
+	switch at {
+	case messaging.Ack, messaging.DoubleAck, messaging.Term:
+		c.ack(seq)
+	case messaging.Nack:
+		c.redeliver(seq, 0)
+	default:
+		c.mu.Lock()
+		wait := c.ackWait
+		c.mu.Unlock()
+		c.redeliver(seq, wait)
+	}
+}
+
+func (c *consumer) ack(seq uint64) {
+	c.mu.Lock()
+	delete(c.pending, seq)
+	moved := c.ackLocked(seq)
+	c.mu.Unlock()
+
+	if moved {
+		if err := c.persist(); err != nil {
+			slog.Warn("failed to persist consumer position", slog.String("consumer", c.name), slog.String("error", err.Error()))
+		}
+	}
+	c.wake()
+}
+
+// ackLocked acknowledges the entry and moves the floor over the acknowledged
+// and discarded entries. It reports whether the floor moved.
+func (c *consumer) ackLocked(seq uint64) bool {
+	if seq <= c.floor {
+		return false
+	}
+	c.acked[seq] = struct{}{}
+
+	floor := max(c.floor, c.stream.first()-1)
+	for {
+		if _, ok := c.acked[floor+1]; !ok {
+			break
+		}
+		floor++
+	}
+	for s := range c.acked {
+		if s <= floor {
+			delete(c.acked, s)
+		}
+	}
+	moved := floor != c.floor
+	c.floor = floor
+
+	return moved
+}
+
+func (c *consumer) redeliver(seq uint64, delay time.Duration) {
+	time.AfterFunc(delay, func() {
+		c.mu.Lock()
+		if _, ok := c.pending[seq]; !ok || c.removed {
+			c.mu.Unlock()
+			return
+		}
+		c.redeliveries = append(c.redeliveries, seq)
+		c.mu.Unlock()
+		c.wake()
+	})
+}
+
+// persist stores the consumer filter and floor, never moving the stored
+// floor back, since concurrent acknowledgements may persist out of order.
+func (c *consumer) persist() error {
+	if c.stream.db == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	floor, filter, removed := c.floor, c.filter, c.removed
+	c.mu.Unlock()
+	if removed {
+		return nil
+	}
+
+	return c.stream.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(c.stream.consumersBucket())
+		if err != nil {
+			return err
+		}
+		if v := b.Get([]byte(c.name)); len(v) >= 8 {
+			floor = max(floor, binary.BigEndian.Uint64(v[:8]))
+		}
+		v := binary.BigEndian.AppendUint64(nil, floor)
+
+		return b.Put([]byte(c.name), append(v, filter...))
+	})
+}
+
+func errAckType(err error) messaging.AckType {
+	if err == nil {
+		return messaging.Ack
+	}
+	if e, ok := err.(messaging.Error); ok && e != nil {
+		return e.Ack()
+	}
+	return messaging.NoAck
+}
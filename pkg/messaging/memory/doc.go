@@ -0,0 +1,14 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package memory holds an in-process implementation of the Publisher and
+// PubSub interfaces for single binary deployments and tests, which run
+// without an external message broker.
+//
+// Publishers and subscribers created with the same URL in a process share
+// the broker. Messages are kept in a stream per prefix and consumed by
+// durable consumers, MQTT wildcards included, with the same acknowledgement
+// semantics as JetStream. A URL with a path, e.g.
+// memory:///var/lib/magistrala/broker.db, persists streams and consumer
+// positions in a bbolt database which survives restarts.
+package memory
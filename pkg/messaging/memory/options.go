@@ -0,0 +1,102 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"errors"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+// ErrInvalidType is returned when the provided value is not of the expected type.
+var ErrInvalidType = errors.New("invalid type")
+
+const (
+	msgPrefix = "m"
+
+	defaultAckWait       = 30 * time.Second
+	defaultMaxAckPending = 1000
+	defaultMaxMessages   = 1e6
+)
+
+type options struct {
+	prefix        string
+	ackWait       time.Duration
+	maxAckPending int
+	maxMessages   int
+}
+
+func defaultOptions() options {
+	return options{
+		prefix:        msgPrefix,
+		ackWait:       defaultAckWait,
+		maxAckPending: defaultMaxAckPending,
+		maxMessages:   defaultMaxMessages,
+	}
+}
+
+// Prefix sets the prefix for the publisher or subscriber. Every prefix has
+// its own stream.
+func Prefix(prefix string) messaging.Option {
+	return func(val any) error {
+		switch v := val.(type) {
+		case *publisher:
+			v.prefix = prefix
+		case *pubsub:
+			v.prefix = prefix
+		default:
+			return ErrInvalidType
+		}
+
+		return nil
+	}
+}
+
+// MaxMessages sets the number of messages retained by the stream, older
+// messages are discarded. It applies to the stream when it is created.
+func MaxMessages(n int) messaging.Option {
+	return func(val any) error {
+		switch v := val.(type) {
+		case *publisher:
+			v.maxMessages = n
+		case *pubsub:
+			v.maxMessages = n
+		default:
+			return ErrInvalidType
+		}
+
+		return nil
+	}
+}
+
+// AckWait sets the duration after which a message which is neither
+// acknowledged nor terminated is redelivered to the subscriber.
+func AckWait(wait time.Duration) messaging.Option {
+	return func(val any) error {
+		switch v := val.(type) {
+		case *pubsub:
+			v.ackWait = wait
+		default:
+			return ErrInvalidType
+		}
+
+		return nil
+	}
+}
+
+// MaxAckPending sets the number of messages delivered to a consumer and not
+// yet acknowledged, after which the delivery is paused.
+func MaxAckPending(n int) messaging.Option {
+	return func(val any) error {
+		switch v := val.(type) {
+		case *pubsub:
+			v.maxAckPending = n
+		default:
+			return ErrInvalidType
+		}
+
+		return nil
+	}
+}
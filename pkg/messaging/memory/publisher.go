@@ -0,0 +1,73 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ messaging.Publisher = (*publisher)(nil)
+
+type publisher struct {
+	broker *broker
+	stream *stream
+	options
+}
+
+// NewPublisher returns in-process message Publisher. The url is either
+// memory://<name> for an in-memory broker or memory://<name>/<path> for a
+// broker persisted in the bbolt database at path.
+func NewPublisher(_ context.Context, url string, opts ...messaging.Option) (messaging.Publisher, error) {
+	pub := &publisher{
+		options: defaultOptions(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(pub); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := pub.connect(url); err != nil {
+		return nil, err
+	}
+
+	return pub, nil
+}
+
+func (pub *publisher) connect(url string) error {
+	b, err := acquire(url)
+	if err != nil {
+		return err
+	}
+	s, err := b.stream(pub.prefix, pub.maxMessages)
+	if err != nil {
+		_ = b.release()
+		return err
+	}
+	pub.broker = b
+	pub.stream = s
+
+	return nil
+}
+
+func (pub *publisher) Publish(_ context.Context, topic string, msg *messaging.Message) error {
+	if topic == "" {
+		return ErrEmptyTopic
+	}
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return pub.stream.append(entryTopic(topic), data)
+}
+
+func (pub *publisher) Close() error {
+	return pub.broker.release()
+}
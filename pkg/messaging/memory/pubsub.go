@@ -0,0 +1,127 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+// Publisher and Subscriber errors.
+var (
+	ErrNotSubscribed = errors.New("not subscribed")
+	ErrEmptyTopic    = errors.New("empty topic")
+	ErrEmptyID       = errors.New("empty id")
+)
+
+var _ messaging.PubSub = (*pubsub)(nil)
+
+type pubsub struct {
+	publisher
+	logger *slog.Logger
+
+	mu            sync.Mutex
+	subscriptions map[string]*subscription
+}
+
+// NewPubSub returns in-process message publisher/subscriber. Every
+// subscription is a durable consumer named after the subscribed topic and
+// subscriber ID, which is shared by the subscribers with the same ID.
+func NewPubSub(_ context.Context, url string, logger *slog.Logger, opts ...messaging.Option) (messaging.PubSub, error) {
+	ps := &pubsub{
+		publisher: publisher{
+			options: defaultOptions(),
+		},
+		logger:        logger,
+		subscriptions: make(map[string]*subscription),
+	}
+
+	for _, opt := range opts {
+		if err := opt(ps); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ps.connect(url); err != nil {
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+func (ps *pubsub) Subscribe(_ context.Context, cfg messaging.SubscriberConfig) error {
+	if cfg.ID == "" {
+		return ErrEmptyID
+	}
+	if cfg.Topic == "" {
+		return ErrEmptyTopic
+	}
+
+	c, err := ps.stream.consumer(formatConsumerName(cfg.Topic, cfg.ID), topicFilter(ps.prefix, cfg.Topic), cfg)
+	if err != nil {
+		return err
+	}
+	c.configure(cfg.Ordered, ps.options)
+
+	sub := &subscription{
+		consumer: c,
+		handler:  cfg.Handler,
+		logger:   ps.logger,
+	}
+	key := subscriptionKey(cfg.ID, cfg.Topic)
+	ps.mu.Lock()
+	old, ok := ps.subscriptions[key]
+	ps.subscriptions[key] = sub
+	ps.mu.Unlock()
+
+	c.attach(sub)
+	if ok {
+		old.consumer.detach(old)
+	}
+
+	return nil
+}
+
+func (ps *pubsub) Unsubscribe(_ context.Context, id, topic string) error {
+	if id == "" {
+		return ErrEmptyID
+	}
+	if topic == "" {
+		return ErrEmptyTopic
+	}
+
+	key := subscriptionKey(id, topic)
+	ps.mu.Lock()
+	sub, ok := ps.subscriptions[key]
+	delete(ps.subscriptions, key)
+	ps.mu.Unlock()
+	if !ok {
+		return ErrNotSubscribed
+	}
+
+	// Like deleting a durable consumer, the consumer position is dropped
+	// once no subscriber is left.
+	if sub.consumer.detach(sub) {
+		return sub.consumer.remove()
+	}
+
+	return nil
+}
+
+func (ps *pubsub) Close() error {
+	ps.mu.Lock()
+	subs := ps.subscriptions
+	ps.subscriptions = make(map[string]*subscription)
+	ps.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.consumer.detach(sub)
+	}
+
+	return ps.publisher.Close()
+}
@@ -0,0 +1,254 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+var errCorruptedEntry = errors.New("corrupted stream entry")
+
+type entry struct {
+	seq   uint64
+	topic string
+	data  []byte
+}
+
+// stream is an append only log of the messages published with a prefix.
+// Entries are numbered by consecutive sequence numbers and the oldest
+// entries are discarded once the stream holds more than maxMessages.
+type stream struct {
+	db          *bolt.DB
+	name        string
+	maxMessages int
+
+	mu        sync.Mutex
+	entries   []entry
+	last      uint64
+	consumers map[string]*consumer
+}
+
+func (s *stream) messagesBucket() []byte {
+	return []byte("messages/" + s.name)
+}
+
+func (s *stream) consumersBucket() []byte {
+	return []byte("consumers/" + s.name)
+}
+
+// load replays the persisted entries and consumers of the stream.
+func (s *stream) load() error {
+	if s.db == nil {
+		return nil
+	}
+
+	return s.db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket(s.messagesBucket()); b != nil {
+			if err := b.ForEach(func(k, v []byte) error {
+				e, err := decodeEntry(k, v)
+				if err != nil {
+					return err
+				}
+				s.entries = append(s.entries, e)
+				s.last = e.seq
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		b := tx.Bucket(s.consumersBucket())
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			if len(v) < 8 {
+				return errCorruptedEntry
+			}
+			c := newConsumer(s, string(k), string(v[8:]), binary.BigEndian.Uint64(v[:8]))
+			s.consumers[c.name] = c
+			s.last = max(s.last, c.floor)
+			return nil
+		})
+	})
+}
+
+func (s *stream) append(topic string, data []byte) error {
+	s.mu.Lock()
+	e := entry{seq: s.last + 1, topic: topic, data: data}
+	discard := max(len(s.entries)+1-s.maxMessages, 0)
+	if s.db != nil {
+		if err := s.db.Update(func(tx *bolt.Tx) error {
+			b, err := tx.CreateBucketIfNotExists(s.messagesBucket())
+			if err != nil {
+				return err
+			}
+			for _, old := range s.entries[:discard] {
+				if err := b.Delete(seqKey(old.seq)); err != nil {
+					return err
+				}
+			}
+			return b.Put(seqKey(e.seq), encodeEntry(e))
+		}); err != nil {
+			s.mu.Unlock()
+			return err
+		}
+	}
+	s.entries = append(s.entries[discard:], e)
+	s.last = e.seq
+	consumers := make([]*consumer, 0, len(s.consumers))
+	for _, c := range s.consumers {
+		consumers = append(consumers, c)
+	}
+	s.mu.Unlock()
+
+	for _, c := range consumers {
+		c.wake()
+	}
+
+	return nil
+}
+
+// next returns the oldest retained entry with the sequence not lower than seq.
+func (s *stream) next(seq uint64) (entry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.entries) == 0 {
+		return entry{}, false
+	}
+	first := s.entries[0].seq
+	if seq < first {
+		seq = first
+	}
+	if i := seq - first; i < uint64(len(s.entries)) {
+		return s.entries[i], true
+	}
+
+	return entry{}, false
+}
+
+func (s *stream) get(seq uint64) (entry, bool) {
+	e, ok := s.next(seq)
+	if !ok || e.seq != seq {
+		return entry{}, false
+	}
+
+	return e, true
+}
+
+// first returns the sequence of the oldest retained entry.
+func (s *stream) first() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.entries) == 0 {
+		return s.last + 1
+	}
+
+	return s.entries[0].seq
+}
+
+// consumer returns the durable consumer, creating it if it does not exist.
+// The delivery policy applies only to new consumers, existing consumers
+// resume from their acknowledgement floor.
+func (s *stream) consumer(name, filter string, cfg messaging.SubscriberConfig) (*consumer, error) {
+	s.mu.Lock()
+	c, ok := s.consumers[name]
+	if !ok {
+		var floor uint64
+		switch cfg.DeliveryPolicy {
+		case messaging.DeliverAllPolicy:
+			if len(s.entries) > 0 {
+				floor = s.entries[0].seq - 1
+			}
+		case messaging.DeliverByStartTimePolicy:
+			floor = s.floorByTime(cfg.StartTime)
+		case messaging.DeliverByStartSequencePolicy:
+			floor = min(max(cfg.StartSequence, 1)-1, s.last)
+		default:
+			floor = s.last
+		}
+		c = newConsumer(s, name, filter, floor)
+		s.consumers[name] = c
+	}
+	s.mu.Unlock()
+
+	c.setFilter(filter)
+	if err := c.persist(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// floorByTime returns the floor from which the first delivered entry is the
+// oldest one created at or after t. Entries are searched by the message
+// creation time since the stream keeps no timestamps of its own.
+func (s *stream) floorByTime(t time.Time) uint64 {
+	ts := t.UnixNano()
+	for _, e := range s.entries {
+		var msg messaging.Message
+		if err := proto.Unmarshal(e.data, &msg); err != nil {
+			continue
+		}
+		if msg.GetCreated() >= ts {
+			return e.seq - 1
+		}
+	}
+
+	return s.last
+}
+
+func (s *stream) removeConsumer(c *consumer) error {
+	s.mu.Lock()
+	if s.consumers[c.name] == c {
+		delete(s.consumers, c.name)
+	}
+	s.mu.Unlock()
+
+	if s.db == nil {
+		return nil
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(s.consumersBucket())
+		if b == nil {
+			return nil
+		}
+		return b.Delete([]byte(c.name))
+	})
+}
+
+func seqKey(seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, seq)
+}
+
+func encodeEntry(e entry) []byte {
+	buf := binary.AppendUvarint(nil, uint64(len(e.topic)))
+	buf = append(buf, e.topic...)
+
+	return append(buf, e.data...)
+}
+
+func decodeEntry(k, v []byte) (entry, error) {
+	n, l := binary.Uvarint(v)
+	if len(k) != 8 || l <= 0 || uint64(len(v)-l) < n {
+		return entry{}, errCorruptedEntry
+	}
+	v = v[l:]
+
+	return entry{
+		seq:   binary.BigEndian.Uint64(k),
+		topic: string(v[:n]),
+		data:  append([]byte(nil), v[n:]...),
+	}, nil
+}
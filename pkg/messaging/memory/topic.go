@@ -0,0 +1,54 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"fmt"
+	"strings"
+)
+
+var nameReplacer = strings.NewReplacer(
+	" ", "_",
+	".", "_",
+	"*", "_",
+	">", "_",
+	"/", "_",
+	"\\", "_",
+	"+", "_",
+	"#", "_",
+)
+
+// entryTopic returns the topic of the published message relative to the prefix.
+func entryTopic(topic string) string {
+	return strings.Trim(strings.TrimSpace(topic), "/")
+}
+
+// topicFilter returns the subscription filter relative to the prefix.
+func topicFilter(prefix, topic string) string {
+	topic = strings.TrimSpace(topic)
+	switch {
+	case topic == prefix:
+		return "#"
+	case strings.HasPrefix(topic, prefix+"/"):
+		topic = strings.TrimPrefix(topic, prefix+"/")
+	}
+
+	topic = strings.Trim(topic, "/")
+	if topic == "" {
+		return "#"
+	}
+
+	return topic
+}
+
+func formatConsumerName(topic, id string) string {
+	topic = nameReplacer.Replace(topic)
+	id = nameReplacer.Replace(id)
+
+	return fmt.Sprintf("%s-%s", topic, id)
+}
+
+func subscriptionKey(id, topic string) string {
+	return fmt.Sprintf("%s|%s", id, topic)
+}
@@ -101,6 +101,12 @@ func (ps *pubsub) Subscribe(ctx context.Context, cfg messaging.SubscriberConfig)
 		consumerConfig.DeliverPolicy = jetstream.DeliverNewPolicy
 	case messaging.DeliverAllPolicy:
 		consumerConfig.DeliverPolicy = jetstream.DeliverAllPolicy
+	case messaging.DeliverByStartTimePolicy:
+		consumerConfig.DeliverPolicy = jetstream.DeliverByStartTimePolicy
+		consumerConfig.OptStartTime = &cfg.StartTime
+	case messaging.DeliverByStartSequencePolicy:
+		consumerConfig.DeliverPolicy = jetstream.DeliverByStartSequencePolicy
+		consumerConfig.OptStartSeq = cfg.StartSequence
 	}
 
 	consumer, err := ps.stream.CreateOrUpdateConsumer(ctx, consumerConfig)
@@ -161,6 +167,17 @@ func (ps *pubsub) natsHandler(h messaging.MessageHandler) func(m jetstream.Msg)
 			return
 		}
 
+		if dh, ok := h.(messaging.DeferredAckHandler); ok {
+			dh.HandleDeferred(&msg, func(at messaging.AckType, err error) {
+				if err != nil {
+					args = append(args, slog.String("ack_type", at.String()), slog.String("error", err.Error()))
+					ps.logger.Warn("failed to handle message", args...)
+				}
+				ps.handleAck(at, m)
+			})
+			return
+		}
+
 		err = h.Handle(&msg)
 		ackType := ps.errAckType(err)
 		if err != nil {
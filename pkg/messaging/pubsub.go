@@ -6,6 +6,7 @@ package messaging
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 type DeliveryPolicy uint8
@@ -17,6 +18,14 @@ const (
 
 	// DeliverAllPolicy starts delivering messages from the very beginning of a stream.
 	DeliverAllPolicy
+
+	// DeliverByStartTimePolicy starts delivering messages from the first message
+	// stored at or after the subscriber's StartTime.
+	DeliverByStartTimePolicy
+
+	// DeliverByStartSequencePolicy starts delivering messages from the subscriber's
+	// StartSequence. Sequences are broker specific stream positions.
+	DeliverByStartSequencePolicy
 )
 
 // AckType is used for message acknowledgement.
@@ -69,6 +78,22 @@ type MessageHandler interface {
 	Cancel() error
 }
 
+// AckFunc acknowledges a message using the given acknowledgement type.
+// The err is the message handling error, if any.
+type AckFunc func(at AckType, err error)
+
+// DeferredAckHandler is an optional MessageHandler extension for handlers
+// which complete message processing after the handling call returns, such as
+// consumers buffering messages for batch writes. Subscribers supporting
+// deferred acknowledgement call HandleDeferred instead of Handle and the
+// handler is responsible for calling ack exactly once per message.
+type DeferredAckHandler interface {
+	MessageHandler
+
+	// HandleDeferred handles the message and acknowledges it using ack.
+	HandleDeferred(msg *Message, ack AckFunc)
+}
+
 // SubscriberConfig defines the configuration for a subscriber that processes messages from a topic.
 type SubscriberConfig struct {
 	ID             string         // Unique identifier for the subscriber.
@@ -77,6 +102,8 @@ type SubscriberConfig struct {
 	Handler        MessageHandler // Function that handles incoming messages.
 	DeliveryPolicy DeliveryPolicy // DeliverPolicy defines from which point to start delivering messages.
 	Ordered        bool           // Whether message delivery must preserve order.
+	StartTime      time.Time      // Start time used by DeliverByStartTimePolicy.
+	StartSequence  uint64         // Start sequence used by DeliverByStartSequencePolicy.
 }
 
 // Subscriber specifies message subscription API.
@@ -330,6 +330,28 @@ func formatSubtopic(subtopic string) (string, error) {
 	return subtopic, nil
 }
 
+// MatchTopic reports whether the /-separated topic matches the filter, which
+// may contain MQTT single level (+) and multi level (#) wildcards.
+func MatchTopic(filter, topic string) bool {
+	if filter == "#" {
+		return true
+	}
+	fparts := strings.Split(filter, "/")
+	tparts := strings.Split(topic, "/")
+	for i, f := range fparts {
+		switch {
+		case f == "#":
+			return true
+		case i >= len(tparts):
+			return false
+		case f != "+" && f != tparts[i]:
+			return false
+		}
+	}
+
+	return len(fparts) == len(tparts)
+}
+
 func EncodeTopic(domainID string, channelID string, subtopic string) string {
 	return fmt.Sprintf("%s/%s", string(MsgTopicPrefix), EncodeTopicSuffix(domainID, channelID, subtopic))
 }
@@ -450,6 +450,70 @@ func TestEncodeTopic(t *testing.T) {
 	}
 }
 
+func TestMatchTopic(t *testing.T) {
+	cases := []struct {
+		desc     string
+		filter   string
+		topic    string
+		expected bool
+	}{
+		{
+			desc:     "exact match",
+			filter:   "domain1/c/chan1/temp",
+			topic:    "domain1/c/chan1/temp",
+			expected: true,
+		},
+		{
+			desc:     "different topic",
+			filter:   "domain1/c/chan1/temp",
+			topic:    "domain1/c/chan1/humidity",
+			expected: false,
+		},
+		{
+			desc:     "multi level wildcard only",
+			filter:   "#",
+			topic:    "domain1/c/chan1/temp",
+			expected: true,
+		},
+		{
+			desc:     "multi level wildcard",
+			filter:   "domain1/c/#",
+			topic:    "domain1/c/chan1/dev/temp",
+			expected: true,
+		},
+		{
+			desc:     "multi level wildcard matching parent level",
+			filter:   "domain1/c/chan1/#",
+			topic:    "domain1/c/chan1",
+			expected: true,
+		},
+		{
+			desc:     "single level wildcard",
+			filter:   "domain1/c/+/temp",
+			topic:    "domain1/c/chan1/temp",
+			expected: true,
+		},
+		{
+			desc:     "single level wildcard with more levels",
+			filter:   "domain1/c/+",
+			topic:    "domain1/c/chan1/temp",
+			expected: false,
+		},
+		{
+			desc:     "filter longer than topic",
+			filter:   "domain1/c/chan1/temp",
+			topic:    "domain1/c/chan1",
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.expected, messaging.MatchTopic(tc.filter, tc.topic))
+		})
+	}
+}
+
 func TestEncodeTopicSuffix(t *testing.T) {
 	cases := []struct {
 		desc      string
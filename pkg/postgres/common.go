@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+
+	"github.com/jmoiron/sqlx"
 )
 
 // CreateMetadataQuery creates a query to filter by metadata.
@@ -30,12 +32,17 @@ func CreateMetadataQuery(entity string, um map[string]any) (string, []byte, erro
 	return query, param, nil
 }
 
+// Querier executes named queries.
+type Querier interface {
+	NamedQueryContext(ctx context.Context, query string, arg any) (*sqlx.Rows, error)
+}
+
 // Total returns the total number of rows.
 //
 // For example:
 //
 //	total, err := Total(ctx, db, "SELECT COUNT(*) FROM table", nil)
-func Total(ctx context.Context, db Database, query string, params any) (uint64, error) {
+func Total(ctx context.Context, db Querier, query string, params any) (uint64, error) {
 	rows, err := db.NamedQueryContext(ctx, query, params)
 	if err != nil {
 		return 0, err
@@ -6,4 +6,8 @@
 //
 // It provides the abstraction of the PostgreSQL database service, which is used
 // to configure, setup and connect to the PostgreSQL database.
+//
+// It also provides a transactional outbox: services store their events in the
+// outbox table in the same transaction as the changes the events describe, and
+// the Relay sends the stored events to the event store.
 package postgres
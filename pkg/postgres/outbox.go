@@ -0,0 +1,121 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/events"
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+var errSaveEvent = errors.New("failed to save event to outbox")
+
+var _ events.TxPublisher = (*outbox)(nil)
+
+type outbox struct {
+	db Database
+}
+
+// OutboxMigrations returns the migrations creating the outbox tables. They
+// are meant to be appended to the migrations of a service using the outbox.
+func OutboxMigrations() []*migrate.Migration {
+	return []*migrate.Migration{
+		{
+			Id: "outbox_01",
+			Up: []string{
+				`CREATE TABLE IF NOT EXISTS outbox (
+					id          BIGSERIAL PRIMARY KEY,
+					stream      VARCHAR(254) NOT NULL,
+					payload     JSONB NOT NULL,
+					created_at  TIMESTAMP NOT NULL
+				)`,
+			},
+			Down: []string{
+				`DROP TABLE IF EXISTS outbox`,
+			},
+		},
+		{
+			Id: "outbox_02",
+			Up: []string{
+				`ALTER TABLE outbox ADD COLUMN IF NOT EXISTS attempts INTEGER NOT NULL DEFAULT 0`,
+				`CREATE TABLE IF NOT EXISTS outbox_dead_letter (
+					id          BIGINT PRIMARY KEY,
+					stream      VARCHAR(254) NOT NULL,
+					payload     JSONB NOT NULL,
+					created_at  TIMESTAMP NOT NULL,
+					attempts    INTEGER NOT NULL,
+					error       TEXT NOT NULL,
+					failed_at   TIMESTAMP NOT NULL
+				)`,
+			},
+			Down: []string{
+				`DROP TABLE IF EXISTS outbox_dead_letter`,
+				`ALTER TABLE outbox DROP COLUMN IF EXISTS attempts`,
+			},
+		},
+	}
+}
+
+// NewOutbox returns an events publisher which stores events in the outbox
+// table instead of sending them to the event store. Events published within
+// Atomic are stored in the same transaction as the state changes they
+// describe, so either both are persisted or neither is. The transaction
+// begins with the first change made within Atomic, so calls to other
+// services made before it are not part of it. The stored events are sent to
+// the event store by the Relay.
+func NewOutbox(db Database) events.TxPublisher {
+	return &outbox{db: db}
+}
+
+func (ob *outbox) Publish(ctx context.Context, stream string, event events.Event) error {
+	values, err := event.Encode()
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(values)
+	if err != nil {
+		return errors.Wrap(errSaveEvent, err)
+	}
+
+	q := `INSERT INTO outbox (stream, payload, created_at) VALUES ($1, $2, $3)`
+	if _, err := ob.db.ExecContext(ctx, q, stream, payload, time.Now().UTC()); err != nil {
+		return errors.Wrap(errSaveEvent, err)
+	}
+
+	return nil
+}
+
+func (ob *outbox) Atomic(ctx context.Context, fn func(ctx context.Context) error) error {
+	return ob.db.WithinTx(ctx, fn)
+}
+
+func (ob *outbox) Close() error {
+	return nil
+}
+
+type outboxEvent struct {
+	ID       int64  `db:"id"`
+	Stream   string `db:"stream"`
+	Payload  []byte `db:"payload"`
+	Attempts uint64 `db:"attempts"`
+}
+
+// Encode decodes numbers as json.Number so that they are published exactly
+// as they were stored.
+func (oe outboxEvent) Encode() (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(oe.Payload))
+	dec.UseNumber()
+
+	var values map[string]any
+	if err := dec.Decode(&values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
@@ -0,0 +1,113 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
+	pgclient "github.com/absmach/magistrala/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const stream = "test.stream"
+
+type testEvent struct {
+	id string
+}
+
+func (te testEvent) Encode() (map[string]any, error) {
+	return map[string]any{
+		"operation": "item.create",
+		"id":        te.id,
+	}, nil
+}
+
+func TestOutboxPublish(t *testing.T) {
+	t.Cleanup(func() {
+		cleanOutbox(t)
+	})
+
+	ob := pgclient.NewOutbox(database)
+	id := testsutil.GenerateUUID(t)
+
+	err := ob.Publish(context.Background(), stream, testEvent{id: id})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, []string{id}, outboxIDs(t), "expected event to be stored")
+}
+
+func TestOutboxAtomic(t *testing.T) {
+	t.Cleanup(func() {
+		cleanOutbox(t)
+		_, err := db.Exec("DELETE FROM items")
+		require.Nil(t, err, fmt.Sprintf("clean items unexpected error: %s", err))
+	})
+
+	ob := pgclient.NewOutbox(database)
+
+	cases := []struct {
+		desc   string
+		id     string
+		fnErr  error
+		err    error
+		stored bool
+	}{
+		{
+			desc:   "store event with state change",
+			id:     testsutil.GenerateUUID(t),
+			stored: true,
+		},
+		{
+			desc:   "discard event with failed state change",
+			id:     testsutil.GenerateUUID(t),
+			fnErr:  errFn,
+			err:    errFn,
+			stored: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cleanOutbox(t)
+			err := ob.Atomic(context.Background(), func(ctx context.Context) error {
+				if err := saveItem(ctx, tc.id); err != nil {
+					return err
+				}
+				if err := ob.Publish(ctx, stream, testEvent{id: tc.id}); err != nil {
+					return err
+				}
+				return tc.fnErr
+			})
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.stored, itemExists(t, context.Background(), tc.id), fmt.Sprintf("%s: unexpected stored item", tc.desc))
+			switch tc.stored {
+			case true:
+				assert.Equal(t, []string{tc.id}, outboxIDs(t), fmt.Sprintf("%s: expected event to be stored", tc.desc))
+			default:
+				assert.Empty(t, outboxIDs(t), fmt.Sprintf("%s: expected event not to be stored", tc.desc))
+			}
+		})
+	}
+}
+
+// outboxIDs returns the ids of the items of the events in the outbox, in
+// the order the events were stored.
+func outboxIDs(t *testing.T) []string {
+	ids := []string{}
+	err := db.Select(&ids, `SELECT payload->>'id' FROM outbox ORDER BY id`)
+	require.Nil(t, err, fmt.Sprintf("retrieve outbox unexpected error: %s", err))
+
+	return ids
+}
+
+func cleanOutbox(t *testing.T) {
+	_, err := db.Exec("DELETE FROM outbox")
+	require.Nil(t, err, fmt.Sprintf("clean outbox unexpected error: %s", err))
+	_, err = db.Exec("DELETE FROM outbox_dead_letter")
+	require.Nil(t, err, fmt.Sprintf("clean outbox dead letter unexpected error: %s", err))
+}
@@ -0,0 +1,175 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/events"
+)
+
+const (
+	defRelayInterval    = time.Second
+	defRelayBatchSize   = 100
+	defRelayMaxAttempts = 10
+)
+
+var errRelay = errors.New("failed to relay outbox events")
+
+// RelayConfig represents the outbox relay configuration.
+type RelayConfig struct {
+	Interval    time.Duration `env:"INTERVAL"      envDefault:"1s"`
+	BatchSize   uint64        `env:"BATCH_SIZE"    envDefault:"100"`
+	MaxAttempts uint64        `env:"MAX_ATTEMPTS"  envDefault:"10"`
+}
+
+// Relay sends the events stored in the outbox to the event store and
+// removes them from the outbox once they are published. Events are
+// delivered at least once: an event may be sent again if the relay stops
+// before removing it.
+//
+// Events are sent in the order they were stored. Several relays may run
+// against the same outbox, in which case each batch is sent by a single
+// relay but batches may interleave.
+//
+// An event which fails to be sent holds back the events stored after it and
+// is retried on the next run. Once it has failed MaxAttempts times, it is
+// moved to the outbox_dead_letter table and the following events are sent.
+// Dead-lettered events are kept with their last error and can be sent again
+// by inserting them back into the outbox.
+type Relay struct {
+	db          Database
+	publisher   events.Publisher
+	interval    time.Duration
+	batchSize   uint64
+	maxAttempts uint64
+	logger      *slog.Logger
+}
+
+// NewRelay returns a relay which sends the outbox events to the publisher.
+func NewRelay(db Database, publisher events.Publisher, cfg RelayConfig, logger *slog.Logger) *Relay {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defRelayInterval
+	}
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = defRelayBatchSize
+	}
+	if cfg.MaxAttempts == 0 {
+		cfg.MaxAttempts = defRelayMaxAttempts
+	}
+
+	return &Relay{
+		db:          db,
+		publisher:   publisher,
+		interval:    cfg.Interval,
+		batchSize:   cfg.BatchSize,
+		maxAttempts: cfg.MaxAttempts,
+		logger:      logger,
+	}
+}
+
+// Start relays the outbox events until the context is canceled. Full
+// batches are followed by the next one right away, otherwise the relay
+// waits for the configured interval.
+func (r *Relay) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := r.Relay(ctx)
+		if err != nil {
+			r.logger.Warn(fmt.Sprintf("%s: %s", errRelay, err))
+		}
+		if err == nil && n == r.batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Relay sends a single batch of outbox events and returns the number of
+// events removed from the outbox, either sent or dead-lettered.
+func (r *Relay) Relay(ctx context.Context) (uint64, error) {
+	var sent []int64
+	var dead uint64
+	var pubErr error
+
+	err := r.db.WithinTx(ctx, func(ctx context.Context) error {
+		q := `SELECT id, stream, payload, attempts FROM outbox ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED`
+		rows, err := r.db.QueryxContext(ctx, q, r.batchSize)
+		if err != nil {
+			return err
+		}
+		var evts []outboxEvent
+		for rows.Next() {
+			var evt outboxEvent
+			if err := rows.StructScan(&evt); err != nil {
+				rows.Close()
+				return err
+			}
+			evts = append(evts, evt)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, evt := range evts {
+			err := r.publisher.Publish(ctx, evt.Stream, evt)
+			if err == nil {
+				sent = append(sent, evt.ID)
+				continue
+			}
+			pubErr = err
+			if evt.Attempts+1 < r.maxAttempts {
+				// Retry the event on the next run, before the events after it.
+				if _, err := r.db.ExecContext(ctx, `UPDATE outbox SET attempts = attempts + 1 WHERE id = $1`, evt.ID); err != nil {
+					return err
+				}
+				break
+			}
+			if err := r.deadLetter(ctx, evt, pubErr); err != nil {
+				return err
+			}
+			dead++
+		}
+		if len(sent) == 0 {
+			return nil
+		}
+
+		// Remove the published events even if a later one failed, so they
+		// are not sent again.
+		_, err = r.db.ExecContext(ctx, `DELETE FROM outbox WHERE id = ANY($1)`, sent)
+
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(len(sent)) + dead, pubErr
+}
+
+func (r *Relay) deadLetter(ctx context.Context, evt outboxEvent, pubErr error) error {
+	q := `WITH dead AS (
+			DELETE FROM outbox WHERE id = $1 RETURNING id, stream, payload, created_at, attempts
+		)
+		INSERT INTO outbox_dead_letter (id, stream, payload, created_at, attempts, error, failed_at)
+		SELECT id, stream, payload, created_at, attempts + 1, $2, $3 FROM dead`
+	if _, err := r.db.ExecContext(ctx, q, evt.ID, pubErr.Error(), time.Now().UTC()); err != nil {
+		return err
+	}
+	r.logger.Error(fmt.Sprintf("moved outbox event %d of stream %s to dead letter after %d attempts: %s", evt.ID, evt.Stream, evt.Attempts+1, pubErr))
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/internal/testsutil"
+	smqlog "github.com/absmach/magistrala/logger"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/mocks"
+	pgclient "github.com/absmach/magistrala/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var errPublish = errors.New("failed to publish")
+
+func TestRelay(t *testing.T) {
+	t.Cleanup(func() {
+		cleanOutbox(t)
+	})
+
+	ob := pgclient.NewOutbox(database)
+	ids := storeEvents(t, ob, 3)
+
+	var sent []string
+	pub := new(mocks.Publisher)
+	pub.On("Publish", mock.Anything, stream, mock.Anything).Return(func(_ context.Context, _ string, event events.Event) error {
+		values, err := event.Encode()
+		if err != nil {
+			return err
+		}
+		sent = append(sent, values["id"].(string))
+		return nil
+	})
+
+	relay := pgclient.NewRelay(database, pub, pgclient.RelayConfig{BatchSize: 2}, smqlog.NewMock())
+
+	n, err := relay.Relay(context.Background())
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(2), n, "expected full batch to be relayed")
+	assert.Equal(t, ids[:2], sent, "expected first batch to be sent in order")
+	assert.Equal(t, ids[2:], outboxIDs(t), "expected sent events to be removed from the outbox")
+
+	n, err = relay.Relay(context.Background())
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(1), n, "expected remaining event to be relayed")
+	assert.Equal(t, ids, sent, "expected events to be sent in order")
+	assert.Empty(t, outboxIDs(t), "expected outbox to be empty")
+}
+
+func TestRelayFailedEvent(t *testing.T) {
+	t.Cleanup(func() {
+		cleanOutbox(t)
+	})
+
+	ob := pgclient.NewOutbox(database)
+	ids := storeEvents(t, ob, 3)
+
+	var sent []string
+	pub := new(mocks.Publisher)
+	pub.On("Publish", mock.Anything, stream, mock.Anything).Return(func(_ context.Context, _ string, event events.Event) error {
+		values, err := event.Encode()
+		if err != nil {
+			return err
+		}
+		if values["id"] == ids[0] {
+			return errPublish
+		}
+		sent = append(sent, values["id"].(string))
+		return nil
+	})
+
+	relay := pgclient.NewRelay(database, pub, pgclient.RelayConfig{MaxAttempts: 2}, smqlog.NewMock())
+
+	// The failed event holds back the events stored after it.
+	n, err := relay.Relay(context.Background())
+	assert.True(t, errors.Contains(err, errPublish), fmt.Sprintf("expected %s got %s\n", errPublish, err))
+	assert.Equal(t, uint64(0), n, "expected no event to be relayed")
+	assert.Empty(t, sent, "expected no event to be sent")
+	assert.Equal(t, ids, outboxIDs(t), "expected events to be kept in the outbox")
+
+	// The failed event is dead-lettered once it reaches the maximum number
+	// of attempts, and the following events are sent.
+	n, err = relay.Relay(context.Background())
+	assert.True(t, errors.Contains(err, errPublish), fmt.Sprintf("expected %s got %s\n", errPublish, err))
+	assert.Equal(t, uint64(3), n, "expected all events to be removed from the outbox")
+	assert.Equal(t, ids[1:], sent, "expected following events to be sent")
+	assert.Empty(t, outboxIDs(t), "expected outbox to be empty")
+
+	var dead struct {
+		ID       string `db:"item"`
+		Attempts int    `db:"attempts"`
+		Error    string `db:"error"`
+	}
+	err = db.Get(&dead, `SELECT payload->>'id' AS item, attempts, error FROM outbox_dead_letter`)
+	require.Nil(t, err, fmt.Sprintf("retrieve dead letter unexpected error: %s", err))
+	assert.Equal(t, ids[0], dead.ID, "expected failed event to be dead-lettered")
+	assert.Equal(t, 2, dead.Attempts, "expected dead-lettered event attempts")
+	assert.Equal(t, errPublish.Error(), dead.Error, "expected dead-lettered event error")
+}
+
+func storeEvents(t *testing.T, ob events.Publisher, n int) []string {
+	var ids []string
+	for i := 0; i < n; i++ {
+		id := testsutil.GenerateUUID(t)
+		err := ob.Publish(context.Background(), stream, testEvent{id: id})
+		require.Nil(t, err, fmt.Sprintf("store event unexpected error: %s", err))
+		ids = append(ids, id)
+	}
+
+	return ids
+}
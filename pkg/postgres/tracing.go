@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/jmoiron/sqlx"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -43,8 +44,52 @@ type Database interface {
 	// ExecContext executes a query without returning any rows.
 	ExecContext(context.Context, string, ...any) (sql.Result, error)
 
-	// BeginTxx begins a transaction and returns an *sqlx.Tx.
-	BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error)
+	// BeginTxx begins a transaction and returns a Tx. If the context carries
+	// a transaction started by WithinTx, a savepoint of that transaction is
+	// returned instead.
+	BeginTxx(ctx context.Context, opts *sql.TxOptions) (Tx, error)
+
+	// WithinTx runs fn in a transaction. Every query made through the
+	// Database with the context passed to fn is part of that transaction,
+	// which is committed if fn succeeds and rolled back otherwise. The
+	// transaction begins with the first statement which is not a plain
+	// SELECT: reads made before it run outside of the transaction, so fn
+	// should call other services before changing the database.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Tx provides a database transaction interface.
+type Tx interface {
+	// Commit commits the transaction.
+	Commit() error
+
+	// Rollback aborts the transaction.
+	Rollback() error
+
+	// Exec executes a query without returning any rows.
+	Exec(query string, args ...any) (sql.Result, error)
+
+	// ExecContext executes a query without returning any rows.
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+
+	// NamedExec executes a named query within the transaction.
+	NamedExec(query string, arg any) (sql.Result, error)
+
+	// NamedExecContext executes a named query within the transaction.
+	NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error)
+
+	// NamedQuery executes a named query within the transaction and returns
+	// the resulting rows.
+	NamedQuery(query string, arg any) (*sqlx.Rows, error)
+
+	// Queryx queries within the transaction and returns an *sqlx.Rows.
+	Queryx(query string, args ...any) (*sqlx.Rows, error)
+
+	// QueryxContext queries within the transaction and returns an *sqlx.Rows.
+	QueryxContext(ctx context.Context, query string, args ...any) (*sqlx.Rows, error)
+
+	// QueryRowxContext queries within the transaction and returns an *sqlx.Row.
+	QueryRowxContext(ctx context.Context, query string, args ...any) *sqlx.Row
 }
 
 // NewDatabase creates a Clients'Database instance.
@@ -65,6 +110,14 @@ func (d *database) NamedQueryContext(ctx context.Context, query string, args any
 	ctx, span := d.addSpanTags(ctx, query)
 	defer span.End()
 
+	tx, err := d.contextTx(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if tx != nil {
+		return sqlx.NamedQueryContext(ctx, tx, query, args)
+	}
+
 	return d.db.NamedQueryContext(ctx, query, args)
 }
 
@@ -72,6 +125,14 @@ func (d *database) NamedExecContext(ctx context.Context, query string, args any)
 	ctx, span := d.addSpanTags(ctx, query)
 	defer span.End()
 
+	tx, err := d.contextTx(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if tx != nil {
+		return tx.NamedExecContext(ctx, query, args)
+	}
+
 	return d.db.NamedExecContext(ctx, query, args)
 }
 
@@ -79,6 +140,14 @@ func (d *database) ExecContext(ctx context.Context, query string, args ...any) (
 	ctx, span := d.addSpanTags(ctx, query)
 	defer span.End()
 
+	tx, err := d.contextTx(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+
 	return d.db.ExecContext(ctx, query, args...)
 }
 
@@ -86,6 +155,18 @@ func (d *database) QueryRowxContext(ctx context.Context, query string, args ...a
 	ctx, span := d.addSpanTags(ctx, query)
 	defer span.End()
 
+	tx, err := d.contextTx(ctx, query)
+	if err != nil {
+		// The row carries the error of the query, so run it with a canceled
+		// context. The error is also returned by WithinTx.
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		return d.db.QueryRowxContext(cctx, query, args...)
+	}
+	if tx != nil {
+		return tx.QueryRowxContext(ctx, query, args...)
+	}
+
 	return d.db.QueryRowxContext(ctx, query, args...)
 }
 
@@ -93,22 +174,93 @@ func (d *database) QueryxContext(ctx context.Context, query string, args ...any)
 	ctx, span := d.addSpanTags(ctx, query)
 	defer span.End()
 
+	tx, err := d.contextTx(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if tx != nil {
+		return tx.QueryxContext(ctx, query, args...)
+	}
+
 	return d.db.QueryxContext(ctx, query, args...)
 }
 
-func (d database) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+func (d *database) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
 	ctx, span := d.addSpanTags(ctx, query)
 	defer span.End()
+
+	tx, err := d.contextTx(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+
 	return d.db.QueryContext(ctx, query, args...)
 }
 
-func (d database) BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error) {
+func (d *database) BeginTxx(ctx context.Context, opts *sql.TxOptions) (Tx, error) {
 	ctx, span := d.addSpanTags(ctx, "BeginTxx")
 	defer span.End()
 
+	if ct := d.txFromContext(ctx); ct != nil {
+		return ct.savepoint(ctx)
+	}
+
 	return d.db.BeginTxx(ctx, opts)
 }
 
+func (d *database) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	var tx interface {
+		Commit() error
+		Rollback() error
+	}
+	switch ct := d.txFromContext(ctx); ct {
+	case nil:
+		ct = &contextTx{db: d.db, ctx: ctx}
+		ctx = context.WithValue(ctx, txKey{}, ct)
+		tx = ct
+	default:
+		sp, err := ct.savepoint(ctx)
+		if err != nil {
+			return errors.Wrap(errBeginTx, err)
+		}
+		tx = sp
+	}
+
+	if err := fn(ctx); err != nil {
+		if errRollback := tx.Rollback(); errRollback != nil {
+			return errors.Wrap(err, errors.Wrap(errRollbackTx, errRollback))
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(errCommitTx, err)
+	}
+
+	return nil
+}
+
+func (d *database) txFromContext(ctx context.Context) *contextTx {
+	ct, ok := ctx.Value(txKey{}).(*contextTx)
+	if !ok || ct.db != d.db {
+		return nil
+	}
+
+	return ct
+}
+
+// contextTx returns the transaction of the context the query has to run in,
+// or nil if the query runs outside of a transaction.
+func (d *database) contextTx(ctx context.Context, query string) (*sqlx.Tx, error) {
+	if ct := d.txFromContext(ctx); ct != nil {
+		return ct.query(query)
+	}
+
+	return nil, nil
+}
+
 func (d *database) addSpanTags(ctx context.Context, query string) (context.Context, trace.Span) {
 	operation := strings.Replace(strings.Split(query, " ")[0], "(", "", 1)
 
@@ -0,0 +1,166 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	errBeginTx    = errors.New("failed to begin transaction")
+	errCommitTx   = errors.New("failed to commit transaction")
+	errRollbackTx = errors.New("failed to rollback transaction")
+)
+
+var _ Tx = (*savepoint)(nil)
+
+type txKey struct{}
+
+// contextTx is the transaction started by WithinTx and carried by the context
+// passed to its function. The database transaction is begun by the first
+// statement which changes the database, so that reads and calls to other
+// services made before it do not keep the transaction open.
+type contextTx struct {
+	db *sqlx.DB
+	// ctx is the context passed to WithinTx. The database transaction is
+	// bound to it rather than to the context of the statement beginning it,
+	// which may be canceled before the transaction ends.
+	ctx context.Context
+
+	mu         sync.Mutex
+	tx         *sqlx.Tx
+	err        error
+	savepoints uint64
+}
+
+// current returns the database transaction if it has already been begun.
+func (ct *contextTx) current() *sqlx.Tx {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	return ct.tx
+}
+
+// begin returns the database transaction, beginning it if needed.
+func (ct *contextTx) begin() (*sqlx.Tx, error) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	if ct.tx != nil {
+		return ct.tx, nil
+	}
+	if ct.err != nil {
+		return nil, ct.err
+	}
+	tx, err := ct.db.BeginTxx(ct.ctx, nil)
+	if err != nil {
+		ct.err = errors.Wrap(errBeginTx, err)
+		return nil, ct.err
+	}
+	ct.tx = tx
+
+	return tx, nil
+}
+
+// query returns the transaction the query has to run in. Queries which only
+// read run in the transaction once it has been begun, and outside of it
+// before that.
+func (ct *contextTx) query(query string) (*sqlx.Tx, error) {
+	if isRead(query) {
+		return ct.current(), nil
+	}
+
+	return ct.begin()
+}
+
+func (ct *contextTx) savepoint(ctx context.Context) (*savepoint, error) {
+	tx, err := ct.begin()
+	if err != nil {
+		return nil, err
+	}
+
+	ct.mu.Lock()
+	ct.savepoints++
+	name := fmt.Sprintf("sp_%d", ct.savepoints)
+	ct.mu.Unlock()
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return nil, err
+	}
+
+	return &savepoint{Tx: tx, name: name}, nil
+}
+
+// Commit commits the database transaction if it has been begun. It fails if
+// beginning the transaction failed, since the statements of fn were not run.
+func (ct *contextTx) Commit() error {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	if ct.err != nil {
+		return ct.err
+	}
+	if ct.tx != nil {
+		return ct.tx.Commit()
+	}
+
+	return nil
+}
+
+func (ct *contextTx) Rollback() error {
+	if tx := ct.current(); tx != nil {
+		return tx.Rollback()
+	}
+
+	return nil
+}
+
+// isRead reports whether the query only reads the database. Statements
+// locking the rows they select are not reads, since the locks are held
+// until the end of the transaction.
+func isRead(query string) bool {
+	q := strings.ToUpper(strings.TrimSpace(query))
+	if !strings.HasPrefix(q, "SELECT") {
+		return false
+	}
+
+	return !strings.Contains(q, "FOR UPDATE") && !strings.Contains(q, "FOR SHARE") &&
+		!strings.Contains(q, "FOR NO KEY UPDATE") && !strings.Contains(q, "FOR KEY SHARE")
+}
+
+// savepoint is a transaction nested in the context transaction. Committing
+// it releases the savepoint, while rolling it back discards only the changes
+// made since the savepoint was created.
+type savepoint struct {
+	*sqlx.Tx
+	name string
+	done bool
+}
+
+func (sp *savepoint) Commit() error {
+	if sp.done {
+		return sql.ErrTxDone
+	}
+	sp.done = true
+	_, err := sp.Tx.Exec("RELEASE SAVEPOINT " + sp.name)
+
+	return err
+}
+
+func (sp *savepoint) Rollback() error {
+	if sp.done {
+		return sql.ErrTxDone
+	}
+	sp.done = true
+	_, err := sp.Tx.Exec("ROLLBACK TO SAVEPOINT " + sp.name)
+
+	return err
+}
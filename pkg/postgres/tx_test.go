@@ -0,0 +1,207 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errFn = errors.New("function failed")
+
+func TestWithinTx(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM items")
+		require.Nil(t, err, fmt.Sprintf("clean items unexpected error: %s", err))
+	})
+
+	cases := []struct {
+		desc   string
+		id     string
+		fnErr  error
+		err    error
+		stored bool
+	}{
+		{
+			desc:   "commit transaction",
+			id:     testsutil.GenerateUUID(t),
+			stored: true,
+		},
+		{
+			desc:   "rollback transaction",
+			id:     testsutil.GenerateUUID(t),
+			fnErr:  errFn,
+			err:    errFn,
+			stored: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := database.WithinTx(context.Background(), func(ctx context.Context) error {
+				if err := saveItem(ctx, tc.id); err != nil {
+					return err
+				}
+				return tc.fnErr
+			})
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.stored, itemExists(t, context.Background(), tc.id), fmt.Sprintf("%s: unexpected stored item", tc.desc))
+		})
+	}
+}
+
+func TestWithinTxNested(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM items")
+		require.Nil(t, err, fmt.Sprintf("clean items unexpected error: %s", err))
+	})
+
+	cases := []struct {
+		desc        string
+		outerID     string
+		innerID     string
+		innerErr    error
+		outerErr    error
+		err         error
+		outerStored bool
+		innerStored bool
+	}{
+		{
+			desc:        "commit outer and nested transaction",
+			outerID:     testsutil.GenerateUUID(t),
+			innerID:     testsutil.GenerateUUID(t),
+			outerStored: true,
+			innerStored: true,
+		},
+		{
+			desc:        "rollback nested transaction only",
+			outerID:     testsutil.GenerateUUID(t),
+			innerID:     testsutil.GenerateUUID(t),
+			innerErr:    errFn,
+			outerStored: true,
+			innerStored: false,
+		},
+		{
+			desc:        "rollback outer transaction with committed nested transaction",
+			outerID:     testsutil.GenerateUUID(t),
+			innerID:     testsutil.GenerateUUID(t),
+			outerErr:    errFn,
+			err:         errFn,
+			outerStored: false,
+			innerStored: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := database.WithinTx(context.Background(), func(ctx context.Context) error {
+				if err := saveItem(ctx, tc.outerID); err != nil {
+					return err
+				}
+				err := database.WithinTx(ctx, func(ctx context.Context) error {
+					if err := saveItem(ctx, tc.innerID); err != nil {
+						return err
+					}
+					return tc.innerErr
+				})
+				assert.True(t, errors.Contains(err, tc.innerErr), fmt.Sprintf("%s: expected nested %s got %s\n", tc.desc, tc.innerErr, err))
+				assert.True(t, itemExists(t, ctx, tc.outerID), fmt.Sprintf("%s: expected outer item to be stored", tc.desc))
+				return tc.outerErr
+			})
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.outerStored, itemExists(t, context.Background(), tc.outerID), fmt.Sprintf("%s: unexpected stored outer item", tc.desc))
+			assert.Equal(t, tc.innerStored, itemExists(t, context.Background(), tc.innerID), fmt.Sprintf("%s: unexpected stored nested item", tc.desc))
+		})
+	}
+}
+
+func TestWithinTxSameTx(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM items")
+		require.Nil(t, err, fmt.Sprintf("clean items unexpected error: %s", err))
+	})
+
+	id := testsutil.GenerateUUID(t)
+	spID := testsutil.GenerateUUID(t)
+	err := database.WithinTx(context.Background(), func(ctx context.Context) error {
+		if err := saveItem(ctx, id); err != nil {
+			return err
+		}
+		assert.True(t, itemExists(t, ctx, id), "expected item to be visible within the transaction")
+		assert.False(t, itemExists(t, context.Background(), id), "expected item not to be visible outside of the transaction")
+
+		// BeginTxx returns a savepoint of the context transaction.
+		tx, err := database.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO items (id, name) VALUES ($1, $2)`, spID, "item"); err != nil {
+			return err
+		}
+		assert.True(t, itemExists(t, ctx, spID), "expected savepoint item to be visible within the transaction")
+		if err := tx.Rollback(); err != nil {
+			return err
+		}
+		assert.False(t, itemExists(t, ctx, spID), "expected savepoint item to be rolled back")
+		assert.True(t, itemExists(t, ctx, id), "expected item to be kept after savepoint rollback")
+
+		return nil
+	})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.True(t, itemExists(t, context.Background(), id), "expected item to be stored")
+	assert.False(t, itemExists(t, context.Background(), spID), "expected savepoint item not to be stored")
+}
+
+func TestWithinTxBeginsOnWrite(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM items")
+		require.Nil(t, err, fmt.Sprintf("clean items unexpected error: %s", err))
+	})
+
+	id := testsutil.GenerateUUID(t)
+	err := database.WithinTx(context.Background(), func(ctx context.Context) error {
+		assert.False(t, itemExists(t, ctx, id), "expected item not to exist")
+		assert.Equal(t, 0, openTxs(t), "expected reads not to begin the transaction")
+
+		if err := saveItem(ctx, id); err != nil {
+			return err
+		}
+		assert.Equal(t, 1, openTxs(t), "expected write to begin the transaction")
+
+		return nil
+	})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 0, openTxs(t), "expected transaction to be committed")
+	assert.True(t, itemExists(t, context.Background(), id), "expected item to be stored")
+}
+
+func saveItem(ctx context.Context, id string) error {
+	_, err := database.ExecContext(ctx, `INSERT INTO items (id, name) VALUES ($1, $2)`, id, "item")
+
+	return err
+}
+
+func itemExists(t *testing.T, ctx context.Context, id string) bool {
+	var count int
+	err := database.QueryRowxContext(ctx, `SELECT COUNT(*) FROM items WHERE id = $1`, id).Scan(&count)
+	require.Nil(t, err, fmt.Sprintf("count items unexpected error: %s", err))
+
+	return count > 0
+}
+
+// openTxs returns the number of connections which are in a transaction and
+// waiting for the next statement.
+func openTxs(t *testing.T) int {
+	var count int
+	err := db.QueryRowx(`SELECT COUNT(*) FROM pg_stat_activity WHERE datname = current_database() AND state = 'idle in transaction'`).Scan(&count)
+	require.Nil(t, err, fmt.Sprintf("count transactions unexpected error: %s", err))
+
+	return count
+}
@@ -29,3 +29,65 @@ func MakeMetrics(namespace, subsystem string) (*kitprometheus.Counter, *kitprome
 
 	return counter, latency
 }
+
+// MakeCompressionMetrics returns an instance of Prometheus implementations for
+// message payload compression metrics. It returns a payload size counter and
+// a compression ratio histogram.
+//
+//	bytes, ratio := metrics.MakeCompressionMetrics("demo-service", "message_compression")
+func MakeCompressionMetrics(namespace, subsystem string) (*kitprometheus.Counter, *kitprometheus.Histogram) {
+	bytes := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "payload_bytes",
+		Help:      "Total size of original and encoded message payloads in bytes.",
+	}, []string{"encoding", "operation", "size"})
+	ratio := kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "ratio",
+		Help:      "Ratio of encoded to original message payload size.",
+		Buckets:   stdprometheus.LinearBuckets(0.1, 0.1, 10),
+	}, []string{"encoding", "operation"})
+
+	return bytes, ratio
+}
+
+// MakeRejectMetrics returns an instance of Prometheus implementation of a
+// counter of rejected messages.
+//
+//	rejects := metrics.MakeRejectMetrics("demo-service", "payload_schema")
+func MakeRejectMetrics(namespace, subsystem string) *kitprometheus.Counter {
+	return kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "reject_count",
+		Help:      "Number of rejected messages.",
+	}, []string{})
+}
+
+// MakeCacheMetrics returns an instance of Prometheus implementation of a
+// counter of cache lookups, labeled by the lookup result.
+//
+//	lookups := metrics.MakeCacheMetrics("demo-service", "authz_cache")
+func MakeCacheMetrics(namespace, subsystem string) *kitprometheus.Counter {
+	return kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "lookup_count",
+		Help:      "Number of cache lookups.",
+	}, []string{"result"})
+}
+
+// MakeRateLimitMetrics returns an instance of Prometheus implementation of a
+// counter of rate limited messages, labeled by the limit scope.
+//
+//	limited := metrics.MakeRateLimitMetrics("demo-service", "rate_limit")
+func MakeRateLimitMetrics(namespace, subsystem string) *kitprometheus.Counter {
+	return kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "limited_count",
+		Help:      "Number of rate limited messages.",
+	}, []string{"scope"})
+}
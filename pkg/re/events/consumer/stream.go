@@ -9,6 +9,7 @@ import (
 
 	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
 	"github.com/absmach/magistrala/pkg/events/store"
 	rconsumer "github.com/absmach/magistrala/pkg/roles/rolemanager/events/consumer"
 	"github.com/absmach/magistrala/re"
@@ -71,6 +72,9 @@ func (es *eventHandler) Handle(ctx context.Context, event events.Event) error {
 	if err != nil {
 		return err
 	}
+	if _, err := schema.Validate(msg); err != nil {
+		return err
+	}
 
 	op, ok := msg["operation"]
 	if !ok {
@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package retention contains the message retention policies of the channels,
+// read from the channel and domain metadata, and the enforcer which removes
+// the expired messages from the writer databases.
+package retention
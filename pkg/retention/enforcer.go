@@ -0,0 +1,203 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
+	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
+	grpcDomainsV1 "github.com/absmach/magistrala/api/grpc/domains/v1"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"google.golang.org/grpc"
+)
+
+const loadTimeout = 5 * time.Second
+
+var errLoadPolicy = errors.New("failed to load retention policy")
+
+// Store is the message store whose messages are removed by the enforcer.
+type Store interface {
+	// Channels returns the channels which have stored messages.
+	Channels(ctx context.Context) ([]string, error)
+
+	// Delete removes the messages of the channel older than the cutoff and
+	// returns the number of the removed messages.
+	Delete(ctx context.Context, channel string, before time.Time) (int64, error)
+}
+
+// ChunkStore is the store which partitions the messages by time, so that
+// the expired partitions of all the channels are dropped at once, e.g. the
+// TimescaleDB hypertable chunks.
+type ChunkStore interface {
+	Store
+
+	// DropChunks drops the partitions which contain only the messages
+	// older than the cutoff.
+	DropChunks(ctx context.Context, before time.Time) error
+}
+
+// Enforcer periodically removes the messages which are older than the
+// retention period of their channel. The channel policy is read from the
+// channel metadata, falling back to the policy of the channel domain and
+// then to the default policy.
+type Enforcer struct {
+	store    Store
+	channels grpcChannelsV1.ChannelsServiceClient
+	domains  grpcDomainsV1.DomainsServiceClient
+	def      Policy
+	logger   *slog.Logger
+}
+
+// NewEnforcer returns the enforcer of the retention policies, which reads the
+// channel and domain metadata using the channels and domains service clients.
+// The default policy applies to the channels without a policy of their own or
+// of their domain.
+func NewEnforcer(store Store, channels grpcChannelsV1.ChannelsServiceClient, domains grpcDomainsV1.DomainsServiceClient, def Policy, logger *slog.Logger) *Enforcer {
+	return &Enforcer{
+		store:    store,
+		channels: channels,
+		domains:  domains,
+		def:      def,
+		logger:   logger,
+	}
+}
+
+// Run enforces the retention policies at the given interval until the
+// context is canceled.
+func (e *Enforcer) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.Enforce(ctx); err != nil {
+			e.logger.Warn(fmt.Sprintf("Failed to enforce retention policies: %s", err))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Enforce removes the expired messages of all the stored channels.
+func (e *Enforcer) Enforce(ctx context.Context) error {
+	chs, err := e.store.Channels(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	domains := map[string]Policy{}
+	periods := make(map[string]time.Duration, len(chs))
+	// The chunks are dropped only if every channel has a retention period,
+	// and only up to the longest one, so that no message is dropped before
+	// its channel period expires.
+	drop := true
+	var longest time.Duration
+	for _, ch := range chs {
+		p, err := e.policy(ctx, ch, domains)
+		if err != nil {
+			e.logger.Warn(fmt.Sprintf("Skipping retention of channel %s: %s", ch, err))
+			drop = false
+			continue
+		}
+		if p.Period == 0 {
+			drop = false
+			continue
+		}
+		periods[ch] = p.Period
+		longest = max(longest, p.Period)
+	}
+
+	if cs, ok := e.store.(ChunkStore); ok && drop && longest > 0 {
+		if err := cs.DropChunks(ctx, now.Add(-longest)); err != nil {
+			e.logger.Warn(fmt.Sprintf("Failed to drop expired chunks: %s", err))
+		}
+	}
+
+	for _, ch := range chs {
+		period, ok := periods[ch]
+		if !ok {
+			continue
+		}
+		n, err := e.store.Delete(ctx, ch, now.Add(-period))
+		if err != nil {
+			e.logger.Warn(fmt.Sprintf("Failed to remove expired messages of channel %s: %s", ch, err))
+			continue
+		}
+		if n > 0 {
+			e.logger.Info(fmt.Sprintf("Removed %d expired messages of channel %s", n, ch))
+		}
+	}
+
+	return nil
+}
+
+// policy returns the retention policy of the channel. The domain policies
+// are cached for the duration of a single run.
+func (e *Enforcer) policy(ctx context.Context, channel string, domains map[string]Policy) (Policy, error) {
+	entity, err := e.retrieve(ctx, channel, e.channels.RetrieveEntity)
+	if err != nil {
+		return Policy{}, err
+	}
+	if entity == nil {
+		// Messages of the removed channels are kept for the default period.
+		return e.def, nil
+	}
+
+	switch p, ok, err := ParsePolicy(entity.GetMetadata()); {
+	case err != nil:
+		e.logger.Warn(fmt.Sprintf("Using domain retention policy for channel %s: %s", channel, err))
+	case ok:
+		return p, nil
+	}
+
+	domainID := entity.GetDomainId()
+	if domainID == "" {
+		return e.def, nil
+	}
+	if p, ok := domains[domainID]; ok {
+		return p, nil
+	}
+	p := e.def
+	dom, err := e.retrieve(ctx, domainID, e.domains.RetrieveEntity)
+	if err != nil {
+		return Policy{}, err
+	}
+	if dom != nil {
+		switch dp, ok, err := ParsePolicy(dom.GetMetadata()); {
+		case err != nil:
+			e.logger.Warn(fmt.Sprintf("Using default retention policy for domain %s: %s", domainID, err))
+		case ok:
+			p = dp
+		}
+	}
+	domains[domainID] = p
+
+	return p, nil
+}
+
+type retrieveFunc func(ctx context.Context, req *grpcCommonV1.RetrieveEntityReq, opts ...grpc.CallOption) (*grpcCommonV1.RetrieveEntityRes, error)
+
+// retrieve retrieves the entity, returning nil if the entity is not found.
+func (e *Enforcer) retrieve(ctx context.Context, id string, fn retrieveFunc) (*grpcCommonV1.EntityBasic, error) {
+	ctx, cancel := context.WithTimeout(ctx, loadTimeout)
+	defer cancel()
+
+	res, err := fn(ctx, &grpcCommonV1.RetrieveEntityReq{Id: id})
+	if err != nil {
+		if errors.Contains(err, svcerr.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(errLoadPolicy, err)
+	}
+
+	return res.GetEntity(), nil
+}
@@ -0,0 +1,223 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package retention_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
+	chmocks "github.com/absmach/magistrala/channels/mocks"
+	dmocks "github.com/absmach/magistrala/domains/mocks"
+	mglog "github.com/absmach/magistrala/logger"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/retention"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	channel1 = "channel-1"
+	channel2 = "channel-2"
+	domain1  = "domain-1"
+)
+
+type store struct {
+	channels []string
+	err      error
+	deletes  map[string]time.Time
+}
+
+func (s *store) Channels(_ context.Context) ([]string, error) {
+	return s.channels, s.err
+}
+
+func (s *store) Delete(_ context.Context, channel string, before time.Time) (int64, error) {
+	s.deletes[channel] = before
+	return 1, nil
+}
+
+type chunkStore struct {
+	*store
+	drops []time.Time
+}
+
+func (s *chunkStore) DropChunks(_ context.Context, before time.Time) error {
+	s.drops = append(s.drops, before)
+	return nil
+}
+
+type entity struct {
+	domain   string
+	metadata string
+	err      error
+}
+
+func res(e entity) *grpcCommonV1.RetrieveEntityRes {
+	return &grpcCommonV1.RetrieveEntityRes{Entity: &grpcCommonV1.EntityBasic{DomainId: e.domain, Metadata: []byte(e.metadata)}}
+}
+
+func TestEnforce(t *testing.T) {
+	cases := []struct {
+		desc     string
+		channels map[string]entity
+		domains  map[string]entity
+		def      retention.Policy
+		chunks   bool
+		storeErr error
+		deletes  map[string]time.Duration
+		drop     time.Duration
+		err      error
+	}{
+		{
+			desc: "enforce channel policy",
+			channels: map[string]entity{
+				channel1: {domain: domain1, metadata: `{"retention":{"period":"7d"}}`},
+			},
+			deletes: map[string]time.Duration{channel1: 7 * day},
+		},
+		{
+			desc: "enforce domain policy",
+			channels: map[string]entity{
+				channel1: {domain: domain1},
+				channel2: {domain: domain1, metadata: `{"location":"lab"}`},
+			},
+			domains: map[string]entity{
+				domain1: {metadata: `{"retention":{"period":"30d"}}`},
+			},
+			deletes: map[string]time.Duration{channel1: 30 * day, channel2: 30 * day},
+		},
+		{
+			desc: "enforce default policy",
+			channels: map[string]entity{
+				channel1: {domain: domain1},
+			},
+			domains: map[string]entity{
+				domain1: {},
+			},
+			def:     retention.Policy{Period: 90 * day},
+			deletes: map[string]time.Duration{channel1: 90 * day},
+		},
+		{
+			desc: "enforce policy which keeps messages forever",
+			channels: map[string]entity{
+				channel1: {domain: domain1, metadata: `{"retention":{"period":"0"}}`},
+			},
+			def:     retention.Policy{Period: 90 * day},
+			deletes: map[string]time.Duration{},
+		},
+		{
+			desc: "enforce without policies",
+			channels: map[string]entity{
+				channel1: {domain: domain1},
+			},
+			domains: map[string]entity{
+				domain1: {},
+			},
+			deletes: map[string]time.Duration{},
+		},
+		{
+			desc: "enforce invalid channel policy",
+			channels: map[string]entity{
+				channel1: {domain: domain1, metadata: `{"retention":{"period":"soon"}}`},
+			},
+			domains: map[string]entity{
+				domain1: {metadata: `{"retention":{"period":"30d"}}`},
+			},
+			deletes: map[string]time.Duration{channel1: 30 * day},
+		},
+		{
+			desc: "enforce policy of removed channel",
+			channels: map[string]entity{
+				channel1: {err: svcerr.ErrNotFound},
+			},
+			def:     retention.Policy{Period: 90 * day},
+			deletes: map[string]time.Duration{channel1: 90 * day},
+		},
+		{
+			desc: "enforce with failed channel retrieval",
+			channels: map[string]entity{
+				channel1: {err: errors.New("unavailable")},
+				channel2: {domain: domain1, metadata: `{"retention":{"period":"7d"}}`},
+			},
+			def:     retention.Policy{Period: 90 * day},
+			deletes: map[string]time.Duration{channel2: 7 * day},
+		},
+		{
+			desc: "enforce with failed domain retrieval",
+			channels: map[string]entity{
+				channel1: {domain: domain1},
+			},
+			domains: map[string]entity{
+				domain1: {err: errors.New("unavailable")},
+			},
+			def:     retention.Policy{Period: 90 * day},
+			deletes: map[string]time.Duration{},
+		},
+		{
+			desc: "enforce by dropping chunks",
+			channels: map[string]entity{
+				channel1: {domain: domain1, metadata: `{"retention":{"period":"7d"}}`},
+				channel2: {domain: domain1, metadata: `{"retention":{"period":"30d"}}`},
+			},
+			chunks:  true,
+			deletes: map[string]time.Duration{channel1: 7 * day, channel2: 30 * day},
+			drop:    30 * day,
+		},
+		{
+			desc: "enforce without dropping chunks of channel which keeps messages forever",
+			channels: map[string]entity{
+				channel1: {domain: domain1, metadata: `{"retention":{"period":"7d"}}`},
+				channel2: {domain: domain1, metadata: `{"retention":{"period":"0"}}`},
+			},
+			chunks:  true,
+			deletes: map[string]time.Duration{channel1: 7 * day},
+		},
+		{
+			desc:     "enforce with failed channels listing",
+			storeErr: errors.New("unavailable"),
+			deletes:  map[string]time.Duration{},
+			err:      errors.New("unavailable"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			channels := new(chmocks.ChannelsServiceClient)
+			domains := new(dmocks.DomainsServiceClient)
+			s := &store{err: tc.storeErr, deletes: map[string]time.Time{}}
+			for id, e := range tc.channels {
+				s.channels = append(s.channels, id)
+				channels.On("RetrieveEntity", mock.Anything, &grpcCommonV1.RetrieveEntityReq{Id: id}).Return(res(e), e.err)
+			}
+			for id, e := range tc.domains {
+				domains.On("RetrieveEntity", mock.Anything, &grpcCommonV1.RetrieveEntityReq{Id: id}).Return(res(e), e.err).Once()
+			}
+
+			var st retention.Store = s
+			cs := &chunkStore{store: s}
+			if tc.chunks {
+				st = cs
+			}
+			err := retention.NewEnforcer(st, channels, domains, tc.def, mglog.NewMock()).Enforce(context.Background())
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s, got %s", tc.err, err))
+
+			assert.Len(t, s.deletes, len(tc.deletes))
+			for ch, period := range tc.deletes {
+				assert.WithinDuration(t, time.Now().Add(-period), s.deletes[ch], time.Minute, fmt.Sprintf("unexpected cutoff of channel %s", ch))
+			}
+			switch tc.drop {
+			case 0:
+				assert.Empty(t, cs.drops)
+			default:
+				assert.Len(t, cs.drops, 1)
+				assert.WithinDuration(t, time.Now().Add(-tc.drop), cs.drops[0], time.Minute)
+			}
+			domains.AssertExpectations(t)
+		})
+	}
+}
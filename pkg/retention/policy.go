@@ -0,0 +1,132 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package retention
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+// MetadataKey is the channel and domain metadata key of the retention policy.
+const MetadataKey = "retention"
+
+const day = 24 * time.Hour
+
+// ErrInvalidPolicy indicates an invalid retention policy in the entity metadata.
+var ErrInvalidPolicy = errors.NewRequestError("invalid retention policy")
+
+var errNegativePeriod = errors.New("negative retention period")
+
+// Policy is the message retention policy of a channel or a domain. Messages
+// older than the period are removed, and a zero period keeps the messages
+// forever. The period is encoded as a duration such as "720h" or "30d".
+type Policy struct {
+	Period time.Duration
+}
+
+type policy struct {
+	Period string `json:"period"`
+}
+
+// MarshalJSON marshals the policy with its period encoded as a duration.
+func (p Policy) MarshalJSON() ([]byte, error) {
+	period := p.Period.String()
+	if p.Period%day == 0 && p.Period > 0 {
+		period = strconv.FormatInt(int64(p.Period/day), 10) + "d"
+	}
+
+	return json.Marshal(policy{Period: period})
+}
+
+// UnmarshalJSON unmarshals the policy with its period encoded as a duration.
+func (p *Policy) UnmarshalJSON(data []byte) error {
+	var raw policy
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	period, err := ParsePeriod(raw.Period)
+	if err != nil {
+		return err
+	}
+	p.Period = period
+
+	return nil
+}
+
+// ParsePeriod parses the retention period. Besides the time.ParseDuration
+// units, the period can be given in whole days using the "d" suffix.
+func ParsePeriod(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+
+	var period time.Duration
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.ParseInt(days, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		period = time.Duration(n) * day
+	} else {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, err
+		}
+		period = d
+	}
+	if period < 0 {
+		return 0, errNegativePeriod
+	}
+
+	return period, nil
+}
+
+// ParsePolicy returns the retention policy found in the JSON encoded entity
+// metadata. It returns false if the entity has no retention policy.
+func ParsePolicy(metadata []byte) (Policy, bool, error) {
+	if len(metadata) == 0 {
+		return Policy{}, false, nil
+	}
+
+	var md map[string]json.RawMessage
+	if err := json.Unmarshal(metadata, &md); err != nil {
+		return Policy{}, false, errors.Wrap(ErrInvalidPolicy, err)
+	}
+	raw, ok := md[MetadataKey]
+	if !ok {
+		return Policy{}, false, nil
+	}
+
+	var p Policy
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return Policy{}, false, errors.Wrap(ErrInvalidPolicy, err)
+	}
+
+	return p, true, nil
+}
+
+// Validate validates the retention policy of the entity metadata, so that
+// an invalid policy is rejected when the entity is saved.
+func Validate(metadata map[string]any) error {
+	val, ok := metadata[MetadataKey]
+	if !ok {
+		return nil
+	}
+
+	raw, err := json.Marshal(val)
+	if err != nil {
+		return errors.Wrap(ErrInvalidPolicy, err)
+	}
+	var p Policy
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return errors.Wrap(ErrInvalidPolicy, err)
+	}
+
+	return nil
+}
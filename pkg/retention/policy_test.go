@@ -0,0 +1,203 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package retention_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/retention"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const day = 24 * time.Hour
+
+func TestParsePeriod(t *testing.T) {
+	cases := []struct {
+		desc   string
+		period string
+		res    time.Duration
+		err    bool
+	}{
+		{
+			desc:   "parse days",
+			period: "30d",
+			res:    30 * day,
+		},
+		{
+			desc:   "parse duration",
+			period: "36h30m",
+			res:    36*time.Hour + 30*time.Minute,
+		},
+		{
+			desc:   "parse zero period",
+			period: "0",
+			res:    0,
+		},
+		{
+			desc: "parse empty period",
+			res:  0,
+		},
+		{
+			desc:   "parse invalid days",
+			period: "1.5d",
+			err:    true,
+		},
+		{
+			desc:   "parse invalid duration",
+			period: "month",
+			err:    true,
+		},
+		{
+			desc:   "parse negative days",
+			period: "-1d",
+			err:    true,
+		},
+		{
+			desc:   "parse negative duration",
+			period: "-1h",
+			err:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := retention.ParsePeriod(tc.period)
+			assert.Equal(t, tc.err, err != nil, fmt.Sprintf("unexpected error: %s", err))
+			assert.Equal(t, tc.res, res)
+		})
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	cases := []struct {
+		desc     string
+		metadata string
+		policy   retention.Policy
+		ok       bool
+		err      error
+	}{
+		{
+			desc:     "parse policy",
+			metadata: `{"location":"lab","retention":{"period":"7d"}}`,
+			policy:   retention.Policy{Period: 7 * day},
+			ok:       true,
+		},
+		{
+			desc:     "parse policy which keeps messages forever",
+			metadata: `{"retention":{"period":"0"}}`,
+			policy:   retention.Policy{},
+			ok:       true,
+		},
+		{
+			desc:     "parse metadata without policy",
+			metadata: `{"location":"lab"}`,
+		},
+		{
+			desc: "parse empty metadata",
+		},
+		{
+			desc:     "parse invalid metadata",
+			metadata: `{"location":`,
+			err:      retention.ErrInvalidPolicy,
+		},
+		{
+			desc:     "parse policy with invalid period",
+			metadata: `{"retention":{"period":"soon"}}`,
+			err:      retention.ErrInvalidPolicy,
+		},
+		{
+			desc:     "parse invalid policy",
+			metadata: `{"retention":"7d"}`,
+			err:      retention.ErrInvalidPolicy,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			p, ok, err := retention.ParsePolicy([]byte(tc.metadata))
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s, got %s", tc.err, err))
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.policy, p)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		desc     string
+		metadata map[string]any
+		err      error
+	}{
+		{
+			desc:     "validate valid policy",
+			metadata: map[string]any{"retention": map[string]any{"period": "720h"}},
+		},
+		{
+			desc:     "validate metadata without policy",
+			metadata: map[string]any{"location": "lab"},
+		},
+		{
+			desc: "validate empty metadata",
+		},
+		{
+			desc:     "validate policy with invalid period",
+			metadata: map[string]any{"retention": map[string]any{"period": "-30d"}},
+			err:      retention.ErrInvalidPolicy,
+		},
+		{
+			desc:     "validate policy with invalid period type",
+			metadata: map[string]any{"retention": map[string]any{"period": 30}},
+			err:      retention.ErrInvalidPolicy,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := retention.Validate(tc.metadata)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s, got %s", tc.err, err))
+		})
+	}
+}
+
+func TestMarshalPolicy(t *testing.T) {
+	cases := []struct {
+		desc   string
+		policy retention.Policy
+		res    string
+	}{
+		{
+			desc:   "marshal policy in days",
+			policy: retention.Policy{Period: 30 * day},
+			res:    `{"period":"30d"}`,
+		},
+		{
+			desc:   "marshal policy in hours",
+			policy: retention.Policy{Period: 36 * time.Hour},
+			res:    `{"period":"36h0m0s"}`,
+		},
+		{
+			desc:   "marshal policy which keeps messages forever",
+			policy: retention.Policy{},
+			res:    `{"period":"0s"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			data, err := json.Marshal(tc.policy)
+			require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+			assert.JSONEq(t, tc.res, string(data))
+
+			var p retention.Policy
+			err = json.Unmarshal(data, &p)
+			require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+			assert.Equal(t, tc.policy, p)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/pkg/roles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var entityPrefixes = []string{"client.", "channel.", "group.", "domain.", "rule.", "report."}
+
+func TestEventSchemas(t *testing.T) {
+	now := time.Now().UTC()
+	role := roles.Role{
+		ID:        testsutil.GenerateUUID(t),
+		Name:      "admin",
+		EntityID:  testsutil.GenerateUUID(t),
+		CreatedBy: testsutil.GenerateUUID(t),
+		CreatedAt: now,
+		UpdatedBy: testsutil.GenerateUUID(t),
+		UpdatedAt: now,
+	}
+	actions := []string{"read", "update"}
+	members := []string{testsutil.GenerateUUID(t)}
+	requestID := testsutil.GenerateUUID(t)
+
+	covered := make(map[string]bool)
+	for _, prefix := range entityPrefixes {
+		cases := []struct {
+			desc  string
+			event events.Event
+		}{
+			{
+				desc: "add role",
+				event: addRoleEvent{
+					operationPrefix: prefix,
+					RoleProvision:   roles.RoleProvision{Role: role, OptionalActions: actions, OptionalMembers: members},
+					requestID:       requestID,
+				},
+			},
+			{
+				desc:  "remove role",
+				event: removeRoleEvent{operationPrefix: prefix, entityID: role.EntityID, roleID: role.ID, requestID: requestID},
+			},
+			{
+				desc:  "update role",
+				event: updateRoleEvent{operationPrefix: prefix, Role: role, requestID: requestID},
+			},
+			{
+				desc:  "retrieve role",
+				event: retrieveRoleEvent{operationPrefix: prefix, Role: role, requestID: requestID},
+			},
+			{
+				desc:  "retrieve all roles",
+				event: retrieveAllRolesEvent{operationPrefix: prefix, entityID: role.EntityID, limit: 10, requestID: requestID},
+			},
+			{
+				desc:  "list available actions",
+				event: listAvailableActionsEvent{operationPrefix: prefix, requestID: requestID},
+			},
+			{
+				desc:  "add role actions",
+				event: roleAddActionsEvent{operationPrefix: prefix, entityID: role.EntityID, roleID: role.ID, actions: actions, requestID: requestID},
+			},
+			{
+				desc:  "list role actions",
+				event: roleListActionsEvent{operationPrefix: prefix, entityID: role.EntityID, roleID: role.ID, requestID: requestID},
+			},
+			{
+				desc:  "check role actions",
+				event: roleCheckActionsExistsEvent{operationPrefix: prefix, entityID: role.EntityID, roleID: role.ID, actions: actions, isAllExists: true, requestID: requestID},
+			},
+			{
+				desc:  "remove role actions",
+				event: roleRemoveActionsEvent{operationPrefix: prefix, entityID: role.EntityID, roleID: role.ID, actions: actions, requestID: requestID},
+			},
+			{
+				desc:  "remove all role actions",
+				event: roleRemoveAllActionsEvent{operationPrefix: prefix, entityID: role.EntityID, roleID: role.ID, requestID: requestID},
+			},
+			{
+				desc:  "add role members",
+				event: roleAddMembersEvent{operationPrefix: prefix, entityID: role.EntityID, roleID: role.ID, members: members, requestID: requestID},
+			},
+			{
+				desc:  "list role members",
+				event: roleListMembersEvent{operationPrefix: prefix, entityID: role.EntityID, roleID: role.ID, limit: 10, requestID: requestID},
+			},
+			{
+				desc:  "check role members",
+				event: roleCheckMembersExistsEvent{operationPrefix: prefix, entityID: role.EntityID, roleID: role.ID, members: members, requestID: requestID},
+			},
+			{
+				desc:  "remove role members",
+				event: roleRemoveMembersEvent{operationPrefix: prefix, entityID: role.EntityID, roleID: role.ID, members: members, requestID: requestID},
+			},
+			{
+				desc:  "remove all role members",
+				event: roleRemoveAllMembersEvent{operationPrefix: prefix, entityID: role.EntityID, roleID: role.ID, requestID: requestID},
+			},
+			{
+				desc:  "list entity members",
+				event: listEntityMembersEvent{operationPrefix: prefix, entityID: role.EntityID, limit: 10, requestID: requestID},
+			},
+			{
+				desc:  "remove entity members",
+				event: removeEntityMembersEvent{operationPrefix: prefix, entityID: role.EntityID, members: members, requestID: requestID},
+			},
+			{
+				desc:  "remove member from all roles",
+				event: removeMemberFromAllRolesEvent{operationPrefix: prefix, memberID: members[0], requestID: requestID},
+			},
+		}
+
+		for _, tc := range cases {
+			t.Run(prefix+tc.desc, func(t *testing.T) {
+				_, s, err := schema.ValidateEvent(tc.event)
+				require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+				covered[s.Operation] = true
+			})
+		}
+	}
+
+	for _, s := range schema.Schemas() {
+		for _, prefix := range entityPrefixes {
+			if strings.HasPrefix(s.Operation, prefix+"role.") || strings.HasPrefix(s.Operation, prefix+"members.") {
+				assert.True(t, covered[s.Operation], fmt.Sprintf("expected emitted event for schema %s", s.Operation))
+			}
+		}
+	}
+}
@@ -39,54 +39,58 @@ func NewRoleManagerEventStore(svcName, operationPrefix string, svc roles.RoleMan
 	}
 }
 
-func (rmes *RoleManagerEventStore) AddRole(ctx context.Context, session authn.Session, entityID, roleName string, optionalActions []string, optionalMembers []string) (roles.RoleProvision, error) {
-	nrp, err := rmes.svc.AddRole(ctx, session, entityID, roleName, optionalActions, optionalMembers)
-	if err != nil {
-		return nrp, err
-	}
-
-	e := addRoleEvent{
-		operationPrefix: rmes.operationPrefix,
-		RoleProvision:   nrp,
-		requestID:       middleware.GetReqID(ctx),
-	}
-	if err := rmes.Publish(ctx, rmes.streamID, e); err != nil {
-		return nrp, err
-	}
-	return nrp, nil
+func (rmes *RoleManagerEventStore) AddRole(ctx context.Context, session authn.Session, entityID, roleName string, optionalActions []string, optionalMembers []string) (nrp roles.RoleProvision, err error) {
+	err = events.Atomic(ctx, rmes.Publisher, func(ctx context.Context) error {
+		nrp, err = rmes.svc.AddRole(ctx, session, entityID, roleName, optionalActions, optionalMembers)
+		if err != nil {
+			return err
+		}
+
+		e := addRoleEvent{
+			operationPrefix: rmes.operationPrefix,
+			RoleProvision:   nrp,
+			requestID:       middleware.GetReqID(ctx),
+		}
+
+		return rmes.Publish(ctx, rmes.streamID, e)
+	})
+
+	return nrp, err
 }
 
 func (rmes *RoleManagerEventStore) RemoveRole(ctx context.Context, session authn.Session, entityID, roleID string) error {
-	if err := rmes.svc.RemoveRole(ctx, session, entityID, roleID); err != nil {
-		return err
-	}
-	e := removeRoleEvent{
-		operationPrefix: rmes.operationPrefix,
-		roleID:          roleID,
-		entityID:        entityID,
-		requestID:       middleware.GetReqID(ctx),
-	}
-	if err := rmes.Publish(ctx, rmes.streamID, e); err != nil {
-		return err
-	}
-	return nil
+	return events.Atomic(ctx, rmes.Publisher, func(ctx context.Context) error {
+		if err := rmes.svc.RemoveRole(ctx, session, entityID, roleID); err != nil {
+			return err
+		}
+		e := removeRoleEvent{
+			operationPrefix: rmes.operationPrefix,
+			roleID:          roleID,
+			entityID:        entityID,
+			requestID:       middleware.GetReqID(ctx),
+		}
+
+		return rmes.Publish(ctx, rmes.streamID, e)
+	})
 }
 
-func (rmes *RoleManagerEventStore) UpdateRoleName(ctx context.Context, session authn.Session, entityID, roleID, newRoleName string) (roles.Role, error) {
-	ro, err := rmes.svc.UpdateRoleName(ctx, session, entityID, roleID, newRoleName)
-	if err != nil {
-		return ro, err
-	}
+func (rmes *RoleManagerEventStore) UpdateRoleName(ctx context.Context, session authn.Session, entityID, roleID, newRoleName string) (ro roles.Role, err error) {
+	err = events.Atomic(ctx, rmes.Publisher, func(ctx context.Context) error {
+		ro, err = rmes.svc.UpdateRoleName(ctx, session, entityID, roleID, newRoleName)
+		if err != nil {
+			return err
+		}
 
-	e := updateRoleEvent{
-		operationPrefix: rmes.operationPrefix,
-		Role:            ro,
-		requestID:       middleware.GetReqID(ctx),
-	}
-	if err := rmes.Publish(ctx, rmes.streamID, e); err != nil {
-		return ro, err
-	}
-	return ro, nil
+		e := updateRoleEvent{
+			operationPrefix: rmes.operationPrefix,
+			Role:            ro,
+			requestID:       middleware.GetReqID(ctx),
+		}
+
+		return rmes.Publish(ctx, rmes.streamID, e)
+	})
+
+	return ro, err
 }
 
 func (rmes *RoleManagerEventStore) RetrieveRole(ctx context.Context, session authn.Session, entityID, roleID string) (roles.Role, error) {
@@ -139,22 +143,24 @@ func (rmes *RoleManagerEventStore) ListAvailableActions(ctx context.Context, ses
 	return actions, nil
 }
 
-func (rmes *RoleManagerEventStore) RoleAddActions(ctx context.Context, session authn.Session, entityID, roleID string, actions []string) ([]string, error) {
-	actions, err := rmes.svc.RoleAddActions(ctx, session, entityID, roleID, actions)
-	if err != nil {
-		return actions, err
-	}
-	e := roleAddActionsEvent{
-		operationPrefix: rmes.operationPrefix,
-		entityID:        entityID,
-		roleID:          roleID,
-		actions:         actions,
-		requestID:       middleware.GetReqID(ctx),
-	}
-	if err := rmes.Publish(ctx, rmes.streamID, e); err != nil {
-		return actions, err
-	}
-	return actions, nil
+func (rmes *RoleManagerEventStore) RoleAddActions(ctx context.Context, session authn.Session, entityID, roleID string, actions []string) (added []string, err error) {
+	err = events.Atomic(ctx, rmes.Publisher, func(ctx context.Context) error {
+		added, err = rmes.svc.RoleAddActions(ctx, session, entityID, roleID, actions)
+		if err != nil {
+			return err
+		}
+		e := roleAddActionsEvent{
+			operationPrefix: rmes.operationPrefix,
+			entityID:        entityID,
+			roleID:          roleID,
+			actions:         added,
+			requestID:       middleware.GetReqID(ctx),
+		}
+
+		return rmes.Publish(ctx, rmes.streamID, e)
+	})
+
+	return added, err
 }
 
 func (rmes *RoleManagerEventStore) RoleListActions(ctx context.Context, session authn.Session, entityID, roleID string) ([]string, error) {
@@ -196,47 +202,50 @@ func (rmes *RoleManagerEventStore) RoleCheckActionsExists(ctx context.Context, s
 }
 
 func (rmes *RoleManagerEventStore) RoleRemoveActions(ctx context.Context, session authn.Session, entityID, roleID string, actions []string) (err error) {
-	if err := rmes.svc.RoleRemoveActions(ctx, session, entityID, roleID, actions); err != nil {
-		return err
-	}
-
-	e := roleRemoveActionsEvent{
-		operationPrefix: rmes.operationPrefix,
-		entityID:        entityID,
-		roleID:          roleID,
-		actions:         actions,
-		requestID:       middleware.GetReqID(ctx),
-	}
-	if err := rmes.Publish(ctx, rmes.streamID, e); err != nil {
-		return err
-	}
-	return nil
+	return events.Atomic(ctx, rmes.Publisher, func(ctx context.Context) error {
+		if err := rmes.svc.RoleRemoveActions(ctx, session, entityID, roleID, actions); err != nil {
+			return err
+		}
+
+		e := roleRemoveActionsEvent{
+			operationPrefix: rmes.operationPrefix,
+			entityID:        entityID,
+			roleID:          roleID,
+			actions:         actions,
+			requestID:       middleware.GetReqID(ctx),
+		}
+
+		return rmes.Publish(ctx, rmes.streamID, e)
+	})
 }
 
 func (rmes *RoleManagerEventStore) RoleRemoveAllActions(ctx context.Context, session authn.Session, entityID, roleID string) error {
-	if err := rmes.svc.RoleRemoveAllActions(ctx, session, entityID, roleID); err != nil {
-		return err
-	}
-
-	e := roleRemoveAllActionsEvent{
-		operationPrefix: rmes.operationPrefix,
-		entityID:        entityID,
-		roleID:          roleID,
-		requestID:       middleware.GetReqID(ctx),
-	}
-	if err := rmes.Publish(ctx, rmes.streamID, e); err != nil {
-		return err
-	}
-	return nil
+	return events.Atomic(ctx, rmes.Publisher, func(ctx context.Context) error {
+		if err := rmes.svc.RoleRemoveAllActions(ctx, session, entityID, roleID); err != nil {
+			return err
+		}
+
+		e := roleRemoveAllActionsEvent{
+			operationPrefix: rmes.operationPrefix,
+			entityID:        entityID,
+			roleID:          roleID,
+			requestID:       middleware.GetReqID(ctx),
+		}
+
+		return rmes.Publish(ctx, rmes.streamID, e)
+	})
 }
 
-func (rmes *RoleManagerEventStore) RoleAddMembers(ctx context.Context, session authn.Session, entityID, roleID string, members []string) ([]string, error) {
-	mems, err := rmes.svc.RoleAddMembers(ctx, session, entityID, roleID, members)
-	if err != nil {
-		return mems, err
-	}
+func (rmes *RoleManagerEventStore) RoleAddMembers(ctx context.Context, session authn.Session, entityID, roleID string, members []string) (mems []string, err error) {
+	err = events.Atomic(ctx, rmes.Publisher, func(ctx context.Context) error {
+		mems, err = rmes.svc.RoleAddMembers(ctx, session, entityID, roleID, members)
+		if err != nil {
+			return err
+		}
+
+		return rmes.RoleAddMembersEventPublisher(ctx, entityID, roleID, mems)
+	})
 
-	err = rmes.RoleAddMembersEventPublisher(ctx, entityID, roleID, mems)
 	return mems, err
 }
 
@@ -294,38 +303,38 @@ func (rmes *RoleManagerEventStore) RoleCheckMembersExists(ctx context.Context, s
 }
 
 func (rmes *RoleManagerEventStore) RoleRemoveMembers(ctx context.Context, session authn.Session, entityID, roleID string, members []string) (err error) {
-	if err := rmes.svc.RoleRemoveMembers(ctx, session, entityID, roleID, members); err != nil {
-		return err
-	}
-
-	e := roleRemoveMembersEvent{
-		operationPrefix: rmes.operationPrefix,
-		entityID:        entityID,
-		roleID:          roleID,
-		members:         members,
-		requestID:       middleware.GetReqID(ctx),
-	}
-	if err := rmes.Publish(ctx, rmes.streamID, e); err != nil {
-		return err
-	}
-	return nil
+	return events.Atomic(ctx, rmes.Publisher, func(ctx context.Context) error {
+		if err := rmes.svc.RoleRemoveMembers(ctx, session, entityID, roleID, members); err != nil {
+			return err
+		}
+
+		e := roleRemoveMembersEvent{
+			operationPrefix: rmes.operationPrefix,
+			entityID:        entityID,
+			roleID:          roleID,
+			members:         members,
+			requestID:       middleware.GetReqID(ctx),
+		}
+
+		return rmes.Publish(ctx, rmes.streamID, e)
+	})
 }
 
 func (rmes *RoleManagerEventStore) RoleRemoveAllMembers(ctx context.Context, session authn.Session, entityID, roleID string) (err error) {
-	if err := rmes.svc.RoleRemoveAllMembers(ctx, session, entityID, roleID); err != nil {
-		return err
-	}
-
-	e := roleRemoveAllMembersEvent{
-		operationPrefix: rmes.operationPrefix,
-		entityID:        entityID,
-		roleID:          roleID,
-		requestID:       middleware.GetReqID(ctx),
-	}
-	if err := rmes.Publish(ctx, rmes.streamID, e); err != nil {
-		return err
-	}
-	return nil
+	return events.Atomic(ctx, rmes.Publisher, func(ctx context.Context) error {
+		if err := rmes.svc.RoleRemoveAllMembers(ctx, session, entityID, roleID); err != nil {
+			return err
+		}
+
+		e := roleRemoveAllMembersEvent{
+			operationPrefix: rmes.operationPrefix,
+			entityID:        entityID,
+			roleID:          roleID,
+			requestID:       middleware.GetReqID(ctx),
+		}
+
+		return rmes.Publish(ctx, rmes.streamID, e)
+	})
 }
 
 func (rmes *RoleManagerEventStore) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, pageQuery roles.MembersRolePageQuery) (roles.MembersRolePage, error) {
@@ -348,34 +357,34 @@ func (rmes *RoleManagerEventStore) ListEntityMembers(ctx context.Context, sessio
 }
 
 func (rmes *RoleManagerEventStore) RemoveEntityMembers(ctx context.Context, session authn.Session, entityID string, members []string) error {
-	if err := rmes.svc.RemoveEntityMembers(ctx, session, entityID, members); err != nil {
-		return err
-	}
-
-	e := removeEntityMembersEvent{
-		operationPrefix: rmes.operationPrefix,
-		entityID:        entityID,
-		members:         members,
-		requestID:       middleware.GetReqID(ctx),
-	}
-	if err := rmes.Publish(ctx, rmes.streamID, e); err != nil {
-		return err
-	}
-	return nil
+	return events.Atomic(ctx, rmes.Publisher, func(ctx context.Context) error {
+		if err := rmes.svc.RemoveEntityMembers(ctx, session, entityID, members); err != nil {
+			return err
+		}
+
+		e := removeEntityMembersEvent{
+			operationPrefix: rmes.operationPrefix,
+			entityID:        entityID,
+			members:         members,
+			requestID:       middleware.GetReqID(ctx),
+		}
+
+		return rmes.Publish(ctx, rmes.streamID, e)
+	})
 }
 
 func (rmes *RoleManagerEventStore) RemoveMemberFromAllRoles(ctx context.Context, session authn.Session, memberID string) (err error) {
-	if err := rmes.svc.RemoveMemberFromAllRoles(ctx, session, memberID); err != nil {
-		return err
-	}
-
-	e := removeMemberFromAllRolesEvent{
-		operationPrefix: rmes.operationPrefix,
-		memberID:        memberID,
-		requestID:       middleware.GetReqID(ctx),
-	}
-	if err := rmes.Publish(ctx, rmes.streamID, e); err != nil {
-		return err
-	}
-	return nil
+	return events.Atomic(ctx, rmes.Publisher, func(ctx context.Context) error {
+		if err := rmes.svc.RemoveMemberFromAllRoles(ctx, session, memberID); err != nil {
+			return err
+		}
+
+		e := removeMemberFromAllRolesEvent{
+			operationPrefix: rmes.operationPrefix,
+			memberID:        memberID,
+			requestID:       middleware.GetReqID(ctx),
+		}
+
+		return rmes.Publish(ctx, rmes.streamID, e)
+	})
 }
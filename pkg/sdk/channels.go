@@ -18,6 +18,8 @@ import (
 const (
 	channelsEndpoint = "channels"
 	parentEndpoint   = "parent"
+	// retentionKey is the channel metadata key of the retention policy.
+	retentionKey = "retention"
 )
 
 // Channel represents magistrala channel.
@@ -37,6 +39,13 @@ type Channel struct {
 	Roles       []roles.MemberRoleActions `json:"roles,omitempty"`
 }
 
+// RetentionPolicy is the message retention policy of a channel. The period
+// is a duration such as "720h" or a number of days such as "30d", and a zero
+// period keeps the messages forever.
+type RetentionPolicy struct {
+	Period string `json:"period"`
+}
+
 func (sdk mgSDK) CreateChannel(ctx context.Context, c Channel, domainID, token string) (Channel, errors.SDKError) {
 	data, err := json.Marshal(c)
 	if err != nil {
@@ -165,6 +174,50 @@ func (sdk mgSDK) UpdateChannelTags(ctx context.Context, c Channel, domainID, tok
 	return c, nil
 }
 
+func (sdk mgSDK) SetChannelRetention(ctx context.Context, id string, policy RetentionPolicy, domainID, token string) (Channel, errors.SDKError) {
+	return sdk.updateChannelMetadata(ctx, id, domainID, token, func(md Metadata) {
+		md[retentionKey] = policy
+	})
+}
+
+func (sdk mgSDK) RemoveChannelRetention(ctx context.Context, id, domainID, token string) (Channel, errors.SDKError) {
+	return sdk.updateChannelMetadata(ctx, id, domainID, token, func(md Metadata) {
+		delete(md, retentionKey)
+	})
+}
+
+// updateChannelMetadata updates the metadata of the channel. The metadata is
+// sent even if it is empty, so that the last metadata key can be removed.
+func (sdk mgSDK) updateChannelMetadata(ctx context.Context, id, domainID, token string, update func(md Metadata)) (Channel, errors.SDKError) {
+	c, sdkErr := sdk.Channel(ctx, id, domainID, token)
+	if sdkErr != nil {
+		return Channel{}, sdkErr
+	}
+	md := c.Metadata
+	if md == nil {
+		md = Metadata{}
+	}
+	update(md)
+
+	data, err := json.Marshal(map[string]Metadata{"metadata": md})
+	if err != nil {
+		return Channel{}, errors.NewSDKError(err)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.channelsURL, domainID, channelsEndpoint, id)
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodPatch, url, token, data, nil, http.StatusOK)
+	if sdkErr != nil {
+		return Channel{}, sdkErr
+	}
+
+	c = Channel{}
+	if err := json.Unmarshal(body, &c); err != nil {
+		return Channel{}, errors.NewSDKError(err)
+	}
+
+	return c, nil
+}
+
 func (sdk mgSDK) Connect(ctx context.Context, conn Connection, domainID, token string) errors.SDKError {
 	data, err := json.Marshal(conn)
 	if err != nil {
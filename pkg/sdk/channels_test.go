@@ -23,6 +23,7 @@ import (
 	"github.com/absmach/magistrala/pkg/connections"
 	"github.com/absmach/magistrala/pkg/errors"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/retention"
 	"github.com/absmach/magistrala/pkg/roles"
 	sdk "github.com/absmach/magistrala/pkg/sdk"
 	"github.com/absmach/magistrala/pkg/uuid"
@@ -1046,6 +1047,245 @@ func TestUpdateChannel(t *testing.T) {
 	}
 }
 
+func TestSetChannelRetention(t *testing.T) {
+	ts, gsvc, auth := setupChannels()
+	defer ts.Close()
+
+	conf := sdk.Config{
+		ChannelsURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	viewRes := convertChannel(channel)
+	viewRes.Metadata = channels.Metadata{"field": "value"}
+
+	updateRes := convertChannel(channel)
+	updateRes.Metadata = channels.Metadata{
+		"field":     "value",
+		"retention": map[string]any{"period": "30d"},
+	}
+	response := channel
+	response.Metadata = sdk.Metadata{
+		"field":     "value",
+		"retention": map[string]any{"period": "30d"},
+	}
+
+	cases := []struct {
+		desc             string
+		domainID         string
+		token            string
+		session          smqauthn.Session
+		channelID        string
+		policy           sdk.RetentionPolicy
+		viewRes          channels.Channel
+		viewErr          error
+		updateChannelReq channels.Channel
+		svcRes           channels.Channel
+		svcErr           error
+		authenticateErr  error
+		response         sdk.Channel
+		err              errors.SDKError
+	}{
+		{
+			desc:      "set channel retention successfully",
+			domainID:  domainID,
+			token:     validToken,
+			channelID: channel.ID,
+			policy:    sdk.RetentionPolicy{Period: "30d"},
+			viewRes:   viewRes,
+			updateChannelReq: channels.Channel{
+				ID:       channel.ID,
+				Metadata: updateRes.Metadata,
+			},
+			svcRes:   updateRes,
+			response: response,
+			err:      nil,
+		},
+		{
+			desc:      "set channel retention with invalid period",
+			domainID:  domainID,
+			token:     validToken,
+			channelID: channel.ID,
+			policy:    sdk.RetentionPolicy{Period: "invalid"},
+			viewRes:   viewRes,
+			response:  sdk.Channel{},
+			err:       errors.NewSDKErrorWithStatus(retention.ErrInvalidPolicy, http.StatusBadRequest),
+		},
+		{
+			desc:      "set retention of non-existing channel",
+			domainID:  domainID,
+			token:     validToken,
+			channelID: wrongID,
+			policy:    sdk.RetentionPolicy{Period: "30d"},
+			viewErr:   svcerr.ErrNotFound,
+			response:  sdk.Channel{},
+			err:       errors.NewSDKErrorWithStatus(svcerr.ErrNotFound, http.StatusNotFound),
+		},
+		{
+			desc:            "set channel retention with invalid token",
+			domainID:        domainID,
+			token:           invalidToken,
+			channelID:       channel.ID,
+			policy:          sdk.RetentionPolicy{Period: "30d"},
+			authenticateErr: svcerr.ErrAuthentication,
+			response:        sdk.Channel{},
+			err:             errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+		{
+			desc:      "set channel retention with empty channel id",
+			domainID:  domainID,
+			token:     validToken,
+			channelID: "",
+			policy:    sdk.RetentionPolicy{Period: "30d"},
+			response:  sdk.Channel{},
+			err:       errors.NewSDKError(apiutil.ErrMissingID),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			viewCall := gsvc.On("ViewChannel", mock.Anything, tc.session, tc.channelID, false).Return(tc.viewRes, tc.viewErr)
+			svcCall := gsvc.On("UpdateChannel", mock.Anything, tc.session, tc.updateChannelReq).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.SetChannelRetention(context.Background(), tc.channelID, tc.policy, tc.domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "UpdateChannel", mock.Anything, tc.session, tc.updateChannelReq)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			viewCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRemoveChannelRetention(t *testing.T) {
+	ts, gsvc, auth := setupChannels()
+	defer ts.Close()
+
+	conf := sdk.Config{
+		ChannelsURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	viewRes := convertChannel(channel)
+	viewRes.Metadata = channels.Metadata{
+		"field":     "value",
+		"retention": map[string]any{"period": "30d"},
+	}
+	updateRes := convertChannel(channel)
+	updateRes.Metadata = channels.Metadata{"field": "value"}
+	response := channel
+	response.Metadata = sdk.Metadata{"field": "value"}
+
+	onlyRes := convertChannel(channel)
+	onlyRes.Metadata = channels.Metadata{"retention": map[string]any{"period": "30d"}}
+	emptyRes := convertChannel(channel)
+	emptyRes.Metadata = channels.Metadata{}
+	emptyResponse := channel
+	emptyResponse.Metadata = nil
+
+	cases := []struct {
+		desc             string
+		domainID         string
+		token            string
+		session          smqauthn.Session
+		channelID        string
+		viewRes          channels.Channel
+		viewErr          error
+		updateChannelReq channels.Channel
+		svcRes           channels.Channel
+		svcErr           error
+		authenticateErr  error
+		response         sdk.Channel
+		err              errors.SDKError
+	}{
+		{
+			desc:      "remove channel retention successfully",
+			domainID:  domainID,
+			token:     validToken,
+			channelID: channel.ID,
+			viewRes:   viewRes,
+			updateChannelReq: channels.Channel{
+				ID:       channel.ID,
+				Metadata: updateRes.Metadata,
+			},
+			svcRes:   updateRes,
+			response: response,
+			err:      nil,
+		},
+		{
+			desc:      "remove retention of channel without other metadata",
+			domainID:  domainID,
+			token:     validToken,
+			channelID: channel.ID,
+			viewRes:   onlyRes,
+			updateChannelReq: channels.Channel{
+				ID:       channel.ID,
+				Metadata: channels.Metadata{},
+			},
+			svcRes:   emptyRes,
+			response: emptyResponse,
+			err:      nil,
+		},
+		{
+			desc:      "remove retention of non-existing channel",
+			domainID:  domainID,
+			token:     validToken,
+			channelID: wrongID,
+			viewErr:   svcerr.ErrNotFound,
+			response:  sdk.Channel{},
+			err:       errors.NewSDKErrorWithStatus(svcerr.ErrNotFound, http.StatusNotFound),
+		},
+		{
+			desc:      "remove channel retention with update error",
+			domainID:  domainID,
+			token:     validToken,
+			channelID: channel.ID,
+			viewRes:   viewRes,
+			updateChannelReq: channels.Channel{
+				ID:       channel.ID,
+				Metadata: updateRes.Metadata,
+			},
+			svcErr:   svcerr.ErrUpdateEntity,
+			response: sdk.Channel{},
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrUpdateEntity, http.StatusUnprocessableEntity),
+		},
+		{
+			desc:      "remove channel retention with empty channel id",
+			domainID:  domainID,
+			token:     validToken,
+			channelID: "",
+			response:  sdk.Channel{},
+			err:       errors.NewSDKError(apiutil.ErrMissingID),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			viewCall := gsvc.On("ViewChannel", mock.Anything, tc.session, tc.channelID, false).Return(tc.viewRes, tc.viewErr)
+			svcCall := gsvc.On("UpdateChannel", mock.Anything, tc.session, tc.updateChannelReq).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.RemoveChannelRetention(context.Background(), tc.channelID, tc.domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "UpdateChannel", mock.Anything, tc.session, tc.updateChannelReq)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			viewCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
 func TestUpdateChannelTags(t *testing.T) {
 	ts, tsvc, auth := setupChannels()
 	defer ts.Close()
@@ -1599,11 +1839,11 @@ func TestConnect(t *testing.T) {
 				connTypes = append(connTypes, connType)
 			}
 			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
-			svcCall := gsvc.On("Connect", mock.Anything, tc.session, tc.connection.ChannelIDs, tc.connection.ClientIDs, connTypes).Return(tc.svcErr)
+			svcCall := gsvc.On("Connect", mock.Anything, tc.session, tc.connection.ChannelIDs, tc.connection.ClientIDs, connTypes, tc.connection.Topics).Return(tc.svcErr)
 			err := mgsdk.Connect(context.Background(), tc.connection, tc.domainID, tc.token)
 			assert.Equal(t, tc.err, err)
 			if tc.err == nil {
-				ok := svcCall.Parent.AssertCalled(t, "Connect", mock.Anything, tc.session, tc.connection.ChannelIDs, tc.connection.ClientIDs, connTypes)
+				ok := svcCall.Parent.AssertCalled(t, "Connect", mock.Anything, tc.session, tc.connection.ChannelIDs, tc.connection.ClientIDs, connTypes, tc.connection.Topics)
 				assert.True(t, ok)
 			}
 			svcCall.Unset()
@@ -1823,11 +2063,11 @@ func TestConnectClients(t *testing.T) {
 			connType, err := connections.ParseConnType(tc.connType)
 			assert.Nil(t, err, fmt.Sprintf("error parsing connection type %s", tc.connType))
 			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
-			svcCall := gsvc.On("Connect", mock.Anything, tc.session, []string{tc.channelID}, []string{tc.clientID}, []connections.ConnType{connType}).Return(tc.svcErr)
+			svcCall := gsvc.On("Connect", mock.Anything, tc.session, []string{tc.channelID}, []string{tc.clientID}, []connections.ConnType{connType}, []string(nil)).Return(tc.svcErr)
 			err = mgsdk.ConnectClients(context.Background(), tc.channelID, []string{tc.clientID}, []string{tc.connType}, tc.domainID, tc.token)
 			assert.Equal(t, tc.err, err)
 			if tc.err == nil {
-				ok := svcCall.Parent.AssertCalled(t, "Connect", mock.Anything, tc.session, []string{tc.channelID}, []string{tc.clientID}, []connections.ConnType{connType})
+				ok := svcCall.Parent.AssertCalled(t, "Connect", mock.Anything, tc.session, []string{tc.channelID}, []string{tc.clientID}, []connections.ConnType{connType}, []string(nil))
 				assert.True(t, ok)
 			}
 			svcCall.Unset()
@@ -23,8 +23,21 @@ const (
 	identifyEndpoint    = "identify"
 	rolesEndpoint       = "roles"
 	actionsEndpoint     = "actions"
+	importEndpoint      = "import"
+	exportEndpoint      = "export"
 )
 
+// Formats of the imported and exported clients.
+const (
+	CSVFormat    = "csv"
+	NDJSONFormat = "ndjson"
+)
+
+var importContentTypes = map[string]ContentType{
+	CSVFormat:    CTCSV,
+	NDJSONFormat: CTNDJSON,
+}
+
 // Client represents magistrala client.
 type Client struct {
 	ID              string                    `json:"id,omitempty"`
@@ -44,8 +57,33 @@ type Client struct {
 }
 
 type ClientCredentials struct {
-	Identity string `json:"identity,omitempty"`
-	Secret   string `json:"secret,omitempty"`
+	Identity                string    `json:"identity,omitempty"`
+	Secret                  string    `json:"secret,omitempty"`
+	PreviousSecret          string    `json:"previous_secret,omitempty"`
+	PreviousSecretExpiresAt time.Time `json:"previous_secret_expires_at,omitzero"`
+}
+
+// ImportError describes the failure of the imported client on the given line.
+type ImportError struct {
+	Line  uint64 `json:"line"`
+	Error string `json:"error"`
+}
+
+// ImportJob represents the bulk import of the clients.
+type ImportJob struct {
+	ID        string        `json:"id"`
+	DomainID  string        `json:"domain_id"`
+	CreatedBy string        `json:"created_by"`
+	Format    string        `json:"format"`
+	DryRun    bool          `json:"dry_run"`
+	Status    string        `json:"status"`
+	Total     uint64        `json:"total"`
+	Processed uint64        `json:"processed"`
+	Imported  uint64        `json:"imported"`
+	Failed    uint64        `json:"failed"`
+	Errors    []ImportError `json:"errors,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
 }
 
 func (sdk mgSDK) CreateClient(ctx context.Context, client Client, domainID, token string) (Client, errors.SDKError) {
@@ -197,6 +235,29 @@ func (sdk mgSDK) UpdateClientSecret(ctx context.Context, id, secret, domainID, t
 	return t, nil
 }
 
+func (sdk mgSDK) RotateClientSecret(ctx context.Context, id, secret string, overlap time.Duration, domainID, token string) (Client, errors.SDKError) {
+	rcsr := rotateClientSecretReq{Secret: secret, Overlap: overlap.String()}
+
+	data, err := json.Marshal(rcsr)
+	if err != nil {
+		return Client{}, errors.NewSDKError(err)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s/secret/rotate", sdk.clientsURL, domainID, clientsEndpoint, id)
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodPost, url, token, data, nil, http.StatusOK)
+	if sdkErr != nil {
+		return Client{}, sdkErr
+	}
+
+	var t Client
+	if err = json.Unmarshal(body, &t); err != nil {
+		return Client{}, errors.NewSDKError(err)
+	}
+
+	return t, nil
+}
+
 func (sdk mgSDK) EnableClient(ctx context.Context, id, domainID, token string) (Client, errors.SDKError) {
 	return sdk.changeClientStatus(ctx, id, enableEndpoint, domainID, token)
 }
@@ -256,6 +317,61 @@ func (sdk mgSDK) DeleteClient(ctx context.Context, id, domainID, token string) e
 	return sdkErr
 }
 
+func (sdk mgSDK) ImportClients(ctx context.Context, data []byte, format string, dryRun bool, domainID, token string) (ImportJob, errors.SDKError) {
+	ct, ok := importContentTypes[format]
+	if !ok {
+		return ImportJob{}, errors.NewSDKError(apiutil.ErrUnsupportedContentType)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s?dry_run=%t", sdk.clientsURL, domainID, clientsEndpoint, importEndpoint, dryRun)
+	headers := map[string]string{"Content-Type": string(ct)}
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodPost, url, token, data, headers, http.StatusAccepted, http.StatusOK)
+	if sdkErr != nil {
+		return ImportJob{}, sdkErr
+	}
+
+	var job ImportJob
+	if err := json.Unmarshal(body, &job); err != nil {
+		return ImportJob{}, errors.NewSDKError(err)
+	}
+
+	return job, nil
+}
+
+func (sdk mgSDK) ViewImportJob(ctx context.Context, id, domainID, token string) (ImportJob, errors.SDKError) {
+	if id == "" {
+		return ImportJob{}, errors.NewSDKError(apiutil.ErrMissingID)
+	}
+	url := fmt.Sprintf("%s/%s/%s/%s/%s", sdk.clientsURL, domainID, clientsEndpoint, importEndpoint, id)
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkErr != nil {
+		return ImportJob{}, sdkErr
+	}
+
+	var job ImportJob
+	if err := json.Unmarshal(body, &job); err != nil {
+		return ImportJob{}, errors.NewSDKError(err)
+	}
+
+	return job, nil
+}
+
+func (sdk mgSDK) ExportClients(ctx context.Context, format, domainID, token string) ([]byte, errors.SDKError) {
+	if _, ok := importContentTypes[format]; !ok {
+		return nil, errors.NewSDKError(apiutil.ErrInvalidQueryParams)
+	}
+	url := fmt.Sprintf("%s/%s/%s/%s?format=%s", sdk.clientsURL, domainID, clientsEndpoint, exportEndpoint, format)
+
+	_, body, sdkErr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	return body, nil
+}
+
 func (sdk mgSDK) CreateClientRole(ctx context.Context, id, domainID string, rq RoleReq, token string) (Role, errors.SDKError) {
 	return sdk.createRole(ctx, sdk.clientsURL, clientsEndpoint, id, domainID, rq, token)
 }
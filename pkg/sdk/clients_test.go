@@ -6,6 +6,7 @@ package sdk_test
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -15,6 +16,8 @@ import (
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	"github.com/absmach/magistrala/clients"
 	api "github.com/absmach/magistrala/clients/api/http"
+	"github.com/absmach/magistrala/clients/bulk"
+	bmocks "github.com/absmach/magistrala/clients/bulk/mocks"
 	"github.com/absmach/magistrala/clients/mocks"
 	"github.com/absmach/magistrala/internal/testsutil"
 	mglog "github.com/absmach/magistrala/logger"
@@ -32,17 +35,26 @@ import (
 
 var clientID = "fe6b4e92-cc98-425e-b0aa-000000000001"
 
+const maxSecretOverlap = 30 * 24 * time.Hour
+
 func setupClients() (*httptest.Server, *mocks.Service, *authnmocks.Authentication) {
+	ts, tsvc, _, authn := setupClientsWithBulk()
+
+	return ts, tsvc, authn
+}
+
+func setupClientsWithBulk() (*httptest.Server, *mocks.Service, *bmocks.Service, *authnmocks.Authentication) {
 	tsvc := new(mocks.Service)
+	bsvc := new(bmocks.Service)
 
 	logger := mglog.NewMock()
 	mux := chi.NewRouter()
 	idp := uuid.NewMock()
 	authn := new(authnmocks.Authentication)
 	am := smqauthn.NewAuthNMiddleware(authn, smqauthn.WithAllowUnverifiedUser(true))
-	api.MakeHandler(tsvc, am, mux, logger, "", idp)
+	api.MakeHandler(tsvc, bsvc, am, mux, logger, "", idp, maxSecretOverlap)
 
-	return httptest.NewServer(mux), tsvc, authn
+	return httptest.NewServer(mux), tsvc, bsvc, authn
 }
 
 func TestCreateClient(t *testing.T) {
@@ -3284,3 +3296,291 @@ func generateTestClient(t *testing.T, withRoles bool) sdk.Client {
 		Roles:           rl,
 	}
 }
+
+func TestImportClients(t *testing.T) {
+	ts, _, bsvc, auth := setupClientsWithBulk()
+	defer ts.Close()
+
+	conf := sdk.Config{
+		ClientsURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	csvData := []byte("name,tags\nsensor,a;b\n")
+	ndjsonData := []byte(`{"name":"sensor","tags":["a","b"]}` + "\n")
+	job := bulk.Job{
+		ID:        testsutil.GenerateUUID(t),
+		DomainID:  domainID,
+		CreatedBy: validID,
+		Total:     1,
+	}
+
+	cases := []struct {
+		desc            string
+		domainID        string
+		token           string
+		session         smqauthn.Session
+		data            []byte
+		format          string
+		svcFormat       bulk.Format
+		dryRun          bool
+		svcRes          bulk.Job
+		svcErr          error
+		authenticateErr error
+		response        sdk.ImportJob
+		err             errors.SDKError
+	}{
+		{
+			desc:      "import CSV clients successfully",
+			domainID:  domainID,
+			token:     validToken,
+			data:      csvData,
+			format:    sdk.CSVFormat,
+			svcFormat: bulk.CSV,
+			svcRes:    job,
+			response: sdk.ImportJob{
+				ID:        job.ID,
+				DomainID:  domainID,
+				CreatedBy: validID,
+				Format:    sdk.CSVFormat,
+				Status:    "pending",
+				Total:     1,
+			},
+		},
+		{
+			desc:      "import NDJSON clients in dry-run mode",
+			domainID:  domainID,
+			token:     validToken,
+			data:      ndjsonData,
+			format:    sdk.NDJSONFormat,
+			svcFormat: bulk.NDJSON,
+			dryRun:    true,
+			svcRes:    bulk.Job{ID: job.ID, Format: bulk.NDJSON, DryRun: true, Status: bulk.CompletedStatus, Total: 1, Processed: 1},
+			response: sdk.ImportJob{
+				ID:        job.ID,
+				Format:    sdk.NDJSONFormat,
+				DryRun:    true,
+				Status:    "completed",
+				Total:     1,
+				Processed: 1,
+			},
+		},
+		{
+			desc:     "import clients with invalid format",
+			domainID: domainID,
+			token:    validToken,
+			data:     csvData,
+			format:   "xml",
+			err:      errors.NewSDKError(apiutil.ErrUnsupportedContentType),
+		},
+		{
+			desc:      "import clients with malformed data",
+			domainID:  domainID,
+			token:     validToken,
+			data:      csvData,
+			format:    sdk.CSVFormat,
+			svcFormat: bulk.CSV,
+			svcErr:    bulk.ErrMalformedImport,
+			err:       errors.NewSDKErrorWithStatus(bulk.ErrMalformedImport, http.StatusBadRequest),
+		},
+		{
+			desc:            "import clients with invalid token",
+			domainID:        domainID,
+			token:           invalidToken,
+			data:            csvData,
+			format:          sdk.CSVFormat,
+			authenticateErr: svcerr.ErrAuthentication,
+			err:             errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authenticateErr)
+			svcCall := bsvc.On("Import", mock.Anything, tc.session, tc.svcFormat, tc.data, tc.dryRun).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.ImportClients(context.Background(), tc.data, tc.format, tc.dryRun, tc.domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "Import", mock.Anything, tc.session, tc.svcFormat, tc.data, tc.dryRun)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestViewImportJob(t *testing.T) {
+	ts, _, bsvc, auth := setupClientsWithBulk()
+	defer ts.Close()
+
+	conf := sdk.Config{
+		ClientsURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	job := bulk.Job{
+		ID:        testsutil.GenerateUUID(t),
+		DomainID:  domainID,
+		CreatedBy: validID,
+		Status:    bulk.RunningStatus,
+		Total:     2,
+		Processed: 2,
+		Imported:  1,
+		Failed:    1,
+		Errors:    []bulk.RecordError{{Line: 3, Error: "duplicate client id"}},
+	}
+
+	cases := []struct {
+		desc            string
+		domainID        string
+		token           string
+		session         smqauthn.Session
+		jobID           string
+		svcRes          bulk.Job
+		svcErr          error
+		authenticateErr error
+		response        sdk.ImportJob
+		err             errors.SDKError
+	}{
+		{
+			desc:     "view import job successfully",
+			domainID: domainID,
+			token:    validToken,
+			jobID:    job.ID,
+			svcRes:   job,
+			response: sdk.ImportJob{
+				ID:        job.ID,
+				DomainID:  domainID,
+				CreatedBy: validID,
+				Format:    sdk.CSVFormat,
+				Status:    "running",
+				Total:     2,
+				Processed: 2,
+				Imported:  1,
+				Failed:    1,
+				Errors:    []sdk.ImportError{{Line: 3, Error: "duplicate client id"}},
+			},
+		},
+		{
+			desc:     "view import job of another user",
+			domainID: domainID,
+			token:    validToken,
+			jobID:    job.ID,
+			svcErr:   svcerr.ErrAuthorization,
+			err:      errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+		},
+		{
+			desc:     "view import job with empty id",
+			domainID: domainID,
+			token:    validToken,
+			jobID:    "",
+			err:      errors.NewSDKError(apiutil.ErrMissingID),
+		},
+		{
+			desc:            "view import job with invalid token",
+			domainID:        domainID,
+			token:           invalidToken,
+			jobID:           job.ID,
+			authenticateErr: svcerr.ErrAuthentication,
+			err:             errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authenticateErr)
+			svcCall := bsvc.On("ViewJob", mock.Anything, tc.session, tc.jobID).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.ViewImportJob(context.Background(), tc.jobID, tc.domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			if tc.err == nil {
+				ok := svcCall.Parent.AssertCalled(t, "ViewJob", mock.Anything, tc.session, tc.jobID)
+				assert.True(t, ok)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestExportClients(t *testing.T) {
+	ts, _, bsvc, auth := setupClientsWithBulk()
+	defer ts.Close()
+
+	conf := sdk.Config{
+		ClientsURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	exported := "id,name\n" + validID + ",sensor\n"
+
+	cases := []struct {
+		desc            string
+		domainID        string
+		token           string
+		session         smqauthn.Session
+		format          string
+		svcFormat       bulk.Format
+		svcErr          error
+		authenticateErr error
+		response        []byte
+		err             errors.SDKError
+	}{
+		{
+			desc:      "export clients successfully",
+			domainID:  domainID,
+			token:     validToken,
+			format:    sdk.CSVFormat,
+			svcFormat: bulk.CSV,
+			response:  []byte(exported),
+		},
+		{
+			desc:     "export clients with invalid format",
+			domainID: domainID,
+			token:    validToken,
+			format:   "xml",
+			err:      errors.NewSDKError(apiutil.ErrInvalidQueryParams),
+		},
+		{
+			desc:      "export clients with service error",
+			domainID:  domainID,
+			token:     validToken,
+			format:    sdk.NDJSONFormat,
+			svcFormat: bulk.NDJSON,
+			svcErr:    svcerr.ErrAuthorization,
+			err:       errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+		},
+		{
+			desc:            "export clients with invalid token",
+			domainID:        domainID,
+			token:           invalidToken,
+			format:          sdk.CSVFormat,
+			authenticateErr: svcerr.ErrAuthentication,
+			err:             errors.NewSDKErrorWithStatus(svcerr.ErrAuthentication, http.StatusUnauthorized),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, mock.Anything).Return(tc.session, tc.authenticateErr)
+			svcCall := bsvc.On("Export", mock.Anything, tc.session, tc.svcFormat, mock.Anything).Return(tc.svcErr).Run(func(args mock.Arguments) {
+				if tc.svcErr == nil {
+					_, _ = args.Get(3).(io.Writer).Write([]byte(exported))
+				}
+			})
+			resp, err := mgsdk.ExportClients(context.Background(), tc.format, tc.domainID, tc.token)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.response, resp)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
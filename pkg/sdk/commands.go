@@ -0,0 +1,100 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+const commandsEndpoint = "commands"
+
+// Command represents a command sent to the client over its channel.
+type Command struct {
+	ID             string          `json:"id,omitempty"`
+	DomainID       string          `json:"domain_id,omitempty"`
+	ChannelID      string          `json:"channel_id,omitempty"`
+	ClientID       string          `json:"client_id,omitempty"`
+	RuleID         string          `json:"rule_id,omitempty"`
+	Name           string          `json:"name,omitempty"`
+	Payload        json.RawMessage `json:"payload,omitempty"`
+	Response       json.RawMessage `json:"response,omitempty"`
+	Status         string          `json:"status,omitempty"`
+	Timeout        uint64          `json:"timeout,omitempty"`
+	ExpiresAt      time.Time       `json:"expires_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at,omitempty"`
+	CreatedBy      string          `json:"created_by,omitempty"`
+	PublishedAt    time.Time       `json:"published_at,omitempty"`
+	AcknowledgedAt time.Time       `json:"acknowledged_at,omitempty"`
+	UpdatedAt      time.Time       `json:"updated_at,omitempty"`
+}
+
+type CommandsPage struct {
+	Offset   uint64    `json:"offset"`
+	Limit    uint64    `json:"limit"`
+	Total    uint64    `json:"total"`
+	Commands []Command `json:"commands"`
+}
+
+func (sdk mgSDK) SendCommand(ctx context.Context, cmd Command, domainID, token string) (Command, errors.SDKError) {
+	data, err := json.Marshal(cmd)
+	if err != nil {
+		return Command{}, errors.NewSDKError(err)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s", sdk.commandsURL, domainID, commandsEndpoint)
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodPost, url, token, data, nil, http.StatusCreated)
+	if sdkerr != nil {
+		return Command{}, sdkerr
+	}
+
+	var c Command
+	if err := json.Unmarshal(body, &c); err != nil {
+		return Command{}, errors.NewSDKError(err)
+	}
+
+	return c, nil
+}
+
+func (sdk mgSDK) ViewCommand(ctx context.Context, id, domainID, token string) (Command, errors.SDKError) {
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.commandsURL, domainID, commandsEndpoint, id)
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkerr != nil {
+		return Command{}, sdkerr
+	}
+
+	var c Command
+	if err := json.Unmarshal(body, &c); err != nil {
+		return Command{}, errors.NewSDKError(err)
+	}
+
+	return c, nil
+}
+
+func (sdk mgSDK) ListCommands(ctx context.Context, pm PageMetadata, domainID, token string) (CommandsPage, errors.SDKError) {
+	endpoint := fmt.Sprintf("%s/%s", domainID, commandsEndpoint)
+	url, err := sdk.withQueryParams(sdk.commandsURL, endpoint, pm)
+	if err != nil {
+		return CommandsPage{}, errors.NewSDKError(err)
+	}
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkerr != nil {
+		return CommandsPage{}, sdkerr
+	}
+
+	var cp CommandsPage
+	if err := json.Unmarshal(body, &cp); err != nil {
+		return CommandsPage{}, errors.NewSDKError(err)
+	}
+
+	return cp, nil
+}
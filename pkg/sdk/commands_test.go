@@ -0,0 +1,250 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/commands"
+	"github.com/absmach/magistrala/commands/api"
+	cmdmocks "github.com/absmach/magistrala/commands/mocks"
+	mglog "github.com/absmach/magistrala/logger"
+	smqauthn "github.com/absmach/magistrala/pkg/authn"
+	authnmocks "github.com/absmach/magistrala/pkg/authn/mocks"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/sdk"
+	"github.com/absmach/magistrala/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const commandID = "command-1"
+
+var svcCommand = commands.Command{
+	ID:        commandID,
+	DomainID:  domainID,
+	ChannelID: "chan-1",
+	ClientID:  "client-1",
+	Name:      "reboot",
+	Payload:   json.RawMessage(`{"delay":5}`),
+	Status:    commands.PublishedStatus,
+	ExpiresAt: time.Now().UTC().Add(time.Minute),
+	CreatedAt: time.Now().UTC(),
+}
+
+func setupCommands() (*httptest.Server, *cmdmocks.Service, *authnmocks.Authentication) {
+	csvc := new(cmdmocks.Service)
+	logger := mglog.NewMock()
+	authn := new(authnmocks.Authentication)
+	am := smqauthn.NewAuthNMiddleware(authn, smqauthn.WithAllowUnverifiedUser(true))
+	idp := uuid.NewMock()
+	mux := api.MakeHandler(csvc, logger, idp, "", am)
+	return httptest.NewServer(mux), csvc, authn
+}
+
+func TestSendCommand(t *testing.T) {
+	cs, csvc, auth := setupCommands()
+	defer cs.Close()
+
+	conf := sdk.Config{
+		CommandsURL: cs.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	cmd := sdk.Command{
+		ChannelID: "chan-1",
+		ClientID:  "client-1",
+		Name:      "reboot",
+		Payload:   json.RawMessage(`{"delay":5}`),
+		Timeout:   60,
+	}
+
+	cases := []struct {
+		desc            string
+		cmd             sdk.Command
+		token           string
+		session         smqauthn.Session
+		svcRes          commands.Command
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:   "send command successfully",
+			cmd:    cmd,
+			token:  validToken,
+			svcRes: svcCommand,
+		},
+		{
+			desc:    "send command with empty token",
+			cmd:     cmd,
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "send command without name",
+			cmd:     sdk.Command{ChannelID: "chan-1", ClientID: "client-1"},
+			token:   validToken,
+			wantErr: true,
+		},
+		{
+			desc:    "send command with service error",
+			cmd:     cmd,
+			token:   validToken,
+			svcErr:  svcerr.ErrAuthorization,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := csvc.On("SendCommand", mock.Anything, tc.session, mock.Anything).Return(tc.svcRes, tc.svcErr)
+			result, err := mgsdk.SendCommand(context.Background(), tc.cmd, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, commandID, result.ID)
+				assert.Equal(t, commands.Published, result.Status)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestViewCommand(t *testing.T) {
+	cs, csvc, auth := setupCommands()
+	defer cs.Close()
+
+	conf := sdk.Config{
+		CommandsURL: cs.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	cases := []struct {
+		desc            string
+		id              string
+		token           string
+		session         smqauthn.Session
+		svcRes          commands.Command
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:   "view command successfully",
+			id:     commandID,
+			token:  validToken,
+			svcRes: svcCommand,
+		},
+		{
+			desc:    "view command with empty token",
+			id:      commandID,
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "view non-existent command",
+			id:      "non-existent",
+			token:   validToken,
+			svcErr:  svcerr.ErrNotFound,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := csvc.On("ViewCommand", mock.Anything, tc.session, tc.id).Return(tc.svcRes, tc.svcErr)
+			result, err := mgsdk.ViewCommand(context.Background(), tc.id, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, tc.id, result.ID)
+				assert.JSONEq(t, string(svcCommand.Payload), string(result.Payload))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestListCommands(t *testing.T) {
+	cs, csvc, auth := setupCommands()
+	defer cs.Close()
+
+	conf := sdk.Config{
+		CommandsURL: cs.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	cases := []struct {
+		desc            string
+		pm              sdk.PageMetadata
+		token           string
+		session         smqauthn.Session
+		svcRes          commands.CommandsPage
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:  "list commands successfully",
+			pm:    sdk.PageMetadata{Limit: 10, ClientID: "client-1", Status: commands.Published},
+			token: validToken,
+			svcRes: commands.CommandsPage{
+				Total:    1,
+				Limit:    10,
+				Commands: []commands.Command{svcCommand},
+			},
+		},
+		{
+			desc:    "list commands with empty token",
+			pm:      sdk.PageMetadata{Limit: 10},
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "list commands with invalid status",
+			pm:      sdk.PageMetadata{Limit: 10, Status: "invalid"},
+			token:   validToken,
+			wantErr: true,
+		},
+		{
+			desc:    "list commands with service error",
+			pm:      sdk.PageMetadata{Limit: 10},
+			token:   validToken,
+			svcErr:  errors.New("failed"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := csvc.On("ListCommands", mock.Anything, tc.session, mock.Anything).Return(tc.svcRes, tc.svcErr)
+			result, err := mgsdk.ListCommands(context.Background(), tc.pm, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, tc.svcRes.Total, result.Total)
+				assert.Len(t, result.Commands, len(tc.svcRes.Commands))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
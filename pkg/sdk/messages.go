@@ -15,6 +15,8 @@ import (
 	"github.com/absmach/magistrala/pkg/errors"
 )
 
+const payloadPrefix = "payload."
+
 func (sdk mgSDK) ReadMessages(ctx context.Context, pm MessagePageMetadata, chanName, domainID, token string) (MessagesPage, errors.SDKError) {
 	chanNameParts := strings.SplitN(chanName, "/", channelParts)
 	chanID := chanNameParts[0]
@@ -70,6 +72,13 @@ func (sdk mgSDK) withMessageQueryParams(baseURL, endpoint string, mpm MessagePag
 			ret.Add(k, strconv.FormatBool(t))
 		}
 	}
+	for _, f := range mpm.PayloadFilters {
+		val := f.Value
+		if f.Comparator != "" {
+			val = fmt.Sprintf("%s:%s", f.Comparator, f.Value)
+		}
+		ret.Add(payloadPrefix+f.Path, val)
+	}
 	qs := ret.Encode()
 
 	return fmt.Sprintf("%s/%s?%s", baseURL, endpoint, qs), nil
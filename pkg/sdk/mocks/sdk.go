@@ -10,6 +10,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/absmach/magistrala/pkg/sdk"
@@ -6543,6 +6544,88 @@ func (_c *SDK_EntityID_Call) RunAndReturn(run func(ctx context.Context, serialNu
 	return _c
 }
 
+// ExportClients provides a mock function for the type SDK
+func (_mock *SDK) ExportClients(ctx context.Context, format string, domainID string, token string) ([]byte, errors.SDKError) {
+	ret := _mock.Called(ctx, format, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportClients")
+	}
+
+	var r0 []byte
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) ([]byte, errors.SDKError)); ok {
+		return returnFunc(ctx, format, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) []byte); ok {
+		r0 = returnFunc(ctx, format, domainID, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, format, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ExportClients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportClients'
+type SDK_ExportClients_Call struct {
+	*mock.Call
+}
+
+// ExportClients is a helper method to define mock.On call
+//   - ctx context.Context
+//   - format string
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) ExportClients(ctx interface{}, format interface{}, domainID interface{}, token interface{}) *SDK_ExportClients_Call {
+	return &SDK_ExportClients_Call{Call: _e.mock.On("ExportClients", ctx, format, domainID, token)}
+}
+
+func (_c *SDK_ExportClients_Call) Run(run func(ctx context.Context, format string, domainID string, token string)) *SDK_ExportClients_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ExportClients_Call) Return(bytes []byte, sdkError errors.SDKError) *SDK_ExportClients_Call {
+	_c.Call.Return(bytes, sdkError)
+	return _c
+}
+
+func (_c *SDK_ExportClients_Call) RunAndReturn(run func(ctx context.Context, format string, domainID string, token string) ([]byte, errors.SDKError)) *SDK_ExportClients_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FreezeDomain provides a mock function for the type SDK
 func (_mock *SDK) FreezeDomain(ctx context.Context, domainID string, token string) errors.SDKError {
 	ret := _mock.Called(ctx, domainID, token)
@@ -7426,6 +7509,98 @@ func (_c *SDK_Hierarchy_Call) RunAndReturn(run func(ctx context.Context, id stri
 	return _c
 }
 
+// ImportClients provides a mock function for the type SDK
+func (_mock *SDK) ImportClients(ctx context.Context, data []byte, format string, dryRun bool, domainID string, token string) (sdk.ImportJob, errors.SDKError) {
+	ret := _mock.Called(ctx, data, format, dryRun, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportClients")
+	}
+
+	var r0 sdk.ImportJob
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte, string, bool, string, string) (sdk.ImportJob, errors.SDKError)); ok {
+		return returnFunc(ctx, data, format, dryRun, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte, string, bool, string, string) sdk.ImportJob); ok {
+		r0 = returnFunc(ctx, data, format, dryRun, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.ImportJob)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []byte, string, bool, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, data, format, dryRun, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ImportClients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportClients'
+type SDK_ImportClients_Call struct {
+	*mock.Call
+}
+
+// ImportClients is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data []byte
+//   - format string
+//   - dryRun bool
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) ImportClients(ctx interface{}, data interface{}, format interface{}, dryRun interface{}, domainID interface{}, token interface{}) *SDK_ImportClients_Call {
+	return &SDK_ImportClients_Call{Call: _e.mock.On("ImportClients", ctx, data, format, dryRun, domainID, token)}
+}
+
+func (_c *SDK_ImportClients_Call) Run(run func(ctx context.Context, data []byte, format string, dryRun bool, domainID string, token string)) *SDK_ImportClients_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ImportClients_Call) Return(importJob sdk.ImportJob, sdkError errors.SDKError) *SDK_ImportClients_Call {
+	_c.Call.Return(importJob, sdkError)
+	return _c
+}
+
+func (_c *SDK_ImportClients_Call) RunAndReturn(run func(ctx context.Context, data []byte, format string, dryRun bool, domainID string, token string) (sdk.ImportJob, errors.SDKError)) *SDK_ImportClients_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Invitations provides a mock function for the type SDK
 func (_mock *SDK) Invitations(ctx context.Context, pm sdk.PageMetadata, token string) (sdk.InvitationPage, error) {
 	ret := _mock.Called(ctx, pm, token)
@@ -8196,6 +8371,86 @@ func (_c *SDK_ListClientMembers_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// ListCommands provides a mock function for the type SDK
+func (_mock *SDK) ListCommands(ctx context.Context, pm sdk.PageMetadata, domainID string, token string) (sdk.CommandsPage, errors.SDKError) {
+	ret := _mock.Called(ctx, pm, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommands")
+	}
+
+	var r0 sdk.CommandsPage
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) (sdk.CommandsPage, errors.SDKError)); ok {
+		return returnFunc(ctx, pm, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) sdk.CommandsPage); ok {
+		r0 = returnFunc(ctx, pm, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.CommandsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.PageMetadata, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, pm, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ListCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCommands'
+type SDK_ListCommands_Call struct {
+	*mock.Call
+}
+
+// ListCommands is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm sdk.PageMetadata
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) ListCommands(ctx interface{}, pm interface{}, domainID interface{}, token interface{}) *SDK_ListCommands_Call {
+	return &SDK_ListCommands_Call{Call: _e.mock.On("ListCommands", ctx, pm, domainID, token)}
+}
+
+func (_c *SDK_ListCommands_Call) Run(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string)) *SDK_ListCommands_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 sdk.PageMetadata
+		if args[1] != nil {
+			arg1 = args[1].(sdk.PageMetadata)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ListCommands_Call) Return(commandsPage sdk.CommandsPage, sdkError errors.SDKError) *SDK_ListCommands_Call {
+	_c.Call.Return(commandsPage, sdkError)
+	return _c
+}
+
+func (_c *SDK_ListCommands_Call) RunAndReturn(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string) (sdk.CommandsPage, errors.SDKError)) *SDK_ListCommands_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDomainMembers provides a mock function for the type SDK
 func (_mock *SDK) ListDomainMembers(ctx context.Context, domainID string, pm sdk.PageMetadata, token string) (sdk.EntityMembersPage, errors.SDKError) {
 	ret := _mock.Called(ctx, domainID, pm, token)
@@ -8596,6 +8851,92 @@ func (_c *SDK_ListSubscriptions_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// ListTwinRevisions provides a mock function for the type SDK
+func (_mock *SDK) ListTwinRevisions(ctx context.Context, clientID string, pm sdk.PageMetadata, domainID string, token string) (sdk.TwinRevisionsPage, errors.SDKError) {
+	ret := _mock.Called(ctx, clientID, pm, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTwinRevisions")
+	}
+
+	var r0 sdk.TwinRevisionsPage
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string, string) (sdk.TwinRevisionsPage, errors.SDKError)); ok {
+		return returnFunc(ctx, clientID, pm, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string, string) sdk.TwinRevisionsPage); ok {
+		r0 = returnFunc(ctx, clientID, pm, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.TwinRevisionsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, sdk.PageMetadata, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, clientID, pm, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ListTwinRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTwinRevisions'
+type SDK_ListTwinRevisions_Call struct {
+	*mock.Call
+}
+
+// ListTwinRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - pm sdk.PageMetadata
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) ListTwinRevisions(ctx interface{}, clientID interface{}, pm interface{}, domainID interface{}, token interface{}) *SDK_ListTwinRevisions_Call {
+	return &SDK_ListTwinRevisions_Call{Call: _e.mock.On("ListTwinRevisions", ctx, clientID, pm, domainID, token)}
+}
+
+func (_c *SDK_ListTwinRevisions_Call) Run(run func(ctx context.Context, clientID string, pm sdk.PageMetadata, domainID string, token string)) *SDK_ListTwinRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 sdk.PageMetadata
+		if args[2] != nil {
+			arg2 = args[2].(sdk.PageMetadata)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ListTwinRevisions_Call) Return(twinRevisionsPage sdk.TwinRevisionsPage, sdkError errors.SDKError) *SDK_ListTwinRevisions_Call {
+	_c.Call.Return(twinRevisionsPage, sdkError)
+	return _c
+}
+
+func (_c *SDK_ListTwinRevisions_Call) RunAndReturn(run func(ctx context.Context, clientID string, pm sdk.PageMetadata, domainID string, token string) (sdk.TwinRevisionsPage, errors.SDKError)) *SDK_ListTwinRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OCSP provides a mock function for the type SDK
 func (_mock *SDK) OCSP(ctx context.Context, serialNumber string, cert string) (sdk.OCSPResponse, errors.SDKError) {
 	ret := _mock.Called(ctx, serialNumber, cert)
@@ -9698,6 +10039,86 @@ func (_c *SDK_RemoveChannelParent_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// RemoveChannelRetention provides a mock function for the type SDK
+func (_mock *SDK) RemoveChannelRetention(ctx context.Context, id string, domainID string, token string) (sdk.Channel, errors.SDKError) {
+	ret := _mock.Called(ctx, id, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveChannelRetention")
+	}
+
+	var r0 sdk.Channel
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (sdk.Channel, errors.SDKError)); ok {
+		return returnFunc(ctx, id, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) sdk.Channel); ok {
+		r0 = returnFunc(ctx, id, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Channel)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_RemoveChannelRetention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveChannelRetention'
+type SDK_RemoveChannelRetention_Call struct {
+	*mock.Call
+}
+
+// RemoveChannelRetention is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) RemoveChannelRetention(ctx interface{}, id interface{}, domainID interface{}, token interface{}) *SDK_RemoveChannelRetention_Call {
+	return &SDK_RemoveChannelRetention_Call{Call: _e.mock.On("RemoveChannelRetention", ctx, id, domainID, token)}
+}
+
+func (_c *SDK_RemoveChannelRetention_Call) Run(run func(ctx context.Context, id string, domainID string, token string)) *SDK_RemoveChannelRetention_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_RemoveChannelRetention_Call) Return(channel sdk.Channel, sdkError errors.SDKError) *SDK_RemoveChannelRetention_Call {
+	_c.Call.Return(channel, sdkError)
+	return _c
+}
+
+func (_c *SDK_RemoveChannelRetention_Call) RunAndReturn(run func(ctx context.Context, id string, domainID string, token string) (sdk.Channel, errors.SDKError)) *SDK_RemoveChannelRetention_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveChildren provides a mock function for the type SDK
 func (_mock *SDK) RemoveChildren(ctx context.Context, id string, domainID string, groupIDs []string, token string) errors.SDKError {
 	ret := _mock.Called(ctx, id, domainID, groupIDs, token)
@@ -10557,6 +10978,77 @@ func (_c *SDK_RemoveRule_Call) RunAndReturn(run func(ctx context.Context, id str
 	return _c
 }
 
+// RemoveTwin provides a mock function for the type SDK
+func (_mock *SDK) RemoveTwin(ctx context.Context, clientID string, domainID string, token string) errors.SDKError {
+	ret := _mock.Called(ctx, clientID, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTwin")
+	}
+
+	var r0 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r0 = returnFunc(ctx, clientID, domainID, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.SDKError)
+		}
+	}
+	return r0
+}
+
+// SDK_RemoveTwin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTwin'
+type SDK_RemoveTwin_Call struct {
+	*mock.Call
+}
+
+// RemoveTwin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) RemoveTwin(ctx interface{}, clientID interface{}, domainID interface{}, token interface{}) *SDK_RemoveTwin_Call {
+	return &SDK_RemoveTwin_Call{Call: _e.mock.On("RemoveTwin", ctx, clientID, domainID, token)}
+}
+
+func (_c *SDK_RemoveTwin_Call) Run(run func(ctx context.Context, clientID string, domainID string, token string)) *SDK_RemoveTwin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_RemoveTwin_Call) Return(sdkError errors.SDKError) *SDK_RemoveTwin_Call {
+	_c.Call.Return(sdkError)
+	return _c
+}
+
+func (_c *SDK_RemoveTwin_Call) RunAndReturn(run func(ctx context.Context, clientID string, domainID string, token string) errors.SDKError) *SDK_RemoveTwin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RenewCert provides a mock function for the type SDK
 func (_mock *SDK) RenewCert(ctx context.Context, serialNumber string, domainID string, token string) (sdk.Certificate, errors.SDKError) {
 	ret := _mock.Called(ctx, serialNumber, domainID, token)
@@ -10637,6 +11129,92 @@ func (_c *SDK_RenewCert_Call) RunAndReturn(run func(ctx context.Context, serialN
 	return _c
 }
 
+// ReplayRule provides a mock function for the type SDK
+func (_mock *SDK) ReplayRule(ctx context.Context, id string, rp sdk.RuleReplay, domainID string, token string) (sdk.RuleReplay, errors.SDKError) {
+	ret := _mock.Called(ctx, id, rp, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayRule")
+	}
+
+	var r0 sdk.RuleReplay
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.RuleReplay, string, string) (sdk.RuleReplay, errors.SDKError)); ok {
+		return returnFunc(ctx, id, rp, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.RuleReplay, string, string) sdk.RuleReplay); ok {
+		r0 = returnFunc(ctx, id, rp, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.RuleReplay)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, sdk.RuleReplay, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, rp, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ReplayRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayRule'
+type SDK_ReplayRule_Call struct {
+	*mock.Call
+}
+
+// ReplayRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - rp sdk.RuleReplay
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) ReplayRule(ctx interface{}, id interface{}, rp interface{}, domainID interface{}, token interface{}) *SDK_ReplayRule_Call {
+	return &SDK_ReplayRule_Call{Call: _e.mock.On("ReplayRule", ctx, id, rp, domainID, token)}
+}
+
+func (_c *SDK_ReplayRule_Call) Run(run func(ctx context.Context, id string, rp sdk.RuleReplay, domainID string, token string)) *SDK_ReplayRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 sdk.RuleReplay
+		if args[2] != nil {
+			arg2 = args[2].(sdk.RuleReplay)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ReplayRule_Call) Return(ruleReplay sdk.RuleReplay, sdkError errors.SDKError) *SDK_ReplayRule_Call {
+	_c.Call.Return(ruleReplay, sdkError)
+	return _c
+}
+
+func (_c *SDK_ReplayRule_Call) RunAndReturn(run func(ctx context.Context, id string, rp sdk.RuleReplay, domainID string, token string) (sdk.RuleReplay, errors.SDKError)) *SDK_ReplayRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResetPassword provides a mock function for the type SDK
 func (_mock *SDK) ResetPassword(ctx context.Context, password string, confPass string, token string) errors.SDKError {
 	ret := _mock.Called(ctx, password, confPass, token)
@@ -10909,6 +11487,98 @@ func (_c *SDK_RevokeCert_Call) RunAndReturn(run func(ctx context.Context, serial
 	return _c
 }
 
+// RotateClientSecret provides a mock function for the type SDK
+func (_mock *SDK) RotateClientSecret(ctx context.Context, id string, secret string, overlap time.Duration, domainID string, token string) (sdk.Client, errors.SDKError) {
+	ret := _mock.Called(ctx, id, secret, overlap, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateClientSecret")
+	}
+
+	var r0 sdk.Client
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration, string, string) (sdk.Client, errors.SDKError)); ok {
+		return returnFunc(ctx, id, secret, overlap, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration, string, string) sdk.Client); ok {
+		r0 = returnFunc(ctx, id, secret, overlap, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Client)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Duration, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, secret, overlap, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_RotateClientSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateClientSecret'
+type SDK_RotateClientSecret_Call struct {
+	*mock.Call
+}
+
+// RotateClientSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - secret string
+//   - overlap time.Duration
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) RotateClientSecret(ctx interface{}, id interface{}, secret interface{}, overlap interface{}, domainID interface{}, token interface{}) *SDK_RotateClientSecret_Call {
+	return &SDK_RotateClientSecret_Call{Call: _e.mock.On("RotateClientSecret", ctx, id, secret, overlap, domainID, token)}
+}
+
+func (_c *SDK_RotateClientSecret_Call) Run(run func(ctx context.Context, id string, secret string, overlap time.Duration, domainID string, token string)) *SDK_RotateClientSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_RotateClientSecret_Call) Return(client sdk.Client, sDKError errors.SDKError) *SDK_RotateClientSecret_Call {
+	_c.Call.Return(client, sDKError)
+	return _c
+}
+
+func (_c *SDK_RotateClientSecret_Call) RunAndReturn(run func(ctx context.Context, id string, secret string, overlap time.Duration, domainID string, token string) (sdk.Client, errors.SDKError)) *SDK_RotateClientSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchUsers provides a mock function for the type SDK
 func (_mock *SDK) SearchUsers(ctx context.Context, pm sdk.PageMetadata, token string) (sdk.UsersPage, errors.SDKError) {
 	ret := _mock.Called(ctx, pm, token)
@@ -10983,6 +11653,86 @@ func (_c *SDK_SearchUsers_Call) RunAndReturn(run func(ctx context.Context, pm sd
 	return _c
 }
 
+// SendCommand provides a mock function for the type SDK
+func (_mock *SDK) SendCommand(ctx context.Context, cmd sdk.Command, domainID string, token string) (sdk.Command, errors.SDKError) {
+	ret := _mock.Called(ctx, cmd, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendCommand")
+	}
+
+	var r0 sdk.Command
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.Command, string, string) (sdk.Command, errors.SDKError)); ok {
+		return returnFunc(ctx, cmd, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.Command, string, string) sdk.Command); ok {
+		r0 = returnFunc(ctx, cmd, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Command)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.Command, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, cmd, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_SendCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendCommand'
+type SDK_SendCommand_Call struct {
+	*mock.Call
+}
+
+// SendCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cmd sdk.Command
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) SendCommand(ctx interface{}, cmd interface{}, domainID interface{}, token interface{}) *SDK_SendCommand_Call {
+	return &SDK_SendCommand_Call{Call: _e.mock.On("SendCommand", ctx, cmd, domainID, token)}
+}
+
+func (_c *SDK_SendCommand_Call) Run(run func(ctx context.Context, cmd sdk.Command, domainID string, token string)) *SDK_SendCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 sdk.Command
+		if args[1] != nil {
+			arg1 = args[1].(sdk.Command)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_SendCommand_Call) Return(command sdk.Command, sdkError errors.SDKError) *SDK_SendCommand_Call {
+	_c.Call.Return(command, sdkError)
+	return _c
+}
+
+func (_c *SDK_SendCommand_Call) RunAndReturn(run func(ctx context.Context, cmd sdk.Command, domainID string, token string) (sdk.Command, errors.SDKError)) *SDK_SendCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendInvitation provides a mock function for the type SDK
 func (_mock *SDK) SendInvitation(ctx context.Context, invitation sdk.Invitation, token string) error {
 	ret := _mock.Called(ctx, invitation, token)
@@ -11216,7 +11966,93 @@ func (_e *SDK_Expecter) SetChannelParent(ctx interface{}, id interface{}, domain
 	return &SDK_SetChannelParent_Call{Call: _e.mock.On("SetChannelParent", ctx, id, domainID, groupID, token)}
 }
 
-func (_c *SDK_SetChannelParent_Call) Run(run func(ctx context.Context, id string, domainID string, groupID string, token string)) *SDK_SetChannelParent_Call {
+func (_c *SDK_SetChannelParent_Call) Run(run func(ctx context.Context, id string, domainID string, groupID string, token string)) *SDK_SetChannelParent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_SetChannelParent_Call) Return(sDKError errors.SDKError) *SDK_SetChannelParent_Call {
+	_c.Call.Return(sDKError)
+	return _c
+}
+
+func (_c *SDK_SetChannelParent_Call) RunAndReturn(run func(ctx context.Context, id string, domainID string, groupID string, token string) errors.SDKError) *SDK_SetChannelParent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetChannelRetention provides a mock function for the type SDK
+func (_mock *SDK) SetChannelRetention(ctx context.Context, id string, policy sdk.RetentionPolicy, domainID string, token string) (sdk.Channel, errors.SDKError) {
+	ret := _mock.Called(ctx, id, policy, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetChannelRetention")
+	}
+
+	var r0 sdk.Channel
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.RetentionPolicy, string, string) (sdk.Channel, errors.SDKError)); ok {
+		return returnFunc(ctx, id, policy, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.RetentionPolicy, string, string) sdk.Channel); ok {
+		r0 = returnFunc(ctx, id, policy, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Channel)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, sdk.RetentionPolicy, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, policy, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_SetChannelRetention_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetChannelRetention'
+type SDK_SetChannelRetention_Call struct {
+	*mock.Call
+}
+
+// SetChannelRetention is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - policy sdk.RetentionPolicy
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) SetChannelRetention(ctx interface{}, id interface{}, policy interface{}, domainID interface{}, token interface{}) *SDK_SetChannelRetention_Call {
+	return &SDK_SetChannelRetention_Call{Call: _e.mock.On("SetChannelRetention", ctx, id, policy, domainID, token)}
+}
+
+func (_c *SDK_SetChannelRetention_Call) Run(run func(ctx context.Context, id string, policy sdk.RetentionPolicy, domainID string, token string)) *SDK_SetChannelRetention_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -11226,9 +12062,9 @@ func (_c *SDK_SetChannelParent_Call) Run(run func(ctx context.Context, id string
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 sdk.RetentionPolicy
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(sdk.RetentionPolicy)
 		}
 		var arg3 string
 		if args[3] != nil {
@@ -11249,12 +12085,12 @@ func (_c *SDK_SetChannelParent_Call) Run(run func(ctx context.Context, id string
 	return _c
 }
 
-func (_c *SDK_SetChannelParent_Call) Return(sDKError errors.SDKError) *SDK_SetChannelParent_Call {
-	_c.Call.Return(sDKError)
+func (_c *SDK_SetChannelRetention_Call) Return(channel sdk.Channel, sdkError errors.SDKError) *SDK_SetChannelRetention_Call {
+	_c.Call.Return(channel, sdkError)
 	return _c
 }
 
-func (_c *SDK_SetChannelParent_Call) RunAndReturn(run func(ctx context.Context, id string, domainID string, groupID string, token string) errors.SDKError) *SDK_SetChannelParent_Call {
+func (_c *SDK_SetChannelRetention_Call) RunAndReturn(run func(ctx context.Context, id string, policy sdk.RetentionPolicy, domainID string, token string) (sdk.Channel, errors.SDKError)) *SDK_SetChannelRetention_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -13407,6 +14243,92 @@ func (_c *SDK_UpdateRuleTags_Call) RunAndReturn(run func(ctx context.Context, r
 	return _c
 }
 
+// UpdateTwinDesired provides a mock function for the type SDK
+func (_mock *SDK) UpdateTwinDesired(ctx context.Context, clientID string, upd sdk.TwinUpdate, domainID string, token string) (sdk.Twin, errors.SDKError) {
+	ret := _mock.Called(ctx, clientID, upd, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTwinDesired")
+	}
+
+	var r0 sdk.Twin
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.TwinUpdate, string, string) (sdk.Twin, errors.SDKError)); ok {
+		return returnFunc(ctx, clientID, upd, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.TwinUpdate, string, string) sdk.Twin); ok {
+		r0 = returnFunc(ctx, clientID, upd, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Twin)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, sdk.TwinUpdate, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, clientID, upd, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_UpdateTwinDesired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTwinDesired'
+type SDK_UpdateTwinDesired_Call struct {
+	*mock.Call
+}
+
+// UpdateTwinDesired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - upd sdk.TwinUpdate
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) UpdateTwinDesired(ctx interface{}, clientID interface{}, upd interface{}, domainID interface{}, token interface{}) *SDK_UpdateTwinDesired_Call {
+	return &SDK_UpdateTwinDesired_Call{Call: _e.mock.On("UpdateTwinDesired", ctx, clientID, upd, domainID, token)}
+}
+
+func (_c *SDK_UpdateTwinDesired_Call) Run(run func(ctx context.Context, clientID string, upd sdk.TwinUpdate, domainID string, token string)) *SDK_UpdateTwinDesired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 sdk.TwinUpdate
+		if args[2] != nil {
+			arg2 = args[2].(sdk.TwinUpdate)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_UpdateTwinDesired_Call) Return(twin sdk.Twin, sdkError errors.SDKError) *SDK_UpdateTwinDesired_Call {
+	_c.Call.Return(twin, sdkError)
+	return _c
+}
+
+func (_c *SDK_UpdateTwinDesired_Call) RunAndReturn(run func(ctx context.Context, clientID string, upd sdk.TwinUpdate, domainID string, token string) (sdk.Twin, errors.SDKError)) *SDK_UpdateTwinDesired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function for the type SDK
 func (_mock *SDK) UpdateUser(ctx context.Context, user sdk.User, token string) (sdk.User, errors.SDKError) {
 	ret := _mock.Called(ctx, user, token)
@@ -14434,6 +15356,166 @@ func (_c *SDK_ViewCert_Call) RunAndReturn(run func(ctx context.Context, serialNu
 	return _c
 }
 
+// ViewCommand provides a mock function for the type SDK
+func (_mock *SDK) ViewCommand(ctx context.Context, id string, domainID string, token string) (sdk.Command, errors.SDKError) {
+	ret := _mock.Called(ctx, id, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewCommand")
+	}
+
+	var r0 sdk.Command
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (sdk.Command, errors.SDKError)); ok {
+		return returnFunc(ctx, id, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) sdk.Command); ok {
+		r0 = returnFunc(ctx, id, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Command)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ViewCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewCommand'
+type SDK_ViewCommand_Call struct {
+	*mock.Call
+}
+
+// ViewCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) ViewCommand(ctx interface{}, id interface{}, domainID interface{}, token interface{}) *SDK_ViewCommand_Call {
+	return &SDK_ViewCommand_Call{Call: _e.mock.On("ViewCommand", ctx, id, domainID, token)}
+}
+
+func (_c *SDK_ViewCommand_Call) Run(run func(ctx context.Context, id string, domainID string, token string)) *SDK_ViewCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ViewCommand_Call) Return(command sdk.Command, sdkError errors.SDKError) *SDK_ViewCommand_Call {
+	_c.Call.Return(command, sdkError)
+	return _c
+}
+
+func (_c *SDK_ViewCommand_Call) RunAndReturn(run func(ctx context.Context, id string, domainID string, token string) (sdk.Command, errors.SDKError)) *SDK_ViewCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ViewImportJob provides a mock function for the type SDK
+func (_mock *SDK) ViewImportJob(ctx context.Context, id string, domainID string, token string) (sdk.ImportJob, errors.SDKError) {
+	ret := _mock.Called(ctx, id, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewImportJob")
+	}
+
+	var r0 sdk.ImportJob
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (sdk.ImportJob, errors.SDKError)); ok {
+		return returnFunc(ctx, id, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) sdk.ImportJob); ok {
+		r0 = returnFunc(ctx, id, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.ImportJob)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ViewImportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewImportJob'
+type SDK_ViewImportJob_Call struct {
+	*mock.Call
+}
+
+// ViewImportJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) ViewImportJob(ctx interface{}, id interface{}, domainID interface{}, token interface{}) *SDK_ViewImportJob_Call {
+	return &SDK_ViewImportJob_Call{Call: _e.mock.On("ViewImportJob", ctx, id, domainID, token)}
+}
+
+func (_c *SDK_ViewImportJob_Call) Run(run func(ctx context.Context, id string, domainID string, token string)) *SDK_ViewImportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ViewImportJob_Call) Return(importJob sdk.ImportJob, sdkError errors.SDKError) *SDK_ViewImportJob_Call {
+	_c.Call.Return(importJob, sdkError)
+	return _c
+}
+
+func (_c *SDK_ViewImportJob_Call) RunAndReturn(run func(ctx context.Context, id string, domainID string, token string) (sdk.ImportJob, errors.SDKError)) *SDK_ViewImportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ViewReportConfig provides a mock function for the type SDK
 func (_mock *SDK) ViewReportConfig(ctx context.Context, id string, domainID string, token string) (sdk.ReportConfig, errors.SDKError) {
 	ret := _mock.Called(ctx, id, domainID, token)
@@ -14750,6 +15832,86 @@ func (_c *SDK_ViewSubscription_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// ViewTwin provides a mock function for the type SDK
+func (_mock *SDK) ViewTwin(ctx context.Context, clientID string, domainID string, token string) (sdk.Twin, errors.SDKError) {
+	ret := _mock.Called(ctx, clientID, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewTwin")
+	}
+
+	var r0 sdk.Twin
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (sdk.Twin, errors.SDKError)); ok {
+		return returnFunc(ctx, clientID, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) sdk.Twin); ok {
+		r0 = returnFunc(ctx, clientID, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Twin)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, clientID, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ViewTwin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewTwin'
+type SDK_ViewTwin_Call struct {
+	*mock.Call
+}
+
+// ViewTwin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) ViewTwin(ctx interface{}, clientID interface{}, domainID interface{}, token interface{}) *SDK_ViewTwin_Call {
+	return &SDK_ViewTwin_Call{Call: _e.mock.On("ViewTwin", ctx, clientID, domainID, token)}
+}
+
+func (_c *SDK_ViewTwin_Call) Run(run func(ctx context.Context, clientID string, domainID string, token string)) *SDK_ViewTwin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ViewTwin_Call) Return(twin sdk.Twin, sdkError errors.SDKError) *SDK_ViewTwin_Call {
+	_c.Call.Return(twin, sdkError)
+	return _c
+}
+
+func (_c *SDK_ViewTwin_Call) RunAndReturn(run func(ctx context.Context, clientID string, domainID string, token string) (sdk.Twin, errors.SDKError)) *SDK_ViewTwin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Whitelist provides a mock function for the type SDK
 func (_mock *SDK) Whitelist(ctx context.Context, clientID string, status sdk.BootstrapStatus, domainID string, token string) errors.SDKError {
 	ret := _mock.Called(ctx, clientID, status, domainID, token)
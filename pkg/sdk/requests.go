@@ -24,6 +24,11 @@ type updateClientSecretReq struct {
 	Secret string `json:"secret,omitempty"`
 }
 
+type rotateClientSecretReq struct {
+	Secret  string `json:"secret,omitempty"`
+	Overlap string `json:"overlap,omitempty"`
+}
+
 // updateUserEmailReq is used to update the user email.
 type updateUserEmailReq struct {
 	token string
@@ -42,6 +47,7 @@ type Connection struct {
 	ClientIDs  []string `json:"client_ids,omitempty"`
 	ChannelIDs []string `json:"channel_ids,omitempty"`
 	Types      []string `json:"types,omitempty"`
+	Topics     []string `json:"topics,omitempty"`
 }
 
 type UsersRelationRequest struct {
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/absmach/magistrala/pkg/roles"
@@ -35,6 +36,18 @@ type Rule struct {
 	Roles        []roles.MemberRoleActions `json:"roles,omitempty"`
 }
 
+// RuleReplay represents re-processing of the messages stored in the
+// message broker by a rule, starting either from a time or a sequence.
+type RuleReplay struct {
+	ID            string    `json:"id,omitempty"`
+	RuleID        string    `json:"rule_id,omitempty"`
+	StartTime     time.Time `json:"start_time,omitzero"`
+	StartSequence uint64    `json:"start_sequence,omitempty"`
+	EndTime       time.Time `json:"end_time,omitzero"`
+	CreatedAt     time.Time `json:"created_at,omitzero"`
+	CreatedBy     string    `json:"created_by,omitempty"`
+}
+
 type Page struct {
 	Offset uint64 `json:"offset"`
 	Limit  uint64 `json:"limit"`
@@ -200,3 +213,24 @@ func (sdk mgSDK) DisableRule(ctx context.Context, id, domainID, token string) (R
 
 	return a, nil
 }
+
+func (sdk mgSDK) ReplayRule(ctx context.Context, id string, rp RuleReplay, domainID, token string) (RuleReplay, errors.SDKError) {
+	data, err := json.Marshal(rp)
+	if err != nil {
+		return RuleReplay{}, errors.NewSDKError(err)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s/replay", sdk.rulesEngineURL, domainID, rulesEndpoint, id)
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodPost, url, token, data, nil, http.StatusAccepted)
+	if sdkerr != nil {
+		return RuleReplay{}, sdkerr
+	}
+
+	var replay RuleReplay
+	if err := json.Unmarshal(body, &replay); err != nil {
+		return RuleReplay{}, errors.NewSDKError(err)
+	}
+
+	return replay, nil
+}
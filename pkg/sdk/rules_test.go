@@ -8,6 +8,7 @@ import (
 	"errors"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	mglog "github.com/absmach/magistrala/logger"
 	smqauthn "github.com/absmach/magistrala/pkg/authn"
@@ -584,3 +585,74 @@ func TestRemoveRule(t *testing.T) {
 		})
 	}
 }
+
+func TestReplayRule(t *testing.T) {
+	rs, rsvc, auth := setupRules()
+	defer rs.Close()
+
+	conf := sdk.Config{
+		RulesEngineURL: rs.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	start := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Second)
+	svcReplay := re.Replay{
+		ID:        "replay-1",
+		RuleID:    ruleID,
+		StartTime: start,
+		EndTime:   start.Add(time.Hour),
+	}
+
+	cases := []struct {
+		desc            string
+		id              string
+		replay          sdk.RuleReplay
+		token           string
+		session         smqauthn.Session
+		svcReq          re.Replay
+		svcRes          re.Replay
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:   "replay rule successfully",
+			id:     ruleID,
+			replay: sdk.RuleReplay{StartTime: start, EndTime: start.Add(time.Hour)},
+			token:  validToken,
+			svcReq: re.Replay{StartTime: start, EndTime: start.Add(time.Hour)},
+			svcRes: svcReplay,
+		},
+		{
+			desc:    "replay rule without start",
+			id:      ruleID,
+			token:   validToken,
+			wantErr: true,
+		},
+		{
+			desc:    "replay rule with empty token",
+			id:      ruleID,
+			replay:  sdk.RuleReplay{StartSequence: 1},
+			token:   "",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := rsvc.On("ReplayRule", mock.Anything, tc.session, tc.id, tc.svcReq).Return(tc.svcRes, tc.svcErr)
+			result, err := mgsdk.ReplayRule(context.Background(), tc.id, tc.replay, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, svcReplay.ID, result.ID)
+				assert.True(t, svcReplay.StartTime.Equal(result.StartTime))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
@@ -36,6 +36,12 @@ const (
 	// CTBinary represents binary content type.
 	CTBinary ContentType = "application/octet-stream"
 
+	// CTCSV represents CSV content type.
+	CTCSV ContentType = "text/csv"
+
+	// CTNDJSON represents newline delimited JSON content type.
+	CTNDJSON ContentType = "application/x-ndjson"
+
 	// EnabledStatus represents enable status for a client.
 	EnabledStatus = "enabled"
 
@@ -89,8 +95,20 @@ type MessagePageMetadata struct {
 	To          float64 `json:"to,omitempty"`
 	Aggregation string  `json:"aggregation,omitempty"`
 	Interval    string  `json:"interval,omitempty"`
+	Percentile  float64 `json:"percentile,omitempty"`
+	Fill        string  `json:"fill,omitempty"`
 	Value       float64 `json:"value,omitempty"`
 	Protocol    string  `json:"protocol,omitempty"`
+
+	PayloadFilters []PayloadFilter `json:"-"`
+}
+
+// PayloadFilter represents a condition on a nested field of JSON message
+// payload. Path is dot-separated and Value is JSON encoded when possible.
+type PayloadFilter struct {
+	Path       string
+	Comparator string
+	Value      string
 }
 
 type Operator uint8
@@ -175,6 +193,8 @@ type PageMetadata struct {
 	ChannelID          string    `json:"channel_id,omitempty"`
 	ClientID           string    `json:"client_id,omitempty"`
 	Subtopic           string    `json:"subtopic,omitempty"`
+	Kind               string    `json:"kind,omitempty"`
+	Online             *bool     `json:"online,omitempty"`
 	AssigneeID         string    `json:"assignee_id,omitempty"`
 	Severity           uint8     `json:"severity,omitempty"`
 	UpdatedBy          string    `json:"updated_by,omitempty"`
@@ -658,6 +678,15 @@ type SDK interface {
 	//  fmt.Println(client)
 	UpdateClientSecret(ctx context.Context, id, secret, domainID, token string) (Client, smqerrors.SDKError)
 
+	// RotateClientSecret replaces the client's secret and keeps the replaced
+	// secret valid for the overlap period.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  client, err := sdk.RotateClientSecret(ctx, "clientID", "newSecret", 24*time.Hour, "domainID", "token")
+	//  fmt.Println(client)
+	RotateClientSecret(ctx context.Context, id, secret string, overlap time.Duration, domainID, token string) (Client, smqerrors.SDKError)
+
 	// EnableClient changes client status to enabled.
 	//
 	// example:
@@ -839,6 +868,32 @@ type SDK interface {
 	//  fmt.Println(members)
 	ListClientMembers(ctx context.Context, clientID, domainID string, pm PageMetadata, token string) (EntityMembersPage, smqerrors.SDKError)
 
+	// ImportClients starts the bulk import of the clients in the CSV or
+	// NDJSON data. In dry-run mode the clients are only validated.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  data, _ := os.ReadFile("clients.csv")
+	//  job, _ := sdk.ImportClients(ctx, data, sdk.CSVFormat, false, "domainID", "token")
+	//  fmt.Println(job)
+	ImportClients(ctx context.Context, data []byte, format string, dryRun bool, domainID, token string) (ImportJob, smqerrors.SDKError)
+
+	// ViewImportJob returns the status and the progress of the clients import.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  job, _ := sdk.ViewImportJob(ctx, "jobID", "domainID", "token")
+	//  fmt.Println(job)
+	ViewImportJob(ctx context.Context, id, domainID, token string) (ImportJob, smqerrors.SDKError)
+
+	// ExportClients exports the clients of the domain in CSV or NDJSON format.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  data, _ := sdk.ExportClients(ctx, sdk.NDJSONFormat, "domainID", "token")
+	//  fmt.Println(string(data))
+	ExportClients(ctx context.Context, format, domainID, token string) ([]byte, smqerrors.SDKError)
+
 	// CreateGroup creates new group and returns its id.
 	//
 	// example:
@@ -1217,6 +1272,26 @@ type SDK interface {
 	//  fmt.Println(channel)
 	UpdateChannelTags(ctx context.Context, c Channel, domainID, token string) (Channel, smqerrors.SDKError)
 
+	// SetChannelRetention sets the message retention policy of the channel.
+	// The policy is stored in the channel metadata, and the writers remove
+	// the channel messages older than the retention period.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  policy := sdk.RetentionPolicy{Period: "30d"}
+	//  channel, _ := sdk.SetChannelRetention(ctx, "channelID", policy, "domainID", "token")
+	//  fmt.Println(channel)
+	SetChannelRetention(ctx context.Context, id string, policy RetentionPolicy, domainID, token string) (Channel, smqerrors.SDKError)
+
+	// RemoveChannelRetention removes the message retention policy of the
+	// channel, so that the domain or the writer default policy applies.
+	//
+	// example:
+	//  ctx := context.Background()
+	//  channel, _ := sdk.RemoveChannelRetention(ctx, "channelID", "domainID", "token")
+	//  fmt.Println(channel)
+	RemoveChannelRetention(ctx context.Context, id, domainID, token string) (Channel, smqerrors.SDKError)
+
 	// EnableChannel changes channel status to enabled.
 	//
 	// example:
@@ -1700,6 +1775,47 @@ type SDK interface {
 	// DeleteAlarm deletes an alarm.
 	DeleteAlarm(ctx context.Context, id, domainID, token string) smqerrors.SDKError
 
+	// SendCommand sends a command to the client over the channel.
+	//
+	// example:
+	//  cmd := sdk.Command{
+	//    ChannelID: "channelID",
+	//    ClientID:  "clientID",
+	//    Name:      "reboot",
+	//    Payload:   json.RawMessage(`{"delay":5}`),
+	//    Timeout:   60,
+	//  }
+	//  cmd, _ := sdk.SendCommand(context.Background(), cmd, "domainID", "token")
+	//  fmt.Println(cmd)
+	SendCommand(ctx context.Context, cmd Command, domainID, token string) (Command, smqerrors.SDKError)
+
+	// ViewCommand retrieves a command by its ID.
+	ViewCommand(ctx context.Context, id, domainID, token string) (Command, smqerrors.SDKError)
+
+	// ListCommands retrieves a page of commands.
+	ListCommands(ctx context.Context, pm PageMetadata, domainID, token string) (CommandsPage, smqerrors.SDKError)
+
+	// UpdateTwinDesired applies the patch to the client desired state. The
+	// state keys set to nil are removed from the desired state.
+	//
+	// example:
+	//  upd := sdk.TwinUpdate{
+	//    ChannelID: "channelID",
+	//    State:     map[string]any{"fan": "on", "speed": 3},
+	//  }
+	//  twin, _ := sdk.UpdateTwinDesired(context.Background(), "clientID", upd, "domainID", "token")
+	//  fmt.Println(twin.Delta)
+	UpdateTwinDesired(ctx context.Context, clientID string, upd TwinUpdate, domainID, token string) (Twin, smqerrors.SDKError)
+
+	// ViewTwin retrieves the client twin.
+	ViewTwin(ctx context.Context, clientID, domainID, token string) (Twin, smqerrors.SDKError)
+
+	// ListTwinRevisions retrieves a page of the client twin revisions.
+	ListTwinRevisions(ctx context.Context, clientID string, pm PageMetadata, domainID, token string) (TwinRevisionsPage, smqerrors.SDKError)
+
+	// RemoveTwin removes the client twin and its revisions.
+	RemoveTwin(ctx context.Context, clientID, domainID, token string) smqerrors.SDKError
+
 	// AddReportConfig creates a new report configuration.
 	AddReportConfig(ctx context.Context, cfg ReportConfig, domainID, token string) (ReportConfig, smqerrors.SDKError)
 
@@ -1763,6 +1879,15 @@ type SDK interface {
 	// DisableRule disables a rule.
 	DisableRule(ctx context.Context, id, domainID, token string) (Rule, smqerrors.SDKError)
 
+	// ReplayRule re-runs a rule over the messages stored in the message broker,
+	// starting from the given time or sequence.
+	//
+	// example:
+	//  rp := sdk.RuleReplay{StartTime: time.Now().Add(-24 * time.Hour)}
+	//  replay, _ := sdk.ReplayRule(context.Background(), "ruleID", rp, "domainID", "token")
+	//  fmt.Println(replay)
+	ReplayRule(ctx context.Context, id string, rp RuleReplay, domainID, token string) (RuleReplay, smqerrors.SDKError)
+
 	// IssueCert issues a certificate for an entity.
 	//
 	// example:
@@ -1867,6 +1992,8 @@ type mgSDK struct {
 	bootstrapURL   string
 	readersURL     string
 	alarmsURL      string
+	commandsURL    string
+	twinsURL       string
 	reportsURL     string
 	rulesEngineURL string
 
@@ -1890,6 +2017,8 @@ type Config struct {
 	BootstrapURL   string
 	ReaderURL      string
 	AlarmsURL      string
+	CommandsURL    string
+	TwinsURL       string
 	ReportsURL     string
 	RulesEngineURL string
 
@@ -1914,6 +2043,8 @@ func NewSDK(conf Config) SDK {
 		bootstrapURL:   conf.BootstrapURL,
 		readersURL:     conf.ReaderURL,
 		alarmsURL:      conf.AlarmsURL,
+		commandsURL:    conf.CommandsURL,
+		twinsURL:       conf.TwinsURL,
 		reportsURL:     conf.ReportsURL,
 		rulesEngineURL: conf.RulesEngineURL,
 
@@ -1945,7 +2076,7 @@ func (sdk mgSDK) processRequest(ctx context.Context, method, reqUrl, token strin
 	req.Header.Add("Content-Type", string(CTJSON))
 
 	for key, value := range headers {
-		req.Header.Add(key, value)
+		req.Header.Set(key, value)
 	}
 
 	if token != "" {
@@ -2122,6 +2253,21 @@ func (pm PageMetadata) query() (string, error) {
 	if pm.Operation != "" {
 		q.Add("operation", pm.Operation)
 	}
+	if pm.ChannelID != "" {
+		q.Add("channel_id", pm.ChannelID)
+	}
+	if pm.ClientID != "" {
+		q.Add("client_id", pm.ClientID)
+	}
+	if pm.RuleID != "" {
+		q.Add("rule_id", pm.RuleID)
+	}
+	if pm.Kind != "" {
+		q.Add("kind", pm.Kind)
+	}
+	if pm.Online != nil {
+		q.Add("online", strconv.FormatBool(*pm.Online))
+	}
 	if pm.From != 0 {
 		q.Add("from", strconv.FormatInt(pm.From, 10))
 	}
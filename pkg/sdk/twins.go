@@ -0,0 +1,127 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+const (
+	twinsEndpoint     = "twins"
+	desiredEndpoint   = "desired"
+	revisionsEndpoint = "revisions"
+)
+
+// Twin is the desired and the reported state of the client.
+type Twin struct {
+	ClientID  string         `json:"client_id,omitempty"`
+	DomainID  string         `json:"domain_id,omitempty"`
+	ChannelID string         `json:"channel_id,omitempty"`
+	Desired   map[string]any `json:"desired,omitempty"`
+	Reported  map[string]any `json:"reported,omitempty"`
+	Delta     map[string]any `json:"delta,omitempty"`
+	Version   uint64         `json:"version,omitempty"`
+	CreatedAt time.Time      `json:"created_at,omitempty"`
+	UpdatedAt time.Time      `json:"updated_at,omitempty"`
+	UpdatedBy string         `json:"updated_by,omitempty"`
+}
+
+// TwinUpdate is the patch of the client desired state. The channel is
+// required for the client without the twin. The update is rejected if the
+// version is set and the twin was updated in the meantime.
+type TwinUpdate struct {
+	ChannelID string         `json:"channel_id,omitempty"`
+	State     map[string]any `json:"state"`
+	Version   uint64         `json:"version,omitempty"`
+}
+
+// TwinRevision is a single update of the desired or the reported state.
+type TwinRevision struct {
+	ClientID  string         `json:"client_id,omitempty"`
+	DomainID  string         `json:"domain_id,omitempty"`
+	Version   uint64         `json:"version,omitempty"`
+	Kind      string         `json:"kind,omitempty"`
+	Patch     map[string]any `json:"patch,omitempty"`
+	State     map[string]any `json:"state,omitempty"`
+	CreatedAt time.Time      `json:"created_at,omitempty"`
+	CreatedBy string         `json:"created_by,omitempty"`
+}
+
+type TwinRevisionsPage struct {
+	Offset    uint64         `json:"offset"`
+	Limit     uint64         `json:"limit"`
+	Total     uint64         `json:"total"`
+	Revisions []TwinRevision `json:"revisions"`
+}
+
+func (sdk mgSDK) UpdateTwinDesired(ctx context.Context, clientID string, upd TwinUpdate, domainID, token string) (Twin, errors.SDKError) {
+	data, err := json.Marshal(upd)
+	if err != nil {
+		return Twin{}, errors.NewSDKError(err)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s/%s", sdk.twinsURL, domainID, twinsEndpoint, clientID, desiredEndpoint)
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodPatch, url, token, data, nil, http.StatusOK)
+	if sdkerr != nil {
+		return Twin{}, sdkerr
+	}
+
+	var t Twin
+	if err := json.Unmarshal(body, &t); err != nil {
+		return Twin{}, errors.NewSDKError(err)
+	}
+
+	return t, nil
+}
+
+func (sdk mgSDK) ViewTwin(ctx context.Context, clientID, domainID, token string) (Twin, errors.SDKError) {
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.twinsURL, domainID, twinsEndpoint, clientID)
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkerr != nil {
+		return Twin{}, sdkerr
+	}
+
+	var t Twin
+	if err := json.Unmarshal(body, &t); err != nil {
+		return Twin{}, errors.NewSDKError(err)
+	}
+
+	return t, nil
+}
+
+func (sdk mgSDK) ListTwinRevisions(ctx context.Context, clientID string, pm PageMetadata, domainID, token string) (TwinRevisionsPage, errors.SDKError) {
+	endpoint := fmt.Sprintf("%s/%s/%s/%s", domainID, twinsEndpoint, clientID, revisionsEndpoint)
+	url, err := sdk.withQueryParams(sdk.twinsURL, endpoint, pm)
+	if err != nil {
+		return TwinRevisionsPage{}, errors.NewSDKError(err)
+	}
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkerr != nil {
+		return TwinRevisionsPage{}, sdkerr
+	}
+
+	var rp TwinRevisionsPage
+	if err := json.Unmarshal(body, &rp); err != nil {
+		return TwinRevisionsPage{}, errors.NewSDKError(err)
+	}
+
+	return rp, nil
+}
+
+func (sdk mgSDK) RemoveTwin(ctx context.Context, clientID, domainID, token string) errors.SDKError {
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.twinsURL, domainID, twinsEndpoint, clientID)
+
+	_, _, sdkerr := sdk.processRequest(ctx, http.MethodDelete, url, token, nil, nil, http.StatusNoContent)
+
+	return sdkerr
+}
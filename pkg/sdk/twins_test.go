@@ -0,0 +1,309 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mglog "github.com/absmach/magistrala/logger"
+	smqauthn "github.com/absmach/magistrala/pkg/authn"
+	authnmocks "github.com/absmach/magistrala/pkg/authn/mocks"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/sdk"
+	"github.com/absmach/magistrala/pkg/uuid"
+	"github.com/absmach/magistrala/twins"
+	twinsapi "github.com/absmach/magistrala/twins/api"
+	twinsmocks "github.com/absmach/magistrala/twins/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const twinClientID = "client-1"
+
+var svcTwin = twins.Twin{
+	ClientID:  twinClientID,
+	DomainID:  domainID,
+	ChannelID: "chan-1",
+	Desired:   twins.State{"fan": "on", "speed": float64(5)},
+	Reported:  twins.State{"fan": "on", "speed": float64(3)},
+	Delta:     twins.State{"speed": float64(5)},
+	Version:   3,
+	CreatedAt: time.Now().UTC(),
+}
+
+func setupTwins() (*httptest.Server, *twinsmocks.Service, *authnmocks.Authentication) {
+	tsvc := new(twinsmocks.Service)
+	logger := mglog.NewMock()
+	authn := new(authnmocks.Authentication)
+	am := smqauthn.NewAuthNMiddleware(authn, smqauthn.WithAllowUnverifiedUser(true))
+	idp := uuid.NewMock()
+	mux := twinsapi.MakeHandler(tsvc, logger, idp, "", am)
+	return httptest.NewServer(mux), tsvc, authn
+}
+
+func TestUpdateTwinDesired(t *testing.T) {
+	ts, tsvc, auth := setupTwins()
+	defer ts.Close()
+
+	conf := sdk.Config{
+		TwinsURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	upd := sdk.TwinUpdate{
+		ChannelID: "chan-1",
+		State:     map[string]any{"speed": 5},
+		Version:   2,
+	}
+
+	cases := []struct {
+		desc            string
+		upd             sdk.TwinUpdate
+		token           string
+		session         smqauthn.Session
+		svcRes          twins.Twin
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:   "update desired state successfully",
+			upd:    upd,
+			token:  validToken,
+			svcRes: svcTwin,
+		},
+		{
+			desc:    "update desired state with empty token",
+			upd:     upd,
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "update desired state without state",
+			upd:     sdk.TwinUpdate{ChannelID: "chan-1"},
+			token:   validToken,
+			wantErr: true,
+		},
+		{
+			desc:    "update desired state with version conflict",
+			upd:     upd,
+			token:   validToken,
+			svcErr:  errors.Wrap(svcerr.ErrConflict, twins.ErrVersionConflict),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := tsvc.On("UpdateDesired", mock.Anything, tc.session, twinClientID, mock.Anything).Return(tc.svcRes, tc.svcErr)
+			result, err := mgsdk.UpdateTwinDesired(context.Background(), twinClientID, tc.upd, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, svcTwin.Version, result.Version)
+				assert.Equal(t, map[string]any(svcTwin.Delta), result.Delta)
+				tsvc.AssertCalled(t, "UpdateDesired", mock.Anything, tc.session, twinClientID, twins.DesiredUpdate{
+					ChannelID: tc.upd.ChannelID,
+					State:     twins.State{"speed": float64(5)},
+					Version:   tc.upd.Version,
+				})
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestViewTwin(t *testing.T) {
+	ts, tsvc, auth := setupTwins()
+	defer ts.Close()
+
+	conf := sdk.Config{
+		TwinsURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	cases := []struct {
+		desc            string
+		clientID        string
+		token           string
+		session         smqauthn.Session
+		svcRes          twins.Twin
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:     "view twin successfully",
+			clientID: twinClientID,
+			token:    validToken,
+			svcRes:   svcTwin,
+		},
+		{
+			desc:     "view twin with empty token",
+			clientID: twinClientID,
+			token:    "",
+			wantErr:  true,
+		},
+		{
+			desc:     "view non-existent twin",
+			clientID: "non-existent",
+			token:    validToken,
+			svcErr:   svcerr.ErrNotFound,
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := tsvc.On("ViewTwin", mock.Anything, tc.session, tc.clientID).Return(tc.svcRes, tc.svcErr)
+			result, err := mgsdk.ViewTwin(context.Background(), tc.clientID, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, tc.clientID, result.ClientID)
+				assert.Equal(t, map[string]any(svcTwin.Desired), result.Desired)
+				assert.Equal(t, map[string]any(svcTwin.Reported), result.Reported)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestListTwinRevisions(t *testing.T) {
+	ts, tsvc, auth := setupTwins()
+	defer ts.Close()
+
+	conf := sdk.Config{
+		TwinsURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	cases := []struct {
+		desc            string
+		pm              sdk.PageMetadata
+		token           string
+		session         smqauthn.Session
+		svcRes          twins.RevisionsPage
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:  "list twin revisions successfully",
+			pm:    sdk.PageMetadata{Limit: 10, Kind: twins.Reported},
+			token: validToken,
+			svcRes: twins.RevisionsPage{
+				Total: 1,
+				Limit: 10,
+				Revisions: []twins.Revision{{
+					ClientID: twinClientID,
+					DomainID: domainID,
+					Version:  3,
+					Kind:     twins.ReportedKind,
+					Patch:    twins.State{"speed": float64(3)},
+					State:    svcTwin.Reported,
+				}},
+			},
+		},
+		{
+			desc:    "list twin revisions with empty token",
+			pm:      sdk.PageMetadata{Limit: 10},
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "list twin revisions with invalid kind",
+			pm:      sdk.PageMetadata{Limit: 10, Kind: "invalid"},
+			token:   validToken,
+			wantErr: true,
+		},
+		{
+			desc:    "list twin revisions with service error",
+			pm:      sdk.PageMetadata{Limit: 10},
+			token:   validToken,
+			svcErr:  errors.New("failed"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := tsvc.On("ListRevisions", mock.Anything, tc.session, twinClientID, mock.Anything).Return(tc.svcRes, tc.svcErr)
+			result, err := mgsdk.ListTwinRevisions(context.Background(), twinClientID, tc.pm, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, tc.svcRes.Total, result.Total)
+				assert.Len(t, result.Revisions, len(tc.svcRes.Revisions))
+				assert.Equal(t, twins.Reported, result.Revisions[0].Kind)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRemoveTwin(t *testing.T) {
+	ts, tsvc, auth := setupTwins()
+	defer ts.Close()
+
+	conf := sdk.Config{
+		TwinsURL: ts.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	cases := []struct {
+		desc            string
+		token           string
+		session         smqauthn.Session
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:  "remove twin successfully",
+			token: validToken,
+		},
+		{
+			desc:    "remove twin with empty token",
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "remove twin with service error",
+			token:   validToken,
+			svcErr:  svcerr.ErrRemoveEntity,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := tsvc.On("RemoveTwin", mock.Anything, tc.session, twinClientID).Return(tc.svcErr)
+			err := mgsdk.RemoveTwin(context.Background(), twinClientID, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
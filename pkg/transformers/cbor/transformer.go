@@ -0,0 +1,38 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cbor
+
+import (
+	"reflect"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/transformers"
+	"github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/fxamacker/cbor/v2"
+)
+
+// ContentType represents plain CBOR content type.
+const ContentType = "application/cbor"
+
+// CBOR maps are decoded to string keyed maps so that the result is the same
+// as for JSON payloads. Maps with non-string keys are rejected.
+var decMode, _ = cbor.DecOptions{
+	DefaultMapType: reflect.TypeOf(map[string]any(nil)),
+}.DecMode()
+
+// New returns a new CBOR transformer. CBOR payloads are transformed to JSON
+// messages in the same way as JSON payloads, so they can be stored and read
+// by the JSON writers and readers.
+func New(tfs []json.TimeField) transformers.Transformer {
+	return json.NewWithDecoder(tfs, decode)
+}
+
+func decode(msg *messaging.Message) (any, error) {
+	var payload any
+	if err := decMode.Unmarshal(msg.GetPayload(), &payload); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cbor_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/transformers/cbor"
+	"github.com/absmach/magistrala/pkg/transformers/json"
+	fxcbor "github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransformCBOR(t *testing.T) {
+	now := time.Now().Unix()
+	tr := cbor.New([]json.TimeField{
+		{
+			FieldName:   "ts",
+			FieldFormat: "unix",
+		},
+	})
+
+	encode := func(v any) []byte {
+		data, err := fxcbor.Marshal(v)
+		require.Nil(t, err, fmt.Sprintf("marshal expected to succeed: %s", err))
+		return data
+	}
+	message := func(payload []byte, subtopic string) *messaging.Message {
+		return &messaging.Message{
+			Channel:   "channel-1",
+			Subtopic:  subtopic,
+			Publisher: "publisher-1",
+			Protocol:  "protocol",
+			Payload:   payload,
+			Created:   now,
+		}
+	}
+	expected := func(created int64, payloads ...map[string]any) json.Messages {
+		msgs := json.Messages{Format: "subtopic-1"}
+		for _, p := range payloads {
+			msgs.Data = append(msgs.Data, json.Message{
+				Channel:   "channel-1",
+				Subtopic:  "subtopic-1",
+				Publisher: "publisher-1",
+				Protocol:  "protocol",
+				Created:   created,
+				Payload:   p,
+			})
+		}
+		return msgs
+	}
+
+	payload := map[string]any{"key1": "val1", "key2": 123, "key3": map[string]any{"key4": -1.5}}
+	decoded := map[string]any{"key1": "val1", "key2": uint64(123), "key3": map[string]any{"key4": -1.5}}
+
+	cases := []struct {
+		desc string
+		msg  *messaging.Message
+		json any
+		err  error
+	}{
+		{
+			desc: "transform CBOR map",
+			msg:  message(encode(payload), "subtopic-1"),
+			json: expected(now, decoded),
+		},
+		{
+			desc: "transform CBOR array",
+			msg:  message(encode([]any{payload, payload}), "subtopic-1"),
+			json: expected(now, decoded, decoded),
+		},
+		{
+			desc: "transform CBOR with timestamp transformation",
+			msg:  message(encode(map[string]any{"ts": 1638310819, "key1": "val1"}), "subtopic-1"),
+			json: expected(1638310819000000000, map[string]any{"ts": uint64(1638310819), "key1": "val1"}),
+		},
+		{
+			desc: "transform CBOR with non-string keys",
+			msg:  message(encode(map[int]any{1: "val1"}), "subtopic-1"),
+			err:  json.ErrTransform,
+		},
+		{
+			desc: "transform CBOR with invalid payload",
+			msg:  message([]byte{0xa1, 0x61}, "subtopic-1"),
+			err:  json.ErrTransform,
+		},
+		{
+			desc: "transform CBOR scalar",
+			msg:  message(encode(1), "subtopic-1"),
+			err:  json.ErrTransform,
+		},
+		{
+			desc: "transform CBOR without subtopic",
+			msg:  message(encode(payload), ""),
+			err:  json.ErrTransform,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			m, err := tr.Transform(tc.msg)
+			assert.Equal(t, tc.json, m)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s, got %s", tc.err, err))
+		})
+	}
+}
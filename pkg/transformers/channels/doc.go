@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package channels contains per-channel transformer configuration, read
+// from the channel metadata.
+package channels
@@ -0,0 +1,93 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package channels
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/store"
+)
+
+const (
+	stream = "events.magistrala.channel.*"
+
+	payloadReject = "channel.payload_reject"
+	rejectStream  = "magistrala." + payloadReject
+)
+
+var operations = map[string]bool{
+	"channel.create":      true,
+	"channel.update":      true,
+	"channel.update_tags": true,
+	"channel.remove":      true,
+}
+
+var _ events.Event = (*rejectEvent)(nil)
+
+type rejectEvent struct {
+	reject
+	rejected uint64
+	err      string
+}
+
+func (re rejectEvent) Encode() (map[string]any, error) {
+	val := map[string]any{
+		"operation": payloadReject,
+		"id":        re.channel,
+		"domain":    re.domain,
+		"publisher": re.publisher,
+		"protocol":  re.protocol,
+		"rejected":  re.rejected,
+		"error":     re.err,
+	}
+	if re.subtopic != "" {
+		val["subtopic"] = re.subtopic
+	}
+
+	return val, nil
+}
+
+type eventHandler struct {
+	registry *Registry
+}
+
+// Subscribe reloads the channel transformers on channel events. Each writer
+// instance needs all the events, so the consumer name must be unique per
+// instance.
+func Subscribe(ctx context.Context, registry *Registry, esURL, esConsumerName string, logger *slog.Logger) error {
+	subscriber, err := store.NewSubscriber(ctx, esURL, "transformers-es-sub", logger)
+	if err != nil {
+		return err
+	}
+
+	subConfig := events.SubscriberConfig{
+		Stream:   stream,
+		Consumer: esConsumerName,
+		Handler:  NewEventHandler(registry),
+	}
+	return subscriber.Subscribe(ctx, subConfig)
+}
+
+// NewEventHandler returns an event handler which invalidates the transformers
+// of the created, updated and removed channels.
+func NewEventHandler(registry *Registry) events.EventHandler {
+	return &eventHandler{registry: registry}
+}
+
+func (eh *eventHandler) Handle(_ context.Context, event events.Event) error {
+	msg, err := event.Encode()
+	if err != nil {
+		return err
+	}
+
+	op, _ := msg["operation"].(string)
+	id, _ := msg["id"].(string)
+	if operations[op] && id != "" {
+		eh.registry.Invalidate(id)
+	}
+
+	return nil
+}
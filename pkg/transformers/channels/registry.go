@@ -0,0 +1,244 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package channels
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
+	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/transformers"
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	loadTimeout    = 5 * time.Second
+	publishTimeout = 5 * time.Second
+)
+
+var (
+	errLoadSettings  = errors.New("failed to load channel transformer settings")
+	errPublishReject = errors.New("failed to publish payload reject event")
+)
+
+// entry holds the transformer and the payload schema of a channel. A nil
+// transformer means the channel has no valid transformer settings, and a
+// nil schema that the channel payloads are not validated.
+type entry struct {
+	transformer transformers.Transformer
+	schema      *Schema
+}
+
+// reject identifies the rejected messages of a channel publisher.
+type reject struct {
+	domain    string
+	channel   string
+	publisher string
+	protocol  string
+	subtopic  string
+}
+
+type rejectCount struct {
+	count uint64
+	err   string
+}
+
+// Registry holds the transformers and payload schemas of the channels. The
+// settings of a channel are read from its metadata when the first message of
+// the channel is transformed, and read again after the channel is updated.
+//
+// Messages which do not match the channel payload schema are rejected. The
+// rejects are counted and reported periodically to the event store, so that
+// they are recorded in the channel journal.
+type Registry struct {
+	channels  grpcChannelsV1.ChannelsServiceClient
+	publisher events.Publisher
+	rejects   metrics.Counter
+	logger    *slog.Logger
+
+	mu       sync.RWMutex
+	entries  map[string]entry
+	rejected map[reject]rejectCount
+}
+
+// NewRegistry returns a registry which reads channel metadata using the
+// channels service client and publishes payload rejects using publisher.
+func NewRegistry(channels grpcChannelsV1.ChannelsServiceClient, publisher events.Publisher, rejects metrics.Counter, logger *slog.Logger) *Registry {
+	return &Registry{
+		channels:  channels,
+		publisher: publisher,
+		rejects:   rejects,
+		logger:    logger,
+		entries:   make(map[string]entry),
+		rejected:  make(map[reject]rejectCount),
+	}
+}
+
+// Start reports the rejects every interval until ctx is done.
+func (r *Registry) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				r.Report(context.Background()) //nolint:contextcheck // The remaining rejects are reported after ctx is done.
+				return
+			case <-ticker.C:
+				r.Report(ctx)
+			}
+		}
+	}()
+}
+
+// Report publishes the rejects since the last report, one event per
+// channel publisher with the number of rejected messages and the last
+// validation error.
+func (r *Registry) Report(ctx context.Context) {
+	r.mu.Lock()
+	rejected := r.rejected
+	r.rejected = make(map[reject]rejectCount)
+	r.mu.Unlock()
+
+	if r.publisher == nil {
+		return
+	}
+	for rj, c := range rejected {
+		pctx, cancel := context.WithTimeout(ctx, publishTimeout)
+		event := rejectEvent{
+			reject:   rj,
+			rejected: c.count,
+			err:      c.err,
+		}
+		if err := r.publisher.Publish(pctx, rejectStream, event); err != nil {
+			r.logger.Warn(fmt.Sprintf("%s of channel %s: %s", errPublishReject, rj.channel, err))
+		}
+		cancel()
+	}
+}
+
+// Transformer returns a transformer which transforms messages using the
+// transformer of the message channel, or using fallback if the channel has
+// no transformer settings. The transformed messages are validated against
+// the channel payload schema.
+func (r *Registry) Transformer(fallback transformers.Transformer) transformers.Transformer {
+	return &channelTransformer{
+		registry: r,
+		fallback: fallback,
+	}
+}
+
+// Invalidate removes the channel transformer and payload schema, so that
+// the channel settings are read again.
+func (r *Registry) Invalidate(channel string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.entries, channel)
+}
+
+func (r *Registry) entry(channel string) (entry, error) {
+	r.mu.RLock()
+	e, ok := r.entries[channel]
+	r.mu.RUnlock()
+	if ok {
+		return e, nil
+	}
+
+	e, err := r.load(channel)
+	if err != nil {
+		return entry{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[channel] = e
+
+	return e, nil
+}
+
+func (r *Registry) load(channel string) (entry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), loadTimeout)
+	defer cancel()
+
+	res, err := r.channels.RetrieveEntity(ctx, &grpcCommonV1.RetrieveEntityReq{Id: channel})
+	if err != nil {
+		return entry{}, errors.Wrap(errLoadSettings, err)
+	}
+	metadata := res.GetEntity().GetMetadata()
+
+	var e entry
+	switch s, ok, err := ParseSettings(metadata); {
+	case err != nil:
+		r.logger.Warn(fmt.Sprintf("Using default transformer for channel %s: %s", channel, err))
+	case ok:
+		if e.transformer, err = s.Transformer(channel); err != nil {
+			r.logger.Warn(fmt.Sprintf("Using default transformer for channel %s: %s", channel, err))
+		}
+	}
+	if e.schema, err = ParseSchema(metadata); err != nil {
+		r.logger.Warn(fmt.Sprintf("Payloads of channel %s are not validated: %s", channel, err))
+	}
+
+	return e, nil
+}
+
+func (r *Registry) reject(msg *messaging.Message, err error) {
+	r.rejects.Add(1)
+	r.logger.Warn(fmt.Sprintf("Rejected message of channel %s from %s: %s", msg.GetChannel(), msg.GetPublisher(), err))
+
+	rj := reject{
+		domain:    msg.GetDomain(),
+		channel:   msg.GetChannel(),
+		publisher: msg.GetPublisher(),
+		protocol:  msg.GetProtocol(),
+		subtopic:  msg.GetSubtopic(),
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := r.rejected[rj]
+	c.count++
+	c.err = err.Error()
+	r.rejected[rj] = c
+}
+
+type channelTransformer struct {
+	registry *Registry
+	fallback transformers.Transformer
+}
+
+func (ct *channelTransformer) Transform(msg *messaging.Message) (any, error) {
+	e, err := ct.registry.entry(msg.GetChannel())
+	if err != nil {
+		return nil, err
+	}
+
+	t := e.transformer
+	if t == nil {
+		t = ct.fallback
+	}
+	var res any = msg
+	if t != nil {
+		if res, err = t.Transform(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	if e.schema != nil {
+		if err := e.schema.Validate(msg, res); err != nil {
+			ct.registry.reject(msg, err)
+			// Rejected messages are terminated instead of being redelivered.
+			return nil, messaging.NewError(err, messaging.Term)
+		}
+	}
+
+	return res, nil
+}
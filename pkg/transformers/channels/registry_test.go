@@ -0,0 +1,155 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package channels_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
+	chmocks "github.com/absmach/magistrala/channels/mocks"
+	"github.com/absmach/magistrala/internal/testsutil"
+	mglog "github.com/absmach/magistrala/logger"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/transformers/channels"
+	"github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const jsonSettings = `{"transformer":{"format":"json"}}`
+
+type fallback struct{}
+
+func (fallback) Transform(_ *messaging.Message) (any, error) {
+	return "fallback", nil
+}
+
+type event map[string]any
+
+func (e event) Encode() (map[string]any, error) {
+	return e, nil
+}
+
+func entity(metadata string) *grpcCommonV1.RetrieveEntityRes {
+	return &grpcCommonV1.RetrieveEntityRes{Entity: &grpcCommonV1.EntityBasic{Metadata: []byte(metadata)}}
+}
+
+func TestTransform(t *testing.T) {
+	cases := []struct {
+		desc     string
+		metadata string
+		loadErr  error
+		res      any
+		err      error
+	}{
+		{
+			desc:     "transform message of channel with settings",
+			metadata: jsonSettings,
+			res: json.Messages{
+				Data:   []json.Message{{Subtopic: "format", Payload: map[string]any{"temp": 21.5}}},
+				Format: "format",
+			},
+		},
+		{
+			desc:     "transform message of channel without settings",
+			metadata: `{"location":"lab"}`,
+			res:      "fallback",
+		},
+		{
+			desc:     "transform message of channel with invalid settings",
+			metadata: `{"transformer":{"format":"xml"}}`,
+			res:      "fallback",
+		},
+		{
+			desc:    "transform message with failed settings load",
+			loadErr: errors.New("unavailable"),
+			err:     errors.New("unavailable"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			client := new(chmocks.ChannelsServiceClient)
+			channel := testsutil.GenerateUUID(t)
+			call := client.On("RetrieveEntity", mock.Anything, &grpcCommonV1.RetrieveEntityReq{Id: channel}).Return(entity(tc.metadata), tc.loadErr)
+			tr := channels.NewRegistry(client, nil, generic.NewCounter("rejects"), mglog.NewMock()).Transformer(fallback{})
+
+			msg := &messaging.Message{Channel: channel, Subtopic: "format", Payload: []byte(`{"temp":21.5}`)}
+			for range 2 {
+				res, err := tr.Transform(msg)
+				if tc.err != nil {
+					assert.ErrorContains(t, err, tc.err.Error())
+					continue
+				}
+				assert.Nil(t, err, fmt.Sprintf("transform expected to succeed: %s", err))
+				if msgs, ok := tc.res.(json.Messages); ok {
+					msgs.Data[0].Channel = channel
+				}
+				assert.Equal(t, tc.res, res)
+			}
+			// Settings are loaded once, unless loading failed.
+			calls := 1
+			if tc.loadErr != nil {
+				calls = 2
+			}
+			client.AssertNumberOfCalls(t, "RetrieveEntity", calls)
+			call.Unset()
+		})
+	}
+}
+
+func TestEventHandler(t *testing.T) {
+	channel := testsutil.GenerateUUID(t)
+	client := new(chmocks.ChannelsServiceClient)
+	call := client.On("RetrieveEntity", mock.Anything, &grpcCommonV1.RetrieveEntityReq{Id: channel}).Return(entity(jsonSettings), nil)
+	defer call.Unset()
+
+	registry := channels.NewRegistry(client, nil, generic.NewCounter("rejects"), mglog.NewMock())
+	tr := registry.Transformer(fallback{})
+	handler := channels.NewEventHandler(registry)
+	msg := &messaging.Message{Channel: channel, Subtopic: "format", Payload: []byte(`{"temp":21.5}`)}
+
+	cases := []struct {
+		desc  string
+		event event
+		calls int
+	}{
+		{
+			desc:  "handle channel update event",
+			event: event{"operation": "channel.update", "id": channel},
+			calls: 2,
+		},
+		{
+			desc:  "handle channel remove event",
+			event: event{"operation": "channel.remove", "id": channel},
+			calls: 3,
+		},
+		{
+			desc:  "handle other channel update event",
+			event: event{"operation": "channel.update", "id": testsutil.GenerateUUID(t)},
+			calls: 3,
+		},
+		{
+			desc:  "handle channel connect event",
+			event: event{"operation": "channel.connect", "id": channel},
+			calls: 3,
+		},
+	}
+
+	_, err := tr.Transform(msg)
+	assert.Nil(t, err, fmt.Sprintf("transform expected to succeed: %s", err))
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := handler.Handle(context.Background(), tc.event)
+			assert.Nil(t, err, fmt.Sprintf("handle expected to succeed: %s", err))
+			_, err = tr.Transform(msg)
+			assert.Nil(t, err, fmt.Sprintf("transform expected to succeed: %s", err))
+			client.AssertNumberOfCalls(t, "RetrieveEntity", tc.calls)
+		})
+	}
+}
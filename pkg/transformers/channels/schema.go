@@ -0,0 +1,113 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package channels
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	mgjson "github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// SchemaKey is the channel metadata key holding the payload JSON Schema.
+const SchemaKey = "payload_schema"
+
+var (
+	// ErrInvalidSchema indicates an invalid payload schema in the channel metadata.
+	ErrInvalidSchema = errors.NewRequestError("invalid channel payload schema")
+	// ErrInvalidPayload indicates a payload which does not match the channel payload schema.
+	ErrInvalidPayload = errors.New("payload does not match channel payload schema")
+)
+
+// Schema represents the JSON Schema of the channel payloads. Messages
+// transformed to JSON messages are validated per message payload, SenML
+// messages per normalized record, using the field names of the stored
+// records such as name, unit and value, and other messages by their raw
+// payload.
+type Schema struct {
+	schema *gojsonschema.Schema
+}
+
+// ParseSchema returns the payload schema found in the JSON encoded channel
+// metadata, or nil if the channel has no payload schema.
+func ParseSchema(metadata []byte) (*Schema, error) {
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+
+	var md map[string]json.RawMessage
+	if err := json.Unmarshal(metadata, &md); err != nil {
+		return nil, errors.Wrap(ErrInvalidSchema, err)
+	}
+	raw, ok := md[SchemaKey]
+	if !ok {
+		return nil, nil
+	}
+
+	s, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(raw))
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidSchema, err)
+	}
+
+	return &Schema{schema: s}, nil
+}
+
+// ValidateSchema validates the payload schema of the channel metadata, so
+// that an invalid schema is rejected when the channel is saved instead of
+// disabling the validation of the channel payloads.
+func ValidateSchema(metadata map[string]any) error {
+	val, ok := metadata[SchemaKey]
+	if !ok {
+		return nil
+	}
+
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(val)); err != nil {
+		return errors.Wrap(ErrInvalidSchema, err)
+	}
+
+	return nil
+}
+
+// Validate validates the result of the message transformation.
+func (s *Schema) Validate(msg *messaging.Message, res any) error {
+	switch r := res.(type) {
+	case mgjson.Messages:
+		for _, m := range r.Data {
+			if err := s.validate(gojsonschema.NewGoLoader(m.Payload)); err != nil {
+				return err
+			}
+		}
+	case []senml.Message:
+		for _, m := range r {
+			if err := s.validate(gojsonschema.NewGoLoader(m)); err != nil {
+				return err
+			}
+		}
+	default:
+		return s.validate(gojsonschema.NewBytesLoader(msg.GetPayload()))
+	}
+
+	return nil
+}
+
+func (s *Schema) validate(doc gojsonschema.JSONLoader) error {
+	res, err := s.schema.Validate(doc)
+	if err != nil {
+		return errors.Wrap(ErrInvalidPayload, err)
+	}
+	if res.Valid() {
+		return nil
+	}
+
+	errs := make([]string, len(res.Errors()))
+	for i, e := range res.Errors() {
+		errs[i] = e.String()
+	}
+
+	return errors.Wrap(ErrInvalidPayload, errors.New(strings.Join(errs, "; ")))
+}
@@ -0,0 +1,206 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package channels_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	grpcCommonV1 "github.com/absmach/magistrala/api/grpc/common/v1"
+	chmocks "github.com/absmach/magistrala/channels/mocks"
+	"github.com/absmach/magistrala/internal/testsutil"
+	mglog "github.com/absmach/magistrala/logger"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/events"
+	evmocks "github.com/absmach/magistrala/pkg/events/mocks"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/transformers/channels"
+	"github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const tempSchema = `{"type":"object","properties":{"temp":{"type":"number","maximum":100}},"required":["temp"]}`
+
+func TestParseSchema(t *testing.T) {
+	cases := []struct {
+		desc     string
+		metadata string
+		schema   bool
+		err      error
+	}{
+		{
+			desc:     "parse schema",
+			metadata: fmt.Sprintf(`{"location":"lab","payload_schema":%s}`, tempSchema),
+			schema:   true,
+		},
+		{
+			desc:     "parse metadata without schema",
+			metadata: `{"location":"lab"}`,
+		},
+		{
+			desc: "parse empty metadata",
+		},
+		{
+			desc:     "parse invalid metadata",
+			metadata: `{"location":`,
+			err:      channels.ErrInvalidSchema,
+		},
+		{
+			desc:     "parse invalid schema",
+			metadata: `{"payload_schema":{"type":"unknown"}}`,
+			err:      channels.ErrInvalidSchema,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			s, err := channels.ParseSchema([]byte(tc.metadata))
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s, got %s", tc.err, err))
+			assert.Equal(t, tc.schema, s != nil)
+		})
+	}
+}
+
+func TestSchemaValidate(t *testing.T) {
+	s, err := channels.ParseSchema(fmt.Appendf(nil, `{"payload_schema":%s}`, tempSchema))
+	require.Nil(t, err, fmt.Sprintf("parse expected to succeed: %s", err))
+	valueSchema, err := channels.ParseSchema([]byte(`{"payload_schema":{"type":"object","required":["value"]}}`))
+	require.Nil(t, err, fmt.Sprintf("parse expected to succeed: %s", err))
+	value := 21.5
+
+	cases := []struct {
+		desc   string
+		schema *channels.Schema
+		msg    *messaging.Message
+		res    any
+		err    error
+	}{
+		{
+			desc:   "validate valid JSON messages",
+			schema: s,
+			res:    json.Messages{Data: []json.Message{{Payload: map[string]any{"temp": 21.5}}, {Payload: map[string]any{"temp": 22}}}},
+		},
+		{
+			desc:   "validate JSON messages with invalid payload",
+			schema: s,
+			res:    json.Messages{Data: []json.Message{{Payload: map[string]any{"temp": 21.5}}, {Payload: map[string]any{"temp": 122}}}},
+			err:    channels.ErrInvalidPayload,
+		},
+		{
+			desc:   "validate valid SenML messages",
+			schema: valueSchema,
+			res:    []senml.Message{{Name: "temp", Value: &value}},
+		},
+		{
+			desc:   "validate SenML messages with invalid record",
+			schema: valueSchema,
+			res:    []senml.Message{{Name: "temp", Value: &value}, {Name: "on", BoolValue: new(bool)}},
+			err:    channels.ErrInvalidPayload,
+		},
+		{
+			desc:   "validate valid raw payload",
+			schema: s,
+			msg:    &messaging.Message{Payload: []byte(`{"temp":21.5}`)},
+		},
+		{
+			desc:   "validate raw payload missing required field",
+			schema: s,
+			msg:    &messaging.Message{Payload: []byte(`{"hum":40}`)},
+			err:    channels.ErrInvalidPayload,
+		},
+		{
+			desc:   "validate invalid JSON raw payload",
+			schema: s,
+			msg:    &messaging.Message{Payload: []byte(`{"temp":`)},
+			err:    channels.ErrInvalidPayload,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := tc.msg
+			if msg == nil {
+				msg = &messaging.Message{}
+			}
+			res := tc.res
+			if res == nil {
+				res = msg
+			}
+			err := tc.schema.Validate(msg, res)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s, got %s", tc.err, err))
+		})
+	}
+}
+
+func TestReject(t *testing.T) {
+	channel := testsutil.GenerateUUID(t)
+	metadata := fmt.Sprintf(`{"transformer":{"format":"json"},"payload_schema":%s}`, tempSchema)
+	client := new(chmocks.ChannelsServiceClient)
+	client.On("RetrieveEntity", mock.Anything, &grpcCommonV1.RetrieveEntityReq{Id: channel}).Return(entity(metadata), nil)
+	pub := new(evmocks.Publisher)
+	var published []map[string]any
+	pub.On("Publish", mock.Anything, "magistrala.channel.payload_reject", mock.Anything).Run(func(args mock.Arguments) {
+		event, _, err := schema.ValidateEvent(args.Get(2).(events.Event))
+		require.Nil(t, err, fmt.Sprintf("reject event expected to match schema: %s", err))
+		published = append(published, event)
+	}).Return(nil)
+	rejects := generic.NewCounter("rejects")
+	registry := channels.NewRegistry(client, pub, rejects, mglog.NewMock())
+	tr := registry.Transformer(fallback{})
+
+	msg := &messaging.Message{
+		Domain:    "domain",
+		Channel:   channel,
+		Publisher: "publisher",
+		Protocol:  "mqtt",
+		Subtopic:  "format",
+		Payload:   []byte(`{"temp":21.5}`),
+	}
+	_, err := tr.Transform(msg)
+	assert.Nil(t, err, fmt.Sprintf("transform expected to succeed: %s", err))
+	assert.Equal(t, float64(0), rejects.Value())
+	registry.Report(context.Background())
+	pub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+
+	msg.Payload = []byte(`{"temp":"hot"}`)
+	for i := 0; i < 2; i++ {
+		res, err := tr.Transform(msg)
+		assert.Nil(t, res)
+		assert.ErrorContains(t, err, channels.ErrInvalidPayload.Error())
+		merr, ok := err.(messaging.Error)
+		require.True(t, ok, "expected messaging error")
+		assert.Equal(t, messaging.Term, merr.Ack())
+	}
+	assert.Equal(t, float64(2), rejects.Value())
+	// Rejects are published when they are reported, not per message.
+	pub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+
+	registry.Report(context.Background())
+	require.Len(t, published, 1, "expected a single reject event")
+	assert.Equal(t, "channel.payload_reject", published[0]["operation"])
+	assert.Equal(t, channel, published[0]["id"])
+	assert.Equal(t, "domain", published[0]["domain"])
+	assert.Equal(t, "publisher", published[0]["publisher"])
+	assert.Equal(t, "format", published[0]["subtopic"])
+	assert.Equal(t, uint64(2), published[0]["rejected"])
+	assert.NotEmpty(t, published[0]["error"])
+
+	// Reported rejects are not reported again.
+	registry.Report(context.Background())
+	assert.Len(t, published, 1, "expected no new reject event")
+
+	// Publisher errors do not change the reject.
+	pub.ExpectedCalls = nil
+	pub.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("unavailable"))
+	_, err = tr.Transform(msg)
+	assert.ErrorContains(t, err, channels.ErrInvalidPayload.Error())
+	assert.Equal(t, float64(3), rejects.Value())
+	registry.Report(context.Background())
+}
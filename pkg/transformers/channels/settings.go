@@ -0,0 +1,126 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package channels
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/transformers"
+	"github.com/absmach/magistrala/pkg/transformers/cbor"
+	mgjson "github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/absmach/magistrala/pkg/transformers/protobuf"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+)
+
+// MetadataKey is the channel metadata key holding the transformer settings.
+const MetadataKey = "transformer"
+
+// ErrInvalidSettings indicates invalid transformer settings in the channel metadata.
+var ErrInvalidSettings = errors.New("invalid channel transformer settings")
+
+var errUnknownFormat = errors.New("unknown transformer format")
+
+// Settings represents the transformer settings of a channel. Format is one
+// of senml, json, cbor or protobuf. ContentType selects the SenML encoding,
+// TimeFields are used by the JSON based formats and Units sets the unit of
+// SenML records with no unit by record name, including the base name.
+type Settings struct {
+	Format      string             `json:"format"`
+	ContentType string             `json:"content_type,omitempty"`
+	TimeFields  []mgjson.TimeField `json:"time_fields,omitempty"`
+	Units       map[string]string  `json:"units,omitempty"`
+	Protobuf    *Protobuf          `json:"protobuf,omitempty"`
+}
+
+// Protobuf represents the message type of the channel protobuf payloads.
+// DescriptorSet is a base64 encoded FileDescriptorSet containing the message
+// type and all its dependencies.
+type Protobuf struct {
+	DescriptorSet []byte `json:"descriptor_set"`
+	Message       string `json:"message"`
+}
+
+// ParseSettings returns the transformer settings found in the JSON encoded
+// channel metadata. It returns false if the channel has no settings.
+func ParseSettings(metadata []byte) (Settings, bool, error) {
+	if len(metadata) == 0 {
+		return Settings{}, false, nil
+	}
+
+	var md map[string]json.RawMessage
+	if err := json.Unmarshal(metadata, &md); err != nil {
+		return Settings{}, false, errors.Wrap(ErrInvalidSettings, err)
+	}
+	raw, ok := md[MetadataKey]
+	if !ok {
+		return Settings{}, false, nil
+	}
+
+	var s Settings
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return Settings{}, false, errors.Wrap(ErrInvalidSettings, err)
+	}
+
+	return s, true, nil
+}
+
+// Transformer returns the transformer described by the settings.
+func (s Settings) Transformer(channel string) (transformers.Transformer, error) {
+	var t transformers.Transformer
+	switch strings.ToLower(s.Format) {
+	case "senml":
+		ct := s.ContentType
+		if ct == "" {
+			ct = senml.JSON
+		}
+		t = senml.New(ct)
+	case "json":
+		t = mgjson.New(s.TimeFields)
+	case "cbor":
+		t = cbor.New(s.TimeFields)
+	case "protobuf":
+		if s.Protobuf == nil {
+			return nil, errors.Wrap(ErrInvalidSettings, protobuf.ErrInvalidDescriptor)
+		}
+		registry := protobuf.NewRegistry()
+		if err := registry.Register(channel, s.Protobuf.DescriptorSet, s.Protobuf.Message); err != nil {
+			return nil, errors.Wrap(ErrInvalidSettings, err)
+		}
+		t = protobuf.New(s.TimeFields, registry)
+	default:
+		return nil, errors.Wrap(ErrInvalidSettings, errUnknownFormat)
+	}
+
+	if len(s.Units) > 0 {
+		t = unitTransformer{transformer: t, units: s.Units}
+	}
+
+	return t, nil
+}
+
+// unitTransformer sets the unit of SenML records which have none.
+type unitTransformer struct {
+	transformer transformers.Transformer
+	units       map[string]string
+}
+
+func (ut unitTransformer) Transform(msg *messaging.Message) (any, error) {
+	res, err := ut.transformer.Transform(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if msgs, ok := res.([]senml.Message); ok {
+		for i := range msgs {
+			if u, ok := ut.units[msgs[i].Name]; ok && msgs[i].Unit == "" {
+				msgs[i].Unit = u
+			}
+		}
+	}
+
+	return res, nil
+}
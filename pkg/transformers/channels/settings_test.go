@@ -0,0 +1,132 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package channels_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/transformers/channels"
+	"github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSettings(t *testing.T) {
+	cases := []struct {
+		desc     string
+		metadata string
+		settings channels.Settings
+		ok       bool
+		err      error
+	}{
+		{
+			desc:     "parse settings",
+			metadata: `{"location":"lab","transformer":{"format":"json","time_fields":[{"field_name":"ts","field_format":"unix"}],"units":{"temp":"Cel"}}}`,
+			settings: channels.Settings{
+				Format:     "json",
+				TimeFields: []json.TimeField{{FieldName: "ts", FieldFormat: "unix"}},
+				Units:      map[string]string{"temp": "Cel"},
+			},
+			ok: true,
+		},
+		{
+			desc:     "parse metadata without settings",
+			metadata: `{"location":"lab"}`,
+		},
+		{
+			desc: "parse empty metadata",
+		},
+		{
+			desc:     "parse invalid metadata",
+			metadata: `{"location":`,
+			err:      channels.ErrInvalidSettings,
+		},
+		{
+			desc:     "parse invalid settings",
+			metadata: `{"transformer":"json"}`,
+			err:      channels.ErrInvalidSettings,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			s, ok, err := channels.ParseSettings([]byte(tc.metadata))
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s, got %s", tc.err, err))
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.settings, s)
+		})
+	}
+}
+
+func TestSettingsTransformer(t *testing.T) {
+	cases := []struct {
+		desc     string
+		settings channels.Settings
+		err      error
+	}{
+		{
+			desc:     "senml transformer",
+			settings: channels.Settings{Format: "senml", ContentType: senml.CBOR},
+		},
+		{
+			desc:     "json transformer",
+			settings: channels.Settings{Format: "JSON"},
+		},
+		{
+			desc:     "cbor transformer",
+			settings: channels.Settings{Format: "cbor"},
+		},
+		{
+			desc:     "protobuf transformer without message type",
+			settings: channels.Settings{Format: "protobuf"},
+			err:      channels.ErrInvalidSettings,
+		},
+		{
+			desc:     "protobuf transformer with invalid descriptor set",
+			settings: channels.Settings{Format: "protobuf", Protobuf: &channels.Protobuf{DescriptorSet: []byte{0xff}, Message: "sensors.Reading"}},
+			err:      channels.ErrInvalidSettings,
+		},
+		{
+			desc:     "unknown transformer",
+			settings: channels.Settings{Format: "xml"},
+			err:      channels.ErrInvalidSettings,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			tr, err := tc.settings.Transformer("channel-1")
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s, got %s", tc.err, err))
+			if tc.err == nil {
+				assert.NotNil(t, tr)
+			}
+		})
+	}
+}
+
+func TestUnits(t *testing.T) {
+	s := channels.Settings{
+		Format: "senml",
+		Units:  map[string]string{"dev:temp": "Cel", "dev:hum": "%RH"},
+	}
+	tr, err := s.Transformer("channel-1")
+	require.Nil(t, err, fmt.Sprintf("transformer expected to be created: %s", err))
+
+	res, err := tr.Transform(&messaging.Message{
+		Channel: "channel-1",
+		Payload: []byte(`[{"bn":"dev:","n":"temp","v":21.5},{"n":"hum","u":"%","v":40},{"n":"co2","v":400}]`),
+		Created: 1638310819000000000,
+	})
+	require.Nil(t, err, fmt.Sprintf("transform expected to succeed: %s", err))
+	msgs, ok := res.([]senml.Message)
+	require.True(t, ok, "expected SenML messages")
+	require.Len(t, msgs, 3)
+	assert.Equal(t, "Cel", msgs[0].Unit)
+	assert.Equal(t, "%", msgs[1].Unit)
+	assert.Equal(t, "", msgs[2].Unit)
+}
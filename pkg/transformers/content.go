@@ -0,0 +1,60 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package transformers
+
+import (
+	"mime"
+	"strings"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+var _ Transformer = (*contentTypeTransformer)(nil)
+
+type contentTypeTransformer struct {
+	fallback Transformer
+	byType   map[string]Transformer
+}
+
+// ByContentType returns a transformer which selects the transformer by
+// the message content type. Messages with no content type, or with a content
+// type which has no registered transformer, are transformed using fallback.
+// Content types are matched by media type, ignoring case and parameters.
+func ByContentType(fallback Transformer, byType map[string]Transformer) Transformer {
+	ts := make(map[string]Transformer, len(byType))
+	for ct, t := range byType {
+		ts[MediaType(ct)] = t
+	}
+
+	return &contentTypeTransformer{
+		fallback: fallback,
+		byType:   ts,
+	}
+}
+
+func (ct *contentTypeTransformer) Transform(msg *messaging.Message) (any, error) {
+	if t, ok := ct.byType[MediaType(msg.GetContentType())]; ok {
+		return t.Transform(msg)
+	}
+	if ct.fallback == nil {
+		return msg, nil
+	}
+
+	return ct.fallback.Transform(msg)
+}
+
+// MediaType returns the lower-case media type of the content type,
+// without parameters such as charset.
+func MediaType(contentType string) string {
+	if contentType == "" {
+		return ""
+	}
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mt, _, _ = strings.Cut(contentType, ";")
+		mt = strings.ToLower(strings.TrimSpace(mt))
+	}
+
+	return mt
+}
@@ -0,0 +1,76 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package transformers_test
+
+import (
+	"testing"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/transformers"
+	"github.com/stretchr/testify/assert"
+)
+
+type namedTransformer string
+
+func (nt namedTransformer) Transform(msg *messaging.Message) (any, error) {
+	return string(nt), nil
+}
+
+func TestByContentType(t *testing.T) {
+	tr := transformers.ByContentType(namedTransformer("fallback"), map[string]transformers.Transformer{
+		"application/senml+json": namedTransformer("senml"),
+		"Application/JSON":       namedTransformer("json"),
+	})
+
+	cases := []struct {
+		desc        string
+		contentType string
+		want        any
+	}{
+		{
+			desc:        "transform message without content type",
+			contentType: "",
+			want:        "fallback",
+		},
+		{
+			desc:        "transform message with registered content type",
+			contentType: "application/senml+json",
+			want:        "senml",
+		},
+		{
+			desc:        "transform message with content type parameters",
+			contentType: "application/json; charset=utf-8",
+			want:        "json",
+		},
+		{
+			desc:        "transform message with upper case content type",
+			contentType: "APPLICATION/SENML+JSON",
+			want:        "senml",
+		},
+		{
+			desc:        "transform message with unknown content type",
+			contentType: "text/plain",
+			want:        "fallback",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := tr.Transform(&messaging.Message{ContentType: tc.contentType})
+			assert.Nil(t, err, tc.desc)
+			assert.Equal(t, tc.want, got, tc.desc)
+		})
+	}
+}
+
+func TestByContentTypeWithoutFallback(t *testing.T) {
+	tr := transformers.ByContentType(nil, map[string]transformers.Transformer{
+		"application/json": namedTransformer("json"),
+	})
+
+	msg := &messaging.Message{ContentType: "text/plain"}
+	got, err := tr.Transform(msg)
+	assert.Nil(t, err)
+	assert.Equal(t, msg, got)
+}
@@ -14,6 +14,9 @@ import (
 
 const sep = "/"
 
+// ContentType represents plain JSON content type.
+const ContentType = "application/json"
+
 var (
 	keys = [...]string{"publisher", "protocol", "channel", "subtopic"}
 
@@ -31,19 +34,32 @@ var (
 
 // TimeField represents the message fields to use as timestamp.
 type TimeField struct {
-	FieldName   string `toml:"field_name"`
-	FieldFormat string `toml:"field_format"`
-	Location    string `toml:"location"`
+	FieldName   string `toml:"field_name"   json:"field_name"`
+	FieldFormat string `toml:"field_format" json:"field_format"`
+	Location    string `toml:"location"     json:"location,omitempty"`
 }
 
+// Decoder decodes the message payload to JSON compatible values, such as
+// the ones produced by json.Unmarshal.
+type Decoder func(msg *messaging.Message) (any, error)
+
 type transformerService struct {
 	timeFields []TimeField
+	decode     Decoder
 }
 
 // New returns a new JSON transformer.
 func New(tfs []TimeField) transformers.Transformer {
+	return NewWithDecoder(tfs, decode)
+}
+
+// NewWithDecoder returns a new JSON transformer which decodes the message
+// payload using the given decoder. It allows payloads in other encodings to
+// be transformed to JSON messages.
+func NewWithDecoder(tfs []TimeField, decode Decoder) transformers.Transformer {
 	return &transformerService{
 		timeFields: tfs,
+		decode:     decode,
 	}
 }
 
@@ -53,8 +69,10 @@ func (ts *transformerService) Transform(msg *messaging.Message) (any, error) {
 		Publisher: msg.GetPublisher(),
 		Created:   msg.GetCreated(),
 		Protocol:  msg.GetProtocol(),
+		Domain:    msg.GetDomain(),
 		Channel:   msg.GetChannel(),
 		Subtopic:  msg.GetSubtopic(),
+		Headers:   msg.GetHeaders(),
 	}
 
 	if ret.Subtopic == "" {
@@ -67,8 +85,8 @@ func (ts *transformerService) Transform(msg *messaging.Message) (any, error) {
 	}
 
 	format := subs[len(subs)-1]
-	var payload any
-	if err := json.Unmarshal(msg.GetPayload(), &payload); err != nil {
+	payload, err := ts.decode(msg)
+	if err != nil {
 		return nil, errors.Wrap(ErrTransform, err)
 	}
 
@@ -114,6 +132,15 @@ func (ts *transformerService) Transform(msg *messaging.Message) (any, error) {
 	}
 }
 
+func decode(msg *messaging.Message) (any, error) {
+	var payload any
+	if err := json.Unmarshal(msg.GetPayload(), &payload); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
 // ParseFlat receives flat map that represents complex JSON objects and returns
 // the corresponding complex JSON object with nested maps. It's the opposite
 // of the Flatten function.
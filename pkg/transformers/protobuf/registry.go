@@ -0,0 +1,112 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package protobuf
+
+import (
+	"os"
+	"sync"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var (
+	// ErrInvalidDescriptor represents an invalid descriptor set.
+	ErrInvalidDescriptor = errors.New("invalid protobuf descriptor set")
+	// ErrUnknownMessage represents a message type missing from the descriptor set.
+	ErrUnknownMessage = errors.New("unknown protobuf message type")
+	// ErrUnknownChannel represents a channel with no registered message type.
+	ErrUnknownChannel = errors.New("no protobuf message type registered for channel")
+
+	errMissingChannel = errors.New("missing channel")
+	errReadDescriptor = errors.New("failed to read descriptor set file")
+)
+
+// Descriptor represents the message type used to decode the payloads
+// published to a channel. File is the path of a descriptor set produced by
+// protoc --descriptor_set_out with --include_imports, and Message is the
+// fully qualified name of the message type.
+type Descriptor struct {
+	Channel string `toml:"channel"`
+	File    string `toml:"file"`
+	Message string `toml:"message"`
+}
+
+// Registry holds the message types of the channels.
+type Registry struct {
+	mu       sync.RWMutex
+	messages map[string]protoreflect.MessageDescriptor
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		messages: make(map[string]protoreflect.MessageDescriptor),
+	}
+}
+
+// Register sets the message type of the channel, replacing the previous
+// one. The descriptor set is a serialized FileDescriptorSet which contains
+// the message type and all its dependencies.
+func (r *Registry) Register(channel string, descriptorSet []byte, message string) error {
+	if channel == "" {
+		return errors.Wrap(ErrInvalidDescriptor, errMissingChannel)
+	}
+
+	var fds descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(descriptorSet, &fds); err != nil {
+		return errors.Wrap(ErrInvalidDescriptor, err)
+	}
+	files, err := protodesc.NewFiles(&fds)
+	if err != nil {
+		return errors.Wrap(ErrInvalidDescriptor, err)
+	}
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(message))
+	if err != nil {
+		return errors.Wrap(ErrUnknownMessage, err)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return ErrUnknownMessage
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages[channel] = md
+
+	return nil
+}
+
+// RegisterFile registers the message type described in the descriptor set file.
+func (r *Registry) RegisterFile(d Descriptor) error {
+	data, err := os.ReadFile(d.File)
+	if err != nil {
+		return errors.Wrap(errReadDescriptor, err)
+	}
+
+	return r.Register(d.Channel, data, d.Message)
+}
+
+// Remove removes the message type of the channel.
+func (r *Registry) Remove(channel string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.messages, channel)
+}
+
+// Message returns the message type of the channel.
+func (r *Registry) Message(channel string) (protoreflect.MessageDescriptor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	md, ok := r.messages[channel]
+	if !ok {
+		return nil, ErrUnknownChannel
+	}
+
+	return md, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package protobuf
+
+import (
+	stdjson "encoding/json"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/transformers"
+	"github.com/absmach/magistrala/pkg/transformers/json"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	// ContentType represents Protocol Buffers content type.
+	ContentType = "application/protobuf"
+	// XContentType represents the legacy Protocol Buffers content type.
+	XContentType = "application/x-protobuf"
+)
+
+var marshalOpts = protojson.MarshalOptions{UseProtoNames: true}
+
+// New returns a new Protocol Buffers transformer. Payloads are decoded using
+// the message type registered for the message channel and transformed to
+// JSON messages, so they can be stored and read by the JSON writers and
+// readers. Field names are the names used in the .proto file and values
+// follow the Protocol Buffers JSON mapping.
+func New(tfs []json.TimeField, registry *Registry) transformers.Transformer {
+	d := decoder{registry: registry}
+
+	return json.NewWithDecoder(tfs, d.decode)
+}
+
+type decoder struct {
+	registry *Registry
+}
+
+func (d decoder) decode(msg *messaging.Message) (any, error) {
+	md, err := d.registry.Message(msg.GetChannel())
+	if err != nil {
+		return nil, err
+	}
+
+	m := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(msg.GetPayload(), m); err != nil {
+		return nil, err
+	}
+	data, err := marshalOpts.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload any
+	if err := stdjson.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
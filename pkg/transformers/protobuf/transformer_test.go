@@ -0,0 +1,207 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package protobuf_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/transformers/json"
+	"github.com/absmach/magistrala/pkg/transformers/protobuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	channel     = "channel-1"
+	messageName = "sensors.Reading"
+)
+
+var readingFile = &descriptorpb.FileDescriptorProto{
+	Name:    proto.String("reading.proto"),
+	Package: proto.String("sensors"),
+	Syntax:  proto.String("proto3"),
+	MessageType: []*descriptorpb.DescriptorProto{
+		{
+			Name: proto.String("Reading"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("sensor_id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				field("temperature", 2, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE),
+				field("ts", 3, descriptorpb.FieldDescriptorProto_TYPE_UINT32),
+				field("online", 4, descriptorpb.FieldDescriptorProto_TYPE_BOOL),
+			},
+		},
+	},
+}
+
+func field(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     typ.Enum(),
+	}
+}
+
+func descriptorSet(t *testing.T) []byte {
+	data, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{readingFile}})
+	require.Nil(t, err, fmt.Sprintf("marshal expected to succeed: %s", err))
+	return data
+}
+
+func reading(t *testing.T, values map[string]any) []byte {
+	fd, err := protodesc.NewFile(readingFile, nil)
+	require.Nil(t, err, fmt.Sprintf("descriptor expected to be valid: %s", err))
+	md := fd.Messages().ByName("Reading")
+	m := dynamicpb.NewMessage(md)
+	for name, v := range values {
+		f := md.Fields().ByName(protoreflect.Name(name))
+		m.Set(f, protoreflect.ValueOf(v))
+	}
+	data, err := proto.Marshal(m)
+	require.Nil(t, err, fmt.Sprintf("marshal expected to succeed: %s", err))
+	return data
+}
+
+func TestRegister(t *testing.T) {
+	set := descriptorSet(t)
+
+	cases := []struct {
+		desc    string
+		channel string
+		set     []byte
+		message string
+		err     error
+	}{
+		{
+			desc:    "register message type",
+			channel: channel,
+			set:     set,
+			message: messageName,
+		},
+		{
+			desc:    "register message type without channel",
+			set:     set,
+			message: messageName,
+			err:     protobuf.ErrInvalidDescriptor,
+		},
+		{
+			desc:    "register invalid descriptor set",
+			channel: channel,
+			set:     []byte{0xff},
+			message: messageName,
+			err:     protobuf.ErrInvalidDescriptor,
+		},
+		{
+			desc:    "register unknown message type",
+			channel: channel,
+			set:     set,
+			message: "sensors.Unknown",
+			err:     protobuf.ErrUnknownMessage,
+		},
+		{
+			desc:    "register field as message type",
+			channel: channel,
+			set:     set,
+			message: "sensors.Reading.sensor_id",
+			err:     protobuf.ErrUnknownMessage,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			r := protobuf.NewRegistry()
+			err := r.Register(tc.channel, tc.set, tc.message)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s, got %s", tc.err, err))
+			md, err := r.Message(tc.channel)
+			if tc.err != nil {
+				assert.True(t, errors.Contains(err, protobuf.ErrUnknownChannel), fmt.Sprintf("expected %s, got %s", protobuf.ErrUnknownChannel, err))
+				return
+			}
+			assert.Equal(t, messageName, string(md.FullName()))
+			r.Remove(tc.channel)
+			_, err = r.Message(tc.channel)
+			assert.True(t, errors.Contains(err, protobuf.ErrUnknownChannel), fmt.Sprintf("expected %s, got %s", protobuf.ErrUnknownChannel, err))
+		})
+	}
+}
+
+func TestTransformProtobuf(t *testing.T) {
+	now := time.Now().Unix()
+	r := protobuf.NewRegistry()
+	err := r.Register(channel, descriptorSet(t), messageName)
+	require.Nil(t, err, fmt.Sprintf("register expected to succeed: %s", err))
+	tr := protobuf.New([]json.TimeField{{FieldName: "ts", FieldFormat: "unix"}}, r)
+
+	message := func(ch string, payload []byte) *messaging.Message {
+		return &messaging.Message{
+			Channel:   ch,
+			Subtopic:  "subtopic-1",
+			Publisher: "publisher-1",
+			Protocol:  "protocol",
+			Payload:   payload,
+			Created:   now,
+		}
+	}
+	expected := func(created int64, payload map[string]any) json.Messages {
+		return json.Messages{
+			Data: []json.Message{
+				{
+					Channel:   channel,
+					Subtopic:  "subtopic-1",
+					Publisher: "publisher-1",
+					Protocol:  "protocol",
+					Created:   created,
+					Payload:   payload,
+				},
+			},
+			Format: "subtopic-1",
+		}
+	}
+
+	cases := []struct {
+		desc string
+		msg  *messaging.Message
+		json any
+		err  error
+	}{
+		{
+			desc: "transform protobuf message",
+			msg:  message(channel, reading(t, map[string]any{"sensor_id": "s1", "temperature": 21.5, "online": true})),
+			json: expected(now, map[string]any{"sensor_id": "s1", "temperature": 21.5, "online": true}),
+		},
+		{
+			desc: "transform protobuf message with timestamp transformation",
+			msg:  message(channel, reading(t, map[string]any{"sensor_id": "s1", "ts": uint32(1638310819)})),
+			json: expected(1638310819000000000, map[string]any{"sensor_id": "s1", "ts": float64(1638310819)}),
+		},
+		{
+			desc: "transform protobuf message from unknown channel",
+			msg:  message("channel-2", reading(t, map[string]any{"sensor_id": "s1"})),
+			err:  protobuf.ErrUnknownChannel,
+		},
+		{
+			desc: "transform invalid protobuf message",
+			msg:  message(channel, []byte{0x0a, 0x05, 0x73}),
+			err:  json.ErrTransform,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			m, err := tr.Transform(tc.msg)
+			assert.Equal(t, tc.json, m)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("expected %s, got %s", tc.err, err))
+		})
+	}
+}
@@ -74,6 +74,7 @@ func (t transformer) Transform(msg *messaging.Message) (any, error) {
 		}
 
 		msgs[i] = Message{
+			Domain:      msg.GetDomain(),
 			Channel:     msg.GetChannel(),
 			Subtopic:    msg.GetSubtopic(),
 			Publisher:   msg.GetPublisher(),
@@ -87,6 +88,7 @@ func (t transformer) Transform(msg *messaging.Message) (any, error) {
 			DataValue:   v.DataValue,
 			StringValue: v.StringValue,
 			Sum:         v.Sum,
+			Headers:     msg.GetHeaders(),
 		}
 	}
 
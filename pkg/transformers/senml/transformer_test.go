@@ -51,6 +51,19 @@ func TestTransformJSON(t *testing.T) {
 		},
 	}
 
+	headers := map[string]string{"correlation-id": "corr-1"}
+	hdrMsg := &messaging.Message{
+		Channel:     "channel",
+		Subtopic:    "subtopic",
+		Publisher:   "publisher",
+		Protocol:    "protocol",
+		Payload:     jsonBytes,
+		ContentType: senml.JSON,
+		Headers:     headers,
+	}
+	hdrMsgs := []senml.Message{msgs[0]}
+	hdrMsgs[0].Headers = headers
+
 	cases := []struct {
 		desc string
 		msg  *messaging.Message
@@ -63,6 +76,12 @@ func TestTransformJSON(t *testing.T) {
 			msgs: msgs,
 			err:  nil,
 		},
+		{
+			desc: "test normalize JSON with headers",
+			msg:  hdrMsg,
+			msgs: hdrMsgs,
+			err:  nil,
+		},
 		{
 			desc: "test normalize defaults to JSON",
 			msg:  msg,
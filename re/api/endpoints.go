@@ -203,3 +203,29 @@ func disableRuleEndpoint(s re.Service) endpoint.Endpoint {
 		return updateRuleStatusRes{Rule: rule}, err
 	}
 }
+
+func replayRuleEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(replayRuleReq)
+		if err := req.validate(); err != nil {
+			return replayRuleRes{}, err
+		}
+
+		rp := re.Replay{
+			StartTime:     req.StartTime,
+			StartSequence: req.StartSequence,
+			EndTime:       req.EndTime,
+		}
+		replay, err := s.ReplayRule(ctx, session, req.id, rp)
+		if err != nil {
+			return replayRuleRes{}, err
+		}
+
+		return replayRuleRes{Replay: replay}, nil
+	}
+}
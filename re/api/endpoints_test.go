@@ -1193,6 +1193,172 @@ func TestDisableRuleEndpoint(t *testing.T) {
 	}
 }
 
+func TestReplayRuleEndpoint(t *testing.T) {
+	ts, svc, authn := newRuleEngineServer()
+	defer ts.Close()
+
+	start := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Second)
+	end := start.Add(time.Hour)
+	replay := re.Replay{
+		ID:        testsutil.GenerateUUID(t),
+		RuleID:    validID,
+		StartTime: start,
+		EndTime:   end,
+	}
+
+	cases := []struct {
+		desc        string
+		token       string
+		id          string
+		domainID    string
+		contentType string
+		data        string
+		session     smqauthn.Session
+		svcReq      re.Replay
+		svcResp     re.Replay
+		svcErr      error
+		status      int
+		authnErr    error
+		err         error
+	}{
+		{
+			desc:        "replay rule by start time successfully",
+			token:       validToken,
+			domainID:    domainID,
+			id:          validID,
+			contentType: contentType,
+			data:        toJSON(map[string]any{"start_time": start, "end_time": end}),
+			svcReq:      re.Replay{StartTime: start, EndTime: end},
+			svcResp:     replay,
+			status:      http.StatusAccepted,
+		},
+		{
+			desc:        "replay rule by start sequence successfully",
+			token:       validToken,
+			domainID:    domainID,
+			id:          validID,
+			contentType: contentType,
+			data:        toJSON(map[string]any{"start_sequence": 10}),
+			svcReq:      re.Replay{StartSequence: 10},
+			svcResp:     re.Replay{ID: replay.ID, RuleID: validID, StartSequence: 10},
+			status:      http.StatusAccepted,
+		},
+		{
+			desc:        "replay rule with invalid token",
+			token:       invalidToken,
+			domainID:    domainID,
+			id:          validID,
+			contentType: contentType,
+			data:        toJSON(map[string]any{"start_time": start}),
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "replay rule without start",
+			token:       validToken,
+			domainID:    domainID,
+			id:          validID,
+			contentType: contentType,
+			data:        toJSON(map[string]any{"end_time": end}),
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingReplayStart,
+		},
+		{
+			desc:        "replay rule with both start time and sequence",
+			token:       validToken,
+			domainID:    domainID,
+			id:          validID,
+			contentType: contentType,
+			data:        toJSON(map[string]any{"start_time": start, "start_sequence": 10}),
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidReplayRange,
+		},
+		{
+			desc:        "replay rule with end before start",
+			token:       validToken,
+			domainID:    domainID,
+			id:          validID,
+			contentType: contentType,
+			data:        toJSON(map[string]any{"start_time": end, "end_time": start}),
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidReplayRange,
+		},
+		{
+			desc:        "replay rule with end in the future",
+			token:       validToken,
+			domainID:    domainID,
+			id:          validID,
+			contentType: contentType,
+			data:        toJSON(map[string]any{"start_time": start, "end_time": time.Now().Add(time.Hour)}),
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrInvalidReplayRange,
+		},
+		{
+			desc:        "replay rule with invalid content type",
+			token:       validToken,
+			domainID:    domainID,
+			id:          validID,
+			contentType: "application/xml",
+			data:        toJSON(map[string]any{"start_time": start}),
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+		{
+			desc:        "replay rule with malformed body",
+			token:       validToken,
+			domainID:    domainID,
+			id:          validID,
+			contentType: contentType,
+			data:        "{",
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMalformedRequestBody,
+		},
+		{
+			desc:        "replay rule with service error",
+			token:       validToken,
+			domainID:    domainID,
+			id:          validID,
+			contentType: contentType,
+			data:        toJSON(map[string]any{"start_time": start}),
+			svcReq:      re.Replay{StartTime: start},
+			svcErr:      svcerr.ErrAuthorization,
+			status:      http.StatusForbidden,
+			err:         svcerr.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/%s/rules/%s/replay", ts.URL, tc.domainID, tc.id),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(tc.data),
+			}
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("ReplayRule", mock.Anything, tc.session, tc.id, tc.svcReq).Return(tc.svcResp, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var errRes respBody
+			err = json.NewDecoder(res.Body).Decode(&errRes)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if errRes.Err != "" || errRes.Message != "" {
+				err = errors.Wrap(errors.New(errRes.Err), errors.New(errRes.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
 func TestDeleteRuleEndpoint(t *testing.T) {
 	ts, svc, authn := newRuleEngineServer()
 	defer ts.Close()
@@ -4,6 +4,8 @@
 package api
 
 import (
+	"time"
+
 	api "github.com/absmach/magistrala/api/http"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	"github.com/absmach/magistrala/pkg/errors"
@@ -135,3 +137,27 @@ func (req deleteRuleReq) validate() error {
 
 	return nil
 }
+
+type replayRuleReq struct {
+	id            string
+	StartTime     time.Time `json:"start_time"`
+	StartSequence uint64    `json:"start_sequence"`
+	EndTime       time.Time `json:"end_time"`
+}
+
+func (req replayRuleReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.StartTime.IsZero() && req.StartSequence == 0 {
+		return apiutil.ErrMissingReplayStart
+	}
+	if !req.StartTime.IsZero() && req.StartSequence > 0 {
+		return apiutil.ErrInvalidReplayRange
+	}
+	if !req.EndTime.IsZero() && (!req.EndTime.After(req.StartTime) || req.EndTime.After(time.Now())) {
+		return apiutil.ErrInvalidReplayRange
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ var (
 	_ magistrala.Response = (*rulesPageRes)(nil)
 	_ magistrala.Response = (*updateRuleRes)(nil)
 	_ magistrala.Response = (*deleteRuleRes)(nil)
+	_ magistrala.Response = (*replayRuleRes)(nil)
 )
 
 type pageRes struct {
@@ -136,3 +137,19 @@ func (res deleteRuleRes) Headers() map[string]string {
 func (res deleteRuleRes) Empty() bool {
 	return true
 }
+
+type replayRuleRes struct {
+	re.Replay `json:",inline"`
+}
+
+func (res replayRuleRes) Code() int {
+	return http.StatusAccepted
+}
+
+func (res replayRuleRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res replayRuleRes) Empty() bool {
+	return false
+}
@@ -105,6 +105,13 @@ func MakeHandler(svc re.Service, authn smqauthn.AuthNMiddleware, mux *chi.Mux, l
 						opts...,
 					), "disable_rule").ServeHTTP)
 
+					r.Post("/replay", otelhttp.NewHandler(kithttp.NewServer(
+						replayRuleEndpoint(svc),
+						decodeReplayRuleRequest,
+						api.EncodeResponse,
+						opts...,
+					), "replay_rule").ServeHTTP)
+
 					roleManagerHttp.EntityRoleMangerRouter(svc, d, r, opts)
 				})
 			})
@@ -188,6 +195,21 @@ func decodeUpdateRuleStatusRequest(_ context.Context, r *http.Request) (any, err
 	return req, nil
 }
 
+func decodeReplayRuleRequest(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := replayRuleReq{
+		id: chi.URLParam(r, ruleIdKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
 func decodeListRulesRequest(_ context.Context, r *http.Request) (any, error) {
 	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
 	if err != nil {
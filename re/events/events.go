@@ -23,6 +23,7 @@ const (
 	ruleEnable         = rulePrefix + "enable"
 	ruleDisable        = rulePrefix + "disable"
 	ruleRemove         = rulePrefix + "remove"
+	ruleReplay         = rulePrefix + "replay"
 )
 
 var (
@@ -35,6 +36,7 @@ var (
 	_ events.Event = (*enableRuleEvent)(nil)
 	_ events.Event = (*disableRuleEvent)(nil)
 	_ events.Event = (*removeRuleEvent)(nil)
+	_ events.Event = (*replayRuleEvent)(nil)
 )
 
 type baseRuleEvent struct {
@@ -190,3 +192,23 @@ func (rre removeRuleEvent) Encode() (map[string]any, error) {
 	val["operation"] = ruleRemove
 	return val, nil
 }
+
+type replayRuleEvent struct {
+	replay re.Replay
+	baseRuleEvent
+}
+
+func (rre replayRuleEvent) Encode() (map[string]any, error) {
+	val := rre.baseRuleEvent.Encode()
+	val["id"] = rre.replay.RuleID
+	val["replay_id"] = rre.replay.ID
+	if !rre.replay.StartTime.IsZero() {
+		val["start_time"] = rre.replay.StartTime
+	}
+	if rre.replay.StartSequence > 0 {
+		val["start_sequence"] = rre.replay.StartSequence
+	}
+	val["end_time"] = rre.replay.EndTime
+	val["operation"] = ruleReplay
+	return val, nil
+}
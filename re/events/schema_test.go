@@ -0,0 +1,149 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/pkg/roles"
+	"github.com/absmach/magistrala/pkg/schedule"
+	"github.com/absmach/magistrala/re"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSchemas(t *testing.T) {
+	now := time.Now().UTC()
+	session := authn.Session{
+		Type:     authn.AccessToken,
+		UserID:   testsutil.GenerateUUID(t),
+		DomainID: testsutil.GenerateUUID(t),
+	}
+	rule := re.Rule{
+		ID:           testsutil.GenerateUUID(t),
+		Name:         "rule",
+		DomainID:     session.DomainID,
+		Metadata:     re.Metadata{"key": "value"},
+		Tags:         []string{"tag"},
+		InputChannel: testsutil.GenerateUUID(t),
+		InputTopic:   "topic",
+		Logic:        re.Script{Type: re.LuaType, Value: "return message"},
+		Schedule:     schedule.Schedule{StartDateTime: now, Time: now, Recurring: schedule.Daily, RecurringPeriod: 1},
+		Status:       re.EnabledStatus,
+		CreatedAt:    now,
+		CreatedBy:    session.UserID,
+		UpdatedAt:    now,
+		UpdatedBy:    session.UserID,
+	}
+	topic := "topic"
+	scheduled := true
+	recurring := schedule.Daily
+	page := re.PageMeta{
+		Total:           1,
+		Limit:           10,
+		Dir:             "asc",
+		Name:            "rule",
+		InputChannel:    rule.InputChannel,
+		InputTopic:      &topic,
+		Scheduled:       &scheduled,
+		OutputChannel:   testsutil.GenerateUUID(t),
+		Status:          re.EnabledStatus,
+		Domain:          session.DomainID,
+		Tag:             "tag",
+		ScheduledBefore: &now,
+		ScheduledAfter:  &now,
+		Recurring:       &recurring,
+	}
+	base := newBaseRuleEvent(session, testsutil.GenerateUUID(t))
+
+	cases := []struct {
+		desc  string
+		event events.Event
+	}{
+		{
+			desc: "create rule",
+			event: createRuleEvent{
+				rule:             rule,
+				rolesProvisioned: []roles.RoleProvision{{Role: roles.Role{ID: testsutil.GenerateUUID(t), Name: "admin"}, OptionalActions: []string{"read"}}},
+				baseRuleEvent:    base,
+			},
+		},
+		{
+			desc:  "create rule with minimal fields",
+			event: createRuleEvent{rule: re.Rule{ID: rule.ID, CreatedAt: now, CreatedBy: session.UserID}, baseRuleEvent: base},
+		},
+		{
+			desc:  "list rules",
+			event: listRuleEvent{PageMeta: page, baseRuleEvent: base},
+		},
+		{
+			desc:  "view rule",
+			event: viewRuleEvent{rule: rule, baseRuleEvent: base},
+		},
+		{
+			desc:  "update rule",
+			event: updateRuleEvent{rule: rule, baseRuleEvent: base},
+		},
+		{
+			desc:  "update rule tags",
+			event: updateRuleTagsEvent{rule: rule, baseRuleEvent: base},
+		},
+		{
+			desc:  "update rule schedule",
+			event: updateRuleScheduleEvent{rule: rule, baseRuleEvent: base},
+		},
+		{
+			desc:  "enable rule",
+			event: enableRuleEvent{rule: rule, baseRuleEvent: base},
+		},
+		{
+			desc:  "disable rule",
+			event: disableRuleEvent{rule: rule, baseRuleEvent: base},
+		},
+		{
+			desc:  "remove rule",
+			event: removeRuleEvent{id: rule.ID, baseRuleEvent: base},
+		},
+		{
+			desc: "replay rule",
+			event: replayRuleEvent{
+				replay:        re.Replay{ID: testsutil.GenerateUUID(t), RuleID: rule.ID, StartTime: now.Add(-time.Hour), EndTime: now},
+				baseRuleEvent: base,
+			},
+		},
+		{
+			desc: "replay rule from sequence",
+			event: replayRuleEvent{
+				replay:        re.Replay{ID: testsutil.GenerateUUID(t), RuleID: rule.ID, StartSequence: 1, EndTime: now},
+				baseRuleEvent: base,
+			},
+		},
+	}
+
+	covered := make(map[string]bool)
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, s, err := schema.ValidateEvent(tc.event)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			covered[s.Operation] = true
+		})
+	}
+
+	// Role events are published by the roles manager.
+	for _, s := range schema.Schemas() {
+		op := s.Operation
+		if !strings.HasPrefix(op, rulePrefix) ||
+			strings.HasPrefix(op, rulePrefix+"role") || strings.HasPrefix(op, rulePrefix+"members") {
+			continue
+		}
+		assert.True(t, covered[op], fmt.Sprintf("expected emitted event for schema %s", op))
+	}
+}
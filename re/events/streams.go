@@ -27,6 +27,7 @@ const (
 	EnableStream         = magistralaPrefix + ruleEnable
 	DisableStream        = magistralaPrefix + ruleDisable
 	RemoveStream         = magistralaPrefix + ruleRemove
+	ReplayStream         = magistralaPrefix + ruleReplay
 )
 
 var _ re.Service = (*eventStore)(nil)
@@ -190,6 +191,21 @@ func (es *eventStore) DisableRule(ctx context.Context, session authn.Session, id
 	return rule, nil
 }
 
+func (es *eventStore) ReplayRule(ctx context.Context, session authn.Session, id string, rp re.Replay) (re.Replay, error) {
+	replay, err := es.svc.ReplayRule(ctx, session, id, rp)
+	if err != nil {
+		return replay, err
+	}
+	event := replayRuleEvent{
+		replay:        replay,
+		baseRuleEvent: newBaseRuleEvent(session, middleware.GetReqID(ctx)),
+	}
+	if err := es.Publish(ctx, ReplayStream, event); err != nil {
+		return replay, err
+	}
+	return replay, nil
+}
+
 func (es *eventStore) StartScheduler(ctx context.Context) error {
 	return es.svc.StartScheduler(ctx)
 }
@@ -27,14 +27,16 @@ var (
 
 // Type message is a magistrala message with payload replaces by JSON deserialized payload.
 type message struct {
-	Channel   string `json:"channel,omitempty"`
-	ClientID  string `json:"client_id,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Subtopic  string `json:"subtopic,omitempty"`
-	Publisher string `json:"publisher,omitempty"`
-	Protocol  string `json:"protocol,omitempty"`
-	Created   int64  `json:"created,omitempty"`
-	Payload   any    `json:"payload,omitempty"`
+	Channel     string            `json:"channel,omitempty"`
+	ClientID    string            `json:"client_id,omitempty"`
+	Domain      string            `json:"domain,omitempty"`
+	Subtopic    string            `json:"subtopic,omitempty"`
+	Publisher   string            `json:"publisher,omitempty"`
+	Protocol    string            `json:"protocol,omitempty"`
+	Created     int64             `json:"created,omitempty"`
+	ContentType string            `json:"content_type,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Payload     any               `json:"payload,omitempty"`
 }
 
 func (re *re) processGo(ctx context.Context, details []slog.Attr, r Rule, msg *messaging.Message) (ret pkglog.RunInfo) {
@@ -53,13 +55,15 @@ func (re *re) processGo(ctx context.Context, details []slog.Attr, r Rule, msg *m
 		return pkglog.RunInfo{Level: slog.LevelError, Details: details, Message: err.Error()}
 	}
 	m := message{
-		Created:   msg.Created,
-		ClientID:  msg.ClientIdentity(),
-		Domain:    msg.Domain,
-		Publisher: msg.Publisher,
-		Channel:   msg.Channel,
-		Subtopic:  msg.Subtopic,
-		Protocol:  msg.Protocol,
+		Created:     msg.Created,
+		ClientID:    msg.ClientIdentity(),
+		Domain:      msg.Domain,
+		Publisher:   msg.Publisher,
+		Channel:     msg.Channel,
+		Subtopic:    msg.Subtopic,
+		Protocol:    msg.Protocol,
+		ContentType: msg.ContentType,
+		Headers:     msg.Headers,
 	}
 	var pld any
 	if err := json.Unmarshal(msg.Payload, &pld); err != nil {
@@ -100,6 +100,10 @@ func (re *re) handleOutput(ctx context.Context, o Runnable, r Rule, msg *messagi
 		o.AlarmsPub = re.alarmsPub
 		o.RuleID = r.ID
 		return o.Run(ctx, msg, val)
+	case *outputs.Command:
+		o.CommandsPub = re.commandsPub
+		o.RuleID = r.ID
+		return o.Run(ctx, msg, val)
 	case *outputs.Email:
 		o.Emailer = re.email
 		return o.Run(ctx, msg, val)
@@ -98,6 +98,13 @@ func prepareMsg(l *lua.LState, msg *messaging.Message) lua.LValue {
 	message.RawSetString("publisher", lua.LString(msg.Publisher))
 	message.RawSetString("protocol", lua.LString(msg.Protocol))
 	message.RawSetString("created", lua.LNumber(msg.Created))
+	message.RawSetString("content_type", lua.LString(msg.ContentType))
+
+	headers := l.NewTable()
+	for k, v := range msg.Headers {
+		headers.RawSetString(k, lua.LString(v))
+	}
+	message.RawSetString("headers", headers)
 
 	var payload any
 	if err := json.Unmarshal(msg.GetPayload(), &payload); err != nil {
@@ -127,6 +127,14 @@ func (am *authorizationMiddleware) DisableRule(ctx context.Context, session auth
 	return am.svc.DisableRule(ctx, session, id)
 }
 
+func (am *authorizationMiddleware) ReplayRule(ctx context.Context, session authn.Session, id string, rp re.Replay) (re.Replay, error) {
+	if err := am.authorize(ctx, operations.OpReplayRule, session, operations.EntityType, id); err != nil {
+		return re.Replay{}, errors.Wrap(errDomainUpdateRules, err)
+	}
+
+	return am.svc.ReplayRule(ctx, session, id, rp)
+}
+
 func (am *authorizationMiddleware) StartScheduler(ctx context.Context) error {
 	return am.svc.StartScheduler(ctx)
 }
@@ -157,6 +157,21 @@ func (cm *calloutMiddleware) DisableRule(ctx context.Context, session authn.Sess
 	return cm.svc.DisableRule(ctx, session, id)
 }
 
+func (cm *calloutMiddleware) ReplayRule(ctx context.Context, session authn.Session, id string, rp re.Replay) (re.Replay, error) {
+	params := map[string]any{
+		"entity_id":      id,
+		"start_time":     rp.StartTime,
+		"start_sequence": rp.StartSequence,
+		"end_time":       rp.EndTime,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpReplayRule, params); err != nil {
+		return re.Replay{}, err
+	}
+
+	return cm.svc.ReplayRule(ctx, session, id, rp)
+}
+
 func (cm *calloutMiddleware) StartScheduler(ctx context.Context) error {
 	return cm.svc.StartScheduler(ctx)
 }
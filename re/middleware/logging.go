@@ -208,6 +208,29 @@ func (lm *loggingMiddleware) DisableRule(ctx context.Context, session authn.Sess
 	return lm.svc.DisableRule(ctx, session, id)
 }
 
+func (lm *loggingMiddleware) ReplayRule(ctx context.Context, session authn.Session, id string, rp re.Replay) (res re.Replay, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.Group("replay",
+				slog.String("id", res.ID),
+				slog.String("rule_id", id),
+				slog.Time("start_time", rp.StartTime),
+				slog.Uint64("start_sequence", rp.StartSequence),
+				slog.Time("end_time", res.EndTime),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Replay rule failed", args...)
+			return
+		}
+		lm.logger.Info("Replay rule started successfully", args...)
+	}(time.Now())
+	return lm.svc.ReplayRule(ctx, session, id, rp)
+}
+
 func (lm *loggingMiddleware) StartScheduler(ctx context.Context) (err error) {
 	defer func(begin time.Time) {
 		args := []any{
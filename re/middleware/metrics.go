@@ -114,6 +114,15 @@ func (mm *metricsMiddleware) DisableRule(ctx context.Context, session authn.Sess
 	return mm.service.DisableRule(ctx, session, id)
 }
 
+func (mm *metricsMiddleware) ReplayRule(ctx context.Context, session authn.Session, id string, rp re.Replay) (re.Replay, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "replay_rule").Add(1)
+		mm.latency.With("method", "replay_rule").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ReplayRule(ctx, session, id, rp)
+}
+
 func (mm *metricsMiddleware) Handle(msg *messaging.Message) error {
 	defer func(begin time.Time) {
 		mm.counter.With("method", "handle").Add(1)
@@ -115,6 +115,18 @@ func (tm *tracingMiddleware) DisableRule(ctx context.Context, session authn.Sess
 	return tm.svc.DisableRule(ctx, session, id)
 }
 
+func (tm *tracingMiddleware) ReplayRule(ctx context.Context, session authn.Session, id string, rp re.Replay) (re.Replay, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "replay_rule", trace.WithAttributes(
+		attribute.String("id", id),
+		attribute.String("start_time", rp.StartTime.String()),
+		attribute.Int64("start_sequence", int64(rp.StartSequence)),
+		attribute.String("end_time", rp.EndTime.String()),
+	))
+	defer span.End()
+
+	return tm.svc.ReplayRule(ctx, session, id, rp)
+}
+
 func (tm *tracingMiddleware) Handle(msg *messaging.Message) error {
 	_, span := smqTracing.StartSpan(context.Background(), tm.tracer, "handle", trace.WithAttributes(
 		attribute.String("channel", msg.Channel),
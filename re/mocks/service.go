@@ -936,6 +936,84 @@ func (_c *Service_RemoveRule_Call) RunAndReturn(run func(ctx context.Context, se
 	return _c
 }
 
+// ReplayRule provides a mock function for the type Service
+func (_mock *Service) ReplayRule(ctx context.Context, session authn.Session, id string, rp re.Replay) (re.Replay, error) {
+	ret := _mock.Called(ctx, session, id, rp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayRule")
+	}
+
+	var r0 re.Replay
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, re.Replay) (re.Replay, error)); ok {
+		return returnFunc(ctx, session, id, rp)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, re.Replay) re.Replay); ok {
+		r0 = returnFunc(ctx, session, id, rp)
+	} else {
+		r0 = ret.Get(0).(re.Replay)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, re.Replay) error); ok {
+		r1 = returnFunc(ctx, session, id, rp)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ReplayRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayRule'
+type Service_ReplayRule_Call struct {
+	*mock.Call
+}
+
+// ReplayRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+//   - rp re.Replay
+func (_e *Service_Expecter) ReplayRule(ctx interface{}, session interface{}, id interface{}, rp interface{}) *Service_ReplayRule_Call {
+	return &Service_ReplayRule_Call{Call: _e.mock.On("ReplayRule", ctx, session, id, rp)}
+}
+
+func (_c *Service_ReplayRule_Call) Run(run func(ctx context.Context, session authn.Session, id string, rp re.Replay)) *Service_ReplayRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 re.Replay
+		if args[3] != nil {
+			arg3 = args[3].(re.Replay)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ReplayRule_Call) Return(replay re.Replay, err error) *Service_ReplayRule_Call {
+	_c.Call.Return(replay, err)
+	return _c
+}
+
+func (_c *Service_ReplayRule_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string, rp re.Replay) (re.Replay, error)) *Service_ReplayRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveAllRoles provides a mock function for the type Service
 func (_mock *Service) RetrieveAllRoles(ctx context.Context, session authn.Session, entityID string, limit uint64, offset uint64) (roles.RolePage, error) {
 	ret := _mock.Called(ctx, session, entityID, limit, offset)
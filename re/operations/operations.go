@@ -18,6 +18,7 @@ const (
 	OpListRules
 	OpEnableRule
 	OpDisableRule
+	OpReplayRule
 )
 
 func OperationDetails() map[permissions.Operation]permissions.OperationDetails {
@@ -58,5 +59,9 @@ func OperationDetails() map[permissions.Operation]permissions.OperationDetails {
 			Name:               "disable",
 			PermissionRequired: true,
 		},
+		OpReplayRule: {
+			Name:               "replay",
+			PermissionRequired: true,
+		},
 	}
 }
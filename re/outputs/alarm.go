@@ -62,6 +62,7 @@ func (a *Alarm) processAlarm(ctx context.Context, msg *messaging.Message, alarm
 		Channel:   msg.Channel,
 		Subtopic:  msg.Subtopic,
 		Protocol:  msg.Protocol,
+		Headers:   msg.Headers,
 		Payload:   buf.Bytes(),
 	}
 
@@ -30,6 +30,7 @@ func (p *ChannelPublisher) Run(ctx context.Context, msg *messaging.Message, val
 		Channel:   p.Channel,
 		Subtopic:  p.Topic,
 		Protocol:  msg.Protocol,
+		Headers:   msg.Headers,
 		Payload:   data,
 	}
 
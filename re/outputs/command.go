@@ -0,0 +1,83 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package outputs
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"time"
+
+	"github.com/absmach/magistrala/commands"
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+// Command sends the rule result as the command payload to the client. The
+// command is sent to the input message channel and publisher unless the
+// channel and the client are set.
+type Command struct {
+	CommandsPub messaging.Publisher `json:"-"`
+	RuleID      string              `json:"-"`
+	Name        string              `json:"name"`
+	Channel     string              `json:"channel,omitempty"`
+	Client      string              `json:"client,omitempty"`
+	// Timeout is the number of seconds to wait for the client response.
+	Timeout uint64 `json:"timeout,omitempty"`
+}
+
+func (c *Command) Run(ctx context.Context, msg *messaging.Message, val any) error {
+	payload, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+
+	cmd := commands.Command{
+		DomainID:  msg.Domain,
+		ChannelID: c.Channel,
+		ClientID:  c.Client,
+		RuleID:    c.RuleID,
+		Name:      c.Name,
+		Payload:   payload,
+	}
+	if cmd.ChannelID == "" {
+		cmd.ChannelID = msg.Channel
+	}
+	if cmd.ClientID == "" {
+		cmd.ClientID = msg.ClientIdentity()
+	}
+	if c.Timeout > 0 {
+		cmd.ExpiresAt = time.Now().UTC().Add(time.Duration(c.Timeout) * time.Second)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(cmd); err != nil {
+		return err
+	}
+
+	m := &messaging.Message{
+		Domain:    msg.Domain,
+		Publisher: msg.Publisher,
+		ClientId:  msg.ClientIdentity(),
+		Created:   msg.Created,
+		Channel:   cmd.ChannelID,
+		Protocol:  msg.Protocol,
+		Headers:   msg.Headers,
+		Payload:   buf.Bytes(),
+	}
+
+	topic := messaging.EncodeTopicSuffix(msg.Domain, cmd.ChannelID, "")
+
+	return c.CommandsPub.Publish(ctx, topic, m)
+}
+
+func (c *Command) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":    CommandsType.String(),
+		"name":    c.Name,
+		"channel": c.Channel,
+		"client":  c.Client,
+		"timeout": c.Timeout,
+	})
+}
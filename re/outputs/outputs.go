@@ -27,10 +27,11 @@ const (
 	EmailType
 	SaveRemotePgType
 	SlackType
+	CommandsType
 )
 
 var (
-	scriptKindToString = [...]string{"channels", "alarms", "save_senml", "email", "save_remote_pg", "slack"}
+	scriptKindToString = [...]string{"channels", "alarms", "save_senml", "email", "save_remote_pg", "slack", "commands"}
 	stringToScriptKind = map[string]OutputType{
 		"channels":       ChannelsType,
 		"alarms":         AlarmsType,
@@ -38,6 +39,7 @@ var (
 		"email":          EmailType,
 		"save_remote_pg": SaveRemotePgType,
 		"slack":          SlackType,
+		"commands":       CommandsType,
 	}
 )
 
@@ -11,6 +11,9 @@ import (
 	"github.com/absmach/senml"
 )
 
+// senmlContentType is the content type of messages saved by the SenML output.
+const senmlContentType = "application/senml+json"
+
 type SenML struct {
 	WritersPub messaging.Publisher `json:"-"`
 }
@@ -29,14 +32,16 @@ func (s *SenML) Run(ctx context.Context, msg *messaging.Message, val any) error
 	}
 
 	m := &messaging.Message{
-		Domain:    msg.Domain,
-		Publisher: msg.Publisher,
-		ClientId:  msg.ClientIdentity(),
-		Created:   msg.Created,
-		Channel:   msg.Channel,
-		Subtopic:  msg.Subtopic,
-		Protocol:  msg.Protocol,
-		Payload:   data,
+		Domain:      msg.Domain,
+		Publisher:   msg.Publisher,
+		ClientId:    msg.ClientIdentity(),
+		Created:     msg.Created,
+		Channel:     msg.Channel,
+		Subtopic:    msg.Subtopic,
+		Protocol:    msg.Protocol,
+		Headers:     msg.Headers,
+		ContentType: senmlContentType,
+		Payload:     data,
 	}
 	topic := messaging.EncodeMessageTopic(msg)
 	if err := s.WritersPub.Publish(ctx, topic, m); err != nil {
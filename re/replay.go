@@ -0,0 +1,181 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	pkglog "github.com/absmach/magistrala/pkg/logger"
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+const (
+	replayPrefix = "re-replay-"
+	// replayIdleTimeout stops the replay once the stream history is
+	// exhausted and no message reached the replay end time.
+	replayIdleTimeout = 30 * time.Second
+)
+
+var (
+	ErrReplayScheduled = errors.New("scheduled rules can not be replayed")
+	ErrReplayDisabled  = errors.New("disabled rules can not be replayed")
+)
+
+// Replay re-runs a rule over the messages stored in the message broker,
+// for example to re-process the messages received yesterday. Messages are
+// replayed either from StartTime or from StartSequence up to EndTime.
+type Replay struct {
+	ID            string    `json:"id"`
+	RuleID        string    `json:"rule_id"`
+	StartTime     time.Time `json:"start_time,omitzero"`
+	StartSequence uint64    `json:"start_sequence,omitempty"`
+	EndTime       time.Time `json:"end_time,omitzero"`
+	CreatedAt     time.Time `json:"created_at"`
+	CreatedBy     string    `json:"created_by"`
+}
+
+func (re *re) ReplayRule(ctx context.Context, session authn.Session, id string, rp Replay) (Replay, error) {
+	rule, err := re.repo.ViewRule(ctx, id)
+	if err != nil {
+		return Replay{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if rule.InputChannel == "" {
+		return Replay{}, errors.Wrap(svcerr.ErrMalformedEntity, ErrReplayScheduled)
+	}
+	if rule.Status != EnabledStatus {
+		return Replay{}, errors.Wrap(svcerr.ErrMalformedEntity, ErrReplayDisabled)
+	}
+
+	rid, err := re.idp.ID()
+	if err != nil {
+		return Replay{}, err
+	}
+	now := time.Now().UTC()
+	rp.ID = rid
+	rp.RuleID = rule.ID
+	rp.CreatedAt = now
+	rp.CreatedBy = session.UserID
+	if rp.EndTime.IsZero() {
+		rp.EndTime = now
+	}
+
+	r := &replay{
+		re:     re,
+		rule:   rule,
+		info:   rp,
+		topic:  messaging.EncodeTopic(rule.DomainID, rule.InputChannel, rule.InputTopic),
+		active: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	cfg := messaging.SubscriberConfig{
+		ID:             replayPrefix + rp.ID,
+		Topic:          r.topic,
+		Handler:        r,
+		DeliveryPolicy: messaging.DeliverByStartTimePolicy,
+		StartTime:      rp.StartTime,
+		Ordered:        true,
+	}
+	if rp.StartSequence > 0 {
+		cfg.DeliveryPolicy = messaging.DeliverByStartSequencePolicy
+		cfg.StartSequence = rp.StartSequence
+	}
+	// The replay outlives the request, so it must not use the request context.
+	if err := re.rePubSub.Subscribe(context.Background(), cfg); err != nil {
+		return Replay{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+	go r.run(cfg.ID)
+
+	return rp, nil
+}
+
+// replay is the handler of a temporary subscription which processes the
+// rule for each replayed message until the replay end time is reached.
+type replay struct {
+	re        *re
+	rule      Rule
+	info      Replay
+	topic     string
+	processed atomic.Uint64
+	active    chan struct{}
+	done      chan struct{}
+	once      sync.Once
+}
+
+func (r *replay) Handle(msg *messaging.Message) error {
+	select {
+	case <-r.done:
+		return nil
+	default:
+	}
+	if msg.GetCreated() >= r.info.EndTime.UnixNano() {
+		r.stop()
+		return nil
+	}
+	select {
+	case r.active <- struct{}{}:
+	default:
+	}
+	if len(msg.GetPayload()) > maxPayload || !matchTopic(msg.GetSubtopic(), r.rule.InputTopic) {
+		return nil
+	}
+	r.re.runInfo <- r.re.process(context.Background(), r.rule, msg)
+	r.processed.Add(1)
+
+	return nil
+}
+
+func (r *replay) Cancel() error {
+	return nil
+}
+
+func (r *replay) stop() {
+	r.once.Do(func() {
+		close(r.done)
+	})
+}
+
+func (r *replay) run(subID string) {
+	r.wait()
+
+	info := pkglog.RunInfo{
+		Level:   slog.LevelInfo,
+		Message: "rule replay completed",
+		Details: []slog.Attr{
+			slog.String("domain_id", r.rule.DomainID),
+			slog.String("rule_id", r.rule.ID),
+			slog.String("replay_id", r.info.ID),
+			slog.Uint64("processed", r.processed.Load()),
+		},
+	}
+	if err := r.re.rePubSub.Unsubscribe(context.Background(), subID, r.topic); err != nil {
+		info.Level = slog.LevelWarn
+		info.Message = fmt.Sprintf("rule replay completed, failed to unsubscribe: %s", err)
+	}
+	r.re.runInfo <- info
+}
+
+func (r *replay) wait() {
+	timer := time.NewTimer(replayIdleTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-r.active:
+			timer.Reset(replayIdleTimeout)
+		case <-timer.C:
+			r.stop()
+			return
+		case <-r.done:
+			return
+		}
+	}
+}
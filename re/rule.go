@@ -42,6 +42,7 @@ var outputRegistry = map[outputs.OutputType]func() Runnable{
 	outputs.ChannelsType:     func() Runnable { return &outputs.ChannelPublisher{} },
 	outputs.SaveSenMLType:    func() Runnable { return &outputs.SenML{} },
 	outputs.SlackType:        func() Runnable { return &outputs.Slack{} },
+	outputs.CommandsType:     func() Runnable { return &outputs.Command{} },
 }
 
 type Rule struct {
@@ -246,6 +247,8 @@ type Service interface {
 	RemoveRule(ctx context.Context, session authn.Session, id string) error
 	EnableRule(ctx context.Context, session authn.Session, id string) (Rule, error)
 	DisableRule(ctx context.Context, session authn.Session, id string) (Rule, error)
+	// ReplayRule starts re-processing of the rule input messages stored in the message broker.
+	ReplayRule(ctx context.Context, session authn.Session, id string, rp Replay) (Replay, error)
 
 	StartScheduler(ctx context.Context) error
 	roles.RoleManager
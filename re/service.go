@@ -27,19 +27,20 @@ var (
 )
 
 type re struct {
-	repo       Repository
-	runInfo    chan pkglog.RunInfo
-	idp        magistrala.IDProvider
-	rePubSub   messaging.PubSub
-	writersPub messaging.Publisher
-	alarmsPub  messaging.Publisher
-	ticker     ticker.Ticker
-	email      emailer.Emailer
-	readers    grpcReadersV1.ReadersServiceClient
+	repo        Repository
+	runInfo     chan pkglog.RunInfo
+	idp         magistrala.IDProvider
+	rePubSub    messaging.PubSub
+	writersPub  messaging.Publisher
+	alarmsPub   messaging.Publisher
+	commandsPub messaging.Publisher
+	ticker      ticker.Ticker
+	email       emailer.Emailer
+	readers     grpcReadersV1.ReadersServiceClient
 	roles.ProvisionManageService
 }
 
-func NewService(repo Repository, runInfo chan pkglog.RunInfo, policy policies.Service, idp magistrala.IDProvider, rePubSub messaging.PubSub, writersPub, alarmsPub messaging.Publisher, tck ticker.Ticker, emailer emailer.Emailer, readers grpcReadersV1.ReadersServiceClient, availableActions []roles.Action, builtInRoles map[roles.BuiltInRoleName][]roles.Action) (Service, error) {
+func NewService(repo Repository, runInfo chan pkglog.RunInfo, policy policies.Service, idp magistrala.IDProvider, rePubSub messaging.PubSub, writersPub, alarmsPub, commandsPub messaging.Publisher, tck ticker.Ticker, emailer emailer.Emailer, readers grpcReadersV1.ReadersServiceClient, availableActions []roles.Action, builtInRoles map[roles.BuiltInRoleName][]roles.Action) (Service, error) {
 	rpms, err := roles.NewProvisionManageService(operations.EntityType, repo, policy, idp, availableActions, builtInRoles)
 	if err != nil {
 		return nil, err
@@ -51,6 +52,7 @@ func NewService(repo Repository, runInfo chan pkglog.RunInfo, policy policies.Se
 		rePubSub:               rePubSub,
 		writersPub:             writersPub,
 		alarmsPub:              alarmsPub,
+		commandsPub:            commandsPub,
 		ticker:                 tck,
 		email:                  emailer,
 		readers:                readers,
@@ -73,7 +73,7 @@ func newService(t *testing.T, runInfo chan pkglog.RunInfo) (re.Service, *mocks.R
 	builtInRoles := map[roles.BuiltInRoleName][]roles.Action{
 		"admin": availableActions,
 	}
-	svc, err := re.NewService(repo, runInfo, policy, idProvider, pubsub, pubsub, pubsub, mockTicker, e, readersSvc, availableActions, builtInRoles)
+	svc, err := re.NewService(repo, runInfo, policy, idProvider, pubsub, pubsub, pubsub, pubsub, mockTicker, e, readersSvc, availableActions, builtInRoles)
 	if err != nil {
 		t.Fatalf("Failed to create service: %v", err)
 	}
@@ -1164,6 +1164,136 @@ func TestDisableRule(t *testing.T) {
 	}
 }
 
+func TestReplayRule(t *testing.T) {
+	svc, repo, pubsub, _, _, _ := newService(t, make(chan pkglog.RunInfo, 10))
+
+	end := time.Now().Add(-time.Hour)
+	start := end.Add(-24 * time.Hour)
+	rule := re.Rule{
+		ID:           ruleID,
+		Name:         ruleName,
+		DomainID:     domainID,
+		InputChannel: inputChannel,
+		InputTopic:   "sensors/+",
+		Status:       re.EnabledStatus,
+		Logic: re.Script{
+			Type:  re.LuaType,
+			Value: "return message.payload",
+		},
+	}
+	session := authn.Session{UserID: userID, DomainID: domainID}
+
+	cases := []struct {
+		desc     string
+		id       string
+		replay   re.Replay
+		rule     re.Rule
+		repoErr  error
+		subErr   error
+		policy   messaging.DeliveryPolicy
+		err      error
+		expected bool
+	}{
+		{
+			desc:     "replay rule by start time",
+			id:       ruleID,
+			replay:   re.Replay{StartTime: start, EndTime: end},
+			rule:     rule,
+			policy:   messaging.DeliverByStartTimePolicy,
+			expected: true,
+		},
+		{
+			desc:     "replay rule by start sequence",
+			id:       ruleID,
+			replay:   re.Replay{StartSequence: 42, EndTime: end},
+			rule:     rule,
+			policy:   messaging.DeliverByStartSequencePolicy,
+			expected: true,
+		},
+		{
+			desc:    "replay non-existing rule",
+			id:      ruleID,
+			replay:  re.Replay{StartTime: start},
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrViewEntity,
+		},
+		{
+			desc:   "replay scheduled rule",
+			id:     ruleID,
+			replay: re.Replay{StartTime: start},
+			rule:   re.Rule{ID: ruleID, DomainID: domainID, Schedule: schedule},
+			err:    re.ErrReplayScheduled,
+		},
+		{
+			desc:   "replay disabled rule",
+			id:     ruleID,
+			replay: re.Replay{StartTime: start},
+			rule: re.Rule{
+				ID:           ruleID,
+				DomainID:     domainID,
+				InputChannel: inputChannel,
+				Status:       re.DisabledStatus,
+			},
+			err: re.ErrReplayDisabled,
+		},
+		{
+			desc:   "replay rule with failed subscription",
+			id:     ruleID,
+			replay: re.Replay{StartTime: start},
+			rule:   rule,
+			subErr: errors.New("failed to subscribe"),
+			err:    svcerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			topic := messaging.EncodeTopic(domainID, inputChannel, rule.InputTopic)
+			var cfg messaging.SubscriberConfig
+			unsubscribed := make(chan struct{})
+			repoCall := repo.On("ViewRule", context.Background(), tc.id).Return(tc.rule, tc.repoErr)
+			subCall := pubsub.On("Subscribe", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				cfg = args.Get(1).(messaging.SubscriberConfig)
+			}).Return(tc.subErr).Maybe()
+			unsubCall := pubsub.On("Unsubscribe", mock.Anything, mock.Anything, topic).Run(func(args mock.Arguments) {
+				close(unsubscribed)
+			}).Return(nil).Maybe()
+			defer func() {
+				repoCall.Unset()
+				subCall.Unset()
+				unsubCall.Unset()
+			}()
+
+			res, err := svc.ReplayRule(context.Background(), session, tc.id, tc.replay)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if !tc.expected {
+				return
+			}
+			assert.NotEmpty(t, res.ID, fmt.Sprintf("%s: expected replay ID", tc.desc))
+			assert.Equal(t, ruleID, res.RuleID, fmt.Sprintf("%s: unexpected rule ID", tc.desc))
+			assert.Equal(t, topic, cfg.Topic, fmt.Sprintf("%s: unexpected replay topic", tc.desc))
+			assert.Equal(t, tc.policy, cfg.DeliveryPolicy, fmt.Sprintf("%s: unexpected delivery policy", tc.desc))
+			assert.Equal(t, tc.replay.StartSequence, cfg.StartSequence, fmt.Sprintf("%s: unexpected start sequence", tc.desc))
+
+			msg := &messaging.Message{
+				Domain:   domainID,
+				Channel:  inputChannel,
+				Subtopic: "sensors/temperature",
+				Payload:  []byte(`{"temperature": 25.5}`),
+				Created:  end.Add(-time.Minute).UnixNano(),
+			}
+			assert.Nil(t, cfg.Handler.Handle(msg), fmt.Sprintf("%s: unexpected error handling message", tc.desc))
+			msg.Created = end.UnixNano()
+			assert.Nil(t, cfg.Handler.Handle(msg), fmt.Sprintf("%s: unexpected error handling message", tc.desc))
+			select {
+			case <-unsubscribed:
+			case <-time.After(5 * time.Second):
+				assert.Fail(t, fmt.Sprintf("%s: replay did not stop at the end time", tc.desc))
+			}
+		})
+	}
+}
+
 func TestHandle(t *testing.T) {
 	svc, repo, pubmocks, _, emailer, _ := newService(t, make(chan pkglog.RunInfo))
 	now := time.Now()
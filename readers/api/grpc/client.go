@@ -57,10 +57,12 @@ func (client readersGrpcClient) ReadMessages(ctx context.Context, in *grpcReader
 			Offset:      in.GetPageMetadata().GetOffset(),
 			Limit:       in.GetPageMetadata().GetLimit(),
 			Comparator:  in.GetPageMetadata().GetComparator(),
-			Aggregation: in.GetPageMetadata().GetAggregation().String(),
+			Aggregation: stringifyAggregation(in.GetPageMetadata().GetAggregation()),
 			From:        in.GetPageMetadata().GetFrom(),
 			To:          in.GetPageMetadata().GetTo(),
 			Interval:    in.GetPageMetadata().GetInterval(),
+			Percentile:  in.GetPageMetadata().GetPercentile(),
+			Fill:        in.GetPageMetadata().GetFill(),
 			Subtopic:    in.GetPageMetadata().GetSubtopic(),
 			Publisher:   in.GetPageMetadata().GetPublisher(),
 			Protocol:    in.GetPageMetadata().GetProtocol(),
@@ -70,6 +72,8 @@ func (client readersGrpcClient) ReadMessages(ctx context.Context, in *grpcReader
 			StringValue: in.GetPageMetadata().GetStringValue(),
 			DataValue:   in.GetPageMetadata().GetDataValue(),
 			Format:      in.GetPageMetadata().GetFormat(),
+
+			PayloadFilters: decodePayloadFilters(in.GetPageMetadata().GetPayloadFilters()),
 		},
 	})
 	if err != nil {
@@ -114,6 +118,8 @@ func encodeReadMessagesRequest(_ context.Context, grpcReq any) (any, error) {
 			From:        req.pageMeta.From,
 			To:          req.pageMeta.To,
 			Interval:    req.pageMeta.Interval,
+			Percentile:  req.pageMeta.Percentile,
+			Fill:        req.pageMeta.Fill,
 			Subtopic:    req.pageMeta.Subtopic,
 			Publisher:   req.pageMeta.Publisher,
 			Protocol:    req.pageMeta.Protocol,
@@ -125,10 +131,28 @@ func encodeReadMessagesRequest(_ context.Context, grpcReq any) (any, error) {
 			Format:      req.pageMeta.Format,
 			Order:       req.pageMeta.Order,
 			Dir:         req.pageMeta.Dir,
+
+			PayloadFilters: encodePayloadFilters(req.pageMeta.PayloadFilters),
 		},
 	}, nil
 }
 
+func encodePayloadFilters(filters []readers.PayloadFilter) []*grpcReadersV1.PayloadFilter {
+	var res []*grpcReadersV1.PayloadFilter
+	for _, f := range filters {
+		value, err := json.Marshal(f.Value)
+		if err != nil {
+			continue
+		}
+		res = append(res, &grpcReadersV1.PayloadFilter{
+			Path:       f.Path,
+			Comparator: f.Comparator,
+			Value:      value,
+		})
+	}
+	return res
+}
+
 func fromResponseMessages(protoMessages []*grpcReadersV1.Message) []readers.Message {
 	var messages []readers.Message
 	for _, m := range protoMessages {
@@ -174,16 +198,28 @@ func fromResponseMessages(protoMessages []*grpcReadersV1.Message) []readers.Mess
 
 func parseAggregation(agg string) grpcReadersV1.Aggregation {
 	switch strings.ToUpper(agg) {
-	case "MAX":
+	case readers.AggregationMax:
 		return grpcReadersV1.Aggregation_AGGREGATION_MAX
-	case "MIN":
+	case readers.AggregationMin:
 		return grpcReadersV1.Aggregation_AGGREGATION_MIN
-	case "SUM":
+	case readers.AggregationSum:
 		return grpcReadersV1.Aggregation_AGGREGATION_SUM
-	case "COUNT":
+	case readers.AggregationCount:
 		return grpcReadersV1.Aggregation_AGGREGATION_COUNT
-	case "AVG":
+	case readers.AggregationAvg:
 		return grpcReadersV1.Aggregation_AGGREGATION_AVG
+	case readers.AggregationPercentile:
+		return grpcReadersV1.Aggregation_AGGREGATION_PERCENTILE
+	case readers.AggregationFirst:
+		return grpcReadersV1.Aggregation_AGGREGATION_FIRST
+	case readers.AggregationLast:
+		return grpcReadersV1.Aggregation_AGGREGATION_LAST
+	case readers.AggregationStddev:
+		return grpcReadersV1.Aggregation_AGGREGATION_STDDEV
+	case readers.AggregationRate:
+		return grpcReadersV1.Aggregation_AGGREGATION_RATE
+	case readers.AggregationIntegral:
+		return grpcReadersV1.Aggregation_AGGREGATION_INTEGRAL
 	default:
 		return grpcReadersV1.Aggregation_AGGREGATION_UNSPECIFIED
 	}
@@ -44,6 +44,8 @@ func decodeReadMessagesRequest(_ context.Context, grpcReq any) (any, error) {
 			From:        req.GetPageMetadata().GetFrom(),
 			To:          req.GetPageMetadata().GetTo(),
 			Interval:    req.GetPageMetadata().GetInterval(),
+			Percentile:  req.GetPageMetadata().GetPercentile(),
+			Fill:        req.GetPageMetadata().GetFill(),
 			Subtopic:    req.GetPageMetadata().GetSubtopic(),
 			Publisher:   req.GetPageMetadata().GetPublisher(),
 			Protocol:    req.GetPageMetadata().GetProtocol(),
@@ -55,6 +57,8 @@ func decodeReadMessagesRequest(_ context.Context, grpcReq any) (any, error) {
 			Format:      req.GetPageMetadata().GetFormat(),
 			Order:       req.GetPageMetadata().GetOrder(),
 			Dir:         req.GetPageMetadata().GetDir(),
+
+			PayloadFilters: decodePayloadFilters(req.GetPageMetadata().GetPayloadFilters()),
 		},
 	}, nil
 }
@@ -134,20 +138,48 @@ func toResponseMessages(messages []readers.Message) []*grpcReadersV1.Message {
 	return res
 }
 
+func decodePayloadFilters(filters []*grpcReadersV1.PayloadFilter) []readers.PayloadFilter {
+	var res []readers.PayloadFilter
+	for _, f := range filters {
+		var value any
+		if err := json.Unmarshal(f.GetValue(), &value); err != nil {
+			value = string(f.GetValue())
+		}
+		res = append(res, readers.PayloadFilter{
+			Path:       f.GetPath(),
+			Comparator: f.GetComparator(),
+			Value:      value,
+		})
+	}
+	return res
+}
+
 func stringifyAggregation(agg grpcReadersV1.Aggregation) string {
 	switch agg {
 	case grpcReadersV1.Aggregation_AGGREGATION_UNSPECIFIED:
 		return ""
 	case grpcReadersV1.Aggregation_AGGREGATION_MAX:
-		return "MAX"
+		return readers.AggregationMax
 	case grpcReadersV1.Aggregation_AGGREGATION_MIN:
-		return "MIN"
+		return readers.AggregationMin
 	case grpcReadersV1.Aggregation_AGGREGATION_AVG:
-		return "AVG"
+		return readers.AggregationAvg
 	case grpcReadersV1.Aggregation_AGGREGATION_SUM:
-		return "SUM"
+		return readers.AggregationSum
 	case grpcReadersV1.Aggregation_AGGREGATION_COUNT:
-		return "COUNT"
+		return readers.AggregationCount
+	case grpcReadersV1.Aggregation_AGGREGATION_PERCENTILE:
+		return readers.AggregationPercentile
+	case grpcReadersV1.Aggregation_AGGREGATION_FIRST:
+		return readers.AggregationFirst
+	case grpcReadersV1.Aggregation_AGGREGATION_LAST:
+		return readers.AggregationLast
+	case grpcReadersV1.Aggregation_AGGREGATION_STDDEV:
+		return readers.AggregationStddev
+	case grpcReadersV1.Aggregation_AGGREGATION_RATE:
+		return readers.AggregationRate
+	case grpcReadersV1.Aggregation_AGGREGATION_INTEGRAL:
+		return readers.AggregationIntegral
 	default:
 		return ""
 	}
@@ -503,6 +503,88 @@ func TestReadAll(t *testing.T) {
 			key:    clientToken,
 			status: http.StatusBadRequest,
 		},
+		{
+			desc:   "read page with percentile aggregation, interval, to and from as client",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?aggregation=PERCENTILE&percentile=95&interval=10h&from=%f&to=%f", ts.URL, domainID, chanID, messages[19].Time, messages[4].Time),
+			key:    clientToken,
+			status: http.StatusOK,
+			res: pageRes{
+				PageMetadata: readers.PageMetadata{Limit: 10, Format: "messages", Aggregation: "PERCENTILE", Percentile: 95, Interval: "10h", From: messages[19].Time, To: messages[4].Time, Order: "time", Dir: "desc"},
+				Total:        uint64(len(messages[5:20])),
+				Messages:     messages[5:15],
+			},
+		},
+		{
+			desc:   "read page with percentile aggregation and missing percentile as client",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?aggregation=PERCENTILE&interval=10h&from=%f&to=%f", ts.URL, domainID, chanID, messages[19].Time, messages[4].Time),
+			key:    clientToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page with percentile aggregation and invalid percentile as client",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?aggregation=PERCENTILE&percentile=100&interval=10h&from=%f&to=%f", ts.URL, domainID, chanID, messages[19].Time, messages[4].Time),
+			key:    clientToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page with aggregation and fill as client",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?aggregation=LAST&fill=linear&interval=10h&from=%f&to=%f", ts.URL, domainID, chanID, messages[19].Time, messages[4].Time),
+			key:    clientToken,
+			status: http.StatusOK,
+			res: pageRes{
+				PageMetadata: readers.PageMetadata{Limit: 10, Format: "messages", Aggregation: "LAST", Fill: "linear", Interval: "10h", From: messages[19].Time, To: messages[4].Time, Order: "time", Dir: "desc"},
+				Total:        uint64(len(messages[5:20])),
+				Messages:     messages[5:15],
+			},
+		},
+		{
+			desc:   "read page with payload filters as client",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?format=json&payload.battery.level=gt:20&payload.status=ok", ts.URL, domainID, chanID),
+			key:    clientToken,
+			status: http.StatusOK,
+			res: pageRes{
+				PageMetadata: readers.PageMetadata{
+					Limit:  10,
+					Format: "json",
+					Order:  "time",
+					Dir:    "desc",
+					PayloadFilters: []readers.PayloadFilter{
+						{Path: "battery.level", Comparator: readers.GreaterThanKey, Value: float64(20)},
+						{Path: "status", Comparator: readers.EqualKey, Value: "ok"},
+					},
+				},
+			},
+		},
+		{
+			desc:   "read page with invalid payload filter path as client",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?format=json&payload.battery..level=gt:20", ts.URL, domainID, chanID),
+			key:    clientToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page with payload filter and SenML format as client",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?payload.battery.level=gt:20", ts.URL, domainID, chanID),
+			key:    clientToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page with aggregation and invalid fill as client",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?aggregation=LAST&fill=invalid&interval=10h&from=%f&to=%f", ts.URL, domainID, chanID, messages[19].Time, messages[4].Time),
+			key:    clientToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page with aggregation and zero interval as client",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?aggregation=MAX&interval=0s&from=%f&to=%f", ts.URL, domainID, chanID, messages[19].Time, messages[4].Time),
+			key:    clientToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page with aggregation and too many buckets as client",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?aggregation=MAX&interval=1m&from=%d&to=%d", ts.URL, domainID, chanID, 1, int64(30*24*time.Hour)),
+			key:    clientToken,
+			status: http.StatusBadRequest,
+		},
 		{
 			desc:   "read page with aggregation, interval and to with missing from as client",
 			url:    fmt.Sprintf("%s/%s/channels/%s/messages?aggregation=MAX&interval=10h&to=%f", ts.URL, domainID, chanID, messages[4].Time),
@@ -9,13 +9,12 @@ import (
 	"time"
 
 	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/absmach/magistrala/readers"
 )
 
 const maxLimitSize = 1000
 
-var validAggregations = []string{"MAX", "MIN", "AVG", "SUM", "COUNT"}
-
 type listMessagesReq struct {
 	chanID   string
 	token    string
@@ -55,13 +54,38 @@ func (req listMessagesReq) validate() error {
 			return apiutil.ErrMissingTo
 		}
 
-		if !slices.Contains(validAggregations, strings.ToUpper(req.pageMeta.Aggregation)) {
+		if !slices.Contains(readers.Aggregations, strings.ToUpper(req.pageMeta.Aggregation)) {
 			return apiutil.ErrInvalidAggregation
 		}
 
-		if _, err := time.ParseDuration(req.pageMeta.Interval); err != nil {
+		interval, err := time.ParseDuration(req.pageMeta.Interval)
+		if err != nil || interval <= 0 {
 			return apiutil.ErrInvalidInterval
 		}
+
+		if (req.pageMeta.To-req.pageMeta.From)/float64(interval.Nanoseconds()) > readers.MaxBuckets {
+			return apiutil.ErrTooManyBuckets
+		}
+
+		if strings.EqualFold(req.pageMeta.Aggregation, readers.AggregationPercentile) &&
+			(req.pageMeta.Percentile <= 0 || req.pageMeta.Percentile >= 100) {
+			return apiutil.ErrInvalidPercentile
+		}
+
+		if req.pageMeta.Fill != "" && !slices.Contains(readers.Fills, strings.ToLower(req.pageMeta.Fill)) {
+			return apiutil.ErrInvalidFill
+		}
+	}
+
+	if len(req.pageMeta.PayloadFilters) > 0 {
+		if req.pageMeta.Format == "" || req.pageMeta.Format == defFormat {
+			return apiutil.ErrInvalidPayloadFilter
+		}
+		for _, f := range req.pageMeta.PayloadFilters {
+			if err := f.Validate(); err != nil {
+				return errors.Wrap(apiutil.ErrInvalidPayloadFilter, err)
+			}
+		}
 	}
 
 	return nil
@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/absmach/magistrala"
 	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
@@ -42,12 +44,23 @@ const (
 	toKey          = "to"
 	aggregationKey = "aggregation"
 	intervalKey    = "interval"
+	percentileKey  = "percentile"
+	fillKey        = "fill"
+	payloadPrefix  = "payload."
 	defInterval    = "1s"
 	defLimit       = 10
 	defOffset      = 0
 	defFormat      = "messages"
 )
 
+var comparators = []string{
+	readers.EqualKey,
+	readers.LowerThanKey,
+	readers.LowerThanEqualKey,
+	readers.GreaterThanKey,
+	readers.GreaterThanEqualKey,
+}
+
 // MakeHandler returns a HTTP handler for API endpoints.
 func MakeHandler(svc readers.MessageRepository, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, svcName, instanceID string) http.Handler {
 	opts := []kithttp.ServerOption{
@@ -154,12 +167,23 @@ func decodeList(_ context.Context, r *http.Request) (any, error) {
 		return nil, errors.Wrap(apiutil.ErrValidation, err)
 	}
 
-	var interval string
+	var interval, fill string
+	var percentile float64
 	if aggregation != "" {
 		interval, err = apiutil.ReadStringQuery(r, intervalKey, defInterval)
 		if err != nil {
 			return nil, errors.Wrap(apiutil.ErrValidation, err)
 		}
+
+		percentile, err = apiutil.ReadNumQuery[float64](r, percentileKey, 0)
+		if err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		fill, err = apiutil.ReadStringQuery(r, fillKey, "")
+		if err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
 	}
 
 	req := listMessagesReq{
@@ -184,13 +208,50 @@ func decodeList(_ context.Context, r *http.Request) (any, error) {
 			To:          to,
 			Aggregation: aggregation,
 			Interval:    interval,
+			Percentile:  percentile,
+			Fill:        fill,
 			Order:       order,
 			Dir:         dir,
+
+			PayloadFilters: decodePayloadFilters(r),
 		},
 	}
 	return req, nil
 }
 
+// decodePayloadFilters reads JSON payload filters from query parameters in
+// form of payload.<path>=[<comparator>:]<value>, e.g. payload.battery.level=gt:20.
+// Value is decoded as JSON if possible and used as a string otherwise.
+func decodePayloadFilters(r *http.Request) []readers.PayloadFilter {
+	var filters []readers.PayloadFilter
+	for key, vals := range r.URL.Query() {
+		path, ok := strings.CutPrefix(key, payloadPrefix)
+		if !ok {
+			continue
+		}
+		for _, val := range vals {
+			comparator := readers.EqualKey
+			if c, v, ok := strings.Cut(val, ":"); ok && slices.Contains(comparators, c) {
+				comparator, val = c, v
+			}
+			var value any
+			if err := json.Unmarshal([]byte(val), &value); err != nil {
+				value = val
+			}
+			filters = append(filters, readers.PayloadFilter{
+				Path:       path,
+				Comparator: comparator,
+				Value:      value,
+			})
+		}
+	}
+	slices.SortStableFunc(filters, func(a, b readers.PayloadFilter) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return filters
+}
+
 func encodeResponse(_ context.Context, w http.ResponseWriter, response any) error {
 	w.Header().Set("Content-Type", contentType)
 
@@ -214,7 +275,13 @@ func authnAuthz(ctx context.Context, req listMessagesReq, authn smqauthn.Authent
 	if err != nil {
 		return err
 	}
-	if err := authorize(ctx, clientID, clientType, req.chanID, req.domain, channels); err != nil {
+	// Reading without a subtopic filter returns the messages of all the
+	// channel subtopics.
+	subtopic := req.pageMeta.Subtopic
+	if subtopic == "" {
+		subtopic = "#"
+	}
+	if err := authorize(ctx, clientID, clientType, req.chanID, req.domain, subtopic, channels); err != nil {
 		return err
 	}
 	return nil
@@ -248,13 +315,14 @@ func authenticate(ctx context.Context, req listMessagesReq, authn smqauthn.Authe
 	}
 }
 
-func authorize(ctx context.Context, clientID, clientType, chanID, domain string, channels grpcChannelsV1.ChannelsServiceClient) (err error) {
+func authorize(ctx context.Context, clientID, clientType, chanID, domain, subtopic string, channels grpcChannelsV1.ChannelsServiceClient) (err error) {
 	res, err := channels.Authorize(ctx, &grpcChannelsV1.AuthzReq{
 		ClientId:   clientID,
 		ClientType: clientType,
 		Type:       uint32(connections.Subscribe),
 		ChannelId:  chanID,
 		DomainId:   domain,
+		Subtopic:   subtopic,
 	})
 	if err != nil {
 		return errors.Wrap(svcerr.ErrAuthorization, err)
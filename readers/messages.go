@@ -3,7 +3,11 @@
 
 package readers
 
-import "errors"
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
 
 const (
 	// EqualKey represents the equal comparison operator key.
@@ -18,8 +22,80 @@ const (
 	GreaterThanEqualKey = "ge"
 )
 
-// ErrReadMessages indicates failure occurred while reading messages from database.
-var ErrReadMessages = errors.New("failed to read messages from database")
+const (
+	// AggregationMax returns the maximum value in the bucket.
+	AggregationMax = "MAX"
+	// AggregationMin returns the minimum value in the bucket.
+	AggregationMin = "MIN"
+	// AggregationSum returns the sum of values in the bucket.
+	AggregationSum = "SUM"
+	// AggregationCount returns the number of values in the bucket.
+	AggregationCount = "COUNT"
+	// AggregationAvg returns the average value in the bucket.
+	AggregationAvg = "AVG"
+	// AggregationPercentile returns the continuous percentile of values in the bucket.
+	AggregationPercentile = "PERCENTILE"
+	// AggregationFirst returns the earliest value in the bucket.
+	AggregationFirst = "FIRST"
+	// AggregationLast returns the latest value in the bucket.
+	AggregationLast = "LAST"
+	// AggregationStddev returns the sample standard deviation of values in the bucket.
+	AggregationStddev = "STDDEV"
+	// AggregationRate returns the rate of change per second in the bucket.
+	AggregationRate = "RATE"
+	// AggregationIntegral returns the time integral (value * seconds) in the bucket,
+	// e.g. energy from power readings, using the trapezoidal rule.
+	AggregationIntegral = "INTEGRAL"
+)
+
+const (
+	// FillNone leaves empty buckets out of the result.
+	FillNone = "none"
+	// FillNull returns empty buckets with a null value.
+	FillNull = "null"
+	// FillPrevious fills empty buckets with the last known value.
+	FillPrevious = "previous"
+	// FillLinear fills empty buckets with linear interpolation between neighbours.
+	FillLinear = "linear"
+)
+
+// Aggregations contains all supported aggregation functions.
+var Aggregations = []string{
+	AggregationMax,
+	AggregationMin,
+	AggregationSum,
+	AggregationCount,
+	AggregationAvg,
+	AggregationPercentile,
+	AggregationFirst,
+	AggregationLast,
+	AggregationStddev,
+	AggregationRate,
+	AggregationIntegral,
+}
+
+// Fills contains all supported gap-filling strategies.
+var Fills = []string{FillNone, FillNull, FillPrevious, FillLinear}
+
+// maxPayloadPathDepth is the maximum number of elements of a payload filter path.
+const maxPayloadPathDepth = 16
+
+// MaxBuckets is the maximum number of buckets of an aggregated query, that is
+// the time range divided by the aggregation interval.
+const MaxBuckets = 10000
+
+var (
+	// ErrReadMessages indicates failure occurred while reading messages from database.
+	ErrReadMessages = errors.New("failed to read messages from database")
+
+	// ErrInvalidPayloadPath indicates payload filter path is malformed.
+	ErrInvalidPayloadPath = errors.New("invalid payload path")
+
+	// ErrInvalidComparator indicates payload filter comparator is not supported.
+	ErrInvalidComparator = errors.New("invalid comparator")
+
+	payloadPathElemRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+)
 
 // MessageRepository specifies message reader API.
 type MessageRepository interface {
@@ -59,6 +135,68 @@ type PageMetadata struct {
 	Format      string  `json:"format,omitempty"`
 	Aggregation string  `json:"aggregation,omitempty"`
 	Interval    string  `json:"interval,omitempty"`
+	Percentile  float64 `json:"percentile,omitempty"`
+	Fill        string  `json:"fill,omitempty"`
+
+	PayloadFilters []PayloadFilter `json:"payload_filters,omitempty"`
+}
+
+// PayloadFilter represents a condition on a nested field of JSON message payload,
+// e.g. "battery.level" greater than 20. It applies to JSON formats only.
+type PayloadFilter struct {
+	// Path is a dot-separated path to the payload field. Numeric elements
+	// address array items.
+	Path string `json:"path"`
+
+	// Comparator is one of the comparison operator keys, defaults to equal.
+	Comparator string `json:"comparator,omitempty"`
+
+	// Value is compared with the payload field value and must be of the same
+	// JSON type for the ordering comparators.
+	Value any `json:"value"`
+}
+
+// PathElements validates the filter path and returns its elements.
+func (pf PayloadFilter) PathElements() ([]string, error) {
+	elems := strings.Split(pf.Path, ".")
+	if len(elems) > maxPayloadPathDepth {
+		return nil, ErrInvalidPayloadPath
+	}
+	for _, elem := range elems {
+		if !payloadPathElemRegexp.MatchString(elem) {
+			return nil, ErrInvalidPayloadPath
+		}
+	}
+
+	return elems, nil
+}
+
+// Operator returns mathematical notation of the filter comparator.
+func (pf PayloadFilter) Operator() (string, error) {
+	switch pf.Comparator {
+	case "", EqualKey:
+		return "=", nil
+	case LowerThanKey:
+		return "<", nil
+	case LowerThanEqualKey:
+		return "<=", nil
+	case GreaterThanKey:
+		return ">", nil
+	case GreaterThanEqualKey:
+		return ">=", nil
+	default:
+		return "", ErrInvalidComparator
+	}
+}
+
+// Validate checks if the filter path and comparator are valid.
+func (pf PayloadFilter) Validate() error {
+	if _, err := pf.PathElements(); err != nil {
+		return err
+	}
+	_, err := pf.Operator()
+
+	return err
 }
 
 // ParseValueComparator convert comparison operator keys into mathematic anotation.
@@ -72,6 +72,15 @@ func migrateDB(db *sqlx.DB) error {
 					"DROP TABLE messages",
 				},
 			},
+			{
+				Id: "messages_3",
+				Up: []string{
+					`ALTER TABLE messages ADD COLUMN IF NOT EXISTS headers JSONB`,
+				},
+				Down: []string{
+					`ALTER TABLE messages DROP COLUMN IF EXISTS headers`,
+				},
+			},
 		},
 	}
 
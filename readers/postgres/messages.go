@@ -6,7 +6,12 @@ package postgres
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"math"
+	"strings"
+	"time"
 
+	api "github.com/absmach/magistrala/api/http"
 	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/absmach/magistrala/pkg/transformers/senml"
 	"github.com/absmach/magistrala/readers"
@@ -15,6 +20,11 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+// SenML time is stored in nanoseconds.
+const timeDivisor = 1000000000
+
+var errInvalidInterval = errors.New("invalid aggregation interval")
+
 var _ readers.MessageRepository = (*postgresRepository)(nil)
 
 type postgresRepository struct {
@@ -38,9 +48,31 @@ func (tr postgresRepository) ReadAll(chanID string, rpm readers.PageMetadata) (r
 	}
 	cond := fmtCondition(chanID, rpm)
 
+	payloadParams := map[string]any{}
+	if format != defTable {
+		conditions, params, err := fmtPayloadConditions(rpm.PayloadFilters)
+		if err != nil {
+			return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+		}
+		for _, c := range conditions {
+			cond = fmt.Sprintf(`%s AND %s`, cond, c)
+		}
+		payloadParams = params
+	}
+
 	q := fmt.Sprintf(`SELECT * FROM %s
     WHERE %s ORDER BY %s DESC
 	LIMIT :limit OFFSET :offset;`, format, cond, order)
+	totalQuery := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s;`, format, cond)
+
+	if format == defTable && rpm.Aggregation != "" && rpm.Interval != "" {
+		aggQuery, err := fmtAggregation(cond, rpm)
+		if err != nil {
+			return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+		}
+		q = fmt.Sprintf(`%s %s LIMIT :limit OFFSET :offset;`, aggQuery, aggOrdering(rpm))
+		totalQuery = fmt.Sprintf(`SELECT COUNT(*) FROM (%s) AS subquery;`, aggQuery)
+	}
 
 	params := map[string]any{
 		"channel":      chanID,
@@ -56,7 +88,10 @@ func (tr postgresRepository) ReadAll(chanID string, rpm readers.PageMetadata) (r
 		"data_value":   rpm.DataValue,
 		"from":         rpm.From,
 		"to":           rpm.To,
+		"percentile":   rpm.Percentile,
 	}
+	maps.Copy(params, payloadParams)
+
 	rows, err := tr.db.NamedQuery(q, params)
 	if err != nil {
 		if preErr, ok := err.(*pgconn.PrepareError); ok {
@@ -82,8 +117,12 @@ func (tr postgresRepository) ReadAll(chanID string, rpm readers.PageMetadata) (r
 			if err := rows.StructScan(&msg); err != nil {
 				return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
 			}
+			m, err := msg.toSenML()
+			if err != nil {
+				return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+			}
 
-			page.Messages = append(page.Messages, msg.Message)
+			page.Messages = append(page.Messages, m)
 		}
 	default:
 		for rows.Next() {
@@ -99,8 +138,7 @@ func (tr postgresRepository) ReadAll(chanID string, rpm readers.PageMetadata) (r
 		}
 	}
 
-	q = fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s;`, format, cond)
-	rows, err = tr.db.NamedQuery(q, params)
+	rows, err = tr.db.NamedQuery(totalQuery, params)
 	if err != nil {
 		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
 	}
@@ -168,9 +206,182 @@ func fmtCondition(chanID string, rpm readers.PageMetadata) string {
 	return condition
 }
 
+// fmtPayloadConditions returns conditions on nested JSON payload fields
+// together with their query parameters. Field paths and values are bound as
+// parameters, so only validated path elements reach the query.
+func fmtPayloadConditions(filters []readers.PayloadFilter) ([]string, map[string]any, error) {
+	conditions := []string{}
+	params := map[string]any{}
+	for i, f := range filters {
+		elems, err := f.PathElements()
+		if err != nil {
+			return nil, nil, err
+		}
+		op, err := f.Operator()
+		if err != nil {
+			return nil, nil, err
+		}
+		val, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		path := fmt.Sprintf("payload_path_%d", i)
+		value := fmt.Sprintf("payload_value_%d", i)
+		params[path] = fmt.Sprintf("{%s}", strings.Join(elems, ","))
+		params[value] = string(val)
+
+		field := fmt.Sprintf("payload #> CAST(:%s AS TEXT[])", path)
+		cond := fmt.Sprintf("%s %s CAST(:%s AS JSONB)", field, op, value)
+		if op != "=" {
+			// JSONB values of different types are ordered by type, so restrict
+			// ordering comparison to the fields of the same type as the value.
+			cond = fmt.Sprintf("jsonb_typeof(%s) = jsonb_typeof(CAST(:%s AS JSONB)) AND %s", field, value, cond)
+		}
+		conditions = append(conditions, cond)
+	}
+
+	return conditions, params, nil
+}
+
+// fmtAggregation returns the query which groups SenML messages into buckets of
+// the given interval and applies the aggregation function to their values.
+// Since time is stored in nanoseconds, buckets are computed arithmetically.
+// Empty buckets are generated and filled when fill strategy is requested.
+func fmtAggregation(cond string, rpm readers.PageMetadata) (string, error) {
+	interval, err := time.ParseDuration(rpm.Interval)
+	if err != nil {
+		return "", err
+	}
+	if interval <= 0 {
+		return "", errInvalidInterval
+	}
+	width := interval.Nanoseconds()
+
+	source := defTable
+	if strings.EqualFold(rpm.Aggregation, readers.AggregationIntegral) {
+		// Integral needs the previous sample of the same series for the trapezoidal rule.
+		source = fmt.Sprintf(`(SELECT *,
+				LAG(value) OVER w AS prev_value,
+				LAG(time) OVER w AS prev_time
+			FROM %s
+			WHERE %s
+			WINDOW w AS (PARTITION BY publisher, subtopic, name ORDER BY time)) AS m`, defTable, cond)
+	}
+
+	agg := fmt.Sprintf(`
+		SELECT
+			FLOOR(time / %[1]d) AS bucket,
+			FLOOR(time / %[1]d) * %[1]d AS time,
+			%[2]s AS value,
+			COALESCE((ARRAY_AGG(publisher ORDER BY time))[1], '') AS publisher,
+			COALESCE((ARRAY_AGG(protocol ORDER BY time))[1], '') AS protocol,
+			COALESCE((ARRAY_AGG(subtopic ORDER BY time))[1], '') AS subtopic,
+			COALESCE((ARRAY_AGG(name ORDER BY time))[1], '') AS name,
+			COALESCE((ARRAY_AGG(unit ORDER BY time))[1], '') AS unit
+		FROM
+			%[3]s
+		WHERE
+			%[4]s
+		GROUP BY 1`,
+		width, aggregationExpr(rpm.Aggregation), source, cond)
+
+	fill := strings.ToLower(rpm.Fill)
+	if fill == "" || fill == readers.FillNone {
+		return fmt.Sprintf(`SELECT time, value, publisher, protocol, subtopic, name, unit FROM (%s) AS agg`, agg), nil
+	}
+
+	value := "value"
+	switch fill {
+	case readers.FillPrevious:
+		value = "COALESCE(value, prev_value)"
+	case readers.FillLinear:
+		value = "COALESCE(value, prev_value + (next_value - prev_value) * (time - prev_time) / NULLIF(next_time - prev_time, 0))"
+	}
+
+	// Buckets without data get value of the closest non-empty bucket before
+	// and after them, which are found by counting non-empty buckets.
+	return fmt.Sprintf(`
+		WITH agg AS (%[1]s),
+		buckets AS (SELECT generate_series(%[2]d, %[3]d) AS bucket),
+		series AS (
+			SELECT
+				b.bucket * %[4]d AS time,
+				a.value,
+				COALESCE(a.publisher, '') AS publisher,
+				COALESCE(a.protocol, '') AS protocol,
+				COALESCE(a.subtopic, '') AS subtopic,
+				COALESCE(a.name, '') AS name,
+				COALESCE(a.unit, '') AS unit,
+				COUNT(a.value) OVER (ORDER BY b.bucket) AS prev_group,
+				COUNT(a.value) OVER (ORDER BY b.bucket DESC) AS next_group
+			FROM buckets b LEFT JOIN agg a ON a.bucket = b.bucket
+		)
+		SELECT time, %[5]s AS value, publisher, protocol, subtopic, name, unit
+		FROM (
+			SELECT *,
+				MAX(value) OVER (PARTITION BY prev_group) AS prev_value,
+				MAX(CASE WHEN value IS NOT NULL THEN time END) OVER (PARTITION BY prev_group) AS prev_time,
+				MAX(value) OVER (PARTITION BY next_group) AS next_value,
+				MAX(CASE WHEN value IS NOT NULL THEN time END) OVER (PARTITION BY next_group) AS next_time
+			FROM series
+		) AS filled`,
+		agg, int64(math.Floor(rpm.From/float64(width))), int64(math.Ceil(rpm.To/float64(width)))-1, width, value), nil
+}
+
+// aggOrdering returns the ORDER BY clause of the aggregated query. Buckets
+// are ordered by time, unless ordering by one of the other bucket columns
+// is requested.
+func aggOrdering(rpm readers.PageMetadata) string {
+	dir := strings.ToUpper(rpm.Dir)
+	if !strings.EqualFold(dir, api.AscDir) {
+		dir = strings.ToUpper(api.DescDir)
+	}
+
+	switch rpm.Order {
+	case "value", "publisher", "protocol", "subtopic", "name", "unit":
+		return fmt.Sprintf("ORDER BY %s %s, time %s", rpm.Order, dir, dir)
+	default:
+		return fmt.Sprintf("ORDER BY time %s", dir)
+	}
+}
+
+// aggregationExpr returns SQL expression which computes aggregated value of a bucket.
+func aggregationExpr(agg string) string {
+	switch strings.ToUpper(agg) {
+	case readers.AggregationPercentile:
+		return "PERCENTILE_CONT(CAST(:percentile AS DOUBLE PRECISION) / 100) WITHIN GROUP (ORDER BY value)"
+	case readers.AggregationFirst:
+		return "(ARRAY_AGG(value ORDER BY time) FILTER (WHERE value IS NOT NULL))[1]"
+	case readers.AggregationLast:
+		return "(ARRAY_AGG(value ORDER BY time DESC) FILTER (WHERE value IS NOT NULL))[1]"
+	case readers.AggregationStddev:
+		return "STDDEV_SAMP(value)"
+	case readers.AggregationRate:
+		return fmt.Sprintf(`((ARRAY_AGG(value ORDER BY time DESC) FILTER (WHERE value IS NOT NULL))[1] -
+			(ARRAY_AGG(value ORDER BY time) FILTER (WHERE value IS NOT NULL))[1]) /
+			NULLIF((MAX(time) - MIN(time)) / %d, 0)`, timeDivisor)
+	case readers.AggregationIntegral:
+		return fmt.Sprintf("COALESCE(SUM((value + prev_value) / 2 * (time - prev_time) / %d), 0)", timeDivisor)
+	default:
+		return fmt.Sprintf("%s(value)", agg)
+	}
+}
+
 type senmlMessage struct {
 	ID string `db:"id"`
 	senml.Message
+	Headers []byte `db:"headers"`
+}
+
+func (msg senmlMessage) toSenML() (senml.Message, error) {
+	if msg.Headers != nil {
+		if err := json.Unmarshal(msg.Headers, &msg.Message.Headers); err != nil {
+			return senml.Message{}, err
+		}
+	}
+
+	return msg.Message, nil
 }
 
 type jsonMessage struct {
@@ -181,6 +392,7 @@ type jsonMessage struct {
 	Publisher string `db:"publisher"`
 	Protocol  string `db:"protocol"`
 	Payload   []byte `db:"payload"`
+	Headers   []byte `db:"headers"`
 }
 
 func (msg jsonMessage) toMap() (map[string]any, error) {
@@ -198,5 +410,12 @@ func (msg jsonMessage) toMap() (map[string]any, error) {
 		return nil, err
 	}
 	ret["payload"] = pld
+	if msg.Headers != nil {
+		headers := make(map[string]string)
+		if err := json.Unmarshal(msg.Headers, &headers); err != nil {
+			return nil, err
+		}
+		ret["headers"] = headers
+	}
 	return ret, nil
 }
@@ -6,6 +6,7 @@ package postgres_test
 import (
 	"context"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -518,6 +519,172 @@ func TestReadSenml(t *testing.T) {
 	}
 }
 
+func TestReadMessagesWithExtendedAggregation(t *testing.T) {
+	writer := pwriter.New(db)
+
+	chanID := testsutil.GenerateUUID(t)
+	pubID := testsutil.GenerateUUID(t)
+
+	// Two series of 10 messages one second apart, two minutes apart from each other.
+	base := time.Now().Truncate(time.Hour).Add(-time.Hour).Add(10 * time.Minute)
+	messages := []senml.Message{}
+	for i := 0; i < 10; i++ {
+		for j, offset := range []time.Duration{0, 2 * time.Minute} {
+			v := float64(i + j*20)
+			messages = append(messages, senml.Message{
+				Channel:   chanID,
+				Publisher: pubID,
+				Name:      msgName,
+				Time:      float64(base.Add(offset).Add(time.Duration(i) * time.Second).UnixNano()),
+				Value:     &v,
+				Protocol:  mqttProt,
+			})
+		}
+	}
+
+	err := writer.ConsumeBlocking(context.TODO(), messages)
+	require.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+
+	reader := preader.New(db)
+
+	from := float64(base.UnixNano())
+	to := float64(base.Add(time.Minute).UnixNano())
+	nilValue := math.NaN()
+
+	cases := []struct {
+		desc     string
+		pageMeta readers.PageMetadata
+		values   []float64
+	}{
+		{
+			desc: "read message page with PERCENTILE aggregation",
+			pageMeta: readers.PageMetadata{
+				Aggregation: readers.AggregationPercentile,
+				Percentile:  50,
+				Interval:    "1h",
+				From:        from,
+				To:          to,
+			},
+			values: []float64{4.5},
+		},
+		{
+			desc: "read message page with FIRST aggregation",
+			pageMeta: readers.PageMetadata{
+				Aggregation: readers.AggregationFirst,
+				Interval:    "1h",
+				From:        from,
+				To:          to,
+			},
+			values: []float64{0},
+		},
+		{
+			desc: "read message page with LAST aggregation",
+			pageMeta: readers.PageMetadata{
+				Aggregation: readers.AggregationLast,
+				Interval:    "1h",
+				From:        from,
+				To:          to,
+			},
+			values: []float64{9},
+		},
+		{
+			desc: "read message page with STDDEV aggregation",
+			pageMeta: readers.PageMetadata{
+				Aggregation: readers.AggregationStddev,
+				Interval:    "1h",
+				From:        from,
+				To:          to,
+			},
+			values: []float64{math.Sqrt(82.5 / 9)},
+		},
+		{
+			desc: "read message page with RATE aggregation",
+			pageMeta: readers.PageMetadata{
+				Aggregation: readers.AggregationRate,
+				Interval:    "1h",
+				From:        from,
+				To:          to,
+			},
+			values: []float64{1},
+		},
+		{
+			desc: "read message page with INTEGRAL aggregation",
+			pageMeta: readers.PageMetadata{
+				Aggregation: readers.AggregationIntegral,
+				Interval:    "1h",
+				From:        from,
+				To:          to,
+			},
+			values: []float64{40.5},
+		},
+		{
+			desc: "read message page with null gap filling",
+			pageMeta: readers.PageMetadata{
+				Aggregation: readers.AggregationLast,
+				Interval:    "1m",
+				Fill:        readers.FillNull,
+				From:        float64(base.Add(-time.Minute).UnixNano()),
+				To:          float64(base.Add(3 * time.Minute).UnixNano()),
+			},
+			values: []float64{29, nilValue, 9, nilValue},
+		},
+		{
+			desc: "read message page with null gap filling in ascending order",
+			pageMeta: readers.PageMetadata{
+				Aggregation: readers.AggregationLast,
+				Interval:    "1m",
+				Fill:        readers.FillNull,
+				Dir:         "asc",
+				From:        float64(base.Add(-time.Minute).UnixNano()),
+				To:          float64(base.Add(3 * time.Minute).UnixNano()),
+			},
+			values: []float64{nilValue, 9, nilValue, 29},
+		},
+		{
+			desc: "read message page with previous value gap filling",
+			pageMeta: readers.PageMetadata{
+				Aggregation: readers.AggregationLast,
+				Interval:    "1m",
+				Fill:        readers.FillPrevious,
+				From:        float64(base.Add(-time.Minute).UnixNano()),
+				To:          float64(base.Add(3 * time.Minute).UnixNano()),
+			},
+			values: []float64{29, 9, 9, nilValue},
+		},
+		{
+			desc: "read message page with linear gap filling",
+			pageMeta: readers.PageMetadata{
+				Aggregation: readers.AggregationLast,
+				Interval:    "1m",
+				Fill:        readers.FillLinear,
+				From:        float64(base.Add(-time.Minute).UnixNano()),
+				To:          float64(base.Add(3 * time.Minute).UnixNano()),
+			},
+			values: []float64{29, 19, 9, nilValue},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			tc.pageMeta.Limit = 10
+			page, err := reader.ReadAll(chanID, tc.pageMeta)
+			require.Nil(t, err, fmt.Sprintf("expected no error got %s", err))
+			require.Equal(t, uint64(len(tc.values)), page.Total)
+			require.Len(t, page.Messages, len(tc.values))
+			for i, expected := range tc.values {
+				msg, ok := page.Messages[i].(senml.Message)
+				require.True(t, ok, "expected SenML message")
+				if math.IsNaN(expected) {
+					assert.Nil(t, msg.Value, fmt.Sprintf("expected empty bucket %d", i))
+					continue
+				}
+				require.NotNil(t, msg.Value, fmt.Sprintf("expected value in bucket %d", i))
+				assert.InDelta(t, expected, *msg.Value, 0.0001, fmt.Sprintf("bucket %d: expected %v got %v", i, expected, *msg.Value))
+			}
+		})
+	}
+}
+
 func TestReadJSON(t *testing.T) {
 	writer := pwriter.New(db)
 
@@ -630,6 +797,50 @@ func TestReadJSON(t *testing.T) {
 				Messages: fromJSON(msgs2[msgsNum-20 : msgsNum]),
 			},
 		},
+		"read message with payload filter": {
+			chanID: id1,
+			pageMeta: readers.PageMetadata{
+				Format: messages1.Format,
+				Offset: 0,
+				Limit:  10,
+				PayloadFilters: []readers.PayloadFilter{
+					{Path: "field_5.field_2", Comparator: readers.GreaterThanKey, Value: 40.0},
+					{Path: "field_2", Value: "value"},
+				},
+			},
+			page: readers.MessagesPage{
+				Total:    100,
+				Messages: fromJSON(msgs1[:10]),
+			},
+		},
+		"read message with unmatched payload filter": {
+			chanID: id1,
+			pageMeta: readers.PageMetadata{
+				Format: messages1.Format,
+				Offset: 0,
+				Limit:  10,
+				PayloadFilters: []readers.PayloadFilter{
+					{Path: "field_5.field_2", Comparator: readers.GreaterThanEqualKey, Value: 43.0},
+				},
+			},
+			page: readers.MessagesPage{
+				Messages: []readers.Message{},
+			},
+		},
+		"read message with payload filter of different type": {
+			chanID: id1,
+			pageMeta: readers.PageMetadata{
+				Format: messages1.Format,
+				Offset: 0,
+				Limit:  10,
+				PayloadFilters: []readers.PayloadFilter{
+					{Path: "field_1", Comparator: readers.LowerThanKey, Value: "200"},
+				},
+			},
+			page: readers.MessagesPage{
+				Messages: []readers.Message{},
+			},
+		},
 		"read message with protocol": {
 			chanID: id2,
 			pageMeta: readers.PageMetadata{
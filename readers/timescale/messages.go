@@ -6,6 +6,8 @@ package timescale
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"strconv"
 	"strings"
 
 	api "github.com/absmach/magistrala/api/http"
@@ -22,6 +24,9 @@ const (
 	defTable       = "messages"
 	orderByTime    = "time"
 	orderByCreated = "created"
+
+	// SenML time is stored in nanoseconds.
+	timeDivisor = 1000000000
 )
 
 var _ readers.MessageRepository = (*timescaleRepository)(nil)
@@ -47,7 +52,6 @@ func (tr timescaleRepository) ReadAll(chanID string, rpm readers.PageMetadata) (
 	isSenml := (format == defTable)
 
 	// If aggregation is provided, add time_bucket and aggregation to the query
-	const timeDivisor = 1000000000
 	isAggregated := isSenml && rpm.Aggregation != "" && rpm.Interval != ""
 
 	if rpm.Order == "" {
@@ -74,30 +78,23 @@ func (tr timescaleRepository) ReadAll(chanID string, rpm readers.PageMetadata) (
 
 	where := fmtCondition(rpm)
 
+	payloadParams := map[string]any{}
+	if !isSenml {
+		conditions, params, err := fmtPayloadConditions(rpm.PayloadFilters)
+		if err != nil {
+			return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+		}
+		where = strings.Join(append([]string{where}, conditions...), " AND ")
+		payloadParams = params
+	}
+
 	var q string
 	totalQuery := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s;`, format, where)
 
 	if isAggregated {
-		q = fmt.Sprintf(`
-			SELECT
-				EXTRACT(epoch FROM time_bucket('%s', to_timestamp(time/%d))) *%d AS time,
-				%s(value) AS value,
-				FIRST(publisher, time) AS publisher,
-				FIRST(protocol, time) AS protocol,
-				FIRST(subtopic, time) AS subtopic,
-				FIRST(name,time) AS name,
-				FIRST(unit, time) AS unit
-			FROM
-				%s
-			WHERE
-				%s
-			GROUP BY 1
-			%s
-			%s;
-			`,
-			rpm.Interval, timeDivisor, timeDivisor, rpm.Aggregation, format, where, orderClause, pgData)
-
-		totalQuery = fmt.Sprintf(`SELECT COUNT(*) FROM (SELECT EXTRACT(epoch FROM time_bucket('%s', to_timestamp(time/%d))) AS time, %s(value) AS value FROM %s WHERE %s GROUP BY 1) AS subquery;`, rpm.Interval, timeDivisor, rpm.Aggregation, format, where)
+		aggQuery := fmtAggregation(format, where, rpm)
+		q = fmt.Sprintf(`%s %s %s;`, aggQuery, orderClause, pgData)
+		totalQuery = fmt.Sprintf(`SELECT COUNT(*) FROM (%s) AS subquery;`, aggQuery)
 	} else {
 		q = fmt.Sprintf(`SELECT * FROM %s WHERE %s %s %s;`, format, where, orderClause, pgData)
 	}
@@ -116,7 +113,9 @@ func (tr timescaleRepository) ReadAll(chanID string, rpm readers.PageMetadata) (
 		"data_value":   rpm.DataValue,
 		"from":         rpm.From,
 		"to":           rpm.To,
+		"percentile":   rpm.Percentile,
 	}
+	maps.Copy(params, payloadParams)
 
 	rows, err := tr.db.NamedQuery(q, params)
 	if err != nil {
@@ -144,8 +143,12 @@ func (tr timescaleRepository) ReadAll(chanID string, rpm readers.PageMetadata) (
 			if err := rows.StructScan(&msg); err != nil {
 				return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
 			}
+			m, err := msg.toSenML()
+			if err != nil {
+				return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+			}
 
-			page.Messages = append(page.Messages, msg.Message)
+			page.Messages = append(page.Messages, m)
 		}
 	default:
 		for rows.Next() {
@@ -251,6 +254,17 @@ func fmtCondition(rpm readers.PageMetadata) string {
 type senmlMessage struct {
 	ID string `db:"id"`
 	senml.Message
+	Headers []byte `db:"headers"`
+}
+
+func (msg senmlMessage) toSenML() (senml.Message, error) {
+	if msg.Headers != nil {
+		if err := json.Unmarshal(msg.Headers, &msg.Message.Headers); err != nil {
+			return senml.Message{}, err
+		}
+	}
+
+	return msg.Message, nil
 }
 
 type jsonMessage struct {
@@ -260,6 +274,7 @@ type jsonMessage struct {
 	Publisher string `db:"publisher"`
 	Protocol  string `db:"protocol"`
 	Payload   []byte `db:"payload"`
+	Headers   []byte `db:"headers"`
 }
 
 func (msg jsonMessage) toMap() (map[string]any, error) {
@@ -276,9 +291,127 @@ func (msg jsonMessage) toMap() (map[string]any, error) {
 		return nil, err
 	}
 	ret["payload"] = pld
+	if msg.Headers != nil {
+		headers := make(map[string]string)
+		if err := json.Unmarshal(msg.Headers, &headers); err != nil {
+			return nil, err
+		}
+		ret["headers"] = headers
+	}
 	return ret, nil
 }
 
+// fmtPayloadConditions returns conditions on nested JSON payload fields
+// together with their query parameters. Field paths and values are bound as
+// parameters, so only validated path elements reach the query.
+func fmtPayloadConditions(filters []readers.PayloadFilter) ([]string, map[string]any, error) {
+	conditions := []string{}
+	params := map[string]any{}
+	for i, f := range filters {
+		elems, err := f.PathElements()
+		if err != nil {
+			return nil, nil, err
+		}
+		op, err := f.Operator()
+		if err != nil {
+			return nil, nil, err
+		}
+		val, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		path := fmt.Sprintf("payload_path_%d", i)
+		value := fmt.Sprintf("payload_value_%d", i)
+		params[path] = fmt.Sprintf("{%s}", strings.Join(elems, ","))
+		params[value] = string(val)
+
+		field := fmt.Sprintf("payload #> CAST(:%s AS TEXT[])", path)
+		cond := fmt.Sprintf("%s %s CAST(:%s AS JSONB)", field, op, value)
+		if op != "=" {
+			// JSONB values of different types are ordered by type, so restrict
+			// ordering comparison to the fields of the same type as the value.
+			cond = fmt.Sprintf("jsonb_typeof(%s) = jsonb_typeof(CAST(:%s AS JSONB)) AND %s", field, value, cond)
+		}
+		conditions = append(conditions, cond)
+	}
+
+	return conditions, params, nil
+}
+
+// fmtAggregation returns the query which groups SenML messages into time
+// buckets and applies the aggregation function to their values. Empty buckets
+// are generated using time_bucket_gapfill when fill strategy is requested.
+func fmtAggregation(format, where string, rpm readers.PageMetadata) string {
+	fill := strings.ToLower(rpm.Fill)
+	gapfill := fill != "" && fill != readers.FillNone
+
+	bucket := fmt.Sprintf("time_bucket('%s', to_timestamp(time/%d))", rpm.Interval, timeDivisor)
+	if gapfill {
+		bucket = fmt.Sprintf("time_bucket_gapfill('%s', to_timestamp(time/%d), to_timestamp(%s), to_timestamp(%s))",
+			rpm.Interval, timeDivisor, formatSeconds(rpm.From), formatSeconds(rpm.To))
+	}
+
+	value := aggregationExpr(rpm.Aggregation)
+	switch fill {
+	case readers.FillPrevious:
+		value = fmt.Sprintf("locf(%s)", value)
+	case readers.FillLinear:
+		value = fmt.Sprintf("interpolate(%s)", value)
+	}
+
+	source := format
+	if strings.EqualFold(rpm.Aggregation, readers.AggregationIntegral) {
+		// Integral needs the previous sample of the same series for the trapezoidal rule.
+		source = fmt.Sprintf(`(SELECT *,
+				LAG(value) OVER w AS prev_value,
+				LAG(time) OVER w AS prev_time
+			FROM %s
+			WHERE %s
+			WINDOW w AS (PARTITION BY publisher, subtopic, name ORDER BY time)) AS m`, format, where)
+	}
+
+	return fmt.Sprintf(`
+		SELECT
+			EXTRACT(epoch FROM %s) *%d AS time,
+			%s AS value,
+			COALESCE(FIRST(publisher, time), '') AS publisher,
+			COALESCE(FIRST(protocol, time), '') AS protocol,
+			COALESCE(FIRST(subtopic, time), '') AS subtopic,
+			COALESCE(FIRST(name, time), '') AS name,
+			COALESCE(FIRST(unit, time), '') AS unit
+		FROM
+			%s
+		WHERE
+			%s
+		GROUP BY 1`,
+		bucket, timeDivisor, value, source, where)
+}
+
+// aggregationExpr returns SQL expression which computes aggregated value of a bucket.
+func aggregationExpr(agg string) string {
+	switch strings.ToUpper(agg) {
+	case readers.AggregationPercentile:
+		return "PERCENTILE_CONT(CAST(:percentile AS DOUBLE PRECISION) / 100) WITHIN GROUP (ORDER BY value)"
+	case readers.AggregationFirst:
+		return "FIRST(value, time)"
+	case readers.AggregationLast:
+		return "LAST(value, time)"
+	case readers.AggregationStddev:
+		return "STDDEV_SAMP(value)"
+	case readers.AggregationRate:
+		return fmt.Sprintf("(LAST(value, time) - FIRST(value, time)) / NULLIF(CAST(MAX(time) - MIN(time) AS DOUBLE PRECISION) / %d, 0)", timeDivisor)
+	case readers.AggregationIntegral:
+		return fmt.Sprintf("COALESCE(SUM((value + prev_value) / 2 * CAST(time - prev_time AS DOUBLE PRECISION) / %d), 0)", timeDivisor)
+	default:
+		return fmt.Sprintf("%s(value)", agg)
+	}
+}
+
+func formatSeconds(t float64) string {
+	return strconv.FormatFloat(t/timeDivisor, 'f', -1, 64)
+}
+
 func applyOrdering(pm readers.PageMetadata, isAggregated bool, isSenml bool) string {
 	timeCol := orderByTime
 	if !isSenml {
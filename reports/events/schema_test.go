@@ -0,0 +1,61 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/reports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSchemas(t *testing.T) {
+	session := authn.Session{
+		Type:     authn.AccessToken,
+		UserID:   testsutil.GenerateUUID(t),
+		DomainID: testsutil.GenerateUUID(t),
+	}
+	cfg := reports.ReportConfig{ID: testsutil.GenerateUUID(t), Name: "report"}
+	base := newBaseReportEvent(session, testsutil.GenerateUUID(t))
+
+	cases := []struct {
+		desc  string
+		event events.Event
+	}{
+		{
+			desc:  "create report config",
+			event: createReportConfigEvent{cfg: cfg, baseReportEvent: base},
+		},
+		{
+			desc:  "remove report config",
+			event: removeReportConfigEvent{id: cfg.ID, baseReportEvent: base},
+		},
+	}
+
+	covered := make(map[string]bool)
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, s, err := schema.ValidateEvent(tc.event)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			covered[s.Operation] = true
+		})
+	}
+
+	// Role events are published by the roles manager.
+	for _, s := range schema.Schemas() {
+		op := s.Operation
+		if !strings.HasPrefix(op, reportPrefix) ||
+			strings.HasPrefix(op, reportPrefix+"role") || strings.HasPrefix(op, reportPrefix+"members") {
+			continue
+		}
+		assert.True(t, covered[op], fmt.Sprintf("expected emitted event for schema %s", op))
+	}
+}
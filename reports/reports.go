@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/mail"
+	"slices"
 	"strings"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/absmach/magistrala/pkg/roles"
 	"github.com/absmach/magistrala/pkg/schedule"
 	"github.com/absmach/magistrala/pkg/transformers/senml"
+	"github.com/absmach/magistrala/readers"
 )
 
 var (
@@ -27,6 +29,8 @@ var (
 	errInvalidToTime              = errors.New("invalid \"to time\"")
 	errAggIntervalTimeNotProvided = errors.New("aggregation interval time not provided")
 	errInvalidAggInterval         = errors.New("invalid aggregation interval time")
+	errInvalidAggPercentile       = errors.New("invalid aggregation percentile, must be between 0 and 100")
+	errInvalidAggFill             = errors.New("invalid aggregation fill")
 	errNoToEmail                  = errors.New("no \"To\" email address found")
 	errChannelIDNotProvided       = errors.New("channel id not provided")
 	errNameNotProvided            = errors.New("name not provided")
@@ -62,8 +66,10 @@ type ReportFile struct {
 }
 
 type AggConfig struct {
-	AggType  Aggregation `json:"agg_type,omitempty"` // Optional field
-	Interval string      `json:"interval,omitempty"` // Mandatory field if "AggType" field is set MAX, MIN, COUNT, SUM, AVG
+	AggType    Aggregation `json:"agg_type,omitempty"`   // Optional field
+	Interval   string      `json:"interval,omitempty"`   // Mandatory field if "AggType" field is set
+	Percentile float64     `json:"percentile,omitempty"` // Mandatory field if "AggType" field is set PERCENTILE
+	Fill       string      `json:"fill,omitempty"`       // Optional field, one of none, null, previous, linear
 }
 
 func (ac AggConfig) Validate() error {
@@ -75,6 +81,14 @@ func (ac AggConfig) Validate() error {
 		if _, err := time.ParseDuration(ac.Interval); err != nil {
 			return errInvalidAggInterval
 		}
+
+		if ac.AggType == AggregationPERCENTILE && (ac.Percentile <= 0 || ac.Percentile >= 100) {
+			return errInvalidAggPercentile
+		}
+
+		if ac.Fill != "" && !slices.Contains(readers.Fills, strings.ToLower(ac.Fill)) {
+			return errInvalidAggFill
+		}
 	}
 	return nil
 }
@@ -333,6 +347,12 @@ const (
 	AggregationSUM
 	AggregationCOUNT
 	AggregationAVG
+	AggregationPERCENTILE
+	AggregationFIRST
+	AggregationLAST
+	AggregationSTDDEV
+	AggregationRATE
+	AggregationINTEGRAL
 )
 
 const (
@@ -342,6 +362,13 @@ const (
 	aggregationSUM   = "sum"
 	aggregationCOUNT = "count"
 	aggregationAVG   = "avg"
+
+	aggregationPERCENTILE = "percentile"
+	aggregationFIRST      = "first"
+	aggregationLAST       = "last"
+	aggregationSTDDEV     = "stddev"
+	aggregationRATE       = "rate"
+	aggregationINTEGRAL   = "integral"
 )
 
 func (a Aggregation) String() string {
@@ -358,6 +385,18 @@ func (a Aggregation) String() string {
 		return aggregationCOUNT
 	case AggregationAVG:
 		return aggregationAVG
+	case AggregationPERCENTILE:
+		return aggregationPERCENTILE
+	case AggregationFIRST:
+		return aggregationFIRST
+	case AggregationLAST:
+		return aggregationLAST
+	case AggregationSTDDEV:
+		return aggregationSTDDEV
+	case AggregationRATE:
+		return aggregationRATE
+	case AggregationINTEGRAL:
+		return aggregationINTEGRAL
 	default:
 		return fmt.Sprintf(errUnknownAggregationFmt, a)
 	}
@@ -377,6 +416,18 @@ func ToAggregation(agg string) (Aggregation, error) {
 		return AggregationCOUNT, nil
 	case aggregationAVG:
 		return AggregationAVG, nil
+	case aggregationPERCENTILE:
+		return AggregationPERCENTILE, nil
+	case aggregationFIRST:
+		return AggregationFIRST, nil
+	case aggregationLAST:
+		return AggregationLAST, nil
+	case aggregationSTDDEV:
+		return AggregationSTDDEV, nil
+	case aggregationRATE:
+		return AggregationRATE, nil
+	case aggregationINTEGRAL:
+		return AggregationINTEGRAL, nil
 	default:
 		return Aggregation(0), fmt.Errorf(errUnknownAggregationStringFmt, agg)
 	}
@@ -245,6 +245,18 @@ func (r *report) generateReport(ctx context.Context, cfg ReportConfig, action Re
 		agg = grpcReadersV1.Aggregation_AGGREGATION_AVG
 	case AggregationSUM:
 		agg = grpcReadersV1.Aggregation_AGGREGATION_SUM
+	case AggregationPERCENTILE:
+		agg = grpcReadersV1.Aggregation_AGGREGATION_PERCENTILE
+	case AggregationFIRST:
+		agg = grpcReadersV1.Aggregation_AGGREGATION_FIRST
+	case AggregationLAST:
+		agg = grpcReadersV1.Aggregation_AGGREGATION_LAST
+	case AggregationSTDDEV:
+		agg = grpcReadersV1.Aggregation_AGGREGATION_STDDEV
+	case AggregationRATE:
+		agg = grpcReadersV1.Aggregation_AGGREGATION_RATE
+	case AggregationINTEGRAL:
+		agg = grpcReadersV1.Aggregation_AGGREGATION_INTEGRAL
 	}
 
 	loc, err := resolveTimezone(cfg.Config.Timezone)
@@ -278,6 +290,8 @@ func (r *report) generateReport(ctx context.Context, cfg ReportConfig, action Re
 		From:        float64(from.UnixNano()),
 		To:          float64(to.UnixNano()),
 		Interval:    cfg.Config.Aggregation.Interval,
+		Percentile:  cfg.Config.Aggregation.Percentile,
+		Fill:        cfg.Config.Aggregation.Fill,
 	}
 
 	var mets []Metric
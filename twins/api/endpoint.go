@@ -0,0 +1,98 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/twins"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func updateDesiredEndpoint(svc twins.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(updateDesiredReq)
+		if err := req.validate(); err != nil {
+			return twinRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return twinRes{}, svcerr.ErrAuthorization
+		}
+
+		twin, err := svc.UpdateDesired(ctx, session, req.clientID, req.update())
+		if err != nil {
+			return twinRes{}, err
+		}
+
+		return twinRes{Twin: twin}, nil
+	}
+}
+
+func viewTwinEndpoint(svc twins.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(twinReq)
+		if err := req.validate(); err != nil {
+			return twinRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return twinRes{}, svcerr.ErrAuthorization
+		}
+
+		twin, err := svc.ViewTwin(ctx, session, req.clientID)
+		if err != nil {
+			return twinRes{}, err
+		}
+
+		return twinRes{Twin: twin}, nil
+	}
+}
+
+func listRevisionsEndpoint(svc twins.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(listRevisionsReq)
+		if err := req.validate(); err != nil {
+			return revisionsPageRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return revisionsPageRes{}, svcerr.ErrAuthorization
+		}
+
+		page, err := svc.ListRevisions(ctx, session, req.clientID, req.PageMetadata)
+		if err != nil {
+			return revisionsPageRes{}, err
+		}
+
+		return revisionsPageRes{RevisionsPage: page}, nil
+	}
+}
+
+func removeTwinEndpoint(svc twins.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(twinReq)
+		if err := req.validate(); err != nil {
+			return removeTwinRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return removeTwinRes{}, svcerr.ErrAuthorization
+		}
+
+		if err := svc.RemoveTwin(ctx, session, req.clientID); err != nil {
+			return removeTwinRes{}, err
+		}
+
+		return removeTwinRes{}, nil
+	}
+}
@@ -0,0 +1,484 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/internal/testsutil"
+	mglog "github.com/absmach/magistrala/logger"
+	smqauthn "github.com/absmach/magistrala/pkg/authn"
+	authnmocks "github.com/absmach/magistrala/pkg/authn/mocks"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/uuid"
+	"github.com/absmach/magistrala/twins"
+	"github.com/absmach/magistrala/twins/api"
+	"github.com/absmach/magistrala/twins/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const contentType = "application/json"
+
+var (
+	domainID     = testsutil.GenerateUUID(&testing.T{})
+	userID       = testsutil.GenerateUUID(&testing.T{})
+	channelID    = testsutil.GenerateUUID(&testing.T{})
+	clientID     = testsutil.GenerateUUID(&testing.T{})
+	validToken   = "valid"
+	invalidToken = "invalid"
+	session      = smqauthn.Session{UserID: userID, DomainID: domainID}
+	twin         = twins.Twin{
+		ClientID:  clientID,
+		DomainID:  domainID,
+		ChannelID: channelID,
+		Desired:   twins.State{"state": "on"},
+		Reported:  twins.State{"state": "off"},
+		Delta:     twins.State{"state": "on"},
+		Version:   2,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+		UpdatedBy: userID,
+	}
+)
+
+type testRequest struct {
+	client      *http.Client
+	method      string
+	url         string
+	contentType string
+	token       string
+	body        io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
+
+	return tr.client.Do(req)
+}
+
+type respBody struct {
+	Err      string      `json:"error"`
+	Message  string      `json:"message"`
+	ClientID string      `json:"client_id"`
+	Version  uint64      `json:"version"`
+	Delta    twins.State `json:"delta"`
+	Total    uint64      `json:"total"`
+}
+
+func newTwinsServer() (*httptest.Server, *mocks.Service, *authnmocks.Authentication) {
+	svc := new(mocks.Service)
+	authn := new(authnmocks.Authentication)
+
+	logger := mglog.NewMock()
+	am := smqauthn.NewAuthNMiddleware(authn, smqauthn.WithAllowUnverifiedUser(true))
+	mux := api.MakeHandler(svc, logger, uuid.NewMock(), "", am)
+
+	return httptest.NewServer(mux), svc, authn
+}
+
+func decodeError(t *testing.T, desc string, res *http.Response) (respBody, error) {
+	var resBody respBody
+	if res.StatusCode == http.StatusNoContent {
+		return resBody, nil
+	}
+	err := json.NewDecoder(res.Body).Decode(&resBody)
+	assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", desc, err))
+	if resBody.Err != "" || resBody.Message != "" {
+		return resBody, errors.Wrap(errors.New(resBody.Err), errors.New(resBody.Message))
+	}
+
+	return resBody, nil
+}
+
+func TestUpdateDesiredEndpoint(t *testing.T) {
+	ts, svc, authn := newTwinsServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc        string
+		data        string
+		token       string
+		contentType string
+		authnErr    error
+		upd         twins.DesiredUpdate
+		svcErr      error
+		status      int
+		err         error
+	}{
+		{
+			desc:        "update desired state successfully",
+			data:        `{"state":{"state":"on"}}`,
+			token:       validToken,
+			contentType: contentType,
+			upd:         twins.DesiredUpdate{State: twins.State{"state": "on"}},
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "create twin with channel",
+			data:        fmt.Sprintf(`{"channel_id":"%s","state":{"state":"on"}}`, channelID),
+			token:       validToken,
+			contentType: contentType,
+			upd:         twins.DesiredUpdate{ChannelID: channelID, State: twins.State{"state": "on"}},
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "update desired state based on version",
+			data:        `{"state":{"state":"on"},"version":1}`,
+			token:       validToken,
+			contentType: contentType,
+			upd:         twins.DesiredUpdate{State: twins.State{"state": "on"}, Version: 1},
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "update desired state with invalid token",
+			data:        `{"state":{"state":"on"}}`,
+			token:       invalidToken,
+			contentType: contentType,
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "update desired state with empty token",
+			data:        `{"state":{"state":"on"}}`,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+			err:         apiutil.ErrBearerToken,
+		},
+		{
+			desc:        "update desired state with invalid content type",
+			data:        `{"state":{"state":"on"}}`,
+			token:       validToken,
+			contentType: "application/xml",
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+		{
+			desc:        "update desired state with malformed body",
+			data:        `{"state":`,
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMalformedRequestBody,
+		},
+		{
+			desc:        "update desired state without state",
+			data:        `{"version":1}`,
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingTwinState,
+		},
+		{
+			desc:        "update desired state with stale version",
+			data:        `{"state":{"state":"on"},"version":1}`,
+			token:       validToken,
+			contentType: contentType,
+			upd:         twins.DesiredUpdate{State: twins.State{"state": "on"}, Version: 1},
+			svcErr:      errors.Wrap(svcerr.ErrConflict, twins.ErrVersionConflict),
+			status:      http.StatusBadRequest,
+			err:         twins.ErrVersionConflict,
+		},
+		{
+			desc:        "create twin without channel",
+			data:        `{"state":{"state":"on"}}`,
+			token:       validToken,
+			contentType: contentType,
+			upd:         twins.DesiredUpdate{State: twins.State{"state": "on"}},
+			svcErr:      twins.ErrMissingChannel,
+			status:      http.StatusBadRequest,
+			err:         twins.ErrMissingChannel,
+		},
+		{
+			desc:        "update desired state with service error",
+			data:        `{"state":{"state":"on"}}`,
+			token:       validToken,
+			contentType: contentType,
+			upd:         twins.DesiredUpdate{State: twins.State{"state": "on"}},
+			svcErr:      svcerr.ErrUpdateEntity,
+			status:      http.StatusUnprocessableEntity,
+			err:         svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPatch,
+				url:         fmt.Sprintf("%s/%s/twins/%s/desired", ts.URL, domainID, clientID),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(tc.data),
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(session, tc.authnErr)
+			svcCall := svc.On("UpdateDesired", mock.Anything, session, clientID, tc.upd).Return(twin, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			resBody, err := decodeError(t, tc.desc, res)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				assert.Equal(t, twin.Version, resBody.Version)
+				assert.Equal(t, twin.Delta, resBody.Delta)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestViewTwinEndpoint(t *testing.T) {
+	ts, svc, authn := newTwinsServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc     string
+		clientID string
+		token    string
+		authnErr error
+		svcErr   error
+		status   int
+		err      error
+	}{
+		{
+			desc:     "view twin successfully",
+			clientID: clientID,
+			token:    validToken,
+			status:   http.StatusOK,
+		},
+		{
+			desc:     "view twin with invalid token",
+			clientID: clientID,
+			token:    invalidToken,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "view non-existing twin",
+			clientID: testsutil.GenerateUUID(t),
+			token:    validToken,
+			svcErr:   svcerr.ErrNotFound,
+			status:   http.StatusNotFound,
+			err:      svcerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ts.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/%s/twins/%s", ts.URL, domainID, tc.clientID),
+				token:  tc.token,
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(session, tc.authnErr)
+			svcCall := svc.On("ViewTwin", mock.Anything, session, tc.clientID).Return(twin, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			resBody, err := decodeError(t, tc.desc, res)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				assert.Equal(t, twin.ClientID, resBody.ClientID)
+				assert.Equal(t, twin.Version, resBody.Version)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestListRevisionsEndpoint(t *testing.T) {
+	ts, svc, authn := newTwinsServer()
+	defer ts.Close()
+
+	page := twins.RevisionsPage{
+		Total: 1,
+		Limit: 10,
+		Revisions: []twins.Revision{
+			{
+				ClientID:  clientID,
+				DomainID:  domainID,
+				Version:   1,
+				Kind:      twins.DesiredKind,
+				Patch:     twins.State{"state": "on"},
+				State:     twins.State{"state": "on"},
+				CreatedAt: time.Now().UTC(),
+				CreatedBy: userID,
+			},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		query    string
+		token    string
+		authnErr error
+		pm       twins.PageMetadata
+		svcErr   error
+		status   int
+		err      error
+	}{
+		{
+			desc:   "list revisions successfully",
+			token:  validToken,
+			pm:     twins.PageMetadata{Limit: 10, Kind: twins.AllKind, Dir: "desc"},
+			status: http.StatusOK,
+		},
+		{
+			desc:   "list revisions with filters",
+			query:  "?kind=reported&dir=asc&offset=1&limit=5",
+			token:  validToken,
+			pm:     twins.PageMetadata{Offset: 1, Limit: 5, Kind: twins.ReportedKind, Dir: "asc"},
+			status: http.StatusOK,
+		},
+		{
+			desc:     "list revisions with invalid token",
+			token:    invalidToken,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:   "list revisions with invalid kind",
+			query:  "?kind=delta",
+			token:  validToken,
+			status: http.StatusBadRequest,
+			err:    apiutil.ErrValidation,
+		},
+		{
+			desc:   "list revisions with invalid offset",
+			query:  "?offset=invalid",
+			token:  validToken,
+			status: http.StatusBadRequest,
+			err:    apiutil.ErrInvalidQueryParams,
+		},
+		{
+			desc:   "list revisions with invalid limit",
+			query:  "?limit=1000",
+			token:  validToken,
+			status: http.StatusBadRequest,
+			err:    apiutil.ErrLimitSize,
+		},
+		{
+			desc:   "list revisions with invalid direction",
+			query:  "?dir=up",
+			token:  validToken,
+			status: http.StatusBadRequest,
+			err:    apiutil.ErrInvalidDirection,
+		},
+		{
+			desc:   "list revisions with service error",
+			token:  validToken,
+			pm:     twins.PageMetadata{Limit: 10, Kind: twins.AllKind, Dir: "desc"},
+			svcErr: svcerr.ErrViewEntity,
+			status: http.StatusUnprocessableEntity,
+			err:    svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ts.Client(),
+				method: http.MethodGet,
+				url:    fmt.Sprintf("%s/%s/twins/%s/revisions%s", ts.URL, domainID, clientID, tc.query),
+				token:  tc.token,
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(session, tc.authnErr)
+			svcCall := svc.On("ListRevisions", mock.Anything, session, clientID, tc.pm).Return(page, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			resBody, err := decodeError(t, tc.desc, res)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				assert.Equal(t, page.Total, resBody.Total)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRemoveTwinEndpoint(t *testing.T) {
+	ts, svc, authn := newTwinsServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		authnErr error
+		svcErr   error
+		status   int
+		err      error
+	}{
+		{
+			desc:   "remove twin successfully",
+			token:  validToken,
+			status: http.StatusNoContent,
+		},
+		{
+			desc:     "remove twin with invalid token",
+			token:    invalidToken,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:   "remove non-existing twin",
+			token:  validToken,
+			svcErr: svcerr.ErrNotFound,
+			status: http.StatusNotFound,
+			err:    svcerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ts.Client(),
+				method: http.MethodDelete,
+				url:    fmt.Sprintf("%s/%s/twins/%s", ts.URL, domainID, clientID),
+				token:  tc.token,
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(session, tc.authnErr)
+			svcCall := svc.On("RemoveTwin", mock.Anything, session, clientID).Return(tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			_, err = decodeError(t, tc.desc, res)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	api "github.com/absmach/magistrala/api/http"
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/twins"
+)
+
+type twinReq struct {
+	clientID string
+}
+
+func (req twinReq) validate() error {
+	if req.clientID == "" {
+		return apiutil.ErrMissingClientID
+	}
+
+	return nil
+}
+
+type updateDesiredReq struct {
+	clientID  string
+	ChannelID string      `json:"channel_id,omitempty"`
+	State     twins.State `json:"state"`
+	// Version is the twin version the update is based on. The update is
+	// rejected if the twin changed in the meantime.
+	Version uint64 `json:"version,omitempty"`
+}
+
+func (req updateDesiredReq) validate() error {
+	if req.clientID == "" {
+		return apiutil.ErrMissingClientID
+	}
+	if len(req.State) == 0 {
+		return apiutil.ErrMissingTwinState
+	}
+
+	return nil
+}
+
+func (req updateDesiredReq) update() twins.DesiredUpdate {
+	return twins.DesiredUpdate{
+		ChannelID: req.ChannelID,
+		State:     req.State,
+		Version:   req.Version,
+	}
+}
+
+type listRevisionsReq struct {
+	clientID string
+	twins.PageMetadata
+}
+
+func (req listRevisionsReq) validate() error {
+	if req.clientID == "" {
+		return apiutil.ErrMissingClientID
+	}
+	if req.Limit > api.MaxLimitSize || req.Limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+	if req.Dir != api.AscDir && req.Dir != api.DescDir {
+		return apiutil.ErrInvalidDirection
+	}
+
+	return nil
+}
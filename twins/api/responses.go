@@ -0,0 +1,63 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/absmach/magistrala"
+	"github.com/absmach/magistrala/twins"
+)
+
+var (
+	_ magistrala.Response = (*twinRes)(nil)
+	_ magistrala.Response = (*revisionsPageRes)(nil)
+	_ magistrala.Response = (*removeTwinRes)(nil)
+)
+
+type twinRes struct {
+	twins.Twin `json:",inline"`
+}
+
+func (res twinRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res twinRes) Code() int {
+	return http.StatusOK
+}
+
+func (res twinRes) Empty() bool {
+	return false
+}
+
+type revisionsPageRes struct {
+	twins.RevisionsPage `json:",inline"`
+}
+
+func (res revisionsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res revisionsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res revisionsPageRes) Empty() bool {
+	return false
+}
+
+type removeTwinRes struct{}
+
+func (res removeTwinRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res removeTwinRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res removeTwinRes) Empty() bool {
+	return true
+}
@@ -0,0 +1,120 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/absmach/magistrala"
+	api "github.com/absmach/magistrala/api/http"
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	smqauthn "github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/twins"
+	"github.com/go-chi/chi/v5"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const kindKey = "kind"
+
+func MakeHandler(svc twins.Service, logger *slog.Logger, idp magistrala.IDProvider, instanceID string, authn smqauthn.AuthNMiddleware) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, api.EncodeError)),
+	}
+
+	mux := chi.NewRouter()
+
+	mux.Route("/{domainID}/twins/{clientID}", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authn.WithOptions(smqauthn.WithDomainCheck(true)).Middleware())
+			r.Use(api.RequestIDMiddleware(idp))
+
+			r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+				viewTwinEndpoint(svc),
+				decodeTwinReq,
+				api.EncodeResponse,
+				opts...,
+			), "view_twin").ServeHTTP)
+			r.Delete("/", otelhttp.NewHandler(kithttp.NewServer(
+				removeTwinEndpoint(svc),
+				decodeTwinReq,
+				api.EncodeResponse,
+				opts...,
+			), "remove_twin").ServeHTTP)
+			r.Patch("/desired", otelhttp.NewHandler(kithttp.NewServer(
+				updateDesiredEndpoint(svc),
+				decodeUpdateDesiredReq,
+				api.EncodeResponse,
+				opts...,
+			), "update_desired").ServeHTTP)
+			r.Get("/revisions", otelhttp.NewHandler(kithttp.NewServer(
+				listRevisionsEndpoint(svc),
+				decodeListRevisionsReq,
+				api.EncodeResponse,
+				opts...,
+			), "list_revisions").ServeHTTP)
+		})
+	})
+
+	mux.Get("/health", magistrala.Health("twins", instanceID))
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return mux
+}
+
+func decodeTwinReq(_ context.Context, r *http.Request) (any, error) {
+	return twinReq{clientID: chi.URLParam(r, "clientID")}, nil
+}
+
+func decodeUpdateDesiredReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return updateDesiredReq{}, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateDesiredReq{clientID: chi.URLParam(r, "clientID")}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return updateDesiredReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
+func decodeListRevisionsReq(_ context.Context, r *http.Request) (any, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return listRevisionsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return listRevisionsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	k, err := apiutil.ReadStringQuery(r, kindKey, twins.All)
+	if err != nil {
+		return listRevisionsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	kind, err := twins.ToKind(k)
+	if err != nil {
+		return listRevisionsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	dir, err := apiutil.ReadStringQuery(r, api.DirKey, api.DescDir)
+	if err != nil {
+		return listRevisionsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return listRevisionsReq{
+		clientID: chi.URLParam(r, "clientID"),
+		PageMetadata: twins.PageMetadata{
+			Offset: offset,
+			Limit:  limit,
+			Kind:   kind,
+			Dir:    dir,
+		},
+	}, nil
+}
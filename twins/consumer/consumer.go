@@ -0,0 +1,77 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/twins"
+)
+
+// ReportedTopic matches the reported state the clients publish to their
+// channels.
+var ReportedTopic = fmt.Sprintf("%c/+/%c/+/%s", messaging.MsgTopicPrefix, messaging.ChannelTopicPrefix, twins.ReportedSubtopic("+"))
+
+var (
+	errEmptyMessage  = errors.New("message is empty")
+	errFailedDecode  = errors.New("failed to decode reported state")
+	errInvalidClient = errors.New("reporting client does not match the subtopic")
+)
+
+type reportedHandler struct {
+	svc twins.Service
+}
+
+// NewReportedHandler returns the handler of the reported state the clients
+// publish. The payload is the JSON merge patch of the reported state.
+func NewReportedHandler(svc twins.Service) messaging.MessageHandler {
+	return &reportedHandler{svc: svc}
+}
+
+func (h reportedHandler) Handle(msg *messaging.Message) error {
+	if msg == nil {
+		return errEmptyMessage
+	}
+	clientID, ok := twins.ParseReportedSubtopic(msg.GetSubtopic())
+	if !ok {
+		return nil
+	}
+	if clientID != msg.ClientIdentity() {
+		return messaging.NewError(errInvalidClient, messaging.Term)
+	}
+
+	var state twins.State
+	if err := json.Unmarshal(msg.GetPayload(), &state); err != nil {
+		return messaging.NewError(errors.Wrap(errFailedDecode, err), messaging.Term)
+	}
+
+	rep := twins.Report{
+		DomainID:   msg.GetDomain(),
+		ChannelID:  msg.GetChannel(),
+		ClientID:   clientID,
+		State:      state,
+		ReceivedAt: time.Unix(0, msg.GetCreated()).UTC(),
+	}
+	if msg.GetCreated() == 0 {
+		rep.ReceivedAt = time.Now().UTC()
+	}
+
+	_, err := h.svc.UpdateReported(context.Background(), rep)
+	if errors.Contains(err, svcerr.ErrMalformedEntity) {
+		// Retrying the report does not change the outcome.
+		return messaging.NewError(err, messaging.Term)
+	}
+
+	return err
+}
+
+func (h reportedHandler) Cancel() error {
+	return nil
+}
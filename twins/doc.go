@@ -0,0 +1,7 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package twins contains domain concept definitions needed to support
+// Twins service feature, i.e. keeping the desired and the reported state of
+// the clients and publishing the state differences to the clients.
+package twins
@@ -0,0 +1,75 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package twins
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Kind is the kind of the twin state a revision updates.
+type Kind uint8
+
+const (
+	// DesiredKind is the kind of the revisions which update the state
+	// requested by the users.
+	DesiredKind Kind = iota
+	// ReportedKind is the kind of the revisions which update the state
+	// reported by the client.
+	ReportedKind
+
+	// AllKind is used for querying purposes to list revisions irrespective
+	// of their kind. It is never stored in the database as the actual
+	// Revision kind and should always be the largest value in this enumeration.
+	AllKind
+)
+
+const (
+	Desired  = "desired"
+	Reported = "reported"
+	Unknown  = "unknown"
+	All      = "all"
+)
+
+// String converts revision kind to string literal.
+func (k Kind) String() string {
+	switch k {
+	case DesiredKind:
+		return Desired
+	case ReportedKind:
+		return Reported
+	case AllKind:
+		return All
+	default:
+		return Unknown
+	}
+}
+
+// ToKind converts string value to a valid Revision kind.
+func ToKind(kind string) (Kind, error) {
+	switch strings.ToLower(kind) {
+	case Desired:
+		return DesiredKind, nil
+	case Reported:
+		return ReportedKind, nil
+	case All:
+		return AllKind, nil
+	default:
+		return Kind(0), ErrInvalidKind
+	}
+}
+
+// Custom Marshaller for Revision kind.
+func (k Kind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+// Custom Unmarshaler for Revision kind.
+func (k *Kind) UnmarshalJSON(data []byte) error {
+	str := strings.Trim(string(data), "\"")
+	val, err := ToKind(str)
+	*k = val
+
+	return err
+}
@@ -0,0 +1,110 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+
+	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/policies"
+	"github.com/absmach/magistrala/twins"
+)
+
+var (
+	errChannelPublish = errors.New("not authorized to publish to channel")
+	errClientConnect  = errors.New("client is not connected to receive twin deltas on channel")
+)
+
+type authorizationMiddleware struct {
+	svc      twins.Service
+	channels grpcChannelsV1.ChannelsServiceClient
+}
+
+var _ twins.Service = (*authorizationMiddleware)(nil)
+
+// NewAuthorizationMiddleware returns the authorization middleware. The users
+// can update the desired state of the clients connected to receive the deltas
+// on the channels the users can publish to. Viewing the twins is restricted
+// to the domain members by the authentication.
+func NewAuthorizationMiddleware(svc twins.Service, channels grpcChannelsV1.ChannelsServiceClient) twins.Service {
+	return &authorizationMiddleware{
+		svc:      svc,
+		channels: channels,
+	}
+}
+
+func (am *authorizationMiddleware) UpdateDesired(ctx context.Context, session authn.Session, clientID string, upd twins.DesiredUpdate) (twins.Twin, error) {
+	channelID := upd.ChannelID
+	if channelID == "" {
+		twin, err := am.svc.ViewTwin(ctx, session, clientID)
+		switch {
+		case err == nil:
+			channelID = twin.ChannelID
+		case errors.Contains(err, repoerr.ErrNotFound):
+			// The service rejects the new twin without the channel.
+			return am.svc.UpdateDesired(ctx, session, clientID, upd)
+		default:
+			return twins.Twin{}, err
+		}
+	}
+
+	userID := session.DomainUserID
+	if session.SuperAdmin {
+		userID = session.UserID
+	}
+	if err := am.authorize(ctx, &grpcChannelsV1.AuthzReq{
+		DomainId:   session.DomainID,
+		ClientId:   userID,
+		ClientType: policies.UserType,
+		ChannelId:  channelID,
+		Type:       uint32(connections.Publish),
+	}); err != nil {
+		return twins.Twin{}, errors.Wrap(errChannelPublish, err)
+	}
+	if err := am.authorize(ctx, &grpcChannelsV1.AuthzReq{
+		DomainId:   session.DomainID,
+		ClientId:   clientID,
+		ClientType: policies.ClientType,
+		ChannelId:  channelID,
+		Type:       uint32(connections.Subscribe),
+		Subtopic:   twins.DeltaSubtopic(clientID),
+	}); err != nil {
+		return twins.Twin{}, errors.Wrap(errClientConnect, err)
+	}
+
+	return am.svc.UpdateDesired(ctx, session, clientID, upd)
+}
+
+func (am *authorizationMiddleware) UpdateReported(ctx context.Context, rep twins.Report) (twins.Twin, error) {
+	return am.svc.UpdateReported(ctx, rep)
+}
+
+func (am *authorizationMiddleware) ViewTwin(ctx context.Context, session authn.Session, clientID string) (twins.Twin, error) {
+	return am.svc.ViewTwin(ctx, session, clientID)
+}
+
+func (am *authorizationMiddleware) ListRevisions(ctx context.Context, session authn.Session, clientID string, pm twins.PageMetadata) (twins.RevisionsPage, error) {
+	return am.svc.ListRevisions(ctx, session, clientID, pm)
+}
+
+func (am *authorizationMiddleware) RemoveTwin(ctx context.Context, session authn.Session, clientID string) error {
+	return am.svc.RemoveTwin(ctx, session, clientID)
+}
+
+func (am *authorizationMiddleware) authorize(ctx context.Context, req *grpcChannelsV1.AuthzReq) error {
+	res, err := am.channels.Authorize(ctx, req)
+	if err != nil {
+		return errors.Wrap(svcerr.ErrAuthorization, err)
+	}
+	if !res.GetAuthorized() {
+		return svcerr.ErrAuthorization
+	}
+
+	return nil
+}
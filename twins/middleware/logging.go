@@ -0,0 +1,133 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/twins"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+type loggingMiddleware struct {
+	logger  *slog.Logger
+	service twins.Service
+}
+
+var _ twins.Service = (*loggingMiddleware)(nil)
+
+func NewLoggingMiddleware(logger *slog.Logger, service twins.Service) twins.Service {
+	return &loggingMiddleware{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (lm *loggingMiddleware) UpdateDesired(ctx context.Context, session authn.Session, clientID string, upd twins.DesiredUpdate) (twin twins.Twin, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("twin",
+				slog.String("client_id", clientID),
+				slog.String("channel_id", twin.ChannelID),
+				slog.Uint64("version", twin.Version),
+				slog.Int("delta", len(twin.Delta)),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Update desired state failed", args...)
+			return
+		}
+		lm.logger.Info("Update desired state completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.UpdateDesired(ctx, session, clientID, upd)
+}
+
+func (lm *loggingMiddleware) UpdateReported(ctx context.Context, rep twins.Report) (twin twins.Twin, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Group("twin",
+				slog.String("domain_id", rep.DomainID),
+				slog.String("channel_id", rep.ChannelID),
+				slog.String("client_id", rep.ClientID),
+				slog.Uint64("version", twin.Version),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Update reported state failed", args...)
+			return
+		}
+		lm.logger.Info("Update reported state completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.UpdateReported(ctx, rep)
+}
+
+func (lm *loggingMiddleware) ViewTwin(ctx context.Context, session authn.Session, clientID string) (twin twins.Twin, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("client_id", clientID),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("View twin failed", args...)
+			return
+		}
+		lm.logger.Info("View twin completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ViewTwin(ctx, session, clientID)
+}
+
+func (lm *loggingMiddleware) ListRevisions(ctx context.Context, session authn.Session, clientID string, pm twins.PageMetadata) (page twins.RevisionsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("client_id", clientID),
+			slog.Group("page",
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("limit", pm.Limit),
+				slog.String("kind", pm.Kind.String()),
+				slog.Uint64("total", page.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("List twin revisions failed", args...)
+			return
+		}
+		lm.logger.Info("List twin revisions completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ListRevisions(ctx, session, clientID, pm)
+}
+
+func (lm *loggingMiddleware) RemoveTwin(ctx context.Context, session authn.Session, clientID string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("client_id", clientID),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Remove twin failed", args...)
+			return
+		}
+		lm.logger.Info("Remove twin completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.RemoveTwin(ctx, session, clientID)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/twins"
+	"github.com/go-kit/kit/metrics"
+)
+
+type metricsMiddleware struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	service twins.Service
+}
+
+var _ twins.Service = (*metricsMiddleware)(nil)
+
+func NewMetricsMiddleware(counter metrics.Counter, latency metrics.Histogram, service twins.Service) twins.Service {
+	return &metricsMiddleware{
+		counter: counter,
+		latency: latency,
+		service: service,
+	}
+}
+
+func (mm *metricsMiddleware) UpdateDesired(ctx context.Context, session authn.Session, clientID string, upd twins.DesiredUpdate) (twins.Twin, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "update_desired").Add(1)
+		mm.latency.With("method", "update_desired").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.UpdateDesired(ctx, session, clientID, upd)
+}
+
+func (mm *metricsMiddleware) UpdateReported(ctx context.Context, rep twins.Report) (twins.Twin, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "update_reported").Add(1)
+		mm.latency.With("method", "update_reported").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.UpdateReported(ctx, rep)
+}
+
+func (mm *metricsMiddleware) ViewTwin(ctx context.Context, session authn.Session, clientID string) (twins.Twin, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "view_twin").Add(1)
+		mm.latency.With("method", "view_twin").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ViewTwin(ctx, session, clientID)
+}
+
+func (mm *metricsMiddleware) ListRevisions(ctx context.Context, session authn.Session, clientID string, pm twins.PageMetadata) (twins.RevisionsPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "list_revisions").Add(1)
+		mm.latency.With("method", "list_revisions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ListRevisions(ctx, session, clientID, pm)
+}
+
+func (mm *metricsMiddleware) RemoveTwin(ctx context.Context, session authn.Session, clientID string) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "remove_twin").Add(1)
+		mm.latency.With("method", "remove_twin").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.RemoveTwin(ctx, session, clientID)
+}
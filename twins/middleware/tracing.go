@@ -0,0 +1,80 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/absmach/magistrala/pkg/authn"
+	smqTracing "github.com/absmach/magistrala/pkg/tracing"
+	"github.com/absmach/magistrala/twins"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type tracingMiddleware struct {
+	tracer trace.Tracer
+	svc    twins.Service
+}
+
+var _ twins.Service = (*tracingMiddleware)(nil)
+
+func NewTracingMiddleware(tracer trace.Tracer, svc twins.Service) twins.Service {
+	return &tracingMiddleware{
+		tracer: tracer,
+		svc:    svc,
+	}
+}
+
+func (tm *tracingMiddleware) UpdateDesired(ctx context.Context, session authn.Session, clientID string, upd twins.DesiredUpdate) (twins.Twin, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "update_desired", trace.WithAttributes(
+		attribute.String("client_id", clientID),
+		attribute.String("channel_id", upd.ChannelID),
+		attribute.Int64("version", int64(upd.Version)),
+	))
+	defer span.End()
+
+	return tm.svc.UpdateDesired(ctx, session, clientID, upd)
+}
+
+func (tm *tracingMiddleware) UpdateReported(ctx context.Context, rep twins.Report) (twins.Twin, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "update_reported", trace.WithAttributes(
+		attribute.String("domain_id", rep.DomainID),
+		attribute.String("channel_id", rep.ChannelID),
+		attribute.String("client_id", rep.ClientID),
+	))
+	defer span.End()
+
+	return tm.svc.UpdateReported(ctx, rep)
+}
+
+func (tm *tracingMiddleware) ViewTwin(ctx context.Context, session authn.Session, clientID string) (twins.Twin, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "view_twin", trace.WithAttributes(
+		attribute.String("client_id", clientID),
+	))
+	defer span.End()
+
+	return tm.svc.ViewTwin(ctx, session, clientID)
+}
+
+func (tm *tracingMiddleware) ListRevisions(ctx context.Context, session authn.Session, clientID string, pm twins.PageMetadata) (twins.RevisionsPage, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_revisions", trace.WithAttributes(
+		attribute.String("client_id", clientID),
+		attribute.Int64("offset", int64(pm.Offset)),
+		attribute.Int64("limit", int64(pm.Limit)),
+		attribute.String("kind", pm.Kind.String()),
+	))
+	defer span.End()
+
+	return tm.svc.ListRevisions(ctx, session, clientID, pm)
+}
+
+func (tm *tracingMiddleware) RemoveTwin(ctx context.Context, session authn.Session, clientID string) error {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "remove_twin", trace.WithAttributes(
+		attribute.String("client_id", clientID),
+	))
+	defer span.End()
+
+	return tm.svc.RemoveTwin(ctx, session, clientID)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+// Migration of Twins service.
+func Migration() *migrate.MemoryMigrationSource {
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "twins_01",
+				// VARCHAR(36) for columns with IDs as UUIDS have a maximum of 36 characters
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS twins (
+						client_id  VARCHAR(36) PRIMARY KEY,
+						domain_id  VARCHAR(36) NOT NULL,
+						channel_id VARCHAR(36) NOT NULL,
+						desired    JSONB NOT NULL DEFAULT '{}',
+						reported   JSONB NOT NULL DEFAULT '{}',
+						version    BIGINT NOT NULL CHECK (version > 0),
+						created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+						updated_at TIMESTAMPTZ,
+						updated_by VARCHAR(254)
+					)`,
+					`CREATE INDEX IF NOT EXISTS idx_twins_domain ON twins (domain_id)`,
+					`CREATE TABLE IF NOT EXISTS twin_revisions (
+						client_id  VARCHAR(36) NOT NULL REFERENCES twins (client_id) ON DELETE CASCADE,
+						domain_id  VARCHAR(36) NOT NULL,
+						version    BIGINT NOT NULL,
+						kind       SMALLINT NOT NULL CHECK (kind >= 0),
+						patch      JSONB NOT NULL,
+						state      JSONB NOT NULL,
+						created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+						created_by VARCHAR(254),
+						PRIMARY KEY (client_id, version)
+					)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS twin_revisions`,
+					`DROP TABLE IF EXISTS twins`,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/absmach/magistrala/api/http"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/postgres"
+	"github.com/absmach/magistrala/twins"
+)
+
+const (
+	twinColumns     = `client_id, domain_id, channel_id, desired, reported, version, created_at, updated_at, updated_by`
+	revisionColumns = `client_id, domain_id, version, kind, patch, state, created_at, created_by`
+)
+
+type repository struct {
+	db postgres.Database
+	eh errors.Handler
+}
+
+var _ twins.Repository = (*repository)(nil)
+
+func NewRepository(db postgres.Database) twins.Repository {
+	return &repository{
+		db: db,
+		eh: postgres.NewErrorHandler(),
+	}
+}
+
+func (repo *repository) Save(ctx context.Context, twin twins.Twin, rev twins.Revision) (twins.Twin, error) {
+	dbt, err := toDBTwin(twin)
+	if err != nil {
+		return twins.Twin{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	dbr, err := toDBRevision(rev)
+	if err != nil {
+		return twins.Twin{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+
+	// The new twin must not exist, and the updated twin must not be changed
+	// since it was retrieved. Otherwise, no row is returned.
+	q := fmt.Sprintf(`INSERT INTO twins (%s)
+		VALUES (:client_id, :domain_id, :channel_id, :desired, :reported, :version, :created_at, :updated_at, :updated_by)
+		ON CONFLICT (client_id) DO NOTHING
+		RETURNING %s;`, twinColumns, twinColumns)
+	if twin.Version > 1 {
+		q = fmt.Sprintf(`UPDATE twins SET channel_id = :channel_id, desired = :desired, reported = :reported,
+			version = :version, updated_at = :updated_at, updated_by = :updated_by
+			WHERE client_id = :client_id AND domain_id = :domain_id AND version = :version - 1
+			RETURNING %s;`, twinColumns)
+	}
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return twins.Twin{}, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	saved, err := saveTwin(tx, q, dbt)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return twins.Twin{}, errors.Wrap(errors.ErrRollbackTx, rerr)
+		}
+		return twins.Twin{}, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	rq := fmt.Sprintf(`INSERT INTO twin_revisions (%s)
+		VALUES (:client_id, :domain_id, :version, :kind, :patch, :state, :created_at, :created_by);`, revisionColumns)
+	if _, err := tx.NamedExecContext(ctx, rq, dbr); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return twins.Twin{}, errors.Wrap(errors.ErrRollbackTx, rerr)
+		}
+		return twins.Twin{}, repo.eh.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return twins.Twin{}, repo.eh.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	return toTwin(saved)
+}
+
+func saveTwin(tx postgres.Tx, q string, dbt dbTwin) (dbTwin, error) {
+	row, err := tx.NamedQuery(q, dbt)
+	if err != nil {
+		return dbTwin{}, err
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		return dbTwin{}, repoerr.ErrConflict
+	}
+
+	var saved dbTwin
+	if err := row.StructScan(&saved); err != nil {
+		return dbTwin{}, err
+	}
+
+	return saved, nil
+}
+
+func (repo *repository) RetrieveByClient(ctx context.Context, domainID, clientID string) (twins.Twin, error) {
+	q := fmt.Sprintf(`SELECT %s FROM twins WHERE client_id = :client_id AND domain_id = :domain_id;`, twinColumns)
+
+	row, err := repo.db.NamedQueryContext(ctx, q, dbTwin{ClientID: clientID, DomainID: domainID})
+	if err != nil {
+		return twins.Twin{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		return twins.Twin{}, repoerr.ErrNotFound
+	}
+
+	var dbt dbTwin
+	if err := row.StructScan(&dbt); err != nil {
+		return twins.Twin{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return toTwin(dbt)
+}
+
+func (repo *repository) RetrieveRevisions(ctx context.Context, pm twins.PageMetadata) (twins.RevisionsPage, error) {
+	query := pageQuery(pm)
+
+	dir := api.DescDir
+	if pm.Dir == api.AscDir {
+		dir = api.AscDir
+	}
+
+	q := fmt.Sprintf(`SELECT %s FROM twin_revisions %s ORDER BY version %s LIMIT :limit OFFSET :offset;`, revisionColumns, query, dir)
+	rows, err := repo.db.NamedQueryContext(ctx, q, pm)
+	if err != nil {
+		return twins.RevisionsPage{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	items := []twins.Revision{}
+	for rows.Next() {
+		var dbr dbRevision
+		if err := rows.StructScan(&dbr); err != nil {
+			return twins.RevisionsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		rev, err := toRevision(dbr)
+		if err != nil {
+			return twins.RevisionsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		items = append(items, rev)
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) AS total_count FROM twin_revisions %s;`, query)
+	total, err := postgres.Total(ctx, repo.db, cq, pm)
+	if err != nil {
+		return twins.RevisionsPage{}, repo.eh.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return twins.RevisionsPage{
+		Offset:    pm.Offset,
+		Limit:     pm.Limit,
+		Total:     total,
+		Revisions: items,
+	}, nil
+}
+
+func (repo *repository) Remove(ctx context.Context, domainID, clientID string) error {
+	q := `DELETE FROM twins WHERE client_id = :client_id AND domain_id = :domain_id;`
+
+	res, err := repo.db.NamedExecContext(ctx, q, dbTwin{ClientID: clientID, DomainID: domainID})
+	if err != nil {
+		return repo.eh.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func pageQuery(pm twins.PageMetadata) string {
+	query := []string{"domain_id = :domain_id", "client_id = :client_id"}
+	if pm.Kind != twins.AllKind {
+		query = append(query, "kind = :kind")
+	}
+
+	return fmt.Sprintf("WHERE %s", strings.Join(query, " AND "))
+}
+
+type dbTwin struct {
+	ClientID  string         `db:"client_id"`
+	DomainID  string         `db:"domain_id"`
+	ChannelID string         `db:"channel_id"`
+	Desired   []byte         `db:"desired"`
+	Reported  []byte         `db:"reported"`
+	Version   uint64         `db:"version"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt sql.NullTime   `db:"updated_at"`
+	UpdatedBy sql.NullString `db:"updated_by"`
+}
+
+type dbRevision struct {
+	ClientID  string         `db:"client_id"`
+	DomainID  string         `db:"domain_id"`
+	Version   uint64         `db:"version"`
+	Kind      uint8          `db:"kind"`
+	Patch     []byte         `db:"patch"`
+	State     []byte         `db:"state"`
+	CreatedAt time.Time      `db:"created_at"`
+	CreatedBy sql.NullString `db:"created_by"`
+}
+
+func toDBTwin(twin twins.Twin) (dbTwin, error) {
+	desired, err := toJSONB(twin.Desired)
+	if err != nil {
+		return dbTwin{}, err
+	}
+	reported, err := toJSONB(twin.Reported)
+	if err != nil {
+		return dbTwin{}, err
+	}
+
+	return dbTwin{
+		ClientID:  twin.ClientID,
+		DomainID:  twin.DomainID,
+		ChannelID: twin.ChannelID,
+		Desired:   desired,
+		Reported:  reported,
+		Version:   twin.Version,
+		CreatedAt: twin.CreatedAt,
+		UpdatedAt: toNullTime(twin.UpdatedAt),
+		UpdatedBy: toNullString(twin.UpdatedBy),
+	}, nil
+}
+
+func toTwin(dbt dbTwin) (twins.Twin, error) {
+	desired, err := fromJSONB(dbt.Desired)
+	if err != nil {
+		return twins.Twin{}, err
+	}
+	reported, err := fromJSONB(dbt.Reported)
+	if err != nil {
+		return twins.Twin{}, err
+	}
+
+	return twins.Twin{
+		ClientID:  dbt.ClientID,
+		DomainID:  dbt.DomainID,
+		ChannelID: dbt.ChannelID,
+		Desired:   desired,
+		Reported:  reported,
+		Version:   dbt.Version,
+		CreatedAt: dbt.CreatedAt.UTC(),
+		UpdatedAt: fromNullTime(dbt.UpdatedAt),
+		UpdatedBy: dbt.UpdatedBy.String,
+	}, nil
+}
+
+func toDBRevision(rev twins.Revision) (dbRevision, error) {
+	patch, err := toJSONB(rev.Patch)
+	if err != nil {
+		return dbRevision{}, err
+	}
+	state, err := toJSONB(rev.State)
+	if err != nil {
+		return dbRevision{}, err
+	}
+
+	return dbRevision{
+		ClientID:  rev.ClientID,
+		DomainID:  rev.DomainID,
+		Version:   rev.Version,
+		Kind:      uint8(rev.Kind),
+		Patch:     patch,
+		State:     state,
+		CreatedAt: rev.CreatedAt,
+		CreatedBy: toNullString(rev.CreatedBy),
+	}, nil
+}
+
+func toRevision(dbr dbRevision) (twins.Revision, error) {
+	patch, err := fromJSONB(dbr.Patch)
+	if err != nil {
+		return twins.Revision{}, err
+	}
+	state, err := fromJSONB(dbr.State)
+	if err != nil {
+		return twins.Revision{}, err
+	}
+
+	return twins.Revision{
+		ClientID:  dbr.ClientID,
+		DomainID:  dbr.DomainID,
+		Version:   dbr.Version,
+		Kind:      twins.Kind(dbr.Kind),
+		Patch:     patch,
+		State:     state,
+		CreatedAt: dbr.CreatedAt.UTC(),
+		CreatedBy: dbr.CreatedBy.String,
+	}, nil
+}
+
+// toJSONB stores the missing state as the empty JSON object.
+func toJSONB(state twins.State) ([]byte, error) {
+	if state == nil {
+		state = twins.State{}
+	}
+	return json.Marshal(state)
+}
+
+func fromJSONB(data []byte) (twins.State, error) {
+	state := twins.State{}
+	if len(data) == 0 {
+		return state, nil
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func toNullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func fromNullTime(t sql.NullTime) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time.UTC()
+}
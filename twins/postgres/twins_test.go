@@ -0,0 +1,402 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	api "github.com/absmach/magistrala/api/http"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/twins"
+	"github.com/absmach/magistrala/twins/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateTwin(t *testing.T, domainID string) (twins.Twin, twins.Revision) {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	userID := testsutil.GenerateUUID(t)
+	state := twins.State{"state": "on"}
+
+	twin := twins.Twin{
+		ClientID:  testsutil.GenerateUUID(t),
+		DomainID:  domainID,
+		ChannelID: testsutil.GenerateUUID(t),
+		Desired:   state,
+		Reported:  twins.State{},
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+		UpdatedBy: userID,
+	}
+	rev := twins.Revision{
+		ClientID:  twin.ClientID,
+		DomainID:  domainID,
+		Version:   1,
+		Kind:      twins.DesiredKind,
+		Patch:     state,
+		State:     state,
+		CreatedAt: now,
+		CreatedBy: userID,
+	}
+
+	return twin, rev
+}
+
+// nextVersion returns the twin and the revision which update the reported
+// state of the twin.
+func nextVersion(twin twins.Twin, reported string) (twins.Twin, twins.Revision) {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	state := twins.State{"state": reported}
+
+	twin.Reported = state
+	twin.Version++
+	twin.UpdatedAt = now
+	twin.UpdatedBy = ""
+	rev := twins.Revision{
+		ClientID:  twin.ClientID,
+		DomainID:  twin.DomainID,
+		Version:   twin.Version,
+		Kind:      twins.ReportedKind,
+		Patch:     state,
+		State:     state,
+		CreatedAt: now,
+	}
+
+	return twin, rev
+}
+
+func saveTwin(t *testing.T, repo twins.Repository, twin twins.Twin, rev twins.Revision) twins.Twin {
+	saved, err := repo.Save(context.Background(), twin, rev)
+	require.Nil(t, err, fmt.Sprintf("save twin unexpected error: %s", err))
+
+	return saved
+}
+
+func TestSave(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM twins")
+		require.Nil(t, err, fmt.Sprintf("clean twins unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	twin, rev := generateTwin(t, testsutil.GenerateUUID(t))
+	updated, updatedRev := nextVersion(twin, "on")
+	stale, staleRev := nextVersion(twin, "off")
+	skipped, skippedRev := nextVersion(updated, "off")
+	skipped.Version++
+	skippedRev.Version++
+	missing, missingRev := generateTwin(t, testsutil.GenerateUUID(t))
+	missing, missingRev = nextVersion(missing, "on")
+	otherDomain, otherDomainRev := nextVersion(updated, "off")
+	otherDomain.DomainID = testsutil.GenerateUUID(t)
+	otherDomainRev.DomainID = otherDomain.DomainID
+
+	cases := []struct {
+		desc string
+		twin twins.Twin
+		rev  twins.Revision
+		err  error
+	}{
+		{
+			desc: "save new twin",
+			twin: twin,
+			rev:  rev,
+			err:  nil,
+		},
+		{
+			desc: "save existing twin as new",
+			twin: twin,
+			rev:  rev,
+			err:  repoerr.ErrConflict,
+		},
+		{
+			desc: "save twin with the next version",
+			twin: updated,
+			rev:  updatedRev,
+			err:  nil,
+		},
+		{
+			desc: "save twin based on the stale version",
+			twin: stale,
+			rev:  staleRev,
+			err:  repoerr.ErrConflict,
+		},
+		{
+			desc: "save twin skipping the version",
+			twin: skipped,
+			rev:  skippedRev,
+			err:  repoerr.ErrConflict,
+		},
+		{
+			desc: "save next version of non-existing twin",
+			twin: missing,
+			rev:  missingRev,
+			err:  repoerr.ErrConflict,
+		},
+		{
+			desc: "save next version of twin from another domain",
+			twin: otherDomain,
+			rev:  otherDomainRev,
+			err:  repoerr.ErrConflict,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			saved, err := repo.Save(context.Background(), tc.twin, tc.rev)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.twin, saved, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.twin, saved))
+			}
+		})
+	}
+
+	// The rejected updates must not change the twin nor store the revision.
+	twin, err := repo.RetrieveByClient(context.Background(), updated.DomainID, updated.ClientID)
+	require.Nil(t, err, fmt.Sprintf("retrieve twin unexpected error: %s", err))
+	assert.Equal(t, updated, twin, fmt.Sprintf("expected %v got %v\n", updated, twin))
+
+	page, err := repo.RetrieveRevisions(context.Background(), twins.PageMetadata{
+		Limit:    10,
+		DomainID: updated.DomainID,
+		ClientID: updated.ClientID,
+		Kind:     twins.AllKind,
+		Dir:      api.AscDir,
+	})
+	require.Nil(t, err, fmt.Sprintf("retrieve revisions unexpected error: %s", err))
+	assert.Equal(t, []twins.Revision{rev, updatedRev}, page.Revisions, fmt.Sprintf("expected %v got %v\n", []twins.Revision{rev, updatedRev}, page.Revisions))
+}
+
+func TestRetrieveByClient(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM twins")
+		require.Nil(t, err, fmt.Sprintf("clean twins unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	twin, rev := generateTwin(t, testsutil.GenerateUUID(t))
+	twin = saveTwin(t, repo, twin, rev)
+
+	cases := []struct {
+		desc     string
+		domainID string
+		clientID string
+		twin     twins.Twin
+		err      error
+	}{
+		{
+			desc:     "retrieve twin",
+			domainID: twin.DomainID,
+			clientID: twin.ClientID,
+			twin:     twin,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve twin from another domain",
+			domainID: testsutil.GenerateUUID(t),
+			clientID: twin.ClientID,
+			err:      repoerr.ErrNotFound,
+		},
+		{
+			desc:     "retrieve non-existing twin",
+			domainID: twin.DomainID,
+			clientID: testsutil.GenerateUUID(t),
+			err:      repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			twin, err := repo.RetrieveByClient(context.Background(), tc.domainID, tc.clientID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.twin, twin, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.twin, twin))
+		})
+	}
+}
+
+func TestRetrieveRevisions(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM twins")
+		require.Nil(t, err, fmt.Sprintf("clean twins unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	twin, rev := generateTwin(t, testsutil.GenerateUUID(t))
+	twin = saveTwin(t, repo, twin, rev)
+	revs := []twins.Revision{rev}
+	for _, reported := range []string{"on", "off", "on"} {
+		var next twins.Revision
+		twin, next = nextVersion(twin, reported)
+		twin = saveTwin(t, repo, twin, next)
+		revs = append(revs, next)
+	}
+	reported := revs[1:]
+	desc := []twins.Revision{revs[3], revs[2], revs[1], revs[0]}
+
+	cases := []struct {
+		desc string
+		pm   twins.PageMetadata
+		page twins.RevisionsPage
+	}{
+		{
+			desc: "retrieve all revisions",
+			pm: twins.PageMetadata{
+				Limit:    10,
+				DomainID: twin.DomainID,
+				ClientID: twin.ClientID,
+				Kind:     twins.AllKind,
+				Dir:      api.AscDir,
+			},
+			page: twins.RevisionsPage{
+				Limit:     10,
+				Total:     uint64(len(revs)),
+				Revisions: revs,
+			},
+		},
+		{
+			desc: "retrieve all revisions in descending order",
+			pm: twins.PageMetadata{
+				Limit:    10,
+				DomainID: twin.DomainID,
+				ClientID: twin.ClientID,
+				Kind:     twins.AllKind,
+				Dir:      api.DescDir,
+			},
+			page: twins.RevisionsPage{
+				Limit:     10,
+				Total:     uint64(len(revs)),
+				Revisions: desc,
+			},
+		},
+		{
+			desc: "retrieve revisions with offset and limit",
+			pm: twins.PageMetadata{
+				Offset:   1,
+				Limit:    2,
+				DomainID: twin.DomainID,
+				ClientID: twin.ClientID,
+				Kind:     twins.AllKind,
+				Dir:      api.AscDir,
+			},
+			page: twins.RevisionsPage{
+				Offset:    1,
+				Limit:     2,
+				Total:     uint64(len(revs)),
+				Revisions: revs[1:3],
+			},
+		},
+		{
+			desc: "retrieve reported revisions",
+			pm: twins.PageMetadata{
+				Limit:    10,
+				DomainID: twin.DomainID,
+				ClientID: twin.ClientID,
+				Kind:     twins.ReportedKind,
+				Dir:      api.AscDir,
+			},
+			page: twins.RevisionsPage{
+				Limit:     10,
+				Total:     uint64(len(reported)),
+				Revisions: reported,
+			},
+		},
+		{
+			desc: "retrieve desired revisions",
+			pm: twins.PageMetadata{
+				Limit:    10,
+				DomainID: twin.DomainID,
+				ClientID: twin.ClientID,
+				Kind:     twins.DesiredKind,
+				Dir:      api.AscDir,
+			},
+			page: twins.RevisionsPage{
+				Limit:     10,
+				Total:     1,
+				Revisions: revs[:1],
+			},
+		},
+		{
+			desc: "retrieve revisions from another domain",
+			pm: twins.PageMetadata{
+				Limit:    10,
+				DomainID: testsutil.GenerateUUID(t),
+				ClientID: twin.ClientID,
+				Kind:     twins.AllKind,
+				Dir:      api.AscDir,
+			},
+			page: twins.RevisionsPage{
+				Limit:     10,
+				Revisions: []twins.Revision{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := repo.RetrieveRevisions(context.Background(), tc.pm)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.page, page, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.page, page))
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM twins")
+		require.Nil(t, err, fmt.Sprintf("clean twins unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+
+	twin, rev := generateTwin(t, testsutil.GenerateUUID(t))
+	twin = saveTwin(t, repo, twin, rev)
+
+	cases := []struct {
+		desc     string
+		domainID string
+		clientID string
+		err      error
+	}{
+		{
+			desc:     "remove twin from another domain",
+			domainID: testsutil.GenerateUUID(t),
+			clientID: twin.ClientID,
+			err:      repoerr.ErrNotFound,
+		},
+		{
+			desc:     "remove twin",
+			domainID: twin.DomainID,
+			clientID: twin.ClientID,
+			err:      nil,
+		},
+		{
+			desc:     "remove removed twin",
+			domainID: twin.DomainID,
+			clientID: twin.ClientID,
+			err:      repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.Remove(context.Background(), tc.domainID, tc.clientID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+
+	// The revisions are removed with the twin.
+	var count int
+	err := db.Get(&count, "SELECT COUNT(*) FROM twin_revisions WHERE client_id = $1", twin.ClientID)
+	require.Nil(t, err, fmt.Sprintf("count revisions unexpected error: %s", err))
+	assert.Equal(t, 0, count, fmt.Sprintf("expected no revisions got %d\n", count))
+}
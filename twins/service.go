@@ -0,0 +1,213 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package twins
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+const (
+	protocol    = "twins"
+	contentType = "application/json"
+
+	// maxAttempts is the number of attempts to apply the reported state when
+	// the twin is concurrently updated.
+	maxAttempts = 3
+)
+
+var errPublish = errors.New("failed to publish twin delta")
+
+type service struct {
+	repo Repository
+	pub  messaging.Publisher
+}
+
+var _ Service = (*service)(nil)
+
+// NewService returns a new Twins service.
+func NewService(repo Repository, pub messaging.Publisher) Service {
+	return &service{
+		repo: repo,
+		pub:  pub,
+	}
+}
+
+func (s *service) UpdateDesired(ctx context.Context, session authn.Session, clientID string, upd DesiredUpdate) (Twin, error) {
+	if len(upd.State) == 0 {
+		return Twin{}, svcerr.ErrMalformedEntity
+	}
+
+	twin, err := s.retrieve(ctx, session.DomainID, clientID, upd.ChannelID)
+	if err != nil {
+		return Twin{}, err
+	}
+	if upd.Version != 0 && upd.Version != twin.Version {
+		return Twin{}, errors.Wrap(svcerr.ErrConflict, ErrVersionConflict)
+	}
+
+	twin.Desired = Merge(twin.Desired, upd.State)
+	rev := Revision{
+		Kind:      DesiredKind,
+		Patch:     upd.State,
+		State:     twin.Desired,
+		CreatedBy: session.UserID,
+	}
+	twin, err = s.save(ctx, twin, rev)
+	if err != nil {
+		return Twin{}, err
+	}
+
+	if len(twin.Delta) > 0 {
+		if err := s.publish(ctx, twin); err != nil {
+			// The client receives the delta on the next update.
+			return Twin{}, errors.Wrap(errPublish, err)
+		}
+	}
+
+	return twin, nil
+}
+
+func (s *service) UpdateReported(ctx context.Context, rep Report) (Twin, error) {
+	if len(rep.State) == 0 {
+		return Twin{}, svcerr.ErrMalformedEntity
+	}
+
+	var err error
+	for range maxAttempts {
+		var twin Twin
+		twin, err = s.retrieve(ctx, rep.DomainID, rep.ClientID, rep.ChannelID)
+		if err != nil {
+			return Twin{}, err
+		}
+
+		twin.Reported = Merge(twin.Reported, rep.State)
+		rev := Revision{
+			Kind:      ReportedKind,
+			Patch:     rep.State,
+			State:     twin.Reported,
+			CreatedAt: rep.ReceivedAt,
+		}
+		twin, err = s.save(ctx, twin, rev)
+		if !errors.Contains(err, ErrVersionConflict) {
+			return twin, err
+		}
+	}
+
+	return Twin{}, err
+}
+
+// retrieve returns the client twin or the new twin if the client has none.
+// The channel is required for the new twin and updates the channel of the
+// existing one.
+func (s *service) retrieve(ctx context.Context, domainID, clientID, channelID string) (Twin, error) {
+	twin, err := s.repo.RetrieveByClient(ctx, domainID, clientID)
+	switch {
+	case err == nil:
+		if channelID != "" {
+			twin.ChannelID = channelID
+		}
+		return twin, nil
+	case errors.Contains(err, repoerr.ErrNotFound):
+		if channelID == "" {
+			return Twin{}, errors.Wrap(svcerr.ErrMalformedEntity, ErrMissingChannel)
+		}
+		return Twin{
+			ClientID:  clientID,
+			DomainID:  domainID,
+			ChannelID: channelID,
+			Desired:   State{},
+			Reported:  State{},
+		}, nil
+	default:
+		return Twin{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+}
+
+func (s *service) save(ctx context.Context, twin Twin, rev Revision) (Twin, error) {
+	now := time.Now().UTC()
+	twin.Version++
+	if twin.Version == 1 {
+		twin.CreatedAt = now
+	}
+	twin.UpdatedAt = now
+	twin.UpdatedBy = rev.CreatedBy
+
+	rev.ClientID = twin.ClientID
+	rev.DomainID = twin.DomainID
+	rev.Version = twin.Version
+	if rev.CreatedAt.IsZero() {
+		rev.CreatedAt = now
+	}
+
+	saved, err := s.repo.Save(ctx, twin, rev)
+	switch {
+	case err == nil:
+		saved.Delta = Delta(saved.Desired, saved.Reported)
+		return saved, nil
+	case errors.Contains(err, repoerr.ErrConflict):
+		return Twin{}, errors.Wrap(svcerr.ErrConflict, ErrVersionConflict)
+	default:
+		return Twin{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+}
+
+func (s *service) publish(ctx context.Context, twin Twin) error {
+	payload, err := json.Marshal(DeltaMessage{
+		Version: twin.Version,
+		State:   twin.Delta,
+	})
+	if err != nil {
+		return err
+	}
+
+	subtopic := DeltaSubtopic(twin.ClientID)
+	msg := &messaging.Message{
+		Domain:      twin.DomainID,
+		Channel:     twin.ChannelID,
+		Subtopic:    subtopic,
+		Protocol:    protocol,
+		Created:     twin.UpdatedAt.UnixNano(),
+		Payload:     payload,
+		ContentType: contentType,
+	}
+
+	return s.pub.Publish(ctx, messaging.EncodeTopicSuffix(twin.DomainID, twin.ChannelID, subtopic), msg)
+}
+
+func (s *service) ViewTwin(ctx context.Context, session authn.Session, clientID string) (Twin, error) {
+	twin, err := s.repo.RetrieveByClient(ctx, session.DomainID, clientID)
+	if err != nil {
+		return Twin{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	twin.Delta = Delta(twin.Desired, twin.Reported)
+
+	return twin, nil
+}
+
+func (s *service) ListRevisions(ctx context.Context, session authn.Session, clientID string, pm PageMetadata) (RevisionsPage, error) {
+	pm.DomainID = session.DomainID
+	pm.ClientID = clientID
+	page, err := s.repo.RetrieveRevisions(ctx, pm)
+	if err != nil {
+		return RevisionsPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return page, nil
+}
+
+func (s *service) RemoveTwin(ctx context.Context, session authn.Session, clientID string) error {
+	if err := s.repo.Remove(ctx, session.DomainID, clientID); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
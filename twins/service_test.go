@@ -0,0 +1,407 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package twins_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/messaging"
+	msgmocks "github.com/absmach/magistrala/pkg/messaging/mocks"
+	"github.com/absmach/magistrala/twins"
+	"github.com/absmach/magistrala/twins/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	domainID  = testsutil.GenerateUUID(&testing.T{})
+	channelID = testsutil.GenerateUUID(&testing.T{})
+	clientID  = testsutil.GenerateUUID(&testing.T{})
+	session   = authn.Session{DomainID: domainID, UserID: testsutil.GenerateUUID(&testing.T{})}
+)
+
+func newService(t *testing.T) (twins.Service, *mocks.Repository, *msgmocks.PubSub) {
+	repo := new(mocks.Repository)
+	pub := msgmocks.NewPubSub(t)
+
+	return twins.NewService(repo, pub), repo, pub
+}
+
+func saved(_ context.Context, twin twins.Twin, _ twins.Revision) twins.Twin {
+	return twin
+}
+
+func TestUpdateDesired(t *testing.T) {
+	svc, repo, pub := newService(t)
+
+	existing := twins.Twin{
+		ClientID:  clientID,
+		DomainID:  domainID,
+		ChannelID: channelID,
+		Desired:   twins.State{"fan": "on"},
+		Reported:  twins.State{"fan": "on", "speed": float64(3)},
+		Version:   2,
+	}
+
+	cases := []struct {
+		desc        string
+		upd         twins.DesiredUpdate
+		twin        twins.Twin
+		retrieveErr error
+		saveErr     error
+		publishErr  error
+		version     uint64
+		desired     twins.State
+		delta       twins.State
+		err         error
+	}{
+		{
+			desc:    "update desired state of existing twin",
+			upd:     twins.DesiredUpdate{State: twins.State{"speed": float64(5)}},
+			twin:    existing,
+			version: 3,
+			desired: twins.State{"fan": "on", "speed": float64(5)},
+			delta:   twins.State{"speed": float64(5)},
+			err:     nil,
+		},
+		{
+			desc:    "update desired state to reported state",
+			upd:     twins.DesiredUpdate{State: twins.State{"speed": float64(3)}, Version: 2},
+			twin:    existing,
+			version: 3,
+			desired: twins.State{"fan": "on", "speed": float64(3)},
+			delta:   twins.State{},
+			err:     nil,
+		},
+		{
+			desc:        "update desired state of new twin",
+			upd:         twins.DesiredUpdate{ChannelID: channelID, State: twins.State{"fan": "on"}},
+			retrieveErr: repoerr.ErrNotFound,
+			version:     1,
+			desired:     twins.State{"fan": "on"},
+			delta:       twins.State{"fan": "on"},
+			err:         nil,
+		},
+		{
+			desc:        "update desired state of new twin without channel",
+			upd:         twins.DesiredUpdate{State: twins.State{"fan": "on"}},
+			retrieveErr: repoerr.ErrNotFound,
+			err:         svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "update desired state with empty state",
+			upd:  twins.DesiredUpdate{State: twins.State{}},
+			twin: existing,
+			err:  svcerr.ErrMalformedEntity,
+		},
+		{
+			desc: "update desired state with outdated version",
+			upd:  twins.DesiredUpdate{State: twins.State{"speed": float64(5)}, Version: 1},
+			twin: existing,
+			err:  twins.ErrVersionConflict,
+		},
+		{
+			desc:    "update desired state with concurrent update",
+			upd:     twins.DesiredUpdate{State: twins.State{"speed": float64(5)}},
+			twin:    existing,
+			saveErr: repoerr.ErrConflict,
+			err:     twins.ErrVersionConflict,
+		},
+		{
+			desc:        "update desired state with failed retrieve",
+			upd:         twins.DesiredUpdate{State: twins.State{"speed": float64(5)}},
+			retrieveErr: repoerr.ErrViewEntity,
+			err:         svcerr.ErrViewEntity,
+		},
+		{
+			desc:    "update desired state with failed save",
+			upd:     twins.DesiredUpdate{State: twins.State{"speed": float64(5)}},
+			twin:    existing,
+			saveErr: repoerr.ErrUpdateEntity,
+			err:     svcerr.ErrUpdateEntity,
+		},
+		{
+			desc:       "update desired state with failed publish",
+			upd:        twins.DesiredUpdate{State: twins.State{"speed": float64(5)}},
+			twin:       existing,
+			publishErr: errors.New("publish failed"),
+			err:        errors.New("failed to publish twin delta"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var rev twins.Revision
+			var published *messaging.Message
+			retrieveCall := repo.On("RetrieveByClient", context.Background(), domainID, clientID).Return(tc.twin, tc.retrieveErr)
+			saveCall := repo.On("Save", context.Background(), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				rev = args.Get(2).(twins.Revision)
+			}).Return(saved, tc.saveErr)
+			pubCall := pub.On("Publish", context.Background(), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				published = args.Get(2).(*messaging.Message)
+			}).Return(tc.publishErr)
+
+			twin, err := svc.UpdateDesired(context.Background(), session, clientID, tc.upd)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, tc.version, twin.Version, fmt.Sprintf("%s: expected version %d got %d\n", tc.desc, tc.version, twin.Version))
+				assert.Equal(t, tc.desired, twin.Desired)
+				assert.Equal(t, tc.delta, twin.Delta)
+				assert.Equal(t, session.UserID, twin.UpdatedBy)
+				assert.Equal(t, twins.DesiredKind, rev.Kind)
+				assert.Equal(t, tc.version, rev.Version)
+				assert.Equal(t, tc.upd.State, rev.Patch)
+				assert.Equal(t, tc.desired, rev.State)
+				if len(tc.delta) > 0 {
+					assert.Equal(t, twins.DeltaSubtopic(clientID), published.GetSubtopic())
+					assert.Equal(t, channelID, published.GetChannel())
+					var msg twins.DeltaMessage
+					assert.Nil(t, json.Unmarshal(published.GetPayload(), &msg))
+					assert.Equal(t, tc.version, msg.Version)
+					assert.Equal(t, tc.delta, msg.State)
+				}
+			}
+			retrieveCall.Unset()
+			saveCall.Unset()
+			pubCall.Unset()
+		})
+	}
+}
+
+func TestUpdateReported(t *testing.T) {
+	svc, repo, _ := newService(t)
+
+	now := time.Now().UTC()
+	existing := twins.Twin{
+		ClientID:  clientID,
+		DomainID:  domainID,
+		ChannelID: channelID,
+		Desired:   twins.State{"speed": float64(5)},
+		Reported:  twins.State{"speed": float64(3)},
+		Version:   4,
+	}
+	rep := twins.Report{
+		DomainID:   domainID,
+		ChannelID:  channelID,
+		ClientID:   clientID,
+		State:      twins.State{"speed": float64(5)},
+		ReceivedAt: now,
+	}
+
+	cases := []struct {
+		desc        string
+		rep         twins.Report
+		twin        twins.Twin
+		retrieveErr error
+		saveErrs    []error
+		version     uint64
+		reported    twins.State
+		err         error
+	}{
+		{
+			desc:     "update reported state of existing twin",
+			rep:      rep,
+			twin:     existing,
+			version:  5,
+			reported: twins.State{"speed": float64(5)},
+			err:      nil,
+		},
+		{
+			desc:        "update reported state of new twin",
+			rep:         rep,
+			retrieveErr: repoerr.ErrNotFound,
+			version:     1,
+			reported:    twins.State{"speed": float64(5)},
+			err:         nil,
+		},
+		{
+			desc:     "update reported state after concurrent update",
+			rep:      rep,
+			twin:     existing,
+			saveErrs: []error{repoerr.ErrConflict},
+			version:  5,
+			reported: twins.State{"speed": float64(5)},
+			err:      nil,
+		},
+		{
+			desc:     "update reported state with repeated concurrent updates",
+			rep:      rep,
+			twin:     existing,
+			saveErrs: []error{repoerr.ErrConflict, repoerr.ErrConflict, repoerr.ErrConflict},
+			err:      twins.ErrVersionConflict,
+		},
+		{
+			desc: "update reported state with empty state",
+			rep: twins.Report{
+				DomainID:  domainID,
+				ChannelID: channelID,
+				ClientID:  clientID,
+			},
+			err: svcerr.ErrMalformedEntity,
+		},
+		{
+			desc:     "update reported state with failed save",
+			rep:      rep,
+			twin:     existing,
+			saveErrs: []error{repoerr.ErrUpdateEntity},
+			err:      svcerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var rev twins.Revision
+			attempt := 0
+			retrieveCall := repo.On("RetrieveByClient", context.Background(), domainID, clientID).Return(tc.twin, tc.retrieveErr)
+			saveCall := repo.On("Save", context.Background(), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				rev = args.Get(2).(twins.Revision)
+			}).Return(saved, func(context.Context, twins.Twin, twins.Revision) error {
+				defer func() { attempt++ }()
+				if attempt < len(tc.saveErrs) {
+					return tc.saveErrs[attempt]
+				}
+				return nil
+			})
+
+			twin, err := svc.UpdateReported(context.Background(), tc.rep)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, tc.version, twin.Version, fmt.Sprintf("%s: expected version %d got %d\n", tc.desc, tc.version, twin.Version))
+				assert.Equal(t, tc.reported, twin.Reported)
+				assert.Equal(t, channelID, twin.ChannelID)
+				assert.Equal(t, twins.ReportedKind, rev.Kind)
+				assert.Equal(t, tc.rep.ReceivedAt, rev.CreatedAt)
+				assert.Equal(t, tc.reported, rev.State)
+			}
+			retrieveCall.Unset()
+			saveCall.Unset()
+		})
+	}
+}
+
+func TestViewTwin(t *testing.T) {
+	svc, repo, _ := newService(t)
+
+	twin := twins.Twin{
+		ClientID: clientID,
+		DomainID: domainID,
+		Desired:  twins.State{"fan": "on", "speed": float64(5)},
+		Reported: twins.State{"fan": "on", "speed": float64(3)},
+		Version:  3,
+	}
+
+	cases := []struct {
+		desc    string
+		twin    twins.Twin
+		delta   twins.State
+		repoErr error
+		err     error
+	}{
+		{
+			desc:  "view twin successfully",
+			twin:  twin,
+			delta: twins.State{"speed": float64(5)},
+			err:   nil,
+		},
+		{
+			desc:    "view non-existing twin",
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("RetrieveByClient", context.Background(), domainID, clientID).Return(tc.twin, tc.repoErr)
+			twin, err := svc.ViewTwin(context.Background(), session, clientID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, tc.delta, twin.Delta, fmt.Sprintf("%s: expected delta %v got %v\n", tc.desc, tc.delta, twin.Delta))
+			}
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestListRevisions(t *testing.T) {
+	svc, repo, _ := newService(t)
+
+	page := twins.RevisionsPage{
+		Total:     1,
+		Limit:     10,
+		Revisions: []twins.Revision{{ClientID: clientID, DomainID: domainID, Version: 1}},
+	}
+
+	cases := []struct {
+		desc    string
+		pm      twins.PageMetadata
+		page    twins.RevisionsPage
+		repoErr error
+		err     error
+	}{
+		{
+			desc: "list revisions successfully",
+			pm:   twins.PageMetadata{Limit: 10, Kind: twins.AllKind},
+			page: page,
+			err:  nil,
+		},
+		{
+			desc:    "list revisions with failed retrieve",
+			pm:      twins.PageMetadata{Limit: 10, Kind: twins.DesiredKind},
+			repoErr: repoerr.ErrViewEntity,
+			err:     svcerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			pm := tc.pm
+			pm.DomainID = domainID
+			pm.ClientID = clientID
+			repoCall := repo.On("RetrieveRevisions", context.Background(), pm).Return(tc.page, tc.repoErr)
+			page, err := svc.ListRevisions(context.Background(), session, clientID, tc.pm)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.page, page, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.page, page))
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestRemoveTwin(t *testing.T) {
+	svc, repo, _ := newService(t)
+
+	cases := []struct {
+		desc    string
+		repoErr error
+		err     error
+	}{
+		{
+			desc: "remove twin successfully",
+			err:  nil,
+		},
+		{
+			desc:    "remove non-existing twin",
+			repoErr: repoerr.ErrNotFound,
+			err:     svcerr.ErrRemoveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("Remove", context.Background(), domainID, clientID).Return(tc.repoErr)
+			err := svc.RemoveTwin(context.Background(), session, clientID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			repoCall.Unset()
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package twins
+
+import "reflect"
+
+// State is the JSON object describing the client configuration.
+type State map[string]any
+
+// Merge applies the patch to the state as described by the JSON Merge Patch
+// (RFC 7386): the patch objects are merged recursively and the null values
+// remove the state keys. The state is not modified.
+func Merge(state, patch State) State {
+	ret := make(State, len(state)+len(patch))
+	for k, v := range state {
+		ret[k] = v
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(ret, k)
+			continue
+		}
+		p, ok := v.(map[string]any)
+		if !ok {
+			ret[k] = v
+			continue
+		}
+		s, _ := ret[k].(map[string]any)
+		ret[k] = map[string]any(Merge(s, p))
+	}
+
+	return ret
+}
+
+// Delta returns the part of the desired state which differs from the
+// reported state. The objects are compared recursively. The empty delta
+// means the client reached the desired state.
+func Delta(desired, reported State) State {
+	ret := State{}
+	for k, d := range desired {
+		r, ok := reported[k]
+		if !ok {
+			ret[k] = d
+			continue
+		}
+		dm, dok := d.(map[string]any)
+		rm, rok := r.(map[string]any)
+		if dok && rok {
+			if delta := Delta(dm, rm); len(delta) > 0 {
+				ret[k] = map[string]any(delta)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(d, r) {
+			ret[k] = d
+		}
+	}
+
+	return ret
+}
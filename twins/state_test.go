@@ -0,0 +1,162 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package twins_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/twins"
+	"github.com/stretchr/testify/assert"
+)
+
+func state(t *testing.T, data string) twins.State {
+	var s twins.State
+	if err := json.Unmarshal([]byte(data), &s); err != nil {
+		t.Fatalf("failed to decode state %s: %s", data, err)
+	}
+	return s
+}
+
+func TestMerge(t *testing.T) {
+	cases := []struct {
+		desc     string
+		state    string
+		patch    string
+		expected string
+	}{
+		{
+			desc:     "merge into empty state",
+			state:    `{}`,
+			patch:    `{"fan":"on","speed":3}`,
+			expected: `{"fan":"on","speed":3}`,
+		},
+		{
+			desc:     "merge replaces values",
+			state:    `{"fan":"on","speed":3}`,
+			patch:    `{"speed":5}`,
+			expected: `{"fan":"on","speed":5}`,
+		},
+		{
+			desc:     "merge removes null values",
+			state:    `{"fan":"on","speed":3}`,
+			patch:    `{"speed":null}`,
+			expected: `{"fan":"on"}`,
+		},
+		{
+			desc:     "merge nested objects",
+			state:    `{"led":{"color":"red","level":10},"fan":"on"}`,
+			patch:    `{"led":{"level":20,"color":null,"blink":true}}`,
+			expected: `{"led":{"level":20,"blink":true},"fan":"on"}`,
+		},
+		{
+			desc:     "merge replaces arrays",
+			state:    `{"modes":["a","b"]}`,
+			patch:    `{"modes":["c"]}`,
+			expected: `{"modes":["c"]}`,
+		},
+		{
+			desc:     "merge object over value",
+			state:    `{"led":"off"}`,
+			patch:    `{"led":{"level":20,"color":null}}`,
+			expected: `{"led":{"level":20}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			s := state(t, tc.state)
+			merged := twins.Merge(s, state(t, tc.patch))
+			assert.Equal(t, state(t, tc.expected), merged, fmt.Sprintf("%s: expected %s got %v\n", tc.desc, tc.expected, merged))
+			assert.Equal(t, state(t, tc.state), s, fmt.Sprintf("%s: state modified\n", tc.desc))
+		})
+	}
+}
+
+func TestDelta(t *testing.T) {
+	cases := []struct {
+		desc     string
+		desired  string
+		reported string
+		expected string
+	}{
+		{
+			desc:     "delta of reached state",
+			desired:  `{"fan":"on","led":{"level":20}}`,
+			reported: `{"fan":"on","led":{"level":20},"temp":21.5}`,
+			expected: `{}`,
+		},
+		{
+			desc:     "delta of missing value",
+			desired:  `{"fan":"on","speed":3}`,
+			reported: `{"fan":"on"}`,
+			expected: `{"speed":3}`,
+		},
+		{
+			desc:     "delta of different value",
+			desired:  `{"fan":"on","speed":3}`,
+			reported: `{"fan":"off","speed":3}`,
+			expected: `{"fan":"on"}`,
+		},
+		{
+			desc:     "delta of nested objects",
+			desired:  `{"led":{"color":"red","level":20}}`,
+			reported: `{"led":{"color":"red","level":10}}`,
+			expected: `{"led":{"level":20}}`,
+		},
+		{
+			desc:     "delta of arrays",
+			desired:  `{"modes":["a","b"]}`,
+			reported: `{"modes":["a"]}`,
+			expected: `{"modes":["a","b"]}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			delta := twins.Delta(state(t, tc.desired), state(t, tc.reported))
+			assert.Equal(t, state(t, tc.expected), delta, fmt.Sprintf("%s: expected %s got %v\n", tc.desc, tc.expected, delta))
+		})
+	}
+}
+
+func TestParseReportedSubtopic(t *testing.T) {
+	cases := []struct {
+		desc     string
+		subtopic string
+		clientID string
+		ok       bool
+	}{
+		{
+			desc:     "parse reported subtopic",
+			subtopic: twins.ReportedSubtopic("client"),
+			clientID: "client",
+			ok:       true,
+		},
+		{
+			desc:     "parse delta subtopic",
+			subtopic: twins.DeltaSubtopic("client"),
+			ok:       false,
+		},
+		{
+			desc:     "parse subtopic without client",
+			subtopic: "twin//reported",
+			ok:       false,
+		},
+		{
+			desc:     "parse other subtopic",
+			subtopic: "commands/client/res/id",
+			ok:       false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			clientID, ok := twins.ParseReportedSubtopic(tc.subtopic)
+			assert.Equal(t, tc.ok, ok, fmt.Sprintf("%s: expected %t got %t\n", tc.desc, tc.ok, ok))
+			assert.Equal(t, tc.clientID, clientID, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.clientID, clientID))
+		})
+	}
+}
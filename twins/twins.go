@@ -0,0 +1,165 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package twins
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+const (
+	// SubtopicPrefix is the first level of the twin subtopics of the client
+	// channel.
+	SubtopicPrefix = "twin"
+
+	deltaLevel    = "delta"
+	reportedLevel = "reported"
+)
+
+var (
+	// ErrVersionConflict indicates the twin was updated after the version the
+	// update is based on.
+	ErrVersionConflict = errors.NewRequestError("twin version conflict")
+
+	// ErrMissingChannel indicates the new twin without the channel the
+	// deltas are published to.
+	ErrMissingChannel = errors.NewRequestError("missing twin channel id")
+
+	// ErrInvalidKind indicates an invalid revision kind.
+	ErrInvalidKind = errors.New("invalid revision kind")
+)
+
+// Twin is the desired and the reported state of the client. The desired state
+// is set by the users and the reported state is published by the client.
+type Twin struct {
+	ClientID  string    `json:"client_id"`
+	DomainID  string    `json:"domain_id"`
+	ChannelID string    `json:"channel_id"`
+	Desired   State     `json:"desired"`
+	Reported  State     `json:"reported"`
+	Delta     State     `json:"delta"`
+	Version   uint64    `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedBy string    `json:"updated_by,omitempty"`
+}
+
+// Revision is a single update of the twin state. The state is the whole
+// desired or reported state after the patch is applied.
+type Revision struct {
+	ClientID  string    `json:"client_id"`
+	DomainID  string    `json:"domain_id"`
+	Version   uint64    `json:"version"`
+	Kind      Kind      `json:"kind"`
+	Patch     State     `json:"patch"`
+	State     State     `json:"state"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by,omitempty"`
+}
+
+type RevisionsPage struct {
+	Offset    uint64     `json:"offset"`
+	Limit     uint64     `json:"limit"`
+	Total     uint64     `json:"total"`
+	Revisions []Revision `json:"revisions"`
+}
+
+type PageMetadata struct {
+	Offset   uint64 `json:"offset"    db:"offset"`
+	Limit    uint64 `json:"limit"     db:"limit"`
+	DomainID string `json:"domain_id" db:"domain_id"`
+	ClientID string `json:"client_id" db:"client_id"`
+	Kind     Kind   `json:"kind"      db:"kind"`
+	Dir      string `json:"dir"       db:"dir"`
+}
+
+// DesiredUpdate is the patch of the desired state. The update is rejected if
+// the version is set and the twin version differs. The channel is required
+// when the twin is created and sets the channel the deltas are published to.
+type DesiredUpdate struct {
+	ChannelID string
+	State     State
+	Version   uint64
+}
+
+// Report is the reported state patch the client published.
+type Report struct {
+	DomainID   string
+	ChannelID  string
+	ClientID   string
+	State      State
+	ReceivedAt time.Time
+}
+
+// DeltaMessage is the message payload the client receives when the desired
+// state differs from the reported state.
+type DeltaMessage struct {
+	Version uint64 `json:"version"`
+	State   State  `json:"state"`
+}
+
+// DeltaSubtopic returns the subtopic the client receives the state deltas on.
+func DeltaSubtopic(clientID string) string {
+	return fmt.Sprintf("%s/%s/%s", SubtopicPrefix, clientID, deltaLevel)
+}
+
+// ReportedSubtopic returns the subtopic the client publishes the reported
+// state to.
+func ReportedSubtopic(clientID string) string {
+	return fmt.Sprintf("%s/%s/%s", SubtopicPrefix, clientID, reportedLevel)
+}
+
+// ParseReportedSubtopic returns the client ID of the reported state subtopic.
+func ParseReportedSubtopic(subtopic string) (clientID string, ok bool) {
+	levels := strings.Split(subtopic, "/")
+	if len(levels) != 3 || levels[0] != SubtopicPrefix || levels[2] != reportedLevel || levels[1] == "" {
+		return "", false
+	}
+
+	return levels[1], true
+}
+
+// Service specifies an API that must be fulfilled by the domain service
+// implementation, and all of its decorators (e.g. logging & metrics).
+type Service interface {
+	// UpdateDesired applies the patch to the client desired state and
+	// publishes the delta to the client.
+	UpdateDesired(ctx context.Context, session authn.Session, clientID string, upd DesiredUpdate) (Twin, error)
+
+	// UpdateReported applies the patch the client published to its reported
+	// state.
+	UpdateReported(ctx context.Context, rep Report) (Twin, error)
+
+	// ViewTwin retrieves the client twin.
+	ViewTwin(ctx context.Context, session authn.Session, clientID string) (Twin, error)
+
+	// ListRevisions retrieves the client twin state history.
+	ListRevisions(ctx context.Context, session authn.Session, clientID string, pm PageMetadata) (RevisionsPage, error)
+
+	// RemoveTwin removes the client twin and its history.
+	RemoveTwin(ctx context.Context, session authn.Session, clientID string) error
+}
+
+// Repository specifies a twin persistence API.
+type Repository interface {
+	// Save stores the twin and the revision which produced it. The twin with
+	// the version 1 is created, and the others update the twin with the
+	// previous version. If the twin was changed in the meantime, the
+	// repository returns the conflict error.
+	Save(ctx context.Context, twin Twin, rev Revision) (Twin, error)
+
+	// RetrieveByClient retrieves the client twin.
+	RetrieveByClient(ctx context.Context, domainID, clientID string) (Twin, error)
+
+	// RetrieveRevisions retrieves the client twin revisions.
+	RetrieveRevisions(ctx context.Context, pm PageMetadata) (RevisionsPage, error)
+
+	// Remove removes the client twin and its revisions.
+	Remove(ctx context.Context, domainID, clientID string) error
+}
@@ -0,0 +1,179 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/schema"
+	"github.com/absmach/magistrala/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSchemas(t *testing.T) {
+	now := time.Now().UTC()
+	session := authn.Session{
+		Type:       authn.AccessToken,
+		UserID:     testsutil.GenerateUUID(t),
+		SuperAdmin: true,
+	}
+	user := users.User{
+		ID:              testsutil.GenerateUUID(t),
+		FirstName:       "first",
+		LastName:        "last",
+		Tags:            []string{"tag"},
+		Metadata:        users.Metadata{"key": "value"},
+		PrivateMetadata: users.Metadata{"key": "value"},
+		Status:          users.EnabledStatus,
+		Role:            users.UserRole,
+		ProfilePicture:  "https://example.com/picture.png",
+		Credentials:     users.Credentials{Username: "username"},
+		Email:           "user@example.com",
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		UpdatedBy:       session.UserID,
+	}
+	page := users.Page{
+		Total:     1,
+		Limit:     10,
+		Order:     "name",
+		Dir:       "asc",
+		Metadata:  users.Metadata{"key": "value"},
+		Tags:      users.TagsQuery{Elements: []string{"tag"}},
+		Status:    users.EnabledStatus,
+		Username:  "username",
+		FirstName: "first",
+		LastName:  "last",
+		Email:     "user@example.com",
+	}
+	requestID := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc  string
+		event events.Event
+	}{
+		{
+			desc:  "create user",
+			event: createUserEvent{User: user, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "send verification",
+			event: sendVerificationEvent{Session: session, requestID: requestID},
+		},
+		{
+			desc:  "verify email",
+			event: verifyEmailEvent{email: user.Email, userID: user.ID, verifiedAt: now, requestID: requestID},
+		},
+		{
+			desc:  "update user",
+			event: updateUserEvent{User: user, operation: userUpdate, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update user role",
+			event: updateUserEvent{User: user, operation: userUpdateRole, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update user tags",
+			event: updateUserEvent{User: user, operation: userUpdateTags, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update user secret",
+			event: updateUserEvent{User: user, operation: userUpdateSecret, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update user email",
+			event: updateUserEvent{User: user, operation: userUpdateEmail, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update username",
+			event: updateUsernameEvent{User: user, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "update profile picture",
+			event: updateProfilePictureEvent{User: user, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "enable user",
+			event: changeUserStatusEvent{id: user.ID, operation: userEnable, status: users.EnabledStatus.String(), updatedAt: now, updatedBy: session.UserID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "disable user",
+			event: changeUserStatusEvent{id: user.ID, operation: userDisable, status: users.DisabledStatus.String(), updatedAt: now, updatedBy: session.UserID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "view user",
+			event: viewUserEvent{User: user, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "view profile",
+			event: viewProfileEvent{User: user, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "list users",
+			event: listUserEvent{Page: page, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "search users",
+			event: searchUserEvent{Page: page, requestID: requestID},
+		},
+		{
+			desc:  "identify user",
+			event: identifyUserEvent{userID: user.ID, requestID: requestID},
+		},
+		{
+			desc:  "issue token",
+			event: issueTokenEvent{username: "username", requestID: requestID},
+		},
+		{
+			desc:  "refresh token",
+			event: refreshTokenEvent{requestID: requestID},
+		},
+		{
+			desc:  "revoke refresh token",
+			event: revokeRefreshTokenEvent{tokenID: testsutil.GenerateUUID(t), requestID: requestID},
+		},
+		{
+			desc:  "reset secret",
+			event: resetSecretEvent{requestID: requestID},
+		},
+		{
+			desc:  "send password reset",
+			event: sendPasswordResetEvent{host: "https://example.com", email: user.Email, user: "username", requestID: requestID},
+		},
+		{
+			desc:  "oauth callback",
+			event: oauthCallbackEvent{userID: user.ID, requestID: requestID},
+		},
+		{
+			desc:  "delete user",
+			event: deleteUserEvent{id: user.ID, Session: session, requestID: requestID},
+		},
+		{
+			desc:  "add user policy",
+			event: addUserPolicyEvent{id: user.ID, role: users.AdminRole.String(), Session: session, requestID: requestID},
+		},
+	}
+
+	covered := make(map[string]bool)
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, s, err := schema.ValidateEvent(tc.event)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			covered[s.Operation] = true
+		})
+	}
+
+	for _, s := range schema.Schemas() {
+		if strings.HasPrefix(s.Operation, userPrefix) {
+			assert.True(t, covered[s.Operation], fmt.Sprintf("expected emitted event for schema %s", s.Operation))
+		}
+	}
+}